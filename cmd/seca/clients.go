@@ -0,0 +1,98 @@
+package main
+
+import (
+	"context"
+	"errors"
+
+	"github.com/eu-sovereign-cloud/go-sdk/secapi"
+	"github.com/eu-sovereign-cloud/go-sdk/secapi/config"
+)
+
+var ErrNoRegion = errors.New("no region selected, set --region, SECA_REGION or the region of the profile")
+
+// Creates the global and the regional clients on first use
+type clients struct {
+	config *config.Config
+
+	global   *secapi.GlobalClient
+	regional *secapi.RegionalClient
+}
+
+func (c *clients) globalClient(ctx context.Context) (*secapi.GlobalClient, error) {
+	if c.global != nil {
+		return c.global, nil
+	}
+
+	global, err := c.config.NewGlobalClient(ctx)
+	if err != nil {
+		return nil, err
+	}
+	c.global = global
+	return global, nil
+}
+
+func (c *clients) regionalClient(ctx context.Context) (*secapi.RegionalClient, error) {
+	if c.regional != nil {
+		return c.regional, nil
+	}
+	if c.config.Region == "" {
+		return nil, ErrNoRegion
+	}
+
+	global, err := c.globalClient(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	regional, err := global.NewRegionalClient(ctx, c.config.Region)
+	if err != nil {
+		return nil, err
+	}
+	c.regional = regional
+	return regional, nil
+}
+
+// Path of the selected resources, from the flags or the profile
+type selection struct {
+	tenant    string
+	workspace string
+	network   string
+}
+
+func (s *selection) tenantPath() secapi.TenantPath {
+	return secapi.TenantPath{Tenant: secapi.TenantID(s.tenant)}
+}
+
+func (s *selection) workspacePath() secapi.WorkspacePath {
+	return secapi.WorkspacePath{Tenant: secapi.TenantID(s.tenant), Workspace: secapi.WorkspaceID(s.workspace)}
+}
+
+func (s *selection) networkPath() secapi.NetworkPath {
+	return secapi.NetworkPath{Tenant: secapi.TenantID(s.tenant), Workspace: secapi.WorkspaceID(s.workspace), Network: secapi.NetworkID(s.network)}
+}
+
+func (s *selection) tenantReference(name string) secapi.TenantReference {
+	return secapi.TenantReference{Tenant: secapi.TenantID(s.tenant), Name: name}
+}
+
+func (s *selection) workspaceReference(name string) secapi.WorkspaceReference {
+	return secapi.WorkspaceReference{Tenant: secapi.TenantID(s.tenant), Workspace: secapi.WorkspaceID(s.workspace), Name: name}
+}
+
+func (s *selection) networkReference(name string) secapi.NetworkReference {
+	return secapi.NetworkReference{Tenant: secapi.TenantID(s.tenant), Workspace: secapi.WorkspaceID(s.workspace), Network: secapi.NetworkID(s.network), Name: name}
+}
+
+// Checks the selection holds the path of the resources of the scope
+func (s *selection) validate(sc scope) error {
+	switch {
+	case sc >= scopeTenant && s.tenant == "":
+		return errors.New("no tenant selected, set --tenant, SECA_TENANT or the tenant of the profile")
+	case sc >= scopeWorkspace && s.workspace == "":
+		return errors.New("no workspace selected, set --workspace, SECA_WORKSPACE or the workspace of the profile")
+	case sc >= scopeNetwork && s.network == "":
+		return errors.New("no network selected, set --network")
+	default:
+		return nil
+	}
+}
@@ -0,0 +1,533 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"flag"
+	"fmt"
+	"math"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/eu-sovereign-cloud/go-sdk/pkg/spec/schema"
+	"github.com/eu-sovereign-cloud/go-sdk/secapi"
+	"github.com/eu-sovereign-cloud/go-sdk/secapi/builders"
+	"github.com/eu-sovereign-cloud/go-sdk/secapi/manifest"
+	"github.com/eu-sovereign-cloud/go-sdk/secapi/plan"
+)
+
+const defaultInterval = 2 * time.Second
+
+// States of the resources, the watch waits for any state but the current one
+var resourceStates = []schema.ResourceState{
+	schema.ResourceStatePending,
+	schema.ResourceStateCreating,
+	schema.ResourceStateActive,
+	schema.ResourceStateUpdating,
+	schema.ResourceStateDeleting,
+	schema.ResourceStateError,
+}
+
+type command struct {
+	name    string
+	usage   string
+	summary string
+
+	flags func(fs *flag.FlagSet, opts *options)
+	run   func(ctx context.Context, e *env, args []string) error
+}
+
+var commands = []*command{
+	{
+		name:    "get",
+		usage:   "get <kind> <name> [flags]",
+		summary: "Shows a resource",
+		flags: func(fs *flag.FlagSet, opts *options) {
+			outputFlags(fs, opts)
+			watchFlags(fs, opts)
+		},
+		run: runGet,
+	},
+	{
+		name:    "list",
+		usage:   "list <kind> [flags]",
+		summary: "Lists the resources of a kind",
+		flags: func(fs *flag.FlagSet, opts *options) {
+			outputFlags(fs, opts)
+			watchFlags(fs, opts)
+			fs.StringVar(&opts.selector, "l", "", "label selector, as env=prod,tier!=web,version>=2")
+		},
+		run: runList,
+	},
+	{
+		name:    "apply",
+		usage:   "apply -f <manifest> [flags]",
+		summary: "Creates or updates the resources of manifests",
+		flags: func(fs *flag.FlagSet, opts *options) {
+			fs.Var(&opts.files, "f", "manifest file, - reads the standard input, repeatable")
+			fs.BoolVar(&opts.dryRun, "dry-run", false, "prints the plan of the changes without applying them")
+			fs.BoolVar(&opts.wait, "wait", false, "waits for every resource to be active")
+			fs.DurationVar(&opts.interval, "interval", defaultInterval, "interval between the checks of the wait")
+		},
+		run: runApply,
+	},
+	{
+		name:    "delete",
+		usage:   "delete <kind> <name> | -f <manifest> [flags]",
+		summary: "Deletes resources",
+		flags: func(fs *flag.FlagSet, opts *options) {
+			fs.Var(&opts.files, "f", "manifest file, - reads the standard input, repeatable")
+			fs.BoolVar(&opts.wait, "wait", false, "waits for every resource to be deleted")
+			fs.DurationVar(&opts.interval, "interval", defaultInterval, "interval between the checks of the wait")
+		},
+		run: runDelete,
+	},
+	{
+		name:    "wait",
+		usage:   "wait <kind> <name> [flags]",
+		summary: "Waits for a resource to reach a state",
+		flags: func(fs *flag.FlagSet, opts *options) {
+			outputFlags(fs, opts)
+			fs.StringVar(&opts.until, "for", "state=active", "condition to wait for: state=<states>, power=<states> or deleted, the states are comma separated")
+			fs.DurationVar(&opts.interval, "interval", defaultInterval, "interval between the checks")
+		},
+		run: runWait,
+	},
+}
+
+func findCommand(name string) *command {
+	for _, cmd := range commands {
+		if cmd.name == name {
+			return cmd
+		}
+	}
+	return nil
+}
+
+func outputFlags(fs *flag.FlagSet, opts *options) {
+	fs.StringVar(&opts.output, "o", outputTable, "output format: "+strings.Join(outputs, ", "))
+}
+
+func watchFlags(fs *flag.FlagSet, opts *options) {
+	fs.BoolVar(&opts.watch, "watch", false, "prints the resources again on every change, until interrupted")
+	fs.DurationVar(&opts.interval, "interval", defaultInterval, "interval between the checks of the watch")
+}
+
+// Get
+
+func runGet(ctx context.Context, e *env, args []string) error {
+	k, name, err := kindAndName(args, e.selection)
+	if err != nil {
+		return err
+	}
+
+	resource, err := k.get(ctx, e.clients, e.selection, name)
+	if err != nil {
+		return err
+	}
+
+	p := &printer{w: e.stdout, format: e.options.output}
+	if err := p.print(true, resource); err != nil {
+		return err
+	}
+	if !e.options.watch {
+		return nil
+	}
+
+	return watchResource(ctx, e, p, k, name, resource)
+}
+
+// Prints the resource on every change of its state, until it is deleted or the context is done
+func watchResource(ctx context.Context, e *env, p *printer, k *kind, name string, resource any) error {
+	if k.waitState == nil {
+		return fmt.Errorf("the %s resources have no state to watch", k.name)
+	}
+
+	for {
+		document, err := toDocument(resource)
+		if err != nil {
+			return err
+		}
+		current := schema.ResourceState(field(document, "status", "state"))
+
+		next, err := k.waitState(ctx, e.clients, e.selection, name, secapi.ResourceObserverUntilValueConfig[schema.ResourceState]{
+			ExpectedValues: slices.DeleteFunc(slices.Clone(resourceStates), func(state schema.ResourceState) bool { return state == current }),
+			Interval:       e.options.interval,
+			MaxAttempts:    math.MaxInt32,
+		})
+		switch {
+		case ctx.Err() != nil:
+			return nil
+		case errors.Is(err, secapi.ErrResourceNotFound):
+			fmt.Fprintf(e.stdout, "%s/%s deleted\n", k.name, name)
+			return nil
+		case errors.Is(err, secapi.ErrRetryNotFoundExpectedValue), errors.Is(err, secapi.ErrRetryMaxAttemptsReached):
+			// Unchanged during the whole observation
+			continue
+		case err != nil:
+			return err
+		}
+
+		if err := p.print(true, next); err != nil {
+			return err
+		}
+		resource = next
+	}
+}
+
+// List
+
+func runList(ctx context.Context, e *env, args []string) error {
+	if len(args) != 1 {
+		return fmt.Errorf("expected a kind, got %d arguments", len(args))
+	}
+	k, err := findKind(args[0])
+	if err != nil {
+		return err
+	}
+	if k.list == nil {
+		return fmt.Errorf("the %s resources can't be listed", k.name)
+	}
+	if err := e.selection.validate(k.scope); err != nil {
+		return err
+	}
+
+	labels, err := builders.ParseLabels(e.options.selector)
+	if err != nil {
+		return err
+	}
+	options := secapi.NewListOptions().WithLabels(labels)
+
+	resources, err := k.list(ctx, e.clients, e.selection, options)
+	if err != nil {
+		return err
+	}
+
+	p := &printer{w: e.stdout, format: e.options.output}
+	if err := p.print(false, resources...); err != nil {
+		return err
+	}
+	if !e.options.watch {
+		return nil
+	}
+
+	return watchList(ctx, e, p, k, options, resources)
+}
+
+// Lists the resources again at each interval and prints the changed and the deleted ones,
+// until the context is done
+func watchList(ctx context.Context, e *env, p *printer, k *kind, options *secapi.ListOptions, resources []any) error {
+	revisions, err := listRevisions(resources)
+	if err != nil {
+		return err
+	}
+
+	ticker := time.NewTicker(e.options.interval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-ticker.C:
+		}
+
+		resources, err := k.list(ctx, e.clients, e.selection, options)
+		if ctx.Err() != nil {
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+
+		current, err := listRevisions(resources)
+		if err != nil {
+			return err
+		}
+
+		var changed []any
+		for i, resource := range resources {
+			name := current.names[i]
+			if revisions.byName[name] != current.byName[name] {
+				changed = append(changed, resource)
+			}
+		}
+		if err := p.print(false, changed...); err != nil {
+			return err
+		}
+
+		for _, name := range revisions.names {
+			if _, found := current.byName[name]; !found {
+				fmt.Fprintf(e.stdout, "%s/%s deleted\n", k.name, name)
+			}
+		}
+
+		revisions = current
+	}
+}
+
+type revisions struct {
+	names  []string
+	byName map[string]string
+}
+
+// Returns the version and the state of each resource, by name
+func listRevisions(resources []any) (*revisions, error) {
+	r := &revisions{byName: map[string]string{}}
+	for _, resource := range resources {
+		document, err := toDocument(resource)
+		if err != nil {
+			return nil, err
+		}
+
+		name := field(document, "metadata", "name")
+		r.names = append(r.names, name)
+		r.byName[name] = field(document, "metadata", "resourceVersion") + "/" + field(document, "status", "state")
+	}
+	return r, nil
+}
+
+// Apply
+
+func runApply(ctx context.Context, e *env, args []string) error {
+	if len(args) > 0 {
+		return fmt.Errorf("unexpected arguments %s, the resources are read from the manifests", strings.Join(args, " "))
+	}
+
+	resources, err := decodeFiles(e.options.files)
+	if err != nil {
+		return err
+	}
+
+	if e.options.dryRun {
+		global, err := e.clients.globalClient(ctx)
+		if err != nil {
+			return err
+		}
+		regional, err := e.clients.regionalClient(ctx)
+		if err != nil {
+			return err
+		}
+
+		changes, err := plan.NewPlanner(global, regional).Plan(ctx, resources)
+		if err != nil {
+			return err
+		}
+		fmt.Fprint(e.stdout, changes.String())
+		return nil
+	}
+
+	for _, resource := range resources {
+		k, err := findResourceKind(resource)
+		if err != nil {
+			return err
+		}
+		if !k.writable() {
+			return fmt.Errorf("the %s resources can't be applied", k.name)
+		}
+
+		applied, err := k.apply(ctx, e.clients, resource)
+		if err != nil {
+			return err
+		}
+		fmt.Fprintf(e.stdout, "%s/%s applied\n", k.name, resourceName(applied))
+	}
+
+	if !e.options.wait {
+		return nil
+	}
+	for _, resource := range resources {
+		k, _ := findResourceKind(resource)
+		s, name := resourceSelection(resource)
+
+		if _, err := k.waitState(ctx, e.clients, s, name, secapi.ResourceObserverUntilValueConfig[schema.ResourceState]{
+			ExpectedValues: []schema.ResourceState{schema.ResourceStateActive},
+			Interval:       e.options.interval,
+			MaxAttempts:    math.MaxInt32,
+		}); err != nil {
+			return fmt.Errorf("waiting for %s/%s: %w", k.name, name, err)
+		}
+		fmt.Fprintf(e.stdout, "%s/%s active\n", k.name, name)
+	}
+	return nil
+}
+
+// Delete
+
+func runDelete(ctx context.Context, e *env, args []string) error {
+	var resources []any
+	switch {
+	case len(e.options.files) > 0 && len(args) > 0:
+		return fmt.Errorf("expected either a kind and a name or manifests")
+
+	case len(e.options.files) > 0:
+		decoded, err := decodeFiles(e.options.files)
+		if err != nil {
+			return err
+		}
+		// Deletes the dependents before the resources they depend on
+		slices.Reverse(decoded)
+		resources = decoded
+
+	default:
+		k, name, err := kindAndName(args, e.selection)
+		if err != nil {
+			return err
+		}
+		resource, err := k.get(ctx, e.clients, e.selection, name)
+		if err != nil {
+			return err
+		}
+		resources = append(resources, resource)
+	}
+
+	for _, resource := range resources {
+		k, err := findResourceKind(resource)
+		if err != nil {
+			return err
+		}
+		if !k.writable() {
+			return fmt.Errorf("the %s resources can't be deleted", k.name)
+		}
+
+		if err := k.delete(ctx, e.clients, resource); err != nil {
+			return err
+		}
+		fmt.Fprintf(e.stdout, "%s/%s deleted\n", k.name, resourceName(resource))
+	}
+
+	if !e.options.wait {
+		return nil
+	}
+	for _, resource := range resources {
+		k, _ := findResourceKind(resource)
+		s, name := resourceSelection(resource)
+
+		if err := k.waitDeleted(ctx, e.clients, s, name, secapi.ResourceObserverConfig{
+			Interval:    e.options.interval,
+			MaxAttempts: math.MaxInt32,
+		}); err != nil {
+			return fmt.Errorf("waiting for %s/%s: %w", k.name, name, err)
+		}
+	}
+	return nil
+}
+
+// Wait
+
+func runWait(ctx context.Context, e *env, args []string) error {
+	k, name, err := kindAndName(args, e.selection)
+	if err != nil {
+		return err
+	}
+	if !k.writable() {
+		return fmt.Errorf("the %s resources have no state to wait for", k.name)
+	}
+
+	condition, values, _ := strings.Cut(e.options.until, "=")
+	var expected []string
+	for _, value := range strings.Split(values, ",") {
+		if value = strings.TrimSpace(value); value != "" {
+			expected = append(expected, value)
+		}
+	}
+
+	var resource any
+	switch {
+	case condition == "deleted" && values == "":
+		err = k.waitDeleted(ctx, e.clients, e.selection, name, secapi.ResourceObserverConfig{
+			Interval:    e.options.interval,
+			MaxAttempts: math.MaxInt32,
+		})
+		if err == nil {
+			fmt.Fprintf(e.stdout, "%s/%s deleted\n", k.name, name)
+		}
+		return err
+
+	case condition == "state" && len(expected) > 0:
+		states := make([]schema.ResourceState, 0, len(expected))
+		for _, value := range expected {
+			states = append(states, schema.ResourceState(value))
+		}
+		resource, err = k.waitState(ctx, e.clients, e.selection, name, secapi.ResourceObserverUntilValueConfig[schema.ResourceState]{
+			ExpectedValues: states,
+			Interval:       e.options.interval,
+			MaxAttempts:    math.MaxInt32,
+		})
+
+	case condition == "power" && len(expected) > 0:
+		if k.waitPowerState == nil {
+			return fmt.Errorf("the %s resources have no power state", k.name)
+		}
+		resource, err = k.waitPowerState(ctx, e.clients, e.selection, name, secapi.ResourceObserverUntilValueConfig[string]{
+			ExpectedValues: expected,
+			Interval:       e.options.interval,
+			MaxAttempts:    math.MaxInt32,
+		})
+
+	default:
+		return fmt.Errorf("invalid condition %q, expected state=<states>, power=<states> or deleted", e.options.until)
+	}
+	if err != nil {
+		return err
+	}
+
+	p := &printer{w: e.stdout, format: e.options.output}
+	return p.print(true, resource)
+}
+
+// Helpers
+
+func kindAndName(args []string, s *selection) (*kind, string, error) {
+	if len(args) != 2 {
+		return nil, "", fmt.Errorf("expected a kind and a name, got %d arguments", len(args))
+	}
+
+	k, err := findKind(args[0])
+	if err != nil {
+		return nil, "", err
+	}
+	if err := s.validate(k.scope); err != nil {
+		return nil, "", err
+	}
+	return k, args[1], nil
+}
+
+func decodeFiles(files []string) ([]any, error) {
+	if len(files) == 0 {
+		return nil, fmt.Errorf("no manifest, set -f")
+	}
+
+	var resources []any
+	for _, file := range files {
+		var decoded []any
+		var err error
+		if file == "-" {
+			decoded, err = manifest.Decode(stdin)
+		} else {
+			decoded, err = manifest.DecodeFile(file)
+		}
+		if err != nil {
+			return nil, err
+		}
+		resources = append(resources, decoded...)
+	}
+	return resources, nil
+}
+
+// Returns the path and the name of a resource, from its metadata
+func resourceSelection(resource any) (*selection, string) {
+	id, err := plan.Identify(resource)
+	if err != nil {
+		return &selection{}, resourceName(resource)
+	}
+	return &selection{tenant: id.Tenant, workspace: id.Workspace, network: id.Network}, id.Name
+}
+
+func resourceName(resource any) string {
+	document, err := toDocument(resource)
+	if err != nil {
+		return ""
+	}
+	return field(document, "metadata", "name")
+}
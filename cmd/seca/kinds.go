@@ -0,0 +1,124 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/eu-sovereign-cloud/go-sdk/pkg/spec/schema"
+	"github.com/eu-sovereign-cloud/go-sdk/pkg/types"
+	"github.com/eu-sovereign-cloud/go-sdk/secapi"
+	"github.com/eu-sovereign-cloud/go-sdk/secapi/manifest"
+)
+
+var ErrUnknownKind = errors.New("unknown kind")
+
+// Scope of the resources of a kind, by their innermost path
+type scope int
+
+const (
+	scopeGlobal scope = iota
+	scopeTenant
+	scopeWorkspace
+	scopeNetwork
+)
+
+// Operations of a resource kind, only the writable kinds are applied, deleted and waited on.
+type kind struct {
+	name  schema.ResourceMetadataKind
+	scope scope
+
+	list  func(ctx context.Context, c *clients, s *selection, options *secapi.ListOptions) ([]any, error)
+	get   func(ctx context.Context, c *clients, s *selection, name string) (any, error)
+	apply func(ctx context.Context, c *clients, resource any) (any, error)
+
+	delete func(ctx context.Context, c *clients, resource any) error
+
+	waitState      func(ctx context.Context, c *clients, s *selection, name string, config secapi.ResourceObserverUntilValueConfig[schema.ResourceState]) (any, error)
+	waitPowerState func(ctx context.Context, c *clients, s *selection, name string, config secapi.ResourceObserverUntilValueConfig[string]) (any, error)
+	waitDeleted    func(ctx context.Context, c *clients, s *selection, name string, config secapi.ResourceObserverConfig) error
+}
+
+func (k *kind) writable() bool {
+	return k.apply != nil
+}
+
+// Finds a kind by its name or plural, as security-group or security-groups
+func findKind(name string) (*kind, error) {
+	name = strings.ToLower(name)
+	for _, k := range kinds {
+		if string(k.name) == name || string(k.name)+"s" == name {
+			return k, nil
+		}
+	}
+
+	return nil, fmt.Errorf("%w %q, expected one of %s", ErrUnknownKind, name, strings.Join(kindNames(), ", "))
+}
+
+// Finds the kind of a resource decoded from a manifest
+func findResourceKind(resource any) (*kind, error) {
+	name, err := manifest.KindOf(resource)
+	if err != nil {
+		return nil, err
+	}
+	return findKind(string(name))
+}
+
+func kindNames() []string {
+	names := make([]string, 0, len(kinds))
+	for _, k := range kinds {
+		names = append(names, string(k.name))
+	}
+	sort.Strings(names)
+	return names
+}
+
+// Names a generated kind, the kinds missing from manifest.KindOf are left unnamed and fail TestKinds
+func kindOf(resource any) schema.ResourceMetadataKind {
+	name, _ := manifest.KindOf(resource)
+	return name
+}
+
+func collect[T types.ResourceType](ctx context.Context, iter *secapi.Iterator[T], err error) ([]any, error) {
+	if err != nil {
+		return nil, err
+	}
+
+	items, err := iter.All(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	resources := make([]any, 0, len(items))
+	for _, item := range items {
+		resources = append(resources, item)
+	}
+	return resources, nil
+}
+
+// Avoids returning a typed nil inside the interface
+func result[T any](resource *T, err error) (any, error) {
+	if err != nil {
+		return nil, err
+	}
+	if resource == nil {
+		return nil, nil
+	}
+	return resource, nil
+}
+
+func convertValues[T ~string](config secapi.ResourceObserverUntilValueConfig[string]) secapi.ResourceObserverUntilValueConfig[T] {
+	values := make([]T, 0, len(config.ExpectedValues))
+	for _, value := range config.ExpectedValues {
+		values = append(values, T(value))
+	}
+
+	return secapi.ResourceObserverUntilValueConfig[T]{
+		ExpectedValues: values,
+		Delay:          config.Delay,
+		Interval:       config.Interval,
+		MaxAttempts:    config.MaxAttempts,
+	}
+}
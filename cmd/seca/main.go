@@ -0,0 +1,198 @@
+// Seca operates the resources of a SECA deployment through the secapi package.
+//
+// Usage:
+//
+//	seca get <kind> <name> [--watch]
+//	seca list <kind> [-l selector] [--watch]
+//	seca apply -f manifest.yaml [--dry-run] [--wait]
+//	seca delete <kind> <name> | -f manifest.yaml [--wait]
+//	seca wait <kind> <name> [--for state=active|power=on|deleted]
+//
+// The deployment, the token and the default tenant, workspace and region are read from
+// the SECA_* environment variables and the profiles of ~/.config/seca/config.yaml,
+// as described by the secapi/config package. The flags override them.
+package main
+
+import (
+	"context"
+	"errors"
+	"flag"
+	"fmt"
+	"io"
+	"os"
+	"os/signal"
+	"strings"
+	"syscall"
+	"time"
+
+	"github.com/eu-sovereign-cloud/go-sdk/secapi/config"
+)
+
+// Options of the commands, each command registers the flags it reads
+type options struct {
+	config  string
+	profile string
+
+	tenant    string
+	workspace string
+	network   string
+	region    string
+
+	output   string
+	selector string
+	watch    bool
+
+	files  stringsFlag
+	dryRun bool
+	wait   bool
+
+	until    string
+	interval time.Duration
+	timeout  time.Duration
+}
+
+type stringsFlag []string
+
+func (f *stringsFlag) String() string {
+	return strings.Join(*f, ",")
+}
+
+func (f *stringsFlag) Set(value string) error {
+	*f = append(*f, value)
+	return nil
+}
+
+// Standard input of the manifests, replaced by the tests
+var stdin io.Reader = os.Stdin
+
+// Environment of a running command
+type env struct {
+	options   *options
+	stdout    io.Writer
+	clients   *clients
+	selection *selection
+}
+
+func main() {
+	ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt, syscall.SIGTERM)
+	defer stop()
+
+	os.Exit(run(ctx, os.Args[1:], os.Stdout, os.Stderr))
+}
+
+func run(ctx context.Context, args []string, stdout, stderr io.Writer) int {
+	if len(args) == 0 || args[0] == "help" || args[0] == "-h" || args[0] == "--help" {
+		usage(stderr)
+		return 2
+	}
+
+	cmd := findCommand(args[0])
+	if cmd == nil {
+		fmt.Fprintf(stderr, "seca: unknown command %q\n", args[0])
+		usage(stderr)
+		return 2
+	}
+
+	opts := &options{}
+	fs := flag.NewFlagSet("seca "+cmd.name, flag.ContinueOnError)
+	fs.SetOutput(stderr)
+	fs.Usage = func() {
+		fmt.Fprintf(stderr, "Usage: seca %s\n\n", cmd.usage)
+		fs.PrintDefaults()
+	}
+	registerFlags(fs, opts)
+	cmd.flags(fs, opts)
+
+	positional, err := parseFlags(fs, args[1:])
+	if errors.Is(err, flag.ErrHelp) {
+		return 0
+	}
+	if err != nil {
+		return 2
+	}
+
+	e, err := newEnv(opts, stdout)
+	if err == nil {
+		if opts.timeout > 0 {
+			var cancel context.CancelFunc
+			ctx, cancel = context.WithTimeout(ctx, opts.timeout)
+			defer cancel()
+		}
+		err = cmd.run(ctx, e, positional)
+	}
+	if err != nil {
+		fmt.Fprintln(stderr, "seca:", err)
+		return 1
+	}
+	return 0
+}
+
+func usage(w io.Writer) {
+	fmt.Fprintln(w, "Usage: seca <command> [arguments] [flags]")
+	fmt.Fprintln(w)
+	fmt.Fprintln(w, "Commands:")
+	for _, cmd := range commands {
+		fmt.Fprintf(w, "  %-8s %s\n", cmd.name, cmd.summary)
+	}
+	fmt.Fprintln(w)
+	fmt.Fprintln(w, "Kinds:")
+	fmt.Fprintf(w, "  %s\n", strings.Join(kindNames(), ", "))
+}
+
+// Flags of every command
+func registerFlags(fs *flag.FlagSet, opts *options) {
+	fs.StringVar(&opts.config, "config", "", "path of the config file, defaults to SECA_CONFIG then ~/.config/seca/config.yaml")
+	fs.StringVar(&opts.profile, "profile", "", "profile of the config file, defaults to SECA_PROFILE then the current profile")
+	fs.StringVar(&opts.tenant, "tenant", "", "tenant of the resources, defaults to the profile")
+	fs.StringVar(&opts.workspace, "workspace", "", "workspace of the resources, defaults to the profile")
+	fs.StringVar(&opts.network, "network", "", "network of the subnets and route tables")
+	fs.StringVar(&opts.region, "region", "", "region of the regional resources, defaults to the profile")
+	fs.DurationVar(&opts.timeout, "timeout", 0, "time limit of the command, none when zero")
+}
+
+// Parses the flags placed before, between or after the positional arguments
+func parseFlags(fs *flag.FlagSet, args []string) ([]string, error) {
+	var positional []string
+	for {
+		if err := fs.Parse(args); err != nil {
+			return nil, err
+		}
+		if fs.NArg() == 0 {
+			return positional, nil
+		}
+
+		positional = append(positional, fs.Arg(0))
+		args = fs.Args()[1:]
+	}
+}
+
+func newEnv(opts *options, stdout io.Writer) (*env, error) {
+	cfg, err := config.Load(&config.Options{Path: opts.config, Profile: opts.profile})
+	if err != nil {
+		return nil, err
+	}
+
+	if opts.region != "" {
+		cfg.Region = opts.region
+	}
+
+	return &env{
+		options: opts,
+		stdout:  stdout,
+		clients: &clients{config: cfg},
+		selection: &selection{
+			tenant:    firstNonEmpty(opts.tenant, cfg.Tenant),
+			workspace: firstNonEmpty(opts.workspace, cfg.Workspace),
+			network:   opts.network,
+		},
+	}, nil
+}
+
+func firstNonEmpty(values ...string) string {
+	for _, value := range values {
+		if value != "" {
+			return value
+		}
+	}
+	return ""
+}
@@ -0,0 +1,179 @@
+package main
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/eu-sovereign-cloud/go-sdk/internal/secatest"
+	"github.com/eu-sovereign-cloud/go-sdk/secapi/fake"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const testManifest = `
+metadata:
+  kind: workspace
+  name: workspace-1
+  tenant: tenant-1
+  region: region-1
+spec: {}
+---
+metadata:
+  kind: network
+  name: network-1
+  tenant: tenant-1
+  workspace: workspace-1
+  region: region-1
+labels:
+  env: prod
+spec:
+  cidr:
+    ipv4: 10.0.0.0/16
+  skuRef:
+    resource: skus/sku-1
+`
+
+func TestRun(t *testing.T) {
+	ctx := context.Background()
+	dir := setupEnv(t)
+
+	path := filepath.Join(dir, "manifest.yaml")
+	require.NoError(t, os.WriteFile(path, []byte(testManifest), 0o600))
+
+	// Apply
+	out := runTest(t, ctx, 0, "apply", "-f", path, "--wait", "--interval", "10ms")
+	assert.Equal(t, "workspace/workspace-1 applied\nnetwork/network-1 applied\nworkspace/workspace-1 active\nnetwork/network-1 active\n", out)
+
+	out = runTest(t, ctx, 0, "apply", "-f", path, "--dry-run")
+	assert.Contains(t, out, "Plan: 0 to create, 0 to update, 0 to delete, 2 unchanged.")
+
+	// List
+	out = runTest(t, ctx, 0, "list", "networks", "-o", "json")
+	var networks []map[string]any
+	require.NoError(t, json.Unmarshal([]byte(out), &networks))
+	require.Len(t, networks, 1)
+	assert.Equal(t, "network", networks[0]["metadata"].(map[string]any)["kind"])
+
+	out = runTest(t, ctx, 0, "list", "network", "-l", "env=prod")
+	assert.Contains(t, out, "NAME")
+	assert.Contains(t, out, "network-1")
+
+	out = runTest(t, ctx, 0, "list", "network", "-l", "env=dev", "-o", "name")
+	assert.Empty(t, out)
+
+	// Get
+	out = runTest(t, ctx, 0, "get", "network", "network-1", "-o", "yaml")
+	assert.Contains(t, out, "state: active")
+
+	out = runTest(t, ctx, 0, "get", "workspace", "workspace-1", "-o", "name")
+	assert.Equal(t, "workspace/workspace-1\n", out)
+
+	// Wait
+	out = runTest(t, ctx, 0, "wait", "network", "network-1", "--for", "state=active", "--interval", "10ms", "-o", "name")
+	assert.Equal(t, "network/network-1\n", out)
+
+	// Delete
+	out = runTest(t, ctx, 0, "delete", "network", "network-1", "--wait", "--interval", "10ms")
+	assert.Equal(t, "network/network-1 deleted\n", out)
+
+	runTest(t, ctx, 1, "get", "network", "network-1")
+}
+
+func TestRun_Watch(t *testing.T) {
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+	dir := setupEnv(t)
+
+	path := filepath.Join(dir, "manifest.yaml")
+	require.NoError(t, os.WriteFile(path, []byte(testManifest), 0o600))
+	runTest(t, ctx, 0, "apply", "-f", path)
+
+	watched := make(chan string)
+	go func() {
+		var stdout, stderr bytes.Buffer
+		run(ctx, []string{"get", "network", "network-1", "--watch", "--interval", "10ms", "-o", "name"}, &stdout, &stderr)
+		watched <- stdout.String()
+	}()
+
+	// Lets the watch print the resource before deleting it
+	time.Sleep(100 * time.Millisecond)
+	runTest(t, ctx, 0, "delete", "network", "network-1")
+
+	assert.Equal(t, "network/network-1\nnetwork/network-1 deleted\n", <-watched)
+}
+
+func TestRun_Errors(t *testing.T) {
+	ctx := context.Background()
+	setupEnv(t)
+
+	runTest(t, ctx, 2, "")
+	runTest(t, ctx, 2, "unknown")
+	runTest(t, ctx, 2, "get", "--unknown")
+
+	for _, args := range [][]string{
+		{"get", "unknown", "name-1"},
+		{"get", "network"},
+		{"list", "subnets"},
+		{"list", "network", "-l", "env"},
+		{"list", "network", "-o", "xml"},
+		{"apply"},
+		{"wait", "instance-sku", "sku-1"},
+		{"wait", "network", "network-1", "--for", "ready"},
+		{"wait", "network", "network-1", "--for", "power=on"},
+	} {
+		runTest(t, ctx, 1, args...)
+	}
+}
+
+func TestKinds(t *testing.T) {
+	names := map[string]bool{}
+	for i, k := range kinds {
+		require.NotEmpty(t, k.name, "kind %d is missing from manifest.KindOf", i)
+		assert.False(t, names[string(k.name)], "kind %s is generated twice", k.name)
+		names[string(k.name)] = true
+
+		found, err := findKind(string(k.name))
+		require.NoError(t, err)
+		assert.Same(t, k, found)
+	}
+}
+
+// Serves a fake and configures the command through the environment, returns a temporary directory
+func setupEnv(t *testing.T) string {
+	server := httptest.NewServer(fake.NewServer(nil).Handler())
+	t.Cleanup(server.Close)
+
+	dir := t.TempDir()
+	path := filepath.Join(dir, "config.yaml")
+	require.NoError(t, os.WriteFile(path, nil, 0o600))
+
+	t.Setenv("SECA_CONFIG", path)
+	t.Setenv("SECA_PROFILE", "")
+	t.Setenv("SECA_WELLKNOWN_URL", server.URL)
+	t.Setenv("SECA_REGION_URL", "")
+	t.Setenv("SECA_TOKEN", secatest.AuthToken)
+	t.Setenv("SECA_TENANT", secatest.Tenant1Name)
+	t.Setenv("SECA_WORKSPACE", secatest.Workspace1Name)
+	t.Setenv("SECA_REGION", fake.DefaultRegion)
+
+	return dir
+}
+
+func runTest(t *testing.T, ctx context.Context, code int, args ...string) string {
+	var stdout, stderr bytes.Buffer
+	if args[0] == "" {
+		args = nil
+	}
+
+	got := run(ctx, args, &stdout, &stderr)
+	assert.Equal(t, code, got, "seca %s: %s", strings.Join(args, " "), stderr.String())
+	return stdout.String()
+}
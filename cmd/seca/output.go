@@ -0,0 +1,168 @@
+package main
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"strings"
+	"text/tabwriter"
+
+	"github.com/eu-sovereign-cloud/go-sdk/secapi/manifest"
+
+	"gopkg.in/yaml.v3"
+)
+
+// Output formats
+const (
+	outputTable = "table"
+	outputJSON  = "json"
+	outputYAML  = "yaml"
+	outputName  = "name"
+)
+
+var outputs = []string{outputTable, outputJSON, outputYAML, outputName}
+
+// Columns of the table output, the optional ones are shown when any resource has a value
+var columns = []struct {
+	header   string
+	path     []string
+	optional bool
+}{
+	{"NAME", []string{"metadata", "name"}, false},
+	{"WORKSPACE", []string{"metadata", "workspace"}, true},
+	{"NETWORK", []string{"metadata", "network"}, true},
+	{"STATE", []string{"status", "state"}, true},
+	{"POWER", []string{"status", "powerState"}, true},
+	{"CREATED", []string{"metadata", "createdAt"}, true},
+}
+
+// Writes resources in an output format, the header of the table is written once.
+type printer struct {
+	w      io.Writer
+	format string
+
+	headerDone bool
+}
+
+// Writes the resources, a single resource is written as an object in JSON.
+func (p *printer) print(single bool, resources ...any) error {
+	documents := make([]map[string]any, 0, len(resources))
+	for _, resource := range resources {
+		document, err := toDocument(resource)
+		if err != nil {
+			return err
+		}
+		documents = append(documents, document)
+	}
+
+	w := p.w
+	switch p.format {
+	case outputTable:
+		err := printTable(w, documents, !p.headerDone)
+		p.headerDone = p.headerDone || len(documents) > 0
+		return err
+
+	case outputName:
+		for _, document := range documents {
+			fmt.Fprintf(w, "%s/%s\n", field(document, "metadata", "kind"), field(document, "metadata", "name"))
+		}
+		return nil
+
+	case outputJSON:
+		encoder := json.NewEncoder(w)
+		encoder.SetIndent("", "  ")
+		if single && len(documents) == 1 {
+			return encoder.Encode(documents[0])
+		}
+		return encoder.Encode(documents)
+
+	case outputYAML:
+		encoder := yaml.NewEncoder(w)
+		encoder.SetIndent(2)
+		for _, document := range documents {
+			if err := encoder.Encode(document); err != nil {
+				return err
+			}
+		}
+		return encoder.Close()
+
+	default:
+		return fmt.Errorf("unknown output %q, expected one of %s", p.format, strings.Join(outputs, ", "))
+	}
+}
+
+func printTable(w io.Writer, documents []map[string]any, header bool) error {
+	if len(documents) == 0 {
+		return nil
+	}
+
+	var shown []int
+	for i, column := range columns {
+		for _, document := range documents {
+			if !column.optional || field(document, column.path...) != "" {
+				shown = append(shown, i)
+				break
+			}
+		}
+	}
+
+	tw := tabwriter.NewWriter(w, 0, 8, 3, ' ', 0)
+	row := make([]string, len(shown))
+	if header {
+		for j, i := range shown {
+			row[j] = columns[i].header
+		}
+		fmt.Fprintln(tw, strings.Join(row, "\t"))
+	}
+
+	for _, document := range documents {
+		for j, i := range shown {
+			row[j] = field(document, columns[i].path...)
+		}
+		fmt.Fprintln(tw, strings.Join(row, "\t"))
+	}
+	return tw.Flush()
+}
+
+// Returns the generic form of a resource with its status and its kind
+func toDocument(resource any) (map[string]any, error) {
+	kind, err := manifest.KindOf(resource)
+	if err != nil {
+		return nil, err
+	}
+
+	data, err := json.Marshal(resource)
+	if err != nil {
+		return nil, err
+	}
+
+	var document map[string]any
+	if err := json.Unmarshal(data, &document); err != nil {
+		return nil, err
+	}
+
+	metadata, _ := document["metadata"].(map[string]any)
+	if metadata == nil {
+		metadata = map[string]any{}
+		document["metadata"] = metadata
+	}
+	metadata["kind"] = string(kind)
+
+	return document, nil
+}
+
+func field(document map[string]any, path ...string) string {
+	var value any = document
+	for _, key := range path {
+		object, ok := value.(map[string]any)
+		if !ok {
+			return ""
+		}
+		value = object[key]
+	}
+
+	if value == nil {
+		return ""
+	}
+	return fmt.Sprint(value)
+}
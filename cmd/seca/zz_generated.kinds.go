@@ -0,0 +1,754 @@
+// Code generated by secagen. DO NOT EDIT.
+
+package main
+
+import (
+	"context"
+
+	"github.com/eu-sovereign-cloud/go-sdk/pkg/spec/schema"
+	"github.com/eu-sovereign-cloud/go-sdk/secapi"
+)
+
+var kinds = []*kind{
+	{
+		name:  kindOf(&schema.Region{}),
+		scope: scopeGlobal,
+		list: func(ctx context.Context, c *clients, s *selection, options *secapi.ListOptions) ([]any, error) {
+			api, err := c.globalClient(ctx)
+			if err != nil {
+				return nil, err
+			}
+			iter, err := api.RegionV1.ListRegionsWithOptions(ctx, options)
+			return collect(ctx, iter, err)
+		},
+		get: func(ctx context.Context, c *clients, s *selection, name string) (any, error) {
+			api, err := c.globalClient(ctx)
+			if err != nil {
+				return nil, err
+			}
+			return result(api.RegionV1.GetRegion(ctx, name))
+		},
+	},
+	{
+		name:  kindOf(&schema.Role{}),
+		scope: scopeTenant,
+		list: func(ctx context.Context, c *clients, s *selection, options *secapi.ListOptions) ([]any, error) {
+			api, err := c.globalClient(ctx)
+			if err != nil {
+				return nil, err
+			}
+			iter, err := api.AuthorizationV1.ListRolesWithOptions(ctx, s.tenantPath(), options)
+			return collect(ctx, iter, err)
+		},
+		get: func(ctx context.Context, c *clients, s *selection, name string) (any, error) {
+			api, err := c.globalClient(ctx)
+			if err != nil {
+				return nil, err
+			}
+			return result(api.AuthorizationV1.GetRole(ctx, s.tenantReference(name)))
+		},
+		apply: func(ctx context.Context, c *clients, resource any) (any, error) {
+			api, err := c.globalClient(ctx)
+			if err != nil {
+				return nil, err
+			}
+			return result(api.AuthorizationV1.CreateOrUpdateRole(ctx, resource.(*schema.Role)))
+		},
+		delete: func(ctx context.Context, c *clients, resource any) error {
+			api, err := c.globalClient(ctx)
+			if err != nil {
+				return err
+			}
+			return api.AuthorizationV1.DeleteRole(ctx, resource.(*schema.Role))
+		},
+		waitState: func(ctx context.Context, c *clients, s *selection, name string, config secapi.ResourceObserverUntilValueConfig[schema.ResourceState]) (any, error) {
+			api, err := c.globalClient(ctx)
+			if err != nil {
+				return nil, err
+			}
+			return result(api.AuthorizationV1.GetRoleUntilState(ctx, s.tenantReference(name), config))
+		},
+		waitDeleted: func(ctx context.Context, c *clients, s *selection, name string, config secapi.ResourceObserverConfig) error {
+			api, err := c.globalClient(ctx)
+			if err != nil {
+				return err
+			}
+			return api.AuthorizationV1.WatchRoleUntilDeleted(ctx, s.tenantReference(name), config)
+		},
+	},
+	{
+		name:  kindOf(&schema.RoleAssignment{}),
+		scope: scopeTenant,
+		list: func(ctx context.Context, c *clients, s *selection, options *secapi.ListOptions) ([]any, error) {
+			api, err := c.globalClient(ctx)
+			if err != nil {
+				return nil, err
+			}
+			iter, err := api.AuthorizationV1.ListRoleAssignmentsWithOptions(ctx, s.tenantPath(), options)
+			return collect(ctx, iter, err)
+		},
+		get: func(ctx context.Context, c *clients, s *selection, name string) (any, error) {
+			api, err := c.globalClient(ctx)
+			if err != nil {
+				return nil, err
+			}
+			return result(api.AuthorizationV1.GetRoleAssignment(ctx, s.tenantReference(name)))
+		},
+		apply: func(ctx context.Context, c *clients, resource any) (any, error) {
+			api, err := c.globalClient(ctx)
+			if err != nil {
+				return nil, err
+			}
+			return result(api.AuthorizationV1.CreateOrUpdateRoleAssignment(ctx, resource.(*schema.RoleAssignment)))
+		},
+		delete: func(ctx context.Context, c *clients, resource any) error {
+			api, err := c.globalClient(ctx)
+			if err != nil {
+				return err
+			}
+			return api.AuthorizationV1.DeleteRoleAssignment(ctx, resource.(*schema.RoleAssignment))
+		},
+		waitState: func(ctx context.Context, c *clients, s *selection, name string, config secapi.ResourceObserverUntilValueConfig[schema.ResourceState]) (any, error) {
+			api, err := c.globalClient(ctx)
+			if err != nil {
+				return nil, err
+			}
+			return result(api.AuthorizationV1.GetRoleAssignmentUntilState(ctx, s.tenantReference(name), config))
+		},
+		waitDeleted: func(ctx context.Context, c *clients, s *selection, name string, config secapi.ResourceObserverConfig) error {
+			api, err := c.globalClient(ctx)
+			if err != nil {
+				return err
+			}
+			return api.AuthorizationV1.WatchRoleAssignmentUntilDeleted(ctx, s.tenantReference(name), config)
+		},
+	},
+	{
+		name:  kindOf(&schema.Workspace{}),
+		scope: scopeTenant,
+		list: func(ctx context.Context, c *clients, s *selection, options *secapi.ListOptions) ([]any, error) {
+			api, err := c.regionalClient(ctx)
+			if err != nil {
+				return nil, err
+			}
+			iter, err := api.WorkspaceV1.ListWorkspacesWithOptions(ctx, s.tenantPath(), options)
+			return collect(ctx, iter, err)
+		},
+		get: func(ctx context.Context, c *clients, s *selection, name string) (any, error) {
+			api, err := c.regionalClient(ctx)
+			if err != nil {
+				return nil, err
+			}
+			return result(api.WorkspaceV1.GetWorkspace(ctx, s.tenantReference(name)))
+		},
+		apply: func(ctx context.Context, c *clients, resource any) (any, error) {
+			api, err := c.regionalClient(ctx)
+			if err != nil {
+				return nil, err
+			}
+			return result(api.WorkspaceV1.CreateOrUpdateWorkspace(ctx, resource.(*schema.Workspace)))
+		},
+		delete: func(ctx context.Context, c *clients, resource any) error {
+			api, err := c.regionalClient(ctx)
+			if err != nil {
+				return err
+			}
+			return api.WorkspaceV1.DeleteWorkspace(ctx, resource.(*schema.Workspace))
+		},
+		waitState: func(ctx context.Context, c *clients, s *selection, name string, config secapi.ResourceObserverUntilValueConfig[schema.ResourceState]) (any, error) {
+			api, err := c.regionalClient(ctx)
+			if err != nil {
+				return nil, err
+			}
+			return result(api.WorkspaceV1.GetWorkspaceUntilState(ctx, s.tenantReference(name), config))
+		},
+		waitDeleted: func(ctx context.Context, c *clients, s *selection, name string, config secapi.ResourceObserverConfig) error {
+			api, err := c.regionalClient(ctx)
+			if err != nil {
+				return err
+			}
+			return api.WorkspaceV1.WatchWorkspaceUntilDeleted(ctx, s.tenantReference(name), config)
+		},
+	},
+	{
+		name:  kindOf(&schema.InstanceSku{}),
+		scope: scopeTenant,
+		list: func(ctx context.Context, c *clients, s *selection, options *secapi.ListOptions) ([]any, error) {
+			api, err := c.regionalClient(ctx)
+			if err != nil {
+				return nil, err
+			}
+			iter, err := api.ComputeV1.ListSkusWithOptions(ctx, s.tenantPath(), options)
+			return collect(ctx, iter, err)
+		},
+		get: func(ctx context.Context, c *clients, s *selection, name string) (any, error) {
+			api, err := c.regionalClient(ctx)
+			if err != nil {
+				return nil, err
+			}
+			return result(api.ComputeV1.GetSku(ctx, s.tenantReference(name)))
+		},
+	},
+	{
+		name:  kindOf(&schema.Instance{}),
+		scope: scopeWorkspace,
+		list: func(ctx context.Context, c *clients, s *selection, options *secapi.ListOptions) ([]any, error) {
+			api, err := c.regionalClient(ctx)
+			if err != nil {
+				return nil, err
+			}
+			iter, err := api.ComputeV1.ListInstancesWithOptions(ctx, s.workspacePath(), options)
+			return collect(ctx, iter, err)
+		},
+		get: func(ctx context.Context, c *clients, s *selection, name string) (any, error) {
+			api, err := c.regionalClient(ctx)
+			if err != nil {
+				return nil, err
+			}
+			return result(api.ComputeV1.GetInstance(ctx, s.workspaceReference(name)))
+		},
+		apply: func(ctx context.Context, c *clients, resource any) (any, error) {
+			api, err := c.regionalClient(ctx)
+			if err != nil {
+				return nil, err
+			}
+			return result(api.ComputeV1.CreateOrUpdateInstance(ctx, resource.(*schema.Instance)))
+		},
+		delete: func(ctx context.Context, c *clients, resource any) error {
+			api, err := c.regionalClient(ctx)
+			if err != nil {
+				return err
+			}
+			return api.ComputeV1.DeleteInstance(ctx, resource.(*schema.Instance))
+		},
+		waitState: func(ctx context.Context, c *clients, s *selection, name string, config secapi.ResourceObserverUntilValueConfig[schema.ResourceState]) (any, error) {
+			api, err := c.regionalClient(ctx)
+			if err != nil {
+				return nil, err
+			}
+			return result(api.ComputeV1.GetInstanceUntilState(ctx, s.workspaceReference(name), config))
+		},
+		waitPowerState: func(ctx context.Context, c *clients, s *selection, name string, config secapi.ResourceObserverUntilValueConfig[string]) (any, error) {
+			api, err := c.regionalClient(ctx)
+			if err != nil {
+				return nil, err
+			}
+			return result(api.ComputeV1.GetInstanceUntilPowerState(ctx, s.workspaceReference(name), convertValues[schema.InstanceStatusPowerState](config)))
+		},
+		waitDeleted: func(ctx context.Context, c *clients, s *selection, name string, config secapi.ResourceObserverConfig) error {
+			api, err := c.regionalClient(ctx)
+			if err != nil {
+				return err
+			}
+			return api.ComputeV1.WatchInstanceUntilDeleted(ctx, s.workspaceReference(name), config)
+		},
+	},
+	{
+		name:  kindOf(&schema.StorageSku{}),
+		scope: scopeTenant,
+		list: func(ctx context.Context, c *clients, s *selection, options *secapi.ListOptions) ([]any, error) {
+			api, err := c.regionalClient(ctx)
+			if err != nil {
+				return nil, err
+			}
+			iter, err := api.StorageV1.ListSkusWithOptions(ctx, s.tenantPath(), options)
+			return collect(ctx, iter, err)
+		},
+		get: func(ctx context.Context, c *clients, s *selection, name string) (any, error) {
+			api, err := c.regionalClient(ctx)
+			if err != nil {
+				return nil, err
+			}
+			return result(api.StorageV1.GetSku(ctx, s.tenantReference(name)))
+		},
+	},
+	{
+		name:  kindOf(&schema.BlockStorage{}),
+		scope: scopeWorkspace,
+		list: func(ctx context.Context, c *clients, s *selection, options *secapi.ListOptions) ([]any, error) {
+			api, err := c.regionalClient(ctx)
+			if err != nil {
+				return nil, err
+			}
+			iter, err := api.StorageV1.ListBlockStoragesWithOptions(ctx, s.workspacePath(), options)
+			return collect(ctx, iter, err)
+		},
+		get: func(ctx context.Context, c *clients, s *selection, name string) (any, error) {
+			api, err := c.regionalClient(ctx)
+			if err != nil {
+				return nil, err
+			}
+			return result(api.StorageV1.GetBlockStorage(ctx, s.workspaceReference(name)))
+		},
+		apply: func(ctx context.Context, c *clients, resource any) (any, error) {
+			api, err := c.regionalClient(ctx)
+			if err != nil {
+				return nil, err
+			}
+			return result(api.StorageV1.CreateOrUpdateBlockStorage(ctx, resource.(*schema.BlockStorage)))
+		},
+		delete: func(ctx context.Context, c *clients, resource any) error {
+			api, err := c.regionalClient(ctx)
+			if err != nil {
+				return err
+			}
+			return api.StorageV1.DeleteBlockStorage(ctx, resource.(*schema.BlockStorage))
+		},
+		waitState: func(ctx context.Context, c *clients, s *selection, name string, config secapi.ResourceObserverUntilValueConfig[schema.ResourceState]) (any, error) {
+			api, err := c.regionalClient(ctx)
+			if err != nil {
+				return nil, err
+			}
+			return result(api.StorageV1.GetBlockStorageUntilState(ctx, s.workspaceReference(name), config))
+		},
+		waitDeleted: func(ctx context.Context, c *clients, s *selection, name string, config secapi.ResourceObserverConfig) error {
+			api, err := c.regionalClient(ctx)
+			if err != nil {
+				return err
+			}
+			return api.StorageV1.WatchBlockStorageUntilDeleted(ctx, s.workspaceReference(name), config)
+		},
+	},
+	{
+		name:  kindOf(&schema.Image{}),
+		scope: scopeTenant,
+		list: func(ctx context.Context, c *clients, s *selection, options *secapi.ListOptions) ([]any, error) {
+			api, err := c.regionalClient(ctx)
+			if err != nil {
+				return nil, err
+			}
+			iter, err := api.StorageV1.ListImagesWithOptions(ctx, s.tenantPath(), options)
+			return collect(ctx, iter, err)
+		},
+		get: func(ctx context.Context, c *clients, s *selection, name string) (any, error) {
+			api, err := c.regionalClient(ctx)
+			if err != nil {
+				return nil, err
+			}
+			return result(api.StorageV1.GetImage(ctx, s.tenantReference(name)))
+		},
+		apply: func(ctx context.Context, c *clients, resource any) (any, error) {
+			api, err := c.regionalClient(ctx)
+			if err != nil {
+				return nil, err
+			}
+			return result(api.StorageV1.CreateOrUpdateImage(ctx, resource.(*schema.Image)))
+		},
+		delete: func(ctx context.Context, c *clients, resource any) error {
+			api, err := c.regionalClient(ctx)
+			if err != nil {
+				return err
+			}
+			return api.StorageV1.DeleteImage(ctx, resource.(*schema.Image))
+		},
+		waitState: func(ctx context.Context, c *clients, s *selection, name string, config secapi.ResourceObserverUntilValueConfig[schema.ResourceState]) (any, error) {
+			api, err := c.regionalClient(ctx)
+			if err != nil {
+				return nil, err
+			}
+			return result(api.StorageV1.GetImageUntilState(ctx, s.tenantReference(name), config))
+		},
+		waitDeleted: func(ctx context.Context, c *clients, s *selection, name string, config secapi.ResourceObserverConfig) error {
+			api, err := c.regionalClient(ctx)
+			if err != nil {
+				return err
+			}
+			return api.StorageV1.WatchImageUntilDeleted(ctx, s.tenantReference(name), config)
+		},
+	},
+	{
+		name:  kindOf(&schema.NetworkSku{}),
+		scope: scopeTenant,
+		list: func(ctx context.Context, c *clients, s *selection, options *secapi.ListOptions) ([]any, error) {
+			api, err := c.regionalClient(ctx)
+			if err != nil {
+				return nil, err
+			}
+			iter, err := api.NetworkV1.ListSkusWithOptions(ctx, s.tenantPath(), options)
+			return collect(ctx, iter, err)
+		},
+		get: func(ctx context.Context, c *clients, s *selection, name string) (any, error) {
+			api, err := c.regionalClient(ctx)
+			if err != nil {
+				return nil, err
+			}
+			return result(api.NetworkV1.GetSku(ctx, s.tenantReference(name)))
+		},
+	},
+	{
+		name:  kindOf(&schema.Network{}),
+		scope: scopeWorkspace,
+		list: func(ctx context.Context, c *clients, s *selection, options *secapi.ListOptions) ([]any, error) {
+			api, err := c.regionalClient(ctx)
+			if err != nil {
+				return nil, err
+			}
+			iter, err := api.NetworkV1.ListNetworksWithOptions(ctx, s.workspacePath(), options)
+			return collect(ctx, iter, err)
+		},
+		get: func(ctx context.Context, c *clients, s *selection, name string) (any, error) {
+			api, err := c.regionalClient(ctx)
+			if err != nil {
+				return nil, err
+			}
+			return result(api.NetworkV1.GetNetwork(ctx, s.workspaceReference(name)))
+		},
+		apply: func(ctx context.Context, c *clients, resource any) (any, error) {
+			api, err := c.regionalClient(ctx)
+			if err != nil {
+				return nil, err
+			}
+			return result(api.NetworkV1.CreateOrUpdateNetwork(ctx, resource.(*schema.Network)))
+		},
+		delete: func(ctx context.Context, c *clients, resource any) error {
+			api, err := c.regionalClient(ctx)
+			if err != nil {
+				return err
+			}
+			return api.NetworkV1.DeleteNetwork(ctx, resource.(*schema.Network))
+		},
+		waitState: func(ctx context.Context, c *clients, s *selection, name string, config secapi.ResourceObserverUntilValueConfig[schema.ResourceState]) (any, error) {
+			api, err := c.regionalClient(ctx)
+			if err != nil {
+				return nil, err
+			}
+			return result(api.NetworkV1.GetNetworkUntilState(ctx, s.workspaceReference(name), config))
+		},
+		waitDeleted: func(ctx context.Context, c *clients, s *selection, name string, config secapi.ResourceObserverConfig) error {
+			api, err := c.regionalClient(ctx)
+			if err != nil {
+				return err
+			}
+			return api.NetworkV1.WatchNetworkUntilDeleted(ctx, s.workspaceReference(name), config)
+		},
+	},
+	{
+		name:  kindOf(&schema.Subnet{}),
+		scope: scopeNetwork,
+		list: func(ctx context.Context, c *clients, s *selection, options *secapi.ListOptions) ([]any, error) {
+			api, err := c.regionalClient(ctx)
+			if err != nil {
+				return nil, err
+			}
+			iter, err := api.NetworkV1.ListSubnetsWithOptions(ctx, s.networkPath(), options)
+			return collect(ctx, iter, err)
+		},
+		get: func(ctx context.Context, c *clients, s *selection, name string) (any, error) {
+			api, err := c.regionalClient(ctx)
+			if err != nil {
+				return nil, err
+			}
+			return result(api.NetworkV1.GetSubnet(ctx, s.networkReference(name)))
+		},
+		apply: func(ctx context.Context, c *clients, resource any) (any, error) {
+			api, err := c.regionalClient(ctx)
+			if err != nil {
+				return nil, err
+			}
+			return result(api.NetworkV1.CreateOrUpdateSubnet(ctx, resource.(*schema.Subnet)))
+		},
+		delete: func(ctx context.Context, c *clients, resource any) error {
+			api, err := c.regionalClient(ctx)
+			if err != nil {
+				return err
+			}
+			return api.NetworkV1.DeleteSubnet(ctx, resource.(*schema.Subnet))
+		},
+		waitState: func(ctx context.Context, c *clients, s *selection, name string, config secapi.ResourceObserverUntilValueConfig[schema.ResourceState]) (any, error) {
+			api, err := c.regionalClient(ctx)
+			if err != nil {
+				return nil, err
+			}
+			return result(api.NetworkV1.GetSubnetUntilState(ctx, s.networkReference(name), config))
+		},
+		waitDeleted: func(ctx context.Context, c *clients, s *selection, name string, config secapi.ResourceObserverConfig) error {
+			api, err := c.regionalClient(ctx)
+			if err != nil {
+				return err
+			}
+			return api.NetworkV1.WatchSubnetUntilDeleted(ctx, s.networkReference(name), config)
+		},
+	},
+	{
+		name:  kindOf(&schema.RouteTable{}),
+		scope: scopeNetwork,
+		list: func(ctx context.Context, c *clients, s *selection, options *secapi.ListOptions) ([]any, error) {
+			api, err := c.regionalClient(ctx)
+			if err != nil {
+				return nil, err
+			}
+			iter, err := api.NetworkV1.ListRouteTablesWithOptions(ctx, s.networkPath(), options)
+			return collect(ctx, iter, err)
+		},
+		get: func(ctx context.Context, c *clients, s *selection, name string) (any, error) {
+			api, err := c.regionalClient(ctx)
+			if err != nil {
+				return nil, err
+			}
+			return result(api.NetworkV1.GetRouteTable(ctx, s.networkReference(name)))
+		},
+		apply: func(ctx context.Context, c *clients, resource any) (any, error) {
+			api, err := c.regionalClient(ctx)
+			if err != nil {
+				return nil, err
+			}
+			return result(api.NetworkV1.CreateOrUpdateRouteTable(ctx, resource.(*schema.RouteTable)))
+		},
+		delete: func(ctx context.Context, c *clients, resource any) error {
+			api, err := c.regionalClient(ctx)
+			if err != nil {
+				return err
+			}
+			return api.NetworkV1.DeleteRouteTable(ctx, resource.(*schema.RouteTable))
+		},
+		waitState: func(ctx context.Context, c *clients, s *selection, name string, config secapi.ResourceObserverUntilValueConfig[schema.ResourceState]) (any, error) {
+			api, err := c.regionalClient(ctx)
+			if err != nil {
+				return nil, err
+			}
+			return result(api.NetworkV1.GetRouteTableUntilState(ctx, s.networkReference(name), config))
+		},
+		waitDeleted: func(ctx context.Context, c *clients, s *selection, name string, config secapi.ResourceObserverConfig) error {
+			api, err := c.regionalClient(ctx)
+			if err != nil {
+				return err
+			}
+			return api.NetworkV1.WatchRouteTableUntilDeleted(ctx, s.networkReference(name), config)
+		},
+	},
+	{
+		name:  kindOf(&schema.InternetGateway{}),
+		scope: scopeWorkspace,
+		list: func(ctx context.Context, c *clients, s *selection, options *secapi.ListOptions) ([]any, error) {
+			api, err := c.regionalClient(ctx)
+			if err != nil {
+				return nil, err
+			}
+			iter, err := api.NetworkV1.ListInternetGatewaysWithOptions(ctx, s.workspacePath(), options)
+			return collect(ctx, iter, err)
+		},
+		get: func(ctx context.Context, c *clients, s *selection, name string) (any, error) {
+			api, err := c.regionalClient(ctx)
+			if err != nil {
+				return nil, err
+			}
+			return result(api.NetworkV1.GetInternetGateway(ctx, s.workspaceReference(name)))
+		},
+		apply: func(ctx context.Context, c *clients, resource any) (any, error) {
+			api, err := c.regionalClient(ctx)
+			if err != nil {
+				return nil, err
+			}
+			return result(api.NetworkV1.CreateOrUpdateInternetGateway(ctx, resource.(*schema.InternetGateway)))
+		},
+		delete: func(ctx context.Context, c *clients, resource any) error {
+			api, err := c.regionalClient(ctx)
+			if err != nil {
+				return err
+			}
+			return api.NetworkV1.DeleteInternetGateway(ctx, resource.(*schema.InternetGateway))
+		},
+		waitState: func(ctx context.Context, c *clients, s *selection, name string, config secapi.ResourceObserverUntilValueConfig[schema.ResourceState]) (any, error) {
+			api, err := c.regionalClient(ctx)
+			if err != nil {
+				return nil, err
+			}
+			return result(api.NetworkV1.GetInternetGatewayUntilState(ctx, s.workspaceReference(name), config))
+		},
+		waitDeleted: func(ctx context.Context, c *clients, s *selection, name string, config secapi.ResourceObserverConfig) error {
+			api, err := c.regionalClient(ctx)
+			if err != nil {
+				return err
+			}
+			return api.NetworkV1.WatchInternetGatewayUntilDeleted(ctx, s.workspaceReference(name), config)
+		},
+	},
+	{
+		name:  kindOf(&schema.SecurityGroupRule{}),
+		scope: scopeWorkspace,
+		list: func(ctx context.Context, c *clients, s *selection, options *secapi.ListOptions) ([]any, error) {
+			api, err := c.regionalClient(ctx)
+			if err != nil {
+				return nil, err
+			}
+			iter, err := api.NetworkV1.ListSecurityGroupRulesWithOptions(ctx, s.workspacePath(), options)
+			return collect(ctx, iter, err)
+		},
+		get: func(ctx context.Context, c *clients, s *selection, name string) (any, error) {
+			api, err := c.regionalClient(ctx)
+			if err != nil {
+				return nil, err
+			}
+			return result(api.NetworkV1.GetSecurityGroupRule(ctx, s.workspaceReference(name)))
+		},
+		apply: func(ctx context.Context, c *clients, resource any) (any, error) {
+			api, err := c.regionalClient(ctx)
+			if err != nil {
+				return nil, err
+			}
+			return result(api.NetworkV1.CreateOrUpdateSecurityGroupRule(ctx, resource.(*schema.SecurityGroupRule)))
+		},
+		delete: func(ctx context.Context, c *clients, resource any) error {
+			api, err := c.regionalClient(ctx)
+			if err != nil {
+				return err
+			}
+			return api.NetworkV1.DeleteSecurityGroupRule(ctx, resource.(*schema.SecurityGroupRule))
+		},
+		waitState: func(ctx context.Context, c *clients, s *selection, name string, config secapi.ResourceObserverUntilValueConfig[schema.ResourceState]) (any, error) {
+			api, err := c.regionalClient(ctx)
+			if err != nil {
+				return nil, err
+			}
+			return result(api.NetworkV1.GetSecurityGroupRuleUntilState(ctx, s.workspaceReference(name), config))
+		},
+		waitDeleted: func(ctx context.Context, c *clients, s *selection, name string, config secapi.ResourceObserverConfig) error {
+			api, err := c.regionalClient(ctx)
+			if err != nil {
+				return err
+			}
+			return api.NetworkV1.WatchSecurityGroupRuleUntilDeleted(ctx, s.workspaceReference(name), config)
+		},
+	},
+	{
+		name:  kindOf(&schema.SecurityGroup{}),
+		scope: scopeWorkspace,
+		list: func(ctx context.Context, c *clients, s *selection, options *secapi.ListOptions) ([]any, error) {
+			api, err := c.regionalClient(ctx)
+			if err != nil {
+				return nil, err
+			}
+			iter, err := api.NetworkV1.ListSecurityGroupsWithOptions(ctx, s.workspacePath(), options)
+			return collect(ctx, iter, err)
+		},
+		get: func(ctx context.Context, c *clients, s *selection, name string) (any, error) {
+			api, err := c.regionalClient(ctx)
+			if err != nil {
+				return nil, err
+			}
+			return result(api.NetworkV1.GetSecurityGroup(ctx, s.workspaceReference(name)))
+		},
+		apply: func(ctx context.Context, c *clients, resource any) (any, error) {
+			api, err := c.regionalClient(ctx)
+			if err != nil {
+				return nil, err
+			}
+			return result(api.NetworkV1.CreateOrUpdateSecurityGroup(ctx, resource.(*schema.SecurityGroup)))
+		},
+		delete: func(ctx context.Context, c *clients, resource any) error {
+			api, err := c.regionalClient(ctx)
+			if err != nil {
+				return err
+			}
+			return api.NetworkV1.DeleteSecurityGroup(ctx, resource.(*schema.SecurityGroup))
+		},
+		waitState: func(ctx context.Context, c *clients, s *selection, name string, config secapi.ResourceObserverUntilValueConfig[schema.ResourceState]) (any, error) {
+			api, err := c.regionalClient(ctx)
+			if err != nil {
+				return nil, err
+			}
+			return result(api.NetworkV1.GetSecurityGroupUntilState(ctx, s.workspaceReference(name), config))
+		},
+		waitDeleted: func(ctx context.Context, c *clients, s *selection, name string, config secapi.ResourceObserverConfig) error {
+			api, err := c.regionalClient(ctx)
+			if err != nil {
+				return err
+			}
+			return api.NetworkV1.WatchSecurityGroupUntilDeleted(ctx, s.workspaceReference(name), config)
+		},
+	},
+	{
+		name:  kindOf(&schema.Nic{}),
+		scope: scopeWorkspace,
+		list: func(ctx context.Context, c *clients, s *selection, options *secapi.ListOptions) ([]any, error) {
+			api, err := c.regionalClient(ctx)
+			if err != nil {
+				return nil, err
+			}
+			iter, err := api.NetworkV1.ListNicsWithOptions(ctx, s.workspacePath(), options)
+			return collect(ctx, iter, err)
+		},
+		get: func(ctx context.Context, c *clients, s *selection, name string) (any, error) {
+			api, err := c.regionalClient(ctx)
+			if err != nil {
+				return nil, err
+			}
+			return result(api.NetworkV1.GetNic(ctx, s.workspaceReference(name)))
+		},
+		apply: func(ctx context.Context, c *clients, resource any) (any, error) {
+			api, err := c.regionalClient(ctx)
+			if err != nil {
+				return nil, err
+			}
+			return result(api.NetworkV1.CreateOrUpdateNic(ctx, resource.(*schema.Nic)))
+		},
+		delete: func(ctx context.Context, c *clients, resource any) error {
+			api, err := c.regionalClient(ctx)
+			if err != nil {
+				return err
+			}
+			return api.NetworkV1.DeleteNic(ctx, resource.(*schema.Nic))
+		},
+		waitState: func(ctx context.Context, c *clients, s *selection, name string, config secapi.ResourceObserverUntilValueConfig[schema.ResourceState]) (any, error) {
+			api, err := c.regionalClient(ctx)
+			if err != nil {
+				return nil, err
+			}
+			return result(api.NetworkV1.GetNicUntilState(ctx, s.workspaceReference(name), config))
+		},
+		waitDeleted: func(ctx context.Context, c *clients, s *selection, name string, config secapi.ResourceObserverConfig) error {
+			api, err := c.regionalClient(ctx)
+			if err != nil {
+				return err
+			}
+			return api.NetworkV1.WatchNicUntilDeleted(ctx, s.workspaceReference(name), config)
+		},
+	},
+	{
+		name:  kindOf(&schema.PublicIp{}),
+		scope: scopeWorkspace,
+		list: func(ctx context.Context, c *clients, s *selection, options *secapi.ListOptions) ([]any, error) {
+			api, err := c.regionalClient(ctx)
+			if err != nil {
+				return nil, err
+			}
+			iter, err := api.NetworkV1.ListPublicIpsWithOptions(ctx, s.workspacePath(), options)
+			return collect(ctx, iter, err)
+		},
+		get: func(ctx context.Context, c *clients, s *selection, name string) (any, error) {
+			api, err := c.regionalClient(ctx)
+			if err != nil {
+				return nil, err
+			}
+			return result(api.NetworkV1.GetPublicIp(ctx, s.workspaceReference(name)))
+		},
+		apply: func(ctx context.Context, c *clients, resource any) (any, error) {
+			api, err := c.regionalClient(ctx)
+			if err != nil {
+				return nil, err
+			}
+			return result(api.NetworkV1.CreateOrUpdatePublicIp(ctx, resource.(*schema.PublicIp)))
+		},
+		delete: func(ctx context.Context, c *clients, resource any) error {
+			api, err := c.regionalClient(ctx)
+			if err != nil {
+				return err
+			}
+			return api.NetworkV1.DeletePublicIp(ctx, resource.(*schema.PublicIp))
+		},
+		waitState: func(ctx context.Context, c *clients, s *selection, name string, config secapi.ResourceObserverUntilValueConfig[schema.ResourceState]) (any, error) {
+			api, err := c.regionalClient(ctx)
+			if err != nil {
+				return nil, err
+			}
+			return result(api.NetworkV1.GetPublicIpUntilState(ctx, s.workspaceReference(name), config))
+		},
+		waitDeleted: func(ctx context.Context, c *clients, s *selection, name string, config secapi.ResourceObserverConfig) error {
+			api, err := c.regionalClient(ctx)
+			if err != nil {
+				return err
+			}
+			return api.NetworkV1.WatchPublicIpUntilDeleted(ctx, s.workspaceReference(name), config)
+		},
+	},
+}
@@ -0,0 +1,37 @@
+// Package clients builds the secapi clients of the tests. It's apart from secatest, which
+// can't import secapi as the secapi tests import it.
+package clients
+
+import (
+	"context"
+	"testing"
+
+	"github.com/eu-sovereign-cloud/go-sdk/internal/secatest"
+	"github.com/eu-sovereign-cloud/go-sdk/secapi"
+
+	"github.com/stretchr/testify/require"
+)
+
+// Endpoints returns the endpoints of the region and authorization providers mocked at the url.
+func Endpoints(url string) secapi.GlobalEndpoints {
+	return secapi.GlobalEndpoints{
+		RegionV1:        url + secatest.ProviderRegionV1Endpoint,
+		AuthorizationV1: url + secatest.ProviderAuthorizationV1Endpoint,
+	}
+}
+
+// New returns the global client of the config and its regional client of secatest.Region1Name,
+// the token is secatest.AuthToken when the config has none.
+func New(t *testing.T, ctx context.Context, config secapi.GlobalConfig) (*secapi.GlobalClient, *secapi.RegionalClient) {
+	if config.AuthToken == "" {
+		config.AuthToken = secatest.AuthToken
+	}
+
+	global, err := secapi.NewGlobalClient(&config)
+	require.NoError(t, err)
+
+	regional, err := global.NewRegionalClient(ctx, secatest.Region1Name)
+	require.NoError(t, err)
+
+	return global, regional
+}
@@ -14,6 +14,7 @@ const (
 	Region1Name    = "region-1"
 	Region2Name    = "region-2"
 	ZoneA          = "a"
+	ZoneB          = "b"
 
 	// Labels
 	LabelKeyTier         = "tier"
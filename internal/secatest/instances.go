@@ -0,0 +1,15 @@
+package secatest
+
+import (
+	"github.com/eu-sovereign-cloud/go-sdk/pkg/spec/schema"
+)
+
+func NewInstance(name string, zone schema.Zone) *schema.Instance {
+	return &schema.Instance{
+		Metadata: NewRegionalWorkspaceResourceMetadata(name, Tenant1Name, Workspace1Name, Region1Name),
+		Spec: schema.InstanceSpec{
+			SkuRef: schema.Reference{Resource: InstanceSku1Ref},
+			Zone:   zone,
+		},
+	}
+}
@@ -0,0 +1,20 @@
+package secatest
+
+import (
+	"context"
+
+	"github.com/eu-sovereign-cloud/go-sdk/pkg/spec/schema"
+	"github.com/eu-sovereign-cloud/go-sdk/pkg/types"
+)
+
+// Page returns a single page of resources to the iterators mocking a list,
+// as secapi.NewIterator(secatest.Page(instances...)).
+func Page[T types.ResourceType](resources ...*T) func(ctx context.Context, skipToken *string) ([]T, *schema.ResponseMetadata, error) {
+	return func(ctx context.Context, skipToken *string) ([]T, *schema.ResponseMetadata, error) {
+		items := make([]T, 0, len(resources))
+		for _, resource := range resources {
+			items = append(items, *resource)
+		}
+		return items, &schema.ResponseMetadata{}, nil
+	}
+}
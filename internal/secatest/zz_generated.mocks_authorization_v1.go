@@ -1,3 +1,5 @@
+// Code generated by secagen. DO NOT EDIT.
+
 package secatest
 
 import (
@@ -11,10 +13,11 @@ import (
 )
 
 // Role
+
 func MockListRolesV1(sim *mockauthorization.MockServerInterface, resp []schema.Role) {
 	sim.EXPECT().ListRoles(mock.Anything, mock.Anything, mock.Anything, mock.Anything).
-		RunAndReturn(func(w http.ResponseWriter, r *http.Request, tenant string, params authorization.ListRolesParams) {
-			iter := &authorization.RoleIterator{Items: resp}
+		RunAndReturn(func(w http.ResponseWriter, r *http.Request, tenant schema.TenantPathParam, params authorization.ListRolesParams) {
+			iter := authorization.RoleIterator{Items: resp}
 			if err := configGetHttpResponse(w, iter); err != nil {
 				http.Error(w, err.Error(), http.StatusInternalServerError)
 			}
@@ -23,7 +26,7 @@ func MockListRolesV1(sim *mockauthorization.MockServerInterface, resp []schema.R
 
 func MockGetRoleV1(sim *mockauthorization.MockServerInterface, resp *schema.Role, times int) {
 	sim.EXPECT().GetRole(mock.Anything, mock.Anything, mock.Anything, mock.Anything).
-		RunAndReturn(func(w http.ResponseWriter, r *http.Request, tenant string, name string) {
+		RunAndReturn(func(w http.ResponseWriter, r *http.Request, tenant schema.TenantPathParam, name schema.ResourcePathParam) {
 			if err := configGetHttpResponse(w, resp); err != nil {
 				http.Error(w, err.Error(), http.StatusInternalServerError)
 			}
@@ -32,7 +35,7 @@ func MockGetRoleV1(sim *mockauthorization.MockServerInterface, resp *schema.Role
 
 func MockNotFoundRoleV1(sim *mockauthorization.MockServerInterface, resp *schema.Role, times int) {
 	sim.EXPECT().GetRole(mock.Anything, mock.Anything, mock.Anything, mock.Anything).
-		RunAndReturn(func(w http.ResponseWriter, r *http.Request, tenant string, name string) {
+		RunAndReturn(func(w http.ResponseWriter, r *http.Request, tenant schema.TenantPathParam, name schema.ResourcePathParam) {
 			if err := configNotFoundHttpResponse(w); err != nil {
 				http.Error(w, err.Error(), http.StatusInternalServerError)
 			}
@@ -41,7 +44,7 @@ func MockNotFoundRoleV1(sim *mockauthorization.MockServerInterface, resp *schema
 
 func MockCreateOrUpdateRoleV1(sim *mockauthorization.MockServerInterface, resp *schema.Role) {
 	sim.EXPECT().CreateOrUpdateRole(mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything).
-		RunAndReturn(func(w http.ResponseWriter, r *http.Request, tenant string, name string, params authorization.CreateOrUpdateRoleParams) {
+		RunAndReturn(func(w http.ResponseWriter, r *http.Request, tenant schema.TenantPathParam, name schema.ResourcePathParam, params authorization.CreateOrUpdateRoleParams) {
 			if err := configPutHttpResponse(w, resp); err != nil {
 				http.Error(w, err.Error(), http.StatusInternalServerError)
 			}
@@ -50,16 +53,17 @@ func MockCreateOrUpdateRoleV1(sim *mockauthorization.MockServerInterface, resp *
 
 func MockDeleteRoleV1(sim *mockauthorization.MockServerInterface) {
 	sim.EXPECT().DeleteRole(mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything).
-		RunAndReturn(func(w http.ResponseWriter, r *http.Request, tenant string, name string, params authorization.DeleteRoleParams) {
+		RunAndReturn(func(w http.ResponseWriter, r *http.Request, tenant schema.TenantPathParam, name schema.ResourcePathParam, params authorization.DeleteRoleParams) {
 			configDeleteHttpResponse(w)
 		})
 }
 
 // Role Assignment
+
 func MockListRoleAssignmentsV1(sim *mockauthorization.MockServerInterface, resp []schema.RoleAssignment) {
 	sim.EXPECT().ListRoleAssignments(mock.Anything, mock.Anything, mock.Anything, mock.Anything).
-		RunAndReturn(func(w http.ResponseWriter, r *http.Request, tenant string, params authorization.ListRoleAssignmentsParams) {
-			iter := &authorization.RoleAssignmentIterator{Items: resp}
+		RunAndReturn(func(w http.ResponseWriter, r *http.Request, tenant schema.TenantPathParam, params authorization.ListRoleAssignmentsParams) {
+			iter := authorization.RoleAssignmentIterator{Items: resp}
 			if err := configGetHttpResponse(w, iter); err != nil {
 				http.Error(w, err.Error(), http.StatusInternalServerError)
 			}
@@ -68,7 +72,7 @@ func MockListRoleAssignmentsV1(sim *mockauthorization.MockServerInterface, resp
 
 func MockGetRoleAssignmentV1(sim *mockauthorization.MockServerInterface, resp *schema.RoleAssignment, times int) {
 	sim.EXPECT().GetRoleAssignment(mock.Anything, mock.Anything, mock.Anything, mock.Anything).
-		RunAndReturn(func(w http.ResponseWriter, r *http.Request, tenant string, name string) {
+		RunAndReturn(func(w http.ResponseWriter, r *http.Request, tenant schema.TenantPathParam, name schema.ResourcePathParam) {
 			if err := configGetHttpResponse(w, resp); err != nil {
 				http.Error(w, err.Error(), http.StatusInternalServerError)
 			}
@@ -77,7 +81,7 @@ func MockGetRoleAssignmentV1(sim *mockauthorization.MockServerInterface, resp *s
 
 func MockNotFoundRoleAssignmentV1(sim *mockauthorization.MockServerInterface, resp *schema.RoleAssignment, times int) {
 	sim.EXPECT().GetRoleAssignment(mock.Anything, mock.Anything, mock.Anything, mock.Anything).
-		RunAndReturn(func(w http.ResponseWriter, r *http.Request, tenant string, name string) {
+		RunAndReturn(func(w http.ResponseWriter, r *http.Request, tenant schema.TenantPathParam, name schema.ResourcePathParam) {
 			if err := configNotFoundHttpResponse(w); err != nil {
 				http.Error(w, err.Error(), http.StatusInternalServerError)
 			}
@@ -86,7 +90,7 @@ func MockNotFoundRoleAssignmentV1(sim *mockauthorization.MockServerInterface, re
 
 func MockCreateOrUpdateRoleAssignmentV1(sim *mockauthorization.MockServerInterface, resp *schema.RoleAssignment) {
 	sim.EXPECT().CreateOrUpdateRoleAssignment(mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything).
-		RunAndReturn(func(w http.ResponseWriter, r *http.Request, tenant string, name string, params authorization.CreateOrUpdateRoleAssignmentParams) {
+		RunAndReturn(func(w http.ResponseWriter, r *http.Request, tenant schema.TenantPathParam, name schema.ResourcePathParam, params authorization.CreateOrUpdateRoleAssignmentParams) {
 			if err := configPutHttpResponse(w, resp); err != nil {
 				http.Error(w, err.Error(), http.StatusInternalServerError)
 			}
@@ -95,7 +99,7 @@ func MockCreateOrUpdateRoleAssignmentV1(sim *mockauthorization.MockServerInterfa
 
 func MockDeleteRoleAssignmentV1(sim *mockauthorization.MockServerInterface) {
 	sim.EXPECT().DeleteRoleAssignment(mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything).
-		RunAndReturn(func(w http.ResponseWriter, r *http.Request, tenant string, name string, params authorization.DeleteRoleAssignmentParams) {
+		RunAndReturn(func(w http.ResponseWriter, r *http.Request, tenant schema.TenantPathParam, name schema.ResourcePathParam, params authorization.DeleteRoleAssignmentParams) {
 			configDeleteHttpResponse(w)
 		})
 }
@@ -1,3 +1,5 @@
+// Code generated by secagen. DO NOT EDIT.
+
 package secatest
 
 import (
@@ -11,6 +13,7 @@ import (
 )
 
 // Instance Sku
+
 func MockListInstanceSkusV1(sim *mockcompute.MockServerInterface, resp []schema.InstanceSku) {
 	sim.EXPECT().ListSkus(mock.Anything, mock.Anything, mock.Anything, mock.Anything).
 		RunAndReturn(func(w http.ResponseWriter, r *http.Request, tenant schema.TenantPathParam, params compute.ListSkusParams) {
@@ -31,6 +34,7 @@ func MockGetInstanceSkuV1(sim *mockcompute.MockServerInterface, resp *schema.Ins
 }
 
 // Instance
+
 func MockListInstancesV1(sim *mockcompute.MockServerInterface, resp []schema.Instance) {
 	sim.EXPECT().ListInstances(mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything).
 		RunAndReturn(func(w http.ResponseWriter, r *http.Request, tenant schema.TenantPathParam, workspace schema.WorkspacePathParam, params compute.ListInstancesParams) {
@@ -61,7 +65,7 @@ func MockNotFoundInstanceV1(sim *mockcompute.MockServerInterface, resp *schema.I
 
 func MockCreateOrUpdateInstanceV1(sim *mockcompute.MockServerInterface, resp *schema.Instance) {
 	sim.EXPECT().CreateOrUpdateInstance(mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything).
-		RunAndReturn(func(w http.ResponseWriter, r *http.Request, tenant schema.TenantPathParam, workspace schema.WorkspacePathParam, name schema.TenantPathParam, lwp compute.CreateOrUpdateInstanceParams) {
+		RunAndReturn(func(w http.ResponseWriter, r *http.Request, tenant schema.TenantPathParam, workspace schema.WorkspacePathParam, name schema.ResourcePathParam, params compute.CreateOrUpdateInstanceParams) {
 			if err := configPutHttpResponse(w, resp); err != nil {
 				http.Error(w, err.Error(), http.StatusInternalServerError)
 			}
@@ -75,16 +79,16 @@ func MockDeleteInstanceV1(sim *mockcompute.MockServerInterface) {
 		})
 }
 
-func MockStartInstanceV1(sim *mockcompute.MockServerInterface) {
-	sim.EXPECT().StartInstance(mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything).
-		RunAndReturn(func(w http.ResponseWriter, r *http.Request, tenant schema.TenantPathParam, workspace schema.WorkspacePathParam, name schema.ResourcePathParam, params compute.StartInstanceParams) {
+func MockRestartInstanceV1(sim *mockcompute.MockServerInterface) {
+	sim.EXPECT().RestartInstance(mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything).
+		RunAndReturn(func(w http.ResponseWriter, r *http.Request, tenant schema.TenantPathParam, workspace schema.WorkspacePathParam, name schema.ResourcePathParam, params compute.RestartInstanceParams) {
 			configPostHttpResponse(w)
 		})
 }
 
-func MockRestartInstanceV1(sim *mockcompute.MockServerInterface) {
-	sim.EXPECT().RestartInstance(mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything).
-		RunAndReturn(func(w http.ResponseWriter, r *http.Request, tenant schema.TenantPathParam, workspace schema.WorkspacePathParam, name schema.ResourcePathParam, params compute.RestartInstanceParams) {
+func MockStartInstanceV1(sim *mockcompute.MockServerInterface) {
+	sim.EXPECT().StartInstance(mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything).
+		RunAndReturn(func(w http.ResponseWriter, r *http.Request, tenant schema.TenantPathParam, workspace schema.WorkspacePathParam, name schema.ResourcePathParam, params compute.StartInstanceParams) {
 			configPostHttpResponse(w)
 		})
 }
@@ -1,3 +1,5 @@
+// Code generated by secagen. DO NOT EDIT.
+
 package secatest
 
 import (
@@ -11,10 +13,11 @@ import (
 )
 
 // Network Sku
+
 func MockListNetworkSkusV1(sim *mocknetwork.MockServerInterface, resp []schema.NetworkSku) {
 	sim.EXPECT().ListSkus(mock.Anything, mock.Anything, mock.Anything, mock.Anything).
-		RunAndReturn(func(w http.ResponseWriter, r *http.Request, tenant string, params network.ListSkusParams) {
-			iter := &network.SkuIterator{Items: resp}
+		RunAndReturn(func(w http.ResponseWriter, r *http.Request, tenant schema.TenantPathParam, params network.ListSkusParams) {
+			iter := network.SkuIterator{Items: resp}
 			if err := configGetHttpResponse(w, iter); err != nil {
 				http.Error(w, err.Error(), http.StatusInternalServerError)
 			}
@@ -23,7 +26,7 @@ func MockListNetworkSkusV1(sim *mocknetwork.MockServerInterface, resp []schema.N
 
 func MockGetNetworkSkuV1(sim *mocknetwork.MockServerInterface, resp *schema.NetworkSku) {
 	sim.EXPECT().GetSku(mock.Anything, mock.Anything, mock.Anything, mock.Anything).
-		RunAndReturn(func(w http.ResponseWriter, r *http.Request, tenant string, name string) {
+		RunAndReturn(func(w http.ResponseWriter, r *http.Request, tenant schema.TenantPathParam, name schema.ResourcePathParam) {
 			if err := configGetHttpResponse(w, resp); err != nil {
 				http.Error(w, err.Error(), http.StatusInternalServerError)
 			}
@@ -31,10 +34,11 @@ func MockGetNetworkSkuV1(sim *mocknetwork.MockServerInterface, resp *schema.Netw
 }
 
 // Network
+
 func MockListNetworksV1(sim *mocknetwork.MockServerInterface, resp []schema.Network) {
 	sim.EXPECT().ListNetworks(mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything).
-		RunAndReturn(func(w http.ResponseWriter, r *http.Request, tenant string, workspace string, params network.ListNetworksParams) {
-			iter := &network.NetworkIterator{Items: resp}
+		RunAndReturn(func(w http.ResponseWriter, r *http.Request, tenant schema.TenantPathParam, workspace schema.WorkspacePathParam, params network.ListNetworksParams) {
+			iter := network.NetworkIterator{Items: resp}
 			if err := configGetHttpResponse(w, iter); err != nil {
 				http.Error(w, err.Error(), http.StatusInternalServerError)
 			}
@@ -43,7 +47,7 @@ func MockListNetworksV1(sim *mocknetwork.MockServerInterface, resp []schema.Netw
 
 func MockGetNetworkV1(sim *mocknetwork.MockServerInterface, resp *schema.Network, times int) {
 	sim.EXPECT().GetNetwork(mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything).
-		RunAndReturn(func(w http.ResponseWriter, r *http.Request, tenant string, workspace string, name string) {
+		RunAndReturn(func(w http.ResponseWriter, r *http.Request, tenant schema.TenantPathParam, workspace schema.WorkspacePathParam, name schema.ResourcePathParam) {
 			if err := configGetHttpResponse(w, resp); err != nil {
 				http.Error(w, err.Error(), http.StatusInternalServerError)
 			}
@@ -52,7 +56,7 @@ func MockGetNetworkV1(sim *mocknetwork.MockServerInterface, resp *schema.Network
 
 func MockNotFoundNetworkV1(sim *mocknetwork.MockServerInterface, resp *schema.Network, times int) {
 	sim.EXPECT().GetNetwork(mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything).
-		RunAndReturn(func(w http.ResponseWriter, r *http.Request, tenant string, workspace string, name string) {
+		RunAndReturn(func(w http.ResponseWriter, r *http.Request, tenant schema.TenantPathParam, workspace schema.WorkspacePathParam, name schema.ResourcePathParam) {
 			if err := configNotFoundHttpResponse(w); err != nil {
 				http.Error(w, err.Error(), http.StatusInternalServerError)
 			}
@@ -61,7 +65,7 @@ func MockNotFoundNetworkV1(sim *mocknetwork.MockServerInterface, resp *schema.Ne
 
 func MockCreateOrUpdateNetworkV1(sim *mocknetwork.MockServerInterface, resp *schema.Network) {
 	sim.EXPECT().CreateOrUpdateNetwork(mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything).
-		RunAndReturn(func(w http.ResponseWriter, r *http.Request, tenant string, workspace string, name string, params network.CreateOrUpdateNetworkParams) {
+		RunAndReturn(func(w http.ResponseWriter, r *http.Request, tenant schema.TenantPathParam, workspace schema.WorkspacePathParam, name schema.ResourcePathParam, params network.CreateOrUpdateNetworkParams) {
 			if err := configPutHttpResponse(w, resp); err != nil {
 				http.Error(w, err.Error(), http.StatusInternalServerError)
 			}
@@ -70,16 +74,17 @@ func MockCreateOrUpdateNetworkV1(sim *mocknetwork.MockServerInterface, resp *sch
 
 func MockDeleteNetworkV1(sim *mocknetwork.MockServerInterface) {
 	sim.EXPECT().DeleteNetwork(mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything).
-		RunAndReturn(func(w http.ResponseWriter, r *http.Request, tenant string, workspace string, name string, params network.DeleteNetworkParams) {
+		RunAndReturn(func(w http.ResponseWriter, r *http.Request, tenant schema.TenantPathParam, workspace schema.WorkspacePathParam, name schema.ResourcePathParam, params network.DeleteNetworkParams) {
 			configDeleteHttpResponse(w)
 		})
 }
 
 // Subnet
+
 func MockListSubnetsV1(sim *mocknetwork.MockServerInterface, resp []schema.Subnet) {
 	sim.EXPECT().ListSubnets(mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything).
 		RunAndReturn(func(w http.ResponseWriter, r *http.Request, tenant schema.TenantPathParam, workspace schema.WorkspacePathParam, networkPath schema.NetworkPathParam, params network.ListSubnetsParams) {
-			iter := &network.SubnetIterator{Items: resp}
+			iter := network.SubnetIterator{Items: resp}
 			if err := configGetHttpResponse(w, iter); err != nil {
 				http.Error(w, err.Error(), http.StatusInternalServerError)
 			}
@@ -88,7 +93,7 @@ func MockListSubnetsV1(sim *mocknetwork.MockServerInterface, resp []schema.Subne
 
 func MockGetSubnetV1(sim *mocknetwork.MockServerInterface, resp *schema.Subnet, times int) {
 	sim.EXPECT().GetSubnet(mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything).
-		RunAndReturn(func(w http.ResponseWriter, r *http.Request, tenant schema.TenantPathParam, workspace schema.WorkspacePathParam, networkPath schema.NetworkPathParam, params schema.ResourcePathParam) {
+		RunAndReturn(func(w http.ResponseWriter, r *http.Request, tenant schema.TenantPathParam, workspace schema.WorkspacePathParam, networkPath schema.NetworkPathParam, name schema.ResourcePathParam) {
 			if err := configGetHttpResponse(w, resp); err != nil {
 				http.Error(w, err.Error(), http.StatusInternalServerError)
 			}
@@ -97,7 +102,7 @@ func MockGetSubnetV1(sim *mocknetwork.MockServerInterface, resp *schema.Subnet,
 
 func MockNotFoundSubnetV1(sim *mocknetwork.MockServerInterface, resp *schema.Subnet, times int) {
 	sim.EXPECT().GetSubnet(mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything).
-		RunAndReturn(func(w http.ResponseWriter, r *http.Request, tenant schema.TenantPathParam, workspace schema.WorkspacePathParam, networkPath schema.NetworkPathParam, params schema.ResourcePathParam) {
+		RunAndReturn(func(w http.ResponseWriter, r *http.Request, tenant schema.TenantPathParam, workspace schema.WorkspacePathParam, networkPath schema.NetworkPathParam, name schema.ResourcePathParam) {
 			if err := configNotFoundHttpResponse(w); err != nil {
 				http.Error(w, err.Error(), http.StatusInternalServerError)
 			}
@@ -121,10 +126,11 @@ func MockDeleteSubnetV1(sim *mocknetwork.MockServerInterface) {
 }
 
 // Route Table
+
 func MockListRouteTablesV1(sim *mocknetwork.MockServerInterface, resp []schema.RouteTable) {
 	sim.EXPECT().ListRouteTables(mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything).
 		RunAndReturn(func(w http.ResponseWriter, r *http.Request, tenant schema.TenantPathParam, workspace schema.WorkspacePathParam, networkPath schema.NetworkPathParam, params network.ListRouteTablesParams) {
-			iter := &network.RouteTableIterator{Items: resp}
+			iter := network.RouteTableIterator{Items: resp}
 			if err := configGetHttpResponse(w, iter); err != nil {
 				http.Error(w, err.Error(), http.StatusInternalServerError)
 			}
@@ -166,10 +172,11 @@ func MockDeleteRouteTableV1(sim *mocknetwork.MockServerInterface) {
 }
 
 // Internet Gateway
+
 func MockListInternetGatewaysV1(sim *mocknetwork.MockServerInterface, resp []schema.InternetGateway) {
 	sim.EXPECT().ListInternetGateways(mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything).
-		RunAndReturn(func(w http.ResponseWriter, r *http.Request, tenant string, workspace string, params network.ListInternetGatewaysParams) {
-			iter := &network.InternetGatewayIterator{Items: resp}
+		RunAndReturn(func(w http.ResponseWriter, r *http.Request, tenant schema.TenantPathParam, workspace schema.WorkspacePathParam, params network.ListInternetGatewaysParams) {
+			iter := network.InternetGatewayIterator{Items: resp}
 			if err := configGetHttpResponse(w, iter); err != nil {
 				http.Error(w, err.Error(), http.StatusInternalServerError)
 			}
@@ -178,7 +185,7 @@ func MockListInternetGatewaysV1(sim *mocknetwork.MockServerInterface, resp []sch
 
 func MockGetInternetGatewayV1(sim *mocknetwork.MockServerInterface, resp *schema.InternetGateway, times int) {
 	sim.EXPECT().GetInternetGateway(mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything).
-		RunAndReturn(func(w http.ResponseWriter, r *http.Request, tenant string, workspace string, name string) {
+		RunAndReturn(func(w http.ResponseWriter, r *http.Request, tenant schema.TenantPathParam, workspace schema.WorkspacePathParam, name schema.ResourcePathParam) {
 			if err := configGetHttpResponse(w, resp); err != nil {
 				http.Error(w, err.Error(), http.StatusInternalServerError)
 			}
@@ -187,7 +194,7 @@ func MockGetInternetGatewayV1(sim *mocknetwork.MockServerInterface, resp *schema
 
 func MockNotFoundInternetGatewayV1(sim *mocknetwork.MockServerInterface, resp *schema.InternetGateway, times int) {
 	sim.EXPECT().GetInternetGateway(mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything).
-		RunAndReturn(func(w http.ResponseWriter, r *http.Request, tenant string, workspace string, name string) {
+		RunAndReturn(func(w http.ResponseWriter, r *http.Request, tenant schema.TenantPathParam, workspace schema.WorkspacePathParam, name schema.ResourcePathParam) {
 			if err := configNotFoundHttpResponse(w); err != nil {
 				http.Error(w, err.Error(), http.StatusInternalServerError)
 			}
@@ -196,7 +203,7 @@ func MockNotFoundInternetGatewayV1(sim *mocknetwork.MockServerInterface, resp *s
 
 func MockCreateOrUpdateInternetGatewayV1(sim *mocknetwork.MockServerInterface, resp *schema.InternetGateway) {
 	sim.EXPECT().CreateOrUpdateInternetGateway(mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything).
-		RunAndReturn(func(w http.ResponseWriter, r *http.Request, tenant string, workspace string, name string, params network.CreateOrUpdateInternetGatewayParams) {
+		RunAndReturn(func(w http.ResponseWriter, r *http.Request, tenant schema.TenantPathParam, workspace schema.WorkspacePathParam, name schema.ResourcePathParam, params network.CreateOrUpdateInternetGatewayParams) {
 			if err := configPutHttpResponse(w, resp); err != nil {
 				http.Error(w, err.Error(), http.StatusInternalServerError)
 			}
@@ -205,16 +212,17 @@ func MockCreateOrUpdateInternetGatewayV1(sim *mocknetwork.MockServerInterface, r
 
 func MockDeleteInternetGatewayV1(sim *mocknetwork.MockServerInterface) {
 	sim.EXPECT().DeleteInternetGateway(mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything).
-		RunAndReturn(func(w http.ResponseWriter, r *http.Request, tenant string, workspace string, name string, params network.DeleteInternetGatewayParams) {
+		RunAndReturn(func(w http.ResponseWriter, r *http.Request, tenant schema.TenantPathParam, workspace schema.WorkspacePathParam, name schema.ResourcePathParam, params network.DeleteInternetGatewayParams) {
 			configDeleteHttpResponse(w)
 		})
 }
 
-// Security Group Rules
+// Security Group Rule
+
 func MockListSecurityGroupRulesV1(sim *mocknetwork.MockServerInterface, resp []schema.SecurityGroupRule) {
 	sim.EXPECT().ListSecurityGroupRules(mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything).
-		RunAndReturn(func(w http.ResponseWriter, r *http.Request, tenant string, workspace string, params network.ListSecurityGroupRulesParams) {
-			iter := &network.SecurityGroupRuleIterator{Items: resp}
+		RunAndReturn(func(w http.ResponseWriter, r *http.Request, tenant schema.TenantPathParam, workspace schema.WorkspacePathParam, params network.ListSecurityGroupRulesParams) {
+			iter := network.SecurityGroupRuleIterator{Items: resp}
 			if err := configGetHttpResponse(w, iter); err != nil {
 				http.Error(w, err.Error(), http.StatusInternalServerError)
 			}
@@ -223,7 +231,7 @@ func MockListSecurityGroupRulesV1(sim *mocknetwork.MockServerInterface, resp []s
 
 func MockGetSecurityGroupRuleV1(sim *mocknetwork.MockServerInterface, resp *schema.SecurityGroupRule, times int) {
 	sim.EXPECT().GetSecurityGroupRule(mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything).
-		RunAndReturn(func(w http.ResponseWriter, r *http.Request, tenant string, workspace string, name string) {
+		RunAndReturn(func(w http.ResponseWriter, r *http.Request, tenant schema.TenantPathParam, workspace schema.WorkspacePathParam, name schema.ResourcePathParam) {
 			if err := configGetHttpResponse(w, resp); err != nil {
 				http.Error(w, err.Error(), http.StatusInternalServerError)
 			}
@@ -232,7 +240,7 @@ func MockGetSecurityGroupRuleV1(sim *mocknetwork.MockServerInterface, resp *sche
 
 func MockNotFoundSecurityGroupRuleV1(sim *mocknetwork.MockServerInterface, resp *schema.SecurityGroupRule, times int) {
 	sim.EXPECT().GetSecurityGroupRule(mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything).
-		RunAndReturn(func(w http.ResponseWriter, r *http.Request, tenant string, workspace string, name string) {
+		RunAndReturn(func(w http.ResponseWriter, r *http.Request, tenant schema.TenantPathParam, workspace schema.WorkspacePathParam, name schema.ResourcePathParam) {
 			if err := configNotFoundHttpResponse(w); err != nil {
 				http.Error(w, err.Error(), http.StatusInternalServerError)
 			}
@@ -241,7 +249,7 @@ func MockNotFoundSecurityGroupRuleV1(sim *mocknetwork.MockServerInterface, resp
 
 func MockCreateOrUpdateSecurityGroupRuleV1(sim *mocknetwork.MockServerInterface, resp *schema.SecurityGroupRule) {
 	sim.EXPECT().CreateOrUpdateSecurityGroupRule(mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything).
-		RunAndReturn(func(w http.ResponseWriter, r *http.Request, tenant string, workspace string, name string, params network.CreateOrUpdateSecurityGroupRuleParams) {
+		RunAndReturn(func(w http.ResponseWriter, r *http.Request, tenant schema.TenantPathParam, workspace schema.WorkspacePathParam, name schema.ResourcePathParam, params network.CreateOrUpdateSecurityGroupRuleParams) {
 			if err := configPutHttpResponse(w, resp); err != nil {
 				http.Error(w, err.Error(), http.StatusInternalServerError)
 			}
@@ -250,16 +258,17 @@ func MockCreateOrUpdateSecurityGroupRuleV1(sim *mocknetwork.MockServerInterface,
 
 func MockDeleteSecurityGroupRuleV1(sim *mocknetwork.MockServerInterface) {
 	sim.EXPECT().DeleteSecurityGroupRule(mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything).
-		RunAndReturn(func(w http.ResponseWriter, r *http.Request, tenant string, workspace string, name string, params network.DeleteSecurityGroupRuleParams) {
+		RunAndReturn(func(w http.ResponseWriter, r *http.Request, tenant schema.TenantPathParam, workspace schema.WorkspacePathParam, name schema.ResourcePathParam, params network.DeleteSecurityGroupRuleParams) {
 			configDeleteHttpResponse(w)
 		})
 }
 
 // Security Group
+
 func MockListSecurityGroupsV1(sim *mocknetwork.MockServerInterface, resp []schema.SecurityGroup) {
 	sim.EXPECT().ListSecurityGroups(mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything).
-		RunAndReturn(func(w http.ResponseWriter, r *http.Request, tenant string, workspace string, params network.ListSecurityGroupsParams) {
-			iter := &network.SecurityGroupIterator{Items: resp}
+		RunAndReturn(func(w http.ResponseWriter, r *http.Request, tenant schema.TenantPathParam, workspace schema.WorkspacePathParam, params network.ListSecurityGroupsParams) {
+			iter := network.SecurityGroupIterator{Items: resp}
 			if err := configGetHttpResponse(w, iter); err != nil {
 				http.Error(w, err.Error(), http.StatusInternalServerError)
 			}
@@ -268,7 +277,7 @@ func MockListSecurityGroupsV1(sim *mocknetwork.MockServerInterface, resp []schem
 
 func MockGetSecurityGroupV1(sim *mocknetwork.MockServerInterface, resp *schema.SecurityGroup, times int) {
 	sim.EXPECT().GetSecurityGroup(mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything).
-		RunAndReturn(func(w http.ResponseWriter, r *http.Request, tenant string, workspace string, name string) {
+		RunAndReturn(func(w http.ResponseWriter, r *http.Request, tenant schema.TenantPathParam, workspace schema.WorkspacePathParam, name schema.ResourcePathParam) {
 			if err := configGetHttpResponse(w, resp); err != nil {
 				http.Error(w, err.Error(), http.StatusInternalServerError)
 			}
@@ -277,7 +286,7 @@ func MockGetSecurityGroupV1(sim *mocknetwork.MockServerInterface, resp *schema.S
 
 func MockNotFoundSecurityGroupV1(sim *mocknetwork.MockServerInterface, resp *schema.SecurityGroup, times int) {
 	sim.EXPECT().GetSecurityGroup(mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything).
-		RunAndReturn(func(w http.ResponseWriter, r *http.Request, tenant string, workspace string, name string) {
+		RunAndReturn(func(w http.ResponseWriter, r *http.Request, tenant schema.TenantPathParam, workspace schema.WorkspacePathParam, name schema.ResourcePathParam) {
 			if err := configNotFoundHttpResponse(w); err != nil {
 				http.Error(w, err.Error(), http.StatusInternalServerError)
 			}
@@ -286,7 +295,7 @@ func MockNotFoundSecurityGroupV1(sim *mocknetwork.MockServerInterface, resp *sch
 
 func MockCreateOrUpdateSecurityGroupV1(sim *mocknetwork.MockServerInterface, resp *schema.SecurityGroup) {
 	sim.EXPECT().CreateOrUpdateSecurityGroup(mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything).
-		RunAndReturn(func(w http.ResponseWriter, r *http.Request, tenant string, workspace string, name string, params network.CreateOrUpdateSecurityGroupParams) {
+		RunAndReturn(func(w http.ResponseWriter, r *http.Request, tenant schema.TenantPathParam, workspace schema.WorkspacePathParam, name schema.ResourcePathParam, params network.CreateOrUpdateSecurityGroupParams) {
 			if err := configPutHttpResponse(w, resp); err != nil {
 				http.Error(w, err.Error(), http.StatusInternalServerError)
 			}
@@ -295,16 +304,17 @@ func MockCreateOrUpdateSecurityGroupV1(sim *mocknetwork.MockServerInterface, res
 
 func MockDeleteSecurityGroupV1(sim *mocknetwork.MockServerInterface) {
 	sim.EXPECT().DeleteSecurityGroup(mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything).
-		RunAndReturn(func(w http.ResponseWriter, r *http.Request, tenant string, workspace string, name string, params network.DeleteSecurityGroupParams) {
+		RunAndReturn(func(w http.ResponseWriter, r *http.Request, tenant schema.TenantPathParam, workspace schema.WorkspacePathParam, name schema.ResourcePathParam, params network.DeleteSecurityGroupParams) {
 			configDeleteHttpResponse(w)
 		})
 }
 
 // Nic
+
 func MockListNicsV1(sim *mocknetwork.MockServerInterface, resp []schema.Nic) {
 	sim.EXPECT().ListNics(mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything).
-		RunAndReturn(func(w http.ResponseWriter, r *http.Request, tenant string, workspace string, params network.ListNicsParams) {
-			iter := &network.NicIterator{Items: resp}
+		RunAndReturn(func(w http.ResponseWriter, r *http.Request, tenant schema.TenantPathParam, workspace schema.WorkspacePathParam, params network.ListNicsParams) {
+			iter := network.NicIterator{Items: resp}
 			if err := configGetHttpResponse(w, iter); err != nil {
 				http.Error(w, err.Error(), http.StatusInternalServerError)
 			}
@@ -313,7 +323,7 @@ func MockListNicsV1(sim *mocknetwork.MockServerInterface, resp []schema.Nic) {
 
 func MockGetNicV1(sim *mocknetwork.MockServerInterface, resp *schema.Nic, times int) {
 	sim.EXPECT().GetNic(mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything).
-		RunAndReturn(func(w http.ResponseWriter, r *http.Request, tenant string, workspace string, name string) {
+		RunAndReturn(func(w http.ResponseWriter, r *http.Request, tenant schema.TenantPathParam, workspace schema.WorkspacePathParam, name schema.ResourcePathParam) {
 			if err := configGetHttpResponse(w, resp); err != nil {
 				http.Error(w, err.Error(), http.StatusInternalServerError)
 			}
@@ -322,7 +332,7 @@ func MockGetNicV1(sim *mocknetwork.MockServerInterface, resp *schema.Nic, times
 
 func MockNotFoundNicV1(sim *mocknetwork.MockServerInterface, resp *schema.Nic, times int) {
 	sim.EXPECT().GetNic(mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything).
-		RunAndReturn(func(w http.ResponseWriter, r *http.Request, tenant string, workspace string, name string) {
+		RunAndReturn(func(w http.ResponseWriter, r *http.Request, tenant schema.TenantPathParam, workspace schema.WorkspacePathParam, name schema.ResourcePathParam) {
 			if err := configNotFoundHttpResponse(w); err != nil {
 				http.Error(w, err.Error(), http.StatusInternalServerError)
 			}
@@ -331,7 +341,7 @@ func MockNotFoundNicV1(sim *mocknetwork.MockServerInterface, resp *schema.Nic, t
 
 func MockCreateOrUpdateNicV1(sim *mocknetwork.MockServerInterface, resp *schema.Nic) {
 	sim.EXPECT().CreateOrUpdateNic(mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything).
-		RunAndReturn(func(w http.ResponseWriter, r *http.Request, tenant string, workspace string, name string, params network.CreateOrUpdateNicParams) {
+		RunAndReturn(func(w http.ResponseWriter, r *http.Request, tenant schema.TenantPathParam, workspace schema.WorkspacePathParam, name schema.ResourcePathParam, params network.CreateOrUpdateNicParams) {
 			if err := configPutHttpResponse(w, resp); err != nil {
 				http.Error(w, err.Error(), http.StatusInternalServerError)
 			}
@@ -340,16 +350,17 @@ func MockCreateOrUpdateNicV1(sim *mocknetwork.MockServerInterface, resp *schema.
 
 func MockDeleteNicV1(sim *mocknetwork.MockServerInterface) {
 	sim.EXPECT().DeleteNic(mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything).
-		RunAndReturn(func(w http.ResponseWriter, r *http.Request, tenant string, workspace string, name string, params network.DeleteNicParams) {
+		RunAndReturn(func(w http.ResponseWriter, r *http.Request, tenant schema.TenantPathParam, workspace schema.WorkspacePathParam, name schema.ResourcePathParam, params network.DeleteNicParams) {
 			configDeleteHttpResponse(w)
 		})
 }
 
 // Public Ip
+
 func MockListPublicIpsV1(sim *mocknetwork.MockServerInterface, resp []schema.PublicIp) {
 	sim.EXPECT().ListPublicIps(mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything).
-		RunAndReturn(func(w http.ResponseWriter, r *http.Request, tenant string, workspace string, params network.ListPublicIpsParams) {
-			iter := &network.PublicIpIterator{Items: resp}
+		RunAndReturn(func(w http.ResponseWriter, r *http.Request, tenant schema.TenantPathParam, workspace schema.WorkspacePathParam, params network.ListPublicIpsParams) {
+			iter := network.PublicIpIterator{Items: resp}
 			if err := configGetHttpResponse(w, iter); err != nil {
 				http.Error(w, err.Error(), http.StatusInternalServerError)
 			}
@@ -358,7 +369,7 @@ func MockListPublicIpsV1(sim *mocknetwork.MockServerInterface, resp []schema.Pub
 
 func MockGetPublicIpV1(sim *mocknetwork.MockServerInterface, resp *schema.PublicIp, times int) {
 	sim.EXPECT().GetPublicIp(mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything).
-		RunAndReturn(func(w http.ResponseWriter, r *http.Request, tenant string, workspace string, name string) {
+		RunAndReturn(func(w http.ResponseWriter, r *http.Request, tenant schema.TenantPathParam, workspace schema.WorkspacePathParam, name schema.ResourcePathParam) {
 			if err := configGetHttpResponse(w, resp); err != nil {
 				http.Error(w, err.Error(), http.StatusInternalServerError)
 			}
@@ -367,7 +378,7 @@ func MockGetPublicIpV1(sim *mocknetwork.MockServerInterface, resp *schema.Public
 
 func MockNotFoundPublicIpV1(sim *mocknetwork.MockServerInterface, resp *schema.PublicIp, times int) {
 	sim.EXPECT().GetPublicIp(mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything).
-		RunAndReturn(func(w http.ResponseWriter, r *http.Request, tenant string, workspace string, name string) {
+		RunAndReturn(func(w http.ResponseWriter, r *http.Request, tenant schema.TenantPathParam, workspace schema.WorkspacePathParam, name schema.ResourcePathParam) {
 			if err := configNotFoundHttpResponse(w); err != nil {
 				http.Error(w, err.Error(), http.StatusInternalServerError)
 			}
@@ -376,7 +387,7 @@ func MockNotFoundPublicIpV1(sim *mocknetwork.MockServerInterface, resp *schema.P
 
 func MockCreateOrUpdatePublicIpV1(sim *mocknetwork.MockServerInterface, resp *schema.PublicIp) {
 	sim.EXPECT().CreateOrUpdatePublicIp(mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything).
-		RunAndReturn(func(w http.ResponseWriter, r *http.Request, tenant string, workspace, name string, params network.CreateOrUpdatePublicIpParams) {
+		RunAndReturn(func(w http.ResponseWriter, r *http.Request, tenant schema.TenantPathParam, workspace schema.WorkspacePathParam, name schema.ResourcePathParam, params network.CreateOrUpdatePublicIpParams) {
 			if err := configPutHttpResponse(w, resp); err != nil {
 				http.Error(w, err.Error(), http.StatusInternalServerError)
 			}
@@ -385,7 +396,7 @@ func MockCreateOrUpdatePublicIpV1(sim *mocknetwork.MockServerInterface, resp *sc
 
 func MockDeletePublicIpV1(sim *mocknetwork.MockServerInterface) {
 	sim.EXPECT().DeletePublicIp(mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything).
-		RunAndReturn(func(w http.ResponseWriter, r *http.Request, tenant string, workspace, name string, params network.DeletePublicIpParams) {
+		RunAndReturn(func(w http.ResponseWriter, r *http.Request, tenant schema.TenantPathParam, workspace schema.WorkspacePathParam, name schema.ResourcePathParam, params network.DeletePublicIpParams) {
 			configDeleteHttpResponse(w)
 		})
 }
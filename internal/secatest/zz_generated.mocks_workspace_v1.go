@@ -1,3 +1,5 @@
+// Code generated by secagen. DO NOT EDIT.
+
 package secatest
 
 import (
@@ -11,9 +13,10 @@ import (
 )
 
 // Workspace
+
 func MockListWorkspaceV1(sim *mockworkspace.MockServerInterface, resp []schema.Workspace) {
 	sim.EXPECT().ListWorkspaces(mock.Anything, mock.Anything, mock.Anything, mock.Anything).
-		RunAndReturn(func(w http.ResponseWriter, r *http.Request, s string, lwp workspace.ListWorkspacesParams) {
+		RunAndReturn(func(w http.ResponseWriter, r *http.Request, tenant schema.TenantPathParam, params workspace.ListWorkspacesParams) {
 			iter := workspace.WorkspaceIterator{Items: resp}
 			if err := configGetHttpResponse(w, iter); err != nil {
 				http.Error(w, err.Error(), http.StatusInternalServerError)
@@ -23,7 +26,7 @@ func MockListWorkspaceV1(sim *mockworkspace.MockServerInterface, resp []schema.W
 
 func MockGetWorkspaceV1(sim *mockworkspace.MockServerInterface, resp *schema.Workspace, times int) {
 	sim.EXPECT().GetWorkspace(mock.Anything, mock.Anything, mock.Anything, mock.Anything).
-		RunAndReturn(func(w http.ResponseWriter, r *http.Request, tenant string, name string) {
+		RunAndReturn(func(w http.ResponseWriter, r *http.Request, tenant schema.TenantPathParam, name schema.ResourcePathParam) {
 			if err := configGetHttpResponse(w, resp); err != nil {
 				http.Error(w, err.Error(), http.StatusInternalServerError)
 			}
@@ -32,7 +35,7 @@ func MockGetWorkspaceV1(sim *mockworkspace.MockServerInterface, resp *schema.Wor
 
 func MockNotFoundWorkspaceV1(sim *mockworkspace.MockServerInterface, times int) {
 	sim.EXPECT().GetWorkspace(mock.Anything, mock.Anything, mock.Anything, mock.Anything).
-		RunAndReturn(func(w http.ResponseWriter, r *http.Request, tenant string, name string) {
+		RunAndReturn(func(w http.ResponseWriter, r *http.Request, tenant schema.TenantPathParam, name schema.ResourcePathParam) {
 			if err := configNotFoundHttpResponse(w); err != nil {
 				http.Error(w, err.Error(), http.StatusInternalServerError)
 			}
@@ -41,7 +44,7 @@ func MockNotFoundWorkspaceV1(sim *mockworkspace.MockServerInterface, times int)
 
 func MockCreateOrUpdateWorkspaceV1(sim *mockworkspace.MockServerInterface, resp *schema.Workspace) {
 	sim.EXPECT().CreateOrUpdateWorkspace(mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything).
-		RunAndReturn(func(w http.ResponseWriter, r *http.Request, tenant string, name string, params workspace.CreateOrUpdateWorkspaceParams) {
+		RunAndReturn(func(w http.ResponseWriter, r *http.Request, tenant schema.TenantPathParam, name schema.ResourcePathParam, params workspace.CreateOrUpdateWorkspaceParams) {
 			if err := configPutHttpResponse(w, resp); err != nil {
 				http.Error(w, err.Error(), http.StatusInternalServerError)
 			}
@@ -50,7 +53,7 @@ func MockCreateOrUpdateWorkspaceV1(sim *mockworkspace.MockServerInterface, resp
 
 func MockDeleteWorkspaceV1(sim *mockworkspace.MockServerInterface) {
 	sim.EXPECT().DeleteWorkspace(mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything).
-		RunAndReturn(func(w http.ResponseWriter, r *http.Request, tenant string, name string, params workspace.DeleteWorkspaceParams) {
+		RunAndReturn(func(w http.ResponseWriter, r *http.Request, tenant schema.TenantPathParam, name schema.ResourcePathParam, params workspace.DeleteWorkspaceParams) {
 			configDeleteHttpResponse(w)
 		})
 }
@@ -0,0 +1,56 @@
+// Package secautil holds the helpers shared by the secapi packages: parsing the resource
+// references, listing the resources of a path and matching the IP versions.
+package secautil
+
+import (
+	"context"
+	"net/netip"
+	"strings"
+
+	"github.com/eu-sovereign-cloud/go-sdk/pkg/spec/schema"
+)
+
+// Iterator is the part of secapi.Iterator used to list resources, it keeps this package
+// free of secapi so the builders can import it.
+type Iterator[T any] interface {
+	All(ctx context.Context) ([]*T, error)
+}
+
+// List returns all the resources listed by fn for a path, as List(ctx, api.ListNics, wpath).
+func List[T any, P any, I Iterator[T]](ctx context.Context, fn func(context.Context, P) (I, error), path P) ([]*T, error) {
+	iter, err := fn(ctx, path)
+	if err != nil {
+		return nil, err
+	}
+	return iter.All(ctx)
+}
+
+// RefName returns the name of a referenced resource of the given type, from its <type>/<name> path.
+func RefName(ref schema.Reference, typ string) (string, bool) {
+	parts := strings.Split(strings.Trim(ref.Resource, "/"), "/")
+	if len(parts) < 2 || parts[len(parts)-2] != typ {
+		return "", false
+	}
+	return parts[len(parts)-1], true
+}
+
+// RefKey returns the <type>/<name> of a reference.
+func RefKey(ref schema.Reference) string {
+	parts := strings.Split(strings.Trim(ref.Resource, "/"), "/")
+	if len(parts) < 2 {
+		return ref.Resource
+	}
+	return strings.Join(parts[len(parts)-2:], "/")
+}
+
+// VersionMatches reports whether an address is of the IP version, an empty version matches all of them.
+func VersionMatches(version schema.IPVersion, addr netip.Addr) bool {
+	switch version {
+	case schema.IPVersionIPv4:
+		return addr.Is4()
+	case schema.IPVersionIPv6:
+		return addr.Is6()
+	default:
+		return true
+	}
+}
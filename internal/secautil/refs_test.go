@@ -0,0 +1,64 @@
+package secautil
+
+import (
+	"context"
+	"errors"
+	"net/netip"
+	"testing"
+
+	"github.com/eu-sovereign-cloud/go-sdk/pkg/spec/schema"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// Iterator over a slice, secapi can't be imported here as it imports the builders using this package
+type sliceIterator[T any] []*T
+
+func (i sliceIterator[T]) All(ctx context.Context) ([]*T, error) {
+	return i, nil
+}
+
+func TestList(t *testing.T) {
+	ctx := context.Background()
+	nic := &schema.Nic{Metadata: &schema.RegionalWorkspaceResourceMetadata{Name: "nic-1"}}
+
+	nics, err := List(ctx, func(ctx context.Context, workspace string) (sliceIterator[schema.Nic], error) {
+		return sliceIterator[schema.Nic]{nic}, nil
+	}, "workspace-1")
+	require.NoError(t, err)
+	assert.Equal(t, []*schema.Nic{nic}, nics)
+
+	failure := errors.New("failure")
+	_, err = List(ctx, func(ctx context.Context, workspace string) (sliceIterator[schema.Nic], error) {
+		return nil, failure
+	}, "workspace-1")
+	assert.ErrorIs(t, err, failure)
+}
+
+func TestRefName(t *testing.T) {
+	name, ok := RefName(schema.Reference{Resource: "/tenants/t/workspaces/w/nics/nic-1"}, "nics")
+	assert.True(t, ok)
+	assert.Equal(t, "nic-1", name)
+
+	_, ok = RefName(schema.Reference{Resource: "nics/nic-1"}, "instances")
+	assert.False(t, ok)
+
+	_, ok = RefName(schema.Reference{Resource: "nic-1"}, "nics")
+	assert.False(t, ok)
+}
+
+func TestRefKey(t *testing.T) {
+	assert.Equal(t, "nics/nic-1", RefKey(schema.Reference{Resource: "/tenants/t/workspaces/w/nics/nic-1"}))
+	assert.Equal(t, "nic-1", RefKey(schema.Reference{Resource: "nic-1"}))
+}
+
+func TestVersionMatches(t *testing.T) {
+	v4 := netip.MustParseAddr("10.0.0.1")
+	v6 := netip.MustParseAddr("2001:db8::1")
+
+	assert.True(t, VersionMatches(schema.IPVersionIPv4, v4))
+	assert.False(t, VersionMatches(schema.IPVersionIPv4, v6))
+	assert.True(t, VersionMatches(schema.IPVersionIPv6, v6))
+	assert.True(t, VersionMatches("", v6))
+}
@@ -0,0 +1,1042 @@
+// Code generated by mockery v2.52.2. DO NOT EDIT.
+
+package mocksecapi
+
+import (
+	context "context"
+
+	authorization "github.com/eu-sovereign-cloud/go-sdk/pkg/spec/foundation.authorization.v1"
+
+	mock "github.com/stretchr/testify/mock"
+
+	schema "github.com/eu-sovereign-cloud/go-sdk/pkg/spec/schema"
+
+	secapi "github.com/eu-sovereign-cloud/go-sdk/secapi"
+)
+
+// MockAuthorizationV1 is an autogenerated mock type for the AuthorizationV1 type
+type MockAuthorizationV1 struct {
+	mock.Mock
+}
+
+type MockAuthorizationV1_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockAuthorizationV1) EXPECT() *MockAuthorizationV1_Expecter {
+	return &MockAuthorizationV1_Expecter{mock: &_m.Mock}
+}
+
+// CreateOrUpdateRole provides a mock function with given fields: ctx, role
+func (_m *MockAuthorizationV1) CreateOrUpdateRole(ctx context.Context, role *schema.Role) (*schema.Role, error) {
+	ret := _m.Called(ctx, role)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CreateOrUpdateRole")
+	}
+
+	var r0 *schema.Role
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *schema.Role) (*schema.Role, error)); ok {
+		return rf(ctx, role)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *schema.Role) *schema.Role); ok {
+		r0 = rf(ctx, role)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*schema.Role)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *schema.Role) error); ok {
+		r1 = rf(ctx, role)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockAuthorizationV1_CreateOrUpdateRole_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CreateOrUpdateRole'
+type MockAuthorizationV1_CreateOrUpdateRole_Call struct {
+	*mock.Call
+}
+
+// CreateOrUpdateRole is a helper method to define mock.On call
+//   - ctx context.Context
+//   - role *schema.Role
+func (_e *MockAuthorizationV1_Expecter) CreateOrUpdateRole(ctx interface{}, role interface{}) *MockAuthorizationV1_CreateOrUpdateRole_Call {
+	return &MockAuthorizationV1_CreateOrUpdateRole_Call{Call: _e.mock.On("CreateOrUpdateRole", ctx, role)}
+}
+
+func (_c *MockAuthorizationV1_CreateOrUpdateRole_Call) Run(run func(ctx context.Context, role *schema.Role)) *MockAuthorizationV1_CreateOrUpdateRole_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*schema.Role))
+	})
+	return _c
+}
+
+func (_c *MockAuthorizationV1_CreateOrUpdateRole_Call) Return(_a0 *schema.Role, _a1 error) *MockAuthorizationV1_CreateOrUpdateRole_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockAuthorizationV1_CreateOrUpdateRole_Call) RunAndReturn(run func(context.Context, *schema.Role) (*schema.Role, error)) *MockAuthorizationV1_CreateOrUpdateRole_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// CreateOrUpdateRoleAssignment provides a mock function with given fields: ctx, assign
+func (_m *MockAuthorizationV1) CreateOrUpdateRoleAssignment(ctx context.Context, assign *schema.RoleAssignment) (*schema.RoleAssignment, error) {
+	ret := _m.Called(ctx, assign)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CreateOrUpdateRoleAssignment")
+	}
+
+	var r0 *schema.RoleAssignment
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *schema.RoleAssignment) (*schema.RoleAssignment, error)); ok {
+		return rf(ctx, assign)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *schema.RoleAssignment) *schema.RoleAssignment); ok {
+		r0 = rf(ctx, assign)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*schema.RoleAssignment)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *schema.RoleAssignment) error); ok {
+		r1 = rf(ctx, assign)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockAuthorizationV1_CreateOrUpdateRoleAssignment_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CreateOrUpdateRoleAssignment'
+type MockAuthorizationV1_CreateOrUpdateRoleAssignment_Call struct {
+	*mock.Call
+}
+
+// CreateOrUpdateRoleAssignment is a helper method to define mock.On call
+//   - ctx context.Context
+//   - assign *schema.RoleAssignment
+func (_e *MockAuthorizationV1_Expecter) CreateOrUpdateRoleAssignment(ctx interface{}, assign interface{}) *MockAuthorizationV1_CreateOrUpdateRoleAssignment_Call {
+	return &MockAuthorizationV1_CreateOrUpdateRoleAssignment_Call{Call: _e.mock.On("CreateOrUpdateRoleAssignment", ctx, assign)}
+}
+
+func (_c *MockAuthorizationV1_CreateOrUpdateRoleAssignment_Call) Run(run func(ctx context.Context, assign *schema.RoleAssignment)) *MockAuthorizationV1_CreateOrUpdateRoleAssignment_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*schema.RoleAssignment))
+	})
+	return _c
+}
+
+func (_c *MockAuthorizationV1_CreateOrUpdateRoleAssignment_Call) Return(_a0 *schema.RoleAssignment, _a1 error) *MockAuthorizationV1_CreateOrUpdateRoleAssignment_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockAuthorizationV1_CreateOrUpdateRoleAssignment_Call) RunAndReturn(run func(context.Context, *schema.RoleAssignment) (*schema.RoleAssignment, error)) *MockAuthorizationV1_CreateOrUpdateRoleAssignment_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// CreateOrUpdateRoleAssignmentWithParams provides a mock function with given fields: ctx, assign, params
+func (_m *MockAuthorizationV1) CreateOrUpdateRoleAssignmentWithParams(ctx context.Context, assign *schema.RoleAssignment, params *authorization.CreateOrUpdateRoleAssignmentParams) (*schema.RoleAssignment, error) {
+	ret := _m.Called(ctx, assign, params)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CreateOrUpdateRoleAssignmentWithParams")
+	}
+
+	var r0 *schema.RoleAssignment
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *schema.RoleAssignment, *authorization.CreateOrUpdateRoleAssignmentParams) (*schema.RoleAssignment, error)); ok {
+		return rf(ctx, assign, params)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *schema.RoleAssignment, *authorization.CreateOrUpdateRoleAssignmentParams) *schema.RoleAssignment); ok {
+		r0 = rf(ctx, assign, params)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*schema.RoleAssignment)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *schema.RoleAssignment, *authorization.CreateOrUpdateRoleAssignmentParams) error); ok {
+		r1 = rf(ctx, assign, params)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockAuthorizationV1_CreateOrUpdateRoleAssignmentWithParams_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CreateOrUpdateRoleAssignmentWithParams'
+type MockAuthorizationV1_CreateOrUpdateRoleAssignmentWithParams_Call struct {
+	*mock.Call
+}
+
+// CreateOrUpdateRoleAssignmentWithParams is a helper method to define mock.On call
+//   - ctx context.Context
+//   - assign *schema.RoleAssignment
+//   - params *authorization.CreateOrUpdateRoleAssignmentParams
+func (_e *MockAuthorizationV1_Expecter) CreateOrUpdateRoleAssignmentWithParams(ctx interface{}, assign interface{}, params interface{}) *MockAuthorizationV1_CreateOrUpdateRoleAssignmentWithParams_Call {
+	return &MockAuthorizationV1_CreateOrUpdateRoleAssignmentWithParams_Call{Call: _e.mock.On("CreateOrUpdateRoleAssignmentWithParams", ctx, assign, params)}
+}
+
+func (_c *MockAuthorizationV1_CreateOrUpdateRoleAssignmentWithParams_Call) Run(run func(ctx context.Context, assign *schema.RoleAssignment, params *authorization.CreateOrUpdateRoleAssignmentParams)) *MockAuthorizationV1_CreateOrUpdateRoleAssignmentWithParams_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*schema.RoleAssignment), args[2].(*authorization.CreateOrUpdateRoleAssignmentParams))
+	})
+	return _c
+}
+
+func (_c *MockAuthorizationV1_CreateOrUpdateRoleAssignmentWithParams_Call) Return(_a0 *schema.RoleAssignment, _a1 error) *MockAuthorizationV1_CreateOrUpdateRoleAssignmentWithParams_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockAuthorizationV1_CreateOrUpdateRoleAssignmentWithParams_Call) RunAndReturn(run func(context.Context, *schema.RoleAssignment, *authorization.CreateOrUpdateRoleAssignmentParams) (*schema.RoleAssignment, error)) *MockAuthorizationV1_CreateOrUpdateRoleAssignmentWithParams_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// CreateOrUpdateRoleWithParams provides a mock function with given fields: ctx, role, params
+func (_m *MockAuthorizationV1) CreateOrUpdateRoleWithParams(ctx context.Context, role *schema.Role, params *authorization.CreateOrUpdateRoleParams) (*schema.Role, error) {
+	ret := _m.Called(ctx, role, params)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CreateOrUpdateRoleWithParams")
+	}
+
+	var r0 *schema.Role
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *schema.Role, *authorization.CreateOrUpdateRoleParams) (*schema.Role, error)); ok {
+		return rf(ctx, role, params)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *schema.Role, *authorization.CreateOrUpdateRoleParams) *schema.Role); ok {
+		r0 = rf(ctx, role, params)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*schema.Role)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *schema.Role, *authorization.CreateOrUpdateRoleParams) error); ok {
+		r1 = rf(ctx, role, params)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockAuthorizationV1_CreateOrUpdateRoleWithParams_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CreateOrUpdateRoleWithParams'
+type MockAuthorizationV1_CreateOrUpdateRoleWithParams_Call struct {
+	*mock.Call
+}
+
+// CreateOrUpdateRoleWithParams is a helper method to define mock.On call
+//   - ctx context.Context
+//   - role *schema.Role
+//   - params *authorization.CreateOrUpdateRoleParams
+func (_e *MockAuthorizationV1_Expecter) CreateOrUpdateRoleWithParams(ctx interface{}, role interface{}, params interface{}) *MockAuthorizationV1_CreateOrUpdateRoleWithParams_Call {
+	return &MockAuthorizationV1_CreateOrUpdateRoleWithParams_Call{Call: _e.mock.On("CreateOrUpdateRoleWithParams", ctx, role, params)}
+}
+
+func (_c *MockAuthorizationV1_CreateOrUpdateRoleWithParams_Call) Run(run func(ctx context.Context, role *schema.Role, params *authorization.CreateOrUpdateRoleParams)) *MockAuthorizationV1_CreateOrUpdateRoleWithParams_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*schema.Role), args[2].(*authorization.CreateOrUpdateRoleParams))
+	})
+	return _c
+}
+
+func (_c *MockAuthorizationV1_CreateOrUpdateRoleWithParams_Call) Return(_a0 *schema.Role, _a1 error) *MockAuthorizationV1_CreateOrUpdateRoleWithParams_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockAuthorizationV1_CreateOrUpdateRoleWithParams_Call) RunAndReturn(run func(context.Context, *schema.Role, *authorization.CreateOrUpdateRoleParams) (*schema.Role, error)) *MockAuthorizationV1_CreateOrUpdateRoleWithParams_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// DeleteRole provides a mock function with given fields: ctx, role
+func (_m *MockAuthorizationV1) DeleteRole(ctx context.Context, role *schema.Role) error {
+	ret := _m.Called(ctx, role)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DeleteRole")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, *schema.Role) error); ok {
+		r0 = rf(ctx, role)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockAuthorizationV1_DeleteRole_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DeleteRole'
+type MockAuthorizationV1_DeleteRole_Call struct {
+	*mock.Call
+}
+
+// DeleteRole is a helper method to define mock.On call
+//   - ctx context.Context
+//   - role *schema.Role
+func (_e *MockAuthorizationV1_Expecter) DeleteRole(ctx interface{}, role interface{}) *MockAuthorizationV1_DeleteRole_Call {
+	return &MockAuthorizationV1_DeleteRole_Call{Call: _e.mock.On("DeleteRole", ctx, role)}
+}
+
+func (_c *MockAuthorizationV1_DeleteRole_Call) Run(run func(ctx context.Context, role *schema.Role)) *MockAuthorizationV1_DeleteRole_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*schema.Role))
+	})
+	return _c
+}
+
+func (_c *MockAuthorizationV1_DeleteRole_Call) Return(_a0 error) *MockAuthorizationV1_DeleteRole_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockAuthorizationV1_DeleteRole_Call) RunAndReturn(run func(context.Context, *schema.Role) error) *MockAuthorizationV1_DeleteRole_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// DeleteRoleAssignment provides a mock function with given fields: ctx, assign
+func (_m *MockAuthorizationV1) DeleteRoleAssignment(ctx context.Context, assign *schema.RoleAssignment) error {
+	ret := _m.Called(ctx, assign)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DeleteRoleAssignment")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, *schema.RoleAssignment) error); ok {
+		r0 = rf(ctx, assign)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockAuthorizationV1_DeleteRoleAssignment_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DeleteRoleAssignment'
+type MockAuthorizationV1_DeleteRoleAssignment_Call struct {
+	*mock.Call
+}
+
+// DeleteRoleAssignment is a helper method to define mock.On call
+//   - ctx context.Context
+//   - assign *schema.RoleAssignment
+func (_e *MockAuthorizationV1_Expecter) DeleteRoleAssignment(ctx interface{}, assign interface{}) *MockAuthorizationV1_DeleteRoleAssignment_Call {
+	return &MockAuthorizationV1_DeleteRoleAssignment_Call{Call: _e.mock.On("DeleteRoleAssignment", ctx, assign)}
+}
+
+func (_c *MockAuthorizationV1_DeleteRoleAssignment_Call) Run(run func(ctx context.Context, assign *schema.RoleAssignment)) *MockAuthorizationV1_DeleteRoleAssignment_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*schema.RoleAssignment))
+	})
+	return _c
+}
+
+func (_c *MockAuthorizationV1_DeleteRoleAssignment_Call) Return(_a0 error) *MockAuthorizationV1_DeleteRoleAssignment_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockAuthorizationV1_DeleteRoleAssignment_Call) RunAndReturn(run func(context.Context, *schema.RoleAssignment) error) *MockAuthorizationV1_DeleteRoleAssignment_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// DeleteRoleAssignmentWithParams provides a mock function with given fields: ctx, assign, params
+func (_m *MockAuthorizationV1) DeleteRoleAssignmentWithParams(ctx context.Context, assign *schema.RoleAssignment, params *authorization.DeleteRoleAssignmentParams) error {
+	ret := _m.Called(ctx, assign, params)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DeleteRoleAssignmentWithParams")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, *schema.RoleAssignment, *authorization.DeleteRoleAssignmentParams) error); ok {
+		r0 = rf(ctx, assign, params)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockAuthorizationV1_DeleteRoleAssignmentWithParams_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DeleteRoleAssignmentWithParams'
+type MockAuthorizationV1_DeleteRoleAssignmentWithParams_Call struct {
+	*mock.Call
+}
+
+// DeleteRoleAssignmentWithParams is a helper method to define mock.On call
+//   - ctx context.Context
+//   - assign *schema.RoleAssignment
+//   - params *authorization.DeleteRoleAssignmentParams
+func (_e *MockAuthorizationV1_Expecter) DeleteRoleAssignmentWithParams(ctx interface{}, assign interface{}, params interface{}) *MockAuthorizationV1_DeleteRoleAssignmentWithParams_Call {
+	return &MockAuthorizationV1_DeleteRoleAssignmentWithParams_Call{Call: _e.mock.On("DeleteRoleAssignmentWithParams", ctx, assign, params)}
+}
+
+func (_c *MockAuthorizationV1_DeleteRoleAssignmentWithParams_Call) Run(run func(ctx context.Context, assign *schema.RoleAssignment, params *authorization.DeleteRoleAssignmentParams)) *MockAuthorizationV1_DeleteRoleAssignmentWithParams_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*schema.RoleAssignment), args[2].(*authorization.DeleteRoleAssignmentParams))
+	})
+	return _c
+}
+
+func (_c *MockAuthorizationV1_DeleteRoleAssignmentWithParams_Call) Return(_a0 error) *MockAuthorizationV1_DeleteRoleAssignmentWithParams_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockAuthorizationV1_DeleteRoleAssignmentWithParams_Call) RunAndReturn(run func(context.Context, *schema.RoleAssignment, *authorization.DeleteRoleAssignmentParams) error) *MockAuthorizationV1_DeleteRoleAssignmentWithParams_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// DeleteRoleWithParams provides a mock function with given fields: ctx, role, params
+func (_m *MockAuthorizationV1) DeleteRoleWithParams(ctx context.Context, role *schema.Role, params *authorization.DeleteRoleParams) error {
+	ret := _m.Called(ctx, role, params)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DeleteRoleWithParams")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, *schema.Role, *authorization.DeleteRoleParams) error); ok {
+		r0 = rf(ctx, role, params)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockAuthorizationV1_DeleteRoleWithParams_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DeleteRoleWithParams'
+type MockAuthorizationV1_DeleteRoleWithParams_Call struct {
+	*mock.Call
+}
+
+// DeleteRoleWithParams is a helper method to define mock.On call
+//   - ctx context.Context
+//   - role *schema.Role
+//   - params *authorization.DeleteRoleParams
+func (_e *MockAuthorizationV1_Expecter) DeleteRoleWithParams(ctx interface{}, role interface{}, params interface{}) *MockAuthorizationV1_DeleteRoleWithParams_Call {
+	return &MockAuthorizationV1_DeleteRoleWithParams_Call{Call: _e.mock.On("DeleteRoleWithParams", ctx, role, params)}
+}
+
+func (_c *MockAuthorizationV1_DeleteRoleWithParams_Call) Run(run func(ctx context.Context, role *schema.Role, params *authorization.DeleteRoleParams)) *MockAuthorizationV1_DeleteRoleWithParams_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*schema.Role), args[2].(*authorization.DeleteRoleParams))
+	})
+	return _c
+}
+
+func (_c *MockAuthorizationV1_DeleteRoleWithParams_Call) Return(_a0 error) *MockAuthorizationV1_DeleteRoleWithParams_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockAuthorizationV1_DeleteRoleWithParams_Call) RunAndReturn(run func(context.Context, *schema.Role, *authorization.DeleteRoleParams) error) *MockAuthorizationV1_DeleteRoleWithParams_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetRole provides a mock function with given fields: ctx, tref
+func (_m *MockAuthorizationV1) GetRole(ctx context.Context, tref secapi.TenantReference) (*schema.Role, error) {
+	ret := _m.Called(ctx, tref)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetRole")
+	}
+
+	var r0 *schema.Role
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, secapi.TenantReference) (*schema.Role, error)); ok {
+		return rf(ctx, tref)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, secapi.TenantReference) *schema.Role); ok {
+		r0 = rf(ctx, tref)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*schema.Role)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, secapi.TenantReference) error); ok {
+		r1 = rf(ctx, tref)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockAuthorizationV1_GetRole_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetRole'
+type MockAuthorizationV1_GetRole_Call struct {
+	*mock.Call
+}
+
+// GetRole is a helper method to define mock.On call
+//   - ctx context.Context
+//   - tref secapi.TenantReference
+func (_e *MockAuthorizationV1_Expecter) GetRole(ctx interface{}, tref interface{}) *MockAuthorizationV1_GetRole_Call {
+	return &MockAuthorizationV1_GetRole_Call{Call: _e.mock.On("GetRole", ctx, tref)}
+}
+
+func (_c *MockAuthorizationV1_GetRole_Call) Run(run func(ctx context.Context, tref secapi.TenantReference)) *MockAuthorizationV1_GetRole_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(secapi.TenantReference))
+	})
+	return _c
+}
+
+func (_c *MockAuthorizationV1_GetRole_Call) Return(_a0 *schema.Role, _a1 error) *MockAuthorizationV1_GetRole_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockAuthorizationV1_GetRole_Call) RunAndReturn(run func(context.Context, secapi.TenantReference) (*schema.Role, error)) *MockAuthorizationV1_GetRole_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetRoleAssignment provides a mock function with given fields: ctx, tref
+func (_m *MockAuthorizationV1) GetRoleAssignment(ctx context.Context, tref secapi.TenantReference) (*schema.RoleAssignment, error) {
+	ret := _m.Called(ctx, tref)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetRoleAssignment")
+	}
+
+	var r0 *schema.RoleAssignment
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, secapi.TenantReference) (*schema.RoleAssignment, error)); ok {
+		return rf(ctx, tref)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, secapi.TenantReference) *schema.RoleAssignment); ok {
+		r0 = rf(ctx, tref)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*schema.RoleAssignment)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, secapi.TenantReference) error); ok {
+		r1 = rf(ctx, tref)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockAuthorizationV1_GetRoleAssignment_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetRoleAssignment'
+type MockAuthorizationV1_GetRoleAssignment_Call struct {
+	*mock.Call
+}
+
+// GetRoleAssignment is a helper method to define mock.On call
+//   - ctx context.Context
+//   - tref secapi.TenantReference
+func (_e *MockAuthorizationV1_Expecter) GetRoleAssignment(ctx interface{}, tref interface{}) *MockAuthorizationV1_GetRoleAssignment_Call {
+	return &MockAuthorizationV1_GetRoleAssignment_Call{Call: _e.mock.On("GetRoleAssignment", ctx, tref)}
+}
+
+func (_c *MockAuthorizationV1_GetRoleAssignment_Call) Run(run func(ctx context.Context, tref secapi.TenantReference)) *MockAuthorizationV1_GetRoleAssignment_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(secapi.TenantReference))
+	})
+	return _c
+}
+
+func (_c *MockAuthorizationV1_GetRoleAssignment_Call) Return(_a0 *schema.RoleAssignment, _a1 error) *MockAuthorizationV1_GetRoleAssignment_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockAuthorizationV1_GetRoleAssignment_Call) RunAndReturn(run func(context.Context, secapi.TenantReference) (*schema.RoleAssignment, error)) *MockAuthorizationV1_GetRoleAssignment_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetRoleAssignmentUntilState provides a mock function with given fields: ctx, tref, config
+func (_m *MockAuthorizationV1) GetRoleAssignmentUntilState(ctx context.Context, tref secapi.TenantReference, config secapi.ResourceObserverUntilValueConfig[schema.ResourceState]) (*schema.RoleAssignment, error) {
+	ret := _m.Called(ctx, tref, config)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetRoleAssignmentUntilState")
+	}
+
+	var r0 *schema.RoleAssignment
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, secapi.TenantReference, secapi.ResourceObserverUntilValueConfig[schema.ResourceState]) (*schema.RoleAssignment, error)); ok {
+		return rf(ctx, tref, config)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, secapi.TenantReference, secapi.ResourceObserverUntilValueConfig[schema.ResourceState]) *schema.RoleAssignment); ok {
+		r0 = rf(ctx, tref, config)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*schema.RoleAssignment)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, secapi.TenantReference, secapi.ResourceObserverUntilValueConfig[schema.ResourceState]) error); ok {
+		r1 = rf(ctx, tref, config)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockAuthorizationV1_GetRoleAssignmentUntilState_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetRoleAssignmentUntilState'
+type MockAuthorizationV1_GetRoleAssignmentUntilState_Call struct {
+	*mock.Call
+}
+
+// GetRoleAssignmentUntilState is a helper method to define mock.On call
+//   - ctx context.Context
+//   - tref secapi.TenantReference
+//   - config secapi.ResourceObserverUntilValueConfig[schema.ResourceState]
+func (_e *MockAuthorizationV1_Expecter) GetRoleAssignmentUntilState(ctx interface{}, tref interface{}, config interface{}) *MockAuthorizationV1_GetRoleAssignmentUntilState_Call {
+	return &MockAuthorizationV1_GetRoleAssignmentUntilState_Call{Call: _e.mock.On("GetRoleAssignmentUntilState", ctx, tref, config)}
+}
+
+func (_c *MockAuthorizationV1_GetRoleAssignmentUntilState_Call) Run(run func(ctx context.Context, tref secapi.TenantReference, config secapi.ResourceObserverUntilValueConfig[schema.ResourceState])) *MockAuthorizationV1_GetRoleAssignmentUntilState_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(secapi.TenantReference), args[2].(secapi.ResourceObserverUntilValueConfig[schema.ResourceState]))
+	})
+	return _c
+}
+
+func (_c *MockAuthorizationV1_GetRoleAssignmentUntilState_Call) Return(_a0 *schema.RoleAssignment, _a1 error) *MockAuthorizationV1_GetRoleAssignmentUntilState_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockAuthorizationV1_GetRoleAssignmentUntilState_Call) RunAndReturn(run func(context.Context, secapi.TenantReference, secapi.ResourceObserverUntilValueConfig[schema.ResourceState]) (*schema.RoleAssignment, error)) *MockAuthorizationV1_GetRoleAssignmentUntilState_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetRoleUntilState provides a mock function with given fields: ctx, tref, config
+func (_m *MockAuthorizationV1) GetRoleUntilState(ctx context.Context, tref secapi.TenantReference, config secapi.ResourceObserverUntilValueConfig[schema.ResourceState]) (*schema.Role, error) {
+	ret := _m.Called(ctx, tref, config)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetRoleUntilState")
+	}
+
+	var r0 *schema.Role
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, secapi.TenantReference, secapi.ResourceObserverUntilValueConfig[schema.ResourceState]) (*schema.Role, error)); ok {
+		return rf(ctx, tref, config)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, secapi.TenantReference, secapi.ResourceObserverUntilValueConfig[schema.ResourceState]) *schema.Role); ok {
+		r0 = rf(ctx, tref, config)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*schema.Role)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, secapi.TenantReference, secapi.ResourceObserverUntilValueConfig[schema.ResourceState]) error); ok {
+		r1 = rf(ctx, tref, config)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockAuthorizationV1_GetRoleUntilState_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetRoleUntilState'
+type MockAuthorizationV1_GetRoleUntilState_Call struct {
+	*mock.Call
+}
+
+// GetRoleUntilState is a helper method to define mock.On call
+//   - ctx context.Context
+//   - tref secapi.TenantReference
+//   - config secapi.ResourceObserverUntilValueConfig[schema.ResourceState]
+func (_e *MockAuthorizationV1_Expecter) GetRoleUntilState(ctx interface{}, tref interface{}, config interface{}) *MockAuthorizationV1_GetRoleUntilState_Call {
+	return &MockAuthorizationV1_GetRoleUntilState_Call{Call: _e.mock.On("GetRoleUntilState", ctx, tref, config)}
+}
+
+func (_c *MockAuthorizationV1_GetRoleUntilState_Call) Run(run func(ctx context.Context, tref secapi.TenantReference, config secapi.ResourceObserverUntilValueConfig[schema.ResourceState])) *MockAuthorizationV1_GetRoleUntilState_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(secapi.TenantReference), args[2].(secapi.ResourceObserverUntilValueConfig[schema.ResourceState]))
+	})
+	return _c
+}
+
+func (_c *MockAuthorizationV1_GetRoleUntilState_Call) Return(_a0 *schema.Role, _a1 error) *MockAuthorizationV1_GetRoleUntilState_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockAuthorizationV1_GetRoleUntilState_Call) RunAndReturn(run func(context.Context, secapi.TenantReference, secapi.ResourceObserverUntilValueConfig[schema.ResourceState]) (*schema.Role, error)) *MockAuthorizationV1_GetRoleUntilState_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ListRoleAssignments provides a mock function with given fields: ctx, tpath
+func (_m *MockAuthorizationV1) ListRoleAssignments(ctx context.Context, tpath secapi.TenantPath) (*secapi.Iterator[schema.RoleAssignment], error) {
+	ret := _m.Called(ctx, tpath)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListRoleAssignments")
+	}
+
+	var r0 *secapi.Iterator[schema.RoleAssignment]
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, secapi.TenantPath) (*secapi.Iterator[schema.RoleAssignment], error)); ok {
+		return rf(ctx, tpath)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, secapi.TenantPath) *secapi.Iterator[schema.RoleAssignment]); ok {
+		r0 = rf(ctx, tpath)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*secapi.Iterator[schema.RoleAssignment])
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, secapi.TenantPath) error); ok {
+		r1 = rf(ctx, tpath)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockAuthorizationV1_ListRoleAssignments_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListRoleAssignments'
+type MockAuthorizationV1_ListRoleAssignments_Call struct {
+	*mock.Call
+}
+
+// ListRoleAssignments is a helper method to define mock.On call
+//   - ctx context.Context
+//   - tpath secapi.TenantPath
+func (_e *MockAuthorizationV1_Expecter) ListRoleAssignments(ctx interface{}, tpath interface{}) *MockAuthorizationV1_ListRoleAssignments_Call {
+	return &MockAuthorizationV1_ListRoleAssignments_Call{Call: _e.mock.On("ListRoleAssignments", ctx, tpath)}
+}
+
+func (_c *MockAuthorizationV1_ListRoleAssignments_Call) Run(run func(ctx context.Context, tpath secapi.TenantPath)) *MockAuthorizationV1_ListRoleAssignments_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(secapi.TenantPath))
+	})
+	return _c
+}
+
+func (_c *MockAuthorizationV1_ListRoleAssignments_Call) Return(_a0 *secapi.Iterator[schema.RoleAssignment], _a1 error) *MockAuthorizationV1_ListRoleAssignments_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockAuthorizationV1_ListRoleAssignments_Call) RunAndReturn(run func(context.Context, secapi.TenantPath) (*secapi.Iterator[schema.RoleAssignment], error)) *MockAuthorizationV1_ListRoleAssignments_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ListRoleAssignmentsWithOptions provides a mock function with given fields: ctx, tpath, options
+func (_m *MockAuthorizationV1) ListRoleAssignmentsWithOptions(ctx context.Context, tpath secapi.TenantPath, options *secapi.ListOptions) (*secapi.Iterator[schema.RoleAssignment], error) {
+	ret := _m.Called(ctx, tpath, options)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListRoleAssignmentsWithOptions")
+	}
+
+	var r0 *secapi.Iterator[schema.RoleAssignment]
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, secapi.TenantPath, *secapi.ListOptions) (*secapi.Iterator[schema.RoleAssignment], error)); ok {
+		return rf(ctx, tpath, options)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, secapi.TenantPath, *secapi.ListOptions) *secapi.Iterator[schema.RoleAssignment]); ok {
+		r0 = rf(ctx, tpath, options)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*secapi.Iterator[schema.RoleAssignment])
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, secapi.TenantPath, *secapi.ListOptions) error); ok {
+		r1 = rf(ctx, tpath, options)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockAuthorizationV1_ListRoleAssignmentsWithOptions_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListRoleAssignmentsWithOptions'
+type MockAuthorizationV1_ListRoleAssignmentsWithOptions_Call struct {
+	*mock.Call
+}
+
+// ListRoleAssignmentsWithOptions is a helper method to define mock.On call
+//   - ctx context.Context
+//   - tpath secapi.TenantPath
+//   - options *secapi.ListOptions
+func (_e *MockAuthorizationV1_Expecter) ListRoleAssignmentsWithOptions(ctx interface{}, tpath interface{}, options interface{}) *MockAuthorizationV1_ListRoleAssignmentsWithOptions_Call {
+	return &MockAuthorizationV1_ListRoleAssignmentsWithOptions_Call{Call: _e.mock.On("ListRoleAssignmentsWithOptions", ctx, tpath, options)}
+}
+
+func (_c *MockAuthorizationV1_ListRoleAssignmentsWithOptions_Call) Run(run func(ctx context.Context, tpath secapi.TenantPath, options *secapi.ListOptions)) *MockAuthorizationV1_ListRoleAssignmentsWithOptions_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(secapi.TenantPath), args[2].(*secapi.ListOptions))
+	})
+	return _c
+}
+
+func (_c *MockAuthorizationV1_ListRoleAssignmentsWithOptions_Call) Return(_a0 *secapi.Iterator[schema.RoleAssignment], _a1 error) *MockAuthorizationV1_ListRoleAssignmentsWithOptions_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockAuthorizationV1_ListRoleAssignmentsWithOptions_Call) RunAndReturn(run func(context.Context, secapi.TenantPath, *secapi.ListOptions) (*secapi.Iterator[schema.RoleAssignment], error)) *MockAuthorizationV1_ListRoleAssignmentsWithOptions_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ListRoles provides a mock function with given fields: ctx, tpath
+func (_m *MockAuthorizationV1) ListRoles(ctx context.Context, tpath secapi.TenantPath) (*secapi.Iterator[schema.Role], error) {
+	ret := _m.Called(ctx, tpath)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListRoles")
+	}
+
+	var r0 *secapi.Iterator[schema.Role]
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, secapi.TenantPath) (*secapi.Iterator[schema.Role], error)); ok {
+		return rf(ctx, tpath)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, secapi.TenantPath) *secapi.Iterator[schema.Role]); ok {
+		r0 = rf(ctx, tpath)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*secapi.Iterator[schema.Role])
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, secapi.TenantPath) error); ok {
+		r1 = rf(ctx, tpath)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockAuthorizationV1_ListRoles_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListRoles'
+type MockAuthorizationV1_ListRoles_Call struct {
+	*mock.Call
+}
+
+// ListRoles is a helper method to define mock.On call
+//   - ctx context.Context
+//   - tpath secapi.TenantPath
+func (_e *MockAuthorizationV1_Expecter) ListRoles(ctx interface{}, tpath interface{}) *MockAuthorizationV1_ListRoles_Call {
+	return &MockAuthorizationV1_ListRoles_Call{Call: _e.mock.On("ListRoles", ctx, tpath)}
+}
+
+func (_c *MockAuthorizationV1_ListRoles_Call) Run(run func(ctx context.Context, tpath secapi.TenantPath)) *MockAuthorizationV1_ListRoles_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(secapi.TenantPath))
+	})
+	return _c
+}
+
+func (_c *MockAuthorizationV1_ListRoles_Call) Return(_a0 *secapi.Iterator[schema.Role], _a1 error) *MockAuthorizationV1_ListRoles_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockAuthorizationV1_ListRoles_Call) RunAndReturn(run func(context.Context, secapi.TenantPath) (*secapi.Iterator[schema.Role], error)) *MockAuthorizationV1_ListRoles_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ListRolesWithOptions provides a mock function with given fields: ctx, tpath, options
+func (_m *MockAuthorizationV1) ListRolesWithOptions(ctx context.Context, tpath secapi.TenantPath, options *secapi.ListOptions) (*secapi.Iterator[schema.Role], error) {
+	ret := _m.Called(ctx, tpath, options)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListRolesWithOptions")
+	}
+
+	var r0 *secapi.Iterator[schema.Role]
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, secapi.TenantPath, *secapi.ListOptions) (*secapi.Iterator[schema.Role], error)); ok {
+		return rf(ctx, tpath, options)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, secapi.TenantPath, *secapi.ListOptions) *secapi.Iterator[schema.Role]); ok {
+		r0 = rf(ctx, tpath, options)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*secapi.Iterator[schema.Role])
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, secapi.TenantPath, *secapi.ListOptions) error); ok {
+		r1 = rf(ctx, tpath, options)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockAuthorizationV1_ListRolesWithOptions_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListRolesWithOptions'
+type MockAuthorizationV1_ListRolesWithOptions_Call struct {
+	*mock.Call
+}
+
+// ListRolesWithOptions is a helper method to define mock.On call
+//   - ctx context.Context
+//   - tpath secapi.TenantPath
+//   - options *secapi.ListOptions
+func (_e *MockAuthorizationV1_Expecter) ListRolesWithOptions(ctx interface{}, tpath interface{}, options interface{}) *MockAuthorizationV1_ListRolesWithOptions_Call {
+	return &MockAuthorizationV1_ListRolesWithOptions_Call{Call: _e.mock.On("ListRolesWithOptions", ctx, tpath, options)}
+}
+
+func (_c *MockAuthorizationV1_ListRolesWithOptions_Call) Run(run func(ctx context.Context, tpath secapi.TenantPath, options *secapi.ListOptions)) *MockAuthorizationV1_ListRolesWithOptions_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(secapi.TenantPath), args[2].(*secapi.ListOptions))
+	})
+	return _c
+}
+
+func (_c *MockAuthorizationV1_ListRolesWithOptions_Call) Return(_a0 *secapi.Iterator[schema.Role], _a1 error) *MockAuthorizationV1_ListRolesWithOptions_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockAuthorizationV1_ListRolesWithOptions_Call) RunAndReturn(run func(context.Context, secapi.TenantPath, *secapi.ListOptions) (*secapi.Iterator[schema.Role], error)) *MockAuthorizationV1_ListRolesWithOptions_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// WatchRoleAssignmentUntilDeleted provides a mock function with given fields: ctx, tref, config
+func (_m *MockAuthorizationV1) WatchRoleAssignmentUntilDeleted(ctx context.Context, tref secapi.TenantReference, config secapi.ResourceObserverConfig) error {
+	ret := _m.Called(ctx, tref, config)
+
+	if len(ret) == 0 {
+		panic("no return value specified for WatchRoleAssignmentUntilDeleted")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, secapi.TenantReference, secapi.ResourceObserverConfig) error); ok {
+		r0 = rf(ctx, tref, config)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockAuthorizationV1_WatchRoleAssignmentUntilDeleted_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'WatchRoleAssignmentUntilDeleted'
+type MockAuthorizationV1_WatchRoleAssignmentUntilDeleted_Call struct {
+	*mock.Call
+}
+
+// WatchRoleAssignmentUntilDeleted is a helper method to define mock.On call
+//   - ctx context.Context
+//   - tref secapi.TenantReference
+//   - config secapi.ResourceObserverConfig
+func (_e *MockAuthorizationV1_Expecter) WatchRoleAssignmentUntilDeleted(ctx interface{}, tref interface{}, config interface{}) *MockAuthorizationV1_WatchRoleAssignmentUntilDeleted_Call {
+	return &MockAuthorizationV1_WatchRoleAssignmentUntilDeleted_Call{Call: _e.mock.On("WatchRoleAssignmentUntilDeleted", ctx, tref, config)}
+}
+
+func (_c *MockAuthorizationV1_WatchRoleAssignmentUntilDeleted_Call) Run(run func(ctx context.Context, tref secapi.TenantReference, config secapi.ResourceObserverConfig)) *MockAuthorizationV1_WatchRoleAssignmentUntilDeleted_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(secapi.TenantReference), args[2].(secapi.ResourceObserverConfig))
+	})
+	return _c
+}
+
+func (_c *MockAuthorizationV1_WatchRoleAssignmentUntilDeleted_Call) Return(_a0 error) *MockAuthorizationV1_WatchRoleAssignmentUntilDeleted_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockAuthorizationV1_WatchRoleAssignmentUntilDeleted_Call) RunAndReturn(run func(context.Context, secapi.TenantReference, secapi.ResourceObserverConfig) error) *MockAuthorizationV1_WatchRoleAssignmentUntilDeleted_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// WatchRoleUntilDeleted provides a mock function with given fields: ctx, tref, config
+func (_m *MockAuthorizationV1) WatchRoleUntilDeleted(ctx context.Context, tref secapi.TenantReference, config secapi.ResourceObserverConfig) error {
+	ret := _m.Called(ctx, tref, config)
+
+	if len(ret) == 0 {
+		panic("no return value specified for WatchRoleUntilDeleted")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, secapi.TenantReference, secapi.ResourceObserverConfig) error); ok {
+		r0 = rf(ctx, tref, config)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockAuthorizationV1_WatchRoleUntilDeleted_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'WatchRoleUntilDeleted'
+type MockAuthorizationV1_WatchRoleUntilDeleted_Call struct {
+	*mock.Call
+}
+
+// WatchRoleUntilDeleted is a helper method to define mock.On call
+//   - ctx context.Context
+//   - tref secapi.TenantReference
+//   - config secapi.ResourceObserverConfig
+func (_e *MockAuthorizationV1_Expecter) WatchRoleUntilDeleted(ctx interface{}, tref interface{}, config interface{}) *MockAuthorizationV1_WatchRoleUntilDeleted_Call {
+	return &MockAuthorizationV1_WatchRoleUntilDeleted_Call{Call: _e.mock.On("WatchRoleUntilDeleted", ctx, tref, config)}
+}
+
+func (_c *MockAuthorizationV1_WatchRoleUntilDeleted_Call) Run(run func(ctx context.Context, tref secapi.TenantReference, config secapi.ResourceObserverConfig)) *MockAuthorizationV1_WatchRoleUntilDeleted_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(secapi.TenantReference), args[2].(secapi.ResourceObserverConfig))
+	})
+	return _c
+}
+
+func (_c *MockAuthorizationV1_WatchRoleUntilDeleted_Call) Return(_a0 error) *MockAuthorizationV1_WatchRoleUntilDeleted_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockAuthorizationV1_WatchRoleUntilDeleted_Call) RunAndReturn(run func(context.Context, secapi.TenantReference, secapi.ResourceObserverConfig) error) *MockAuthorizationV1_WatchRoleUntilDeleted_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockAuthorizationV1 creates a new instance of MockAuthorizationV1. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockAuthorizationV1(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockAuthorizationV1 {
+	mock := &MockAuthorizationV1{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
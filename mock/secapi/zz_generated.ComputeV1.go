@@ -0,0 +1,1065 @@
+// Code generated by mockery v2.52.2. DO NOT EDIT.
+
+package mocksecapi
+
+import (
+	context "context"
+
+	compute "github.com/eu-sovereign-cloud/go-sdk/pkg/spec/foundation.compute.v1"
+
+	mock "github.com/stretchr/testify/mock"
+
+	schema "github.com/eu-sovereign-cloud/go-sdk/pkg/spec/schema"
+
+	secapi "github.com/eu-sovereign-cloud/go-sdk/secapi"
+)
+
+// MockComputeV1 is an autogenerated mock type for the ComputeV1 type
+type MockComputeV1 struct {
+	mock.Mock
+}
+
+type MockComputeV1_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockComputeV1) EXPECT() *MockComputeV1_Expecter {
+	return &MockComputeV1_Expecter{mock: &_m.Mock}
+}
+
+// CreateOrUpdateInstance provides a mock function with given fields: ctx, inst
+func (_m *MockComputeV1) CreateOrUpdateInstance(ctx context.Context, inst *schema.Instance) (*schema.Instance, error) {
+	ret := _m.Called(ctx, inst)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CreateOrUpdateInstance")
+	}
+
+	var r0 *schema.Instance
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *schema.Instance) (*schema.Instance, error)); ok {
+		return rf(ctx, inst)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *schema.Instance) *schema.Instance); ok {
+		r0 = rf(ctx, inst)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*schema.Instance)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *schema.Instance) error); ok {
+		r1 = rf(ctx, inst)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockComputeV1_CreateOrUpdateInstance_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CreateOrUpdateInstance'
+type MockComputeV1_CreateOrUpdateInstance_Call struct {
+	*mock.Call
+}
+
+// CreateOrUpdateInstance is a helper method to define mock.On call
+//   - ctx context.Context
+//   - inst *schema.Instance
+func (_e *MockComputeV1_Expecter) CreateOrUpdateInstance(ctx interface{}, inst interface{}) *MockComputeV1_CreateOrUpdateInstance_Call {
+	return &MockComputeV1_CreateOrUpdateInstance_Call{Call: _e.mock.On("CreateOrUpdateInstance", ctx, inst)}
+}
+
+func (_c *MockComputeV1_CreateOrUpdateInstance_Call) Run(run func(ctx context.Context, inst *schema.Instance)) *MockComputeV1_CreateOrUpdateInstance_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*schema.Instance))
+	})
+	return _c
+}
+
+func (_c *MockComputeV1_CreateOrUpdateInstance_Call) Return(_a0 *schema.Instance, _a1 error) *MockComputeV1_CreateOrUpdateInstance_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockComputeV1_CreateOrUpdateInstance_Call) RunAndReturn(run func(context.Context, *schema.Instance) (*schema.Instance, error)) *MockComputeV1_CreateOrUpdateInstance_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// CreateOrUpdateInstanceWithParams provides a mock function with given fields: ctx, inst, params
+func (_m *MockComputeV1) CreateOrUpdateInstanceWithParams(ctx context.Context, inst *schema.Instance, params *compute.CreateOrUpdateInstanceParams) (*schema.Instance, error) {
+	ret := _m.Called(ctx, inst, params)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CreateOrUpdateInstanceWithParams")
+	}
+
+	var r0 *schema.Instance
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *schema.Instance, *compute.CreateOrUpdateInstanceParams) (*schema.Instance, error)); ok {
+		return rf(ctx, inst, params)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *schema.Instance, *compute.CreateOrUpdateInstanceParams) *schema.Instance); ok {
+		r0 = rf(ctx, inst, params)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*schema.Instance)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *schema.Instance, *compute.CreateOrUpdateInstanceParams) error); ok {
+		r1 = rf(ctx, inst, params)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockComputeV1_CreateOrUpdateInstanceWithParams_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CreateOrUpdateInstanceWithParams'
+type MockComputeV1_CreateOrUpdateInstanceWithParams_Call struct {
+	*mock.Call
+}
+
+// CreateOrUpdateInstanceWithParams is a helper method to define mock.On call
+//   - ctx context.Context
+//   - inst *schema.Instance
+//   - params *compute.CreateOrUpdateInstanceParams
+func (_e *MockComputeV1_Expecter) CreateOrUpdateInstanceWithParams(ctx interface{}, inst interface{}, params interface{}) *MockComputeV1_CreateOrUpdateInstanceWithParams_Call {
+	return &MockComputeV1_CreateOrUpdateInstanceWithParams_Call{Call: _e.mock.On("CreateOrUpdateInstanceWithParams", ctx, inst, params)}
+}
+
+func (_c *MockComputeV1_CreateOrUpdateInstanceWithParams_Call) Run(run func(ctx context.Context, inst *schema.Instance, params *compute.CreateOrUpdateInstanceParams)) *MockComputeV1_CreateOrUpdateInstanceWithParams_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*schema.Instance), args[2].(*compute.CreateOrUpdateInstanceParams))
+	})
+	return _c
+}
+
+func (_c *MockComputeV1_CreateOrUpdateInstanceWithParams_Call) Return(_a0 *schema.Instance, _a1 error) *MockComputeV1_CreateOrUpdateInstanceWithParams_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockComputeV1_CreateOrUpdateInstanceWithParams_Call) RunAndReturn(run func(context.Context, *schema.Instance, *compute.CreateOrUpdateInstanceParams) (*schema.Instance, error)) *MockComputeV1_CreateOrUpdateInstanceWithParams_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// DeleteInstance provides a mock function with given fields: ctx, inst
+func (_m *MockComputeV1) DeleteInstance(ctx context.Context, inst *schema.Instance) error {
+	ret := _m.Called(ctx, inst)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DeleteInstance")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, *schema.Instance) error); ok {
+		r0 = rf(ctx, inst)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockComputeV1_DeleteInstance_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DeleteInstance'
+type MockComputeV1_DeleteInstance_Call struct {
+	*mock.Call
+}
+
+// DeleteInstance is a helper method to define mock.On call
+//   - ctx context.Context
+//   - inst *schema.Instance
+func (_e *MockComputeV1_Expecter) DeleteInstance(ctx interface{}, inst interface{}) *MockComputeV1_DeleteInstance_Call {
+	return &MockComputeV1_DeleteInstance_Call{Call: _e.mock.On("DeleteInstance", ctx, inst)}
+}
+
+func (_c *MockComputeV1_DeleteInstance_Call) Run(run func(ctx context.Context, inst *schema.Instance)) *MockComputeV1_DeleteInstance_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*schema.Instance))
+	})
+	return _c
+}
+
+func (_c *MockComputeV1_DeleteInstance_Call) Return(_a0 error) *MockComputeV1_DeleteInstance_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockComputeV1_DeleteInstance_Call) RunAndReturn(run func(context.Context, *schema.Instance) error) *MockComputeV1_DeleteInstance_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// DeleteInstanceWithParams provides a mock function with given fields: ctx, inst, params
+func (_m *MockComputeV1) DeleteInstanceWithParams(ctx context.Context, inst *schema.Instance, params *compute.DeleteInstanceParams) error {
+	ret := _m.Called(ctx, inst, params)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DeleteInstanceWithParams")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, *schema.Instance, *compute.DeleteInstanceParams) error); ok {
+		r0 = rf(ctx, inst, params)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockComputeV1_DeleteInstanceWithParams_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DeleteInstanceWithParams'
+type MockComputeV1_DeleteInstanceWithParams_Call struct {
+	*mock.Call
+}
+
+// DeleteInstanceWithParams is a helper method to define mock.On call
+//   - ctx context.Context
+//   - inst *schema.Instance
+//   - params *compute.DeleteInstanceParams
+func (_e *MockComputeV1_Expecter) DeleteInstanceWithParams(ctx interface{}, inst interface{}, params interface{}) *MockComputeV1_DeleteInstanceWithParams_Call {
+	return &MockComputeV1_DeleteInstanceWithParams_Call{Call: _e.mock.On("DeleteInstanceWithParams", ctx, inst, params)}
+}
+
+func (_c *MockComputeV1_DeleteInstanceWithParams_Call) Run(run func(ctx context.Context, inst *schema.Instance, params *compute.DeleteInstanceParams)) *MockComputeV1_DeleteInstanceWithParams_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*schema.Instance), args[2].(*compute.DeleteInstanceParams))
+	})
+	return _c
+}
+
+func (_c *MockComputeV1_DeleteInstanceWithParams_Call) Return(_a0 error) *MockComputeV1_DeleteInstanceWithParams_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockComputeV1_DeleteInstanceWithParams_Call) RunAndReturn(run func(context.Context, *schema.Instance, *compute.DeleteInstanceParams) error) *MockComputeV1_DeleteInstanceWithParams_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetInstance provides a mock function with given fields: ctx, wref
+func (_m *MockComputeV1) GetInstance(ctx context.Context, wref secapi.WorkspaceReference) (*schema.Instance, error) {
+	ret := _m.Called(ctx, wref)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetInstance")
+	}
+
+	var r0 *schema.Instance
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, secapi.WorkspaceReference) (*schema.Instance, error)); ok {
+		return rf(ctx, wref)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, secapi.WorkspaceReference) *schema.Instance); ok {
+		r0 = rf(ctx, wref)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*schema.Instance)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, secapi.WorkspaceReference) error); ok {
+		r1 = rf(ctx, wref)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockComputeV1_GetInstance_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetInstance'
+type MockComputeV1_GetInstance_Call struct {
+	*mock.Call
+}
+
+// GetInstance is a helper method to define mock.On call
+//   - ctx context.Context
+//   - wref secapi.WorkspaceReference
+func (_e *MockComputeV1_Expecter) GetInstance(ctx interface{}, wref interface{}) *MockComputeV1_GetInstance_Call {
+	return &MockComputeV1_GetInstance_Call{Call: _e.mock.On("GetInstance", ctx, wref)}
+}
+
+func (_c *MockComputeV1_GetInstance_Call) Run(run func(ctx context.Context, wref secapi.WorkspaceReference)) *MockComputeV1_GetInstance_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(secapi.WorkspaceReference))
+	})
+	return _c
+}
+
+func (_c *MockComputeV1_GetInstance_Call) Return(_a0 *schema.Instance, _a1 error) *MockComputeV1_GetInstance_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockComputeV1_GetInstance_Call) RunAndReturn(run func(context.Context, secapi.WorkspaceReference) (*schema.Instance, error)) *MockComputeV1_GetInstance_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetInstanceUntilPowerState provides a mock function with given fields: ctx, wref, config
+func (_m *MockComputeV1) GetInstanceUntilPowerState(ctx context.Context, wref secapi.WorkspaceReference, config secapi.ResourceObserverUntilValueConfig[schema.InstanceStatusPowerState]) (*schema.Instance, error) {
+	ret := _m.Called(ctx, wref, config)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetInstanceUntilPowerState")
+	}
+
+	var r0 *schema.Instance
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, secapi.WorkspaceReference, secapi.ResourceObserverUntilValueConfig[schema.InstanceStatusPowerState]) (*schema.Instance, error)); ok {
+		return rf(ctx, wref, config)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, secapi.WorkspaceReference, secapi.ResourceObserverUntilValueConfig[schema.InstanceStatusPowerState]) *schema.Instance); ok {
+		r0 = rf(ctx, wref, config)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*schema.Instance)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, secapi.WorkspaceReference, secapi.ResourceObserverUntilValueConfig[schema.InstanceStatusPowerState]) error); ok {
+		r1 = rf(ctx, wref, config)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockComputeV1_GetInstanceUntilPowerState_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetInstanceUntilPowerState'
+type MockComputeV1_GetInstanceUntilPowerState_Call struct {
+	*mock.Call
+}
+
+// GetInstanceUntilPowerState is a helper method to define mock.On call
+//   - ctx context.Context
+//   - wref secapi.WorkspaceReference
+//   - config secapi.ResourceObserverUntilValueConfig[schema.InstanceStatusPowerState]
+func (_e *MockComputeV1_Expecter) GetInstanceUntilPowerState(ctx interface{}, wref interface{}, config interface{}) *MockComputeV1_GetInstanceUntilPowerState_Call {
+	return &MockComputeV1_GetInstanceUntilPowerState_Call{Call: _e.mock.On("GetInstanceUntilPowerState", ctx, wref, config)}
+}
+
+func (_c *MockComputeV1_GetInstanceUntilPowerState_Call) Run(run func(ctx context.Context, wref secapi.WorkspaceReference, config secapi.ResourceObserverUntilValueConfig[schema.InstanceStatusPowerState])) *MockComputeV1_GetInstanceUntilPowerState_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(secapi.WorkspaceReference), args[2].(secapi.ResourceObserverUntilValueConfig[schema.InstanceStatusPowerState]))
+	})
+	return _c
+}
+
+func (_c *MockComputeV1_GetInstanceUntilPowerState_Call) Return(_a0 *schema.Instance, _a1 error) *MockComputeV1_GetInstanceUntilPowerState_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockComputeV1_GetInstanceUntilPowerState_Call) RunAndReturn(run func(context.Context, secapi.WorkspaceReference, secapi.ResourceObserverUntilValueConfig[schema.InstanceStatusPowerState]) (*schema.Instance, error)) *MockComputeV1_GetInstanceUntilPowerState_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetInstanceUntilState provides a mock function with given fields: ctx, wref, config
+func (_m *MockComputeV1) GetInstanceUntilState(ctx context.Context, wref secapi.WorkspaceReference, config secapi.ResourceObserverUntilValueConfig[schema.ResourceState]) (*schema.Instance, error) {
+	ret := _m.Called(ctx, wref, config)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetInstanceUntilState")
+	}
+
+	var r0 *schema.Instance
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, secapi.WorkspaceReference, secapi.ResourceObserverUntilValueConfig[schema.ResourceState]) (*schema.Instance, error)); ok {
+		return rf(ctx, wref, config)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, secapi.WorkspaceReference, secapi.ResourceObserverUntilValueConfig[schema.ResourceState]) *schema.Instance); ok {
+		r0 = rf(ctx, wref, config)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*schema.Instance)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, secapi.WorkspaceReference, secapi.ResourceObserverUntilValueConfig[schema.ResourceState]) error); ok {
+		r1 = rf(ctx, wref, config)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockComputeV1_GetInstanceUntilState_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetInstanceUntilState'
+type MockComputeV1_GetInstanceUntilState_Call struct {
+	*mock.Call
+}
+
+// GetInstanceUntilState is a helper method to define mock.On call
+//   - ctx context.Context
+//   - wref secapi.WorkspaceReference
+//   - config secapi.ResourceObserverUntilValueConfig[schema.ResourceState]
+func (_e *MockComputeV1_Expecter) GetInstanceUntilState(ctx interface{}, wref interface{}, config interface{}) *MockComputeV1_GetInstanceUntilState_Call {
+	return &MockComputeV1_GetInstanceUntilState_Call{Call: _e.mock.On("GetInstanceUntilState", ctx, wref, config)}
+}
+
+func (_c *MockComputeV1_GetInstanceUntilState_Call) Run(run func(ctx context.Context, wref secapi.WorkspaceReference, config secapi.ResourceObserverUntilValueConfig[schema.ResourceState])) *MockComputeV1_GetInstanceUntilState_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(secapi.WorkspaceReference), args[2].(secapi.ResourceObserverUntilValueConfig[schema.ResourceState]))
+	})
+	return _c
+}
+
+func (_c *MockComputeV1_GetInstanceUntilState_Call) Return(_a0 *schema.Instance, _a1 error) *MockComputeV1_GetInstanceUntilState_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockComputeV1_GetInstanceUntilState_Call) RunAndReturn(run func(context.Context, secapi.WorkspaceReference, secapi.ResourceObserverUntilValueConfig[schema.ResourceState]) (*schema.Instance, error)) *MockComputeV1_GetInstanceUntilState_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetSku provides a mock function with given fields: ctx, tref
+func (_m *MockComputeV1) GetSku(ctx context.Context, tref secapi.TenantReference) (*schema.InstanceSku, error) {
+	ret := _m.Called(ctx, tref)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetSku")
+	}
+
+	var r0 *schema.InstanceSku
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, secapi.TenantReference) (*schema.InstanceSku, error)); ok {
+		return rf(ctx, tref)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, secapi.TenantReference) *schema.InstanceSku); ok {
+		r0 = rf(ctx, tref)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*schema.InstanceSku)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, secapi.TenantReference) error); ok {
+		r1 = rf(ctx, tref)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockComputeV1_GetSku_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetSku'
+type MockComputeV1_GetSku_Call struct {
+	*mock.Call
+}
+
+// GetSku is a helper method to define mock.On call
+//   - ctx context.Context
+//   - tref secapi.TenantReference
+func (_e *MockComputeV1_Expecter) GetSku(ctx interface{}, tref interface{}) *MockComputeV1_GetSku_Call {
+	return &MockComputeV1_GetSku_Call{Call: _e.mock.On("GetSku", ctx, tref)}
+}
+
+func (_c *MockComputeV1_GetSku_Call) Run(run func(ctx context.Context, tref secapi.TenantReference)) *MockComputeV1_GetSku_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(secapi.TenantReference))
+	})
+	return _c
+}
+
+func (_c *MockComputeV1_GetSku_Call) Return(_a0 *schema.InstanceSku, _a1 error) *MockComputeV1_GetSku_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockComputeV1_GetSku_Call) RunAndReturn(run func(context.Context, secapi.TenantReference) (*schema.InstanceSku, error)) *MockComputeV1_GetSku_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ListInstances provides a mock function with given fields: ctx, wpath
+func (_m *MockComputeV1) ListInstances(ctx context.Context, wpath secapi.WorkspacePath) (*secapi.Iterator[schema.Instance], error) {
+	ret := _m.Called(ctx, wpath)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListInstances")
+	}
+
+	var r0 *secapi.Iterator[schema.Instance]
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, secapi.WorkspacePath) (*secapi.Iterator[schema.Instance], error)); ok {
+		return rf(ctx, wpath)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, secapi.WorkspacePath) *secapi.Iterator[schema.Instance]); ok {
+		r0 = rf(ctx, wpath)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*secapi.Iterator[schema.Instance])
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, secapi.WorkspacePath) error); ok {
+		r1 = rf(ctx, wpath)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockComputeV1_ListInstances_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListInstances'
+type MockComputeV1_ListInstances_Call struct {
+	*mock.Call
+}
+
+// ListInstances is a helper method to define mock.On call
+//   - ctx context.Context
+//   - wpath secapi.WorkspacePath
+func (_e *MockComputeV1_Expecter) ListInstances(ctx interface{}, wpath interface{}) *MockComputeV1_ListInstances_Call {
+	return &MockComputeV1_ListInstances_Call{Call: _e.mock.On("ListInstances", ctx, wpath)}
+}
+
+func (_c *MockComputeV1_ListInstances_Call) Run(run func(ctx context.Context, wpath secapi.WorkspacePath)) *MockComputeV1_ListInstances_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(secapi.WorkspacePath))
+	})
+	return _c
+}
+
+func (_c *MockComputeV1_ListInstances_Call) Return(_a0 *secapi.Iterator[schema.Instance], _a1 error) *MockComputeV1_ListInstances_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockComputeV1_ListInstances_Call) RunAndReturn(run func(context.Context, secapi.WorkspacePath) (*secapi.Iterator[schema.Instance], error)) *MockComputeV1_ListInstances_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ListInstancesWithOptions provides a mock function with given fields: ctx, wpath, options
+func (_m *MockComputeV1) ListInstancesWithOptions(ctx context.Context, wpath secapi.WorkspacePath, options *secapi.ListOptions) (*secapi.Iterator[schema.Instance], error) {
+	ret := _m.Called(ctx, wpath, options)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListInstancesWithOptions")
+	}
+
+	var r0 *secapi.Iterator[schema.Instance]
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, secapi.WorkspacePath, *secapi.ListOptions) (*secapi.Iterator[schema.Instance], error)); ok {
+		return rf(ctx, wpath, options)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, secapi.WorkspacePath, *secapi.ListOptions) *secapi.Iterator[schema.Instance]); ok {
+		r0 = rf(ctx, wpath, options)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*secapi.Iterator[schema.Instance])
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, secapi.WorkspacePath, *secapi.ListOptions) error); ok {
+		r1 = rf(ctx, wpath, options)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockComputeV1_ListInstancesWithOptions_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListInstancesWithOptions'
+type MockComputeV1_ListInstancesWithOptions_Call struct {
+	*mock.Call
+}
+
+// ListInstancesWithOptions is a helper method to define mock.On call
+//   - ctx context.Context
+//   - wpath secapi.WorkspacePath
+//   - options *secapi.ListOptions
+func (_e *MockComputeV1_Expecter) ListInstancesWithOptions(ctx interface{}, wpath interface{}, options interface{}) *MockComputeV1_ListInstancesWithOptions_Call {
+	return &MockComputeV1_ListInstancesWithOptions_Call{Call: _e.mock.On("ListInstancesWithOptions", ctx, wpath, options)}
+}
+
+func (_c *MockComputeV1_ListInstancesWithOptions_Call) Run(run func(ctx context.Context, wpath secapi.WorkspacePath, options *secapi.ListOptions)) *MockComputeV1_ListInstancesWithOptions_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(secapi.WorkspacePath), args[2].(*secapi.ListOptions))
+	})
+	return _c
+}
+
+func (_c *MockComputeV1_ListInstancesWithOptions_Call) Return(_a0 *secapi.Iterator[schema.Instance], _a1 error) *MockComputeV1_ListInstancesWithOptions_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockComputeV1_ListInstancesWithOptions_Call) RunAndReturn(run func(context.Context, secapi.WorkspacePath, *secapi.ListOptions) (*secapi.Iterator[schema.Instance], error)) *MockComputeV1_ListInstancesWithOptions_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ListSkus provides a mock function with given fields: ctx, tpath
+func (_m *MockComputeV1) ListSkus(ctx context.Context, tpath secapi.TenantPath) (*secapi.Iterator[schema.InstanceSku], error) {
+	ret := _m.Called(ctx, tpath)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListSkus")
+	}
+
+	var r0 *secapi.Iterator[schema.InstanceSku]
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, secapi.TenantPath) (*secapi.Iterator[schema.InstanceSku], error)); ok {
+		return rf(ctx, tpath)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, secapi.TenantPath) *secapi.Iterator[schema.InstanceSku]); ok {
+		r0 = rf(ctx, tpath)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*secapi.Iterator[schema.InstanceSku])
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, secapi.TenantPath) error); ok {
+		r1 = rf(ctx, tpath)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockComputeV1_ListSkus_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListSkus'
+type MockComputeV1_ListSkus_Call struct {
+	*mock.Call
+}
+
+// ListSkus is a helper method to define mock.On call
+//   - ctx context.Context
+//   - tpath secapi.TenantPath
+func (_e *MockComputeV1_Expecter) ListSkus(ctx interface{}, tpath interface{}) *MockComputeV1_ListSkus_Call {
+	return &MockComputeV1_ListSkus_Call{Call: _e.mock.On("ListSkus", ctx, tpath)}
+}
+
+func (_c *MockComputeV1_ListSkus_Call) Run(run func(ctx context.Context, tpath secapi.TenantPath)) *MockComputeV1_ListSkus_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(secapi.TenantPath))
+	})
+	return _c
+}
+
+func (_c *MockComputeV1_ListSkus_Call) Return(_a0 *secapi.Iterator[schema.InstanceSku], _a1 error) *MockComputeV1_ListSkus_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockComputeV1_ListSkus_Call) RunAndReturn(run func(context.Context, secapi.TenantPath) (*secapi.Iterator[schema.InstanceSku], error)) *MockComputeV1_ListSkus_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ListSkusWithOptions provides a mock function with given fields: ctx, tpath, options
+func (_m *MockComputeV1) ListSkusWithOptions(ctx context.Context, tpath secapi.TenantPath, options *secapi.ListOptions) (*secapi.Iterator[schema.InstanceSku], error) {
+	ret := _m.Called(ctx, tpath, options)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListSkusWithOptions")
+	}
+
+	var r0 *secapi.Iterator[schema.InstanceSku]
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, secapi.TenantPath, *secapi.ListOptions) (*secapi.Iterator[schema.InstanceSku], error)); ok {
+		return rf(ctx, tpath, options)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, secapi.TenantPath, *secapi.ListOptions) *secapi.Iterator[schema.InstanceSku]); ok {
+		r0 = rf(ctx, tpath, options)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*secapi.Iterator[schema.InstanceSku])
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, secapi.TenantPath, *secapi.ListOptions) error); ok {
+		r1 = rf(ctx, tpath, options)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockComputeV1_ListSkusWithOptions_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListSkusWithOptions'
+type MockComputeV1_ListSkusWithOptions_Call struct {
+	*mock.Call
+}
+
+// ListSkusWithOptions is a helper method to define mock.On call
+//   - ctx context.Context
+//   - tpath secapi.TenantPath
+//   - options *secapi.ListOptions
+func (_e *MockComputeV1_Expecter) ListSkusWithOptions(ctx interface{}, tpath interface{}, options interface{}) *MockComputeV1_ListSkusWithOptions_Call {
+	return &MockComputeV1_ListSkusWithOptions_Call{Call: _e.mock.On("ListSkusWithOptions", ctx, tpath, options)}
+}
+
+func (_c *MockComputeV1_ListSkusWithOptions_Call) Run(run func(ctx context.Context, tpath secapi.TenantPath, options *secapi.ListOptions)) *MockComputeV1_ListSkusWithOptions_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(secapi.TenantPath), args[2].(*secapi.ListOptions))
+	})
+	return _c
+}
+
+func (_c *MockComputeV1_ListSkusWithOptions_Call) Return(_a0 *secapi.Iterator[schema.InstanceSku], _a1 error) *MockComputeV1_ListSkusWithOptions_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockComputeV1_ListSkusWithOptions_Call) RunAndReturn(run func(context.Context, secapi.TenantPath, *secapi.ListOptions) (*secapi.Iterator[schema.InstanceSku], error)) *MockComputeV1_ListSkusWithOptions_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// RestartInstance provides a mock function with given fields: ctx, inst
+func (_m *MockComputeV1) RestartInstance(ctx context.Context, inst *schema.Instance) error {
+	ret := _m.Called(ctx, inst)
+
+	if len(ret) == 0 {
+		panic("no return value specified for RestartInstance")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, *schema.Instance) error); ok {
+		r0 = rf(ctx, inst)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockComputeV1_RestartInstance_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RestartInstance'
+type MockComputeV1_RestartInstance_Call struct {
+	*mock.Call
+}
+
+// RestartInstance is a helper method to define mock.On call
+//   - ctx context.Context
+//   - inst *schema.Instance
+func (_e *MockComputeV1_Expecter) RestartInstance(ctx interface{}, inst interface{}) *MockComputeV1_RestartInstance_Call {
+	return &MockComputeV1_RestartInstance_Call{Call: _e.mock.On("RestartInstance", ctx, inst)}
+}
+
+func (_c *MockComputeV1_RestartInstance_Call) Run(run func(ctx context.Context, inst *schema.Instance)) *MockComputeV1_RestartInstance_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*schema.Instance))
+	})
+	return _c
+}
+
+func (_c *MockComputeV1_RestartInstance_Call) Return(_a0 error) *MockComputeV1_RestartInstance_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockComputeV1_RestartInstance_Call) RunAndReturn(run func(context.Context, *schema.Instance) error) *MockComputeV1_RestartInstance_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// RestartInstanceWithParams provides a mock function with given fields: ctx, inst, params
+func (_m *MockComputeV1) RestartInstanceWithParams(ctx context.Context, inst *schema.Instance, params *compute.RestartInstanceParams) error {
+	ret := _m.Called(ctx, inst, params)
+
+	if len(ret) == 0 {
+		panic("no return value specified for RestartInstanceWithParams")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, *schema.Instance, *compute.RestartInstanceParams) error); ok {
+		r0 = rf(ctx, inst, params)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockComputeV1_RestartInstanceWithParams_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RestartInstanceWithParams'
+type MockComputeV1_RestartInstanceWithParams_Call struct {
+	*mock.Call
+}
+
+// RestartInstanceWithParams is a helper method to define mock.On call
+//   - ctx context.Context
+//   - inst *schema.Instance
+//   - params *compute.RestartInstanceParams
+func (_e *MockComputeV1_Expecter) RestartInstanceWithParams(ctx interface{}, inst interface{}, params interface{}) *MockComputeV1_RestartInstanceWithParams_Call {
+	return &MockComputeV1_RestartInstanceWithParams_Call{Call: _e.mock.On("RestartInstanceWithParams", ctx, inst, params)}
+}
+
+func (_c *MockComputeV1_RestartInstanceWithParams_Call) Run(run func(ctx context.Context, inst *schema.Instance, params *compute.RestartInstanceParams)) *MockComputeV1_RestartInstanceWithParams_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*schema.Instance), args[2].(*compute.RestartInstanceParams))
+	})
+	return _c
+}
+
+func (_c *MockComputeV1_RestartInstanceWithParams_Call) Return(_a0 error) *MockComputeV1_RestartInstanceWithParams_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockComputeV1_RestartInstanceWithParams_Call) RunAndReturn(run func(context.Context, *schema.Instance, *compute.RestartInstanceParams) error) *MockComputeV1_RestartInstanceWithParams_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// StartInstance provides a mock function with given fields: ctx, inst
+func (_m *MockComputeV1) StartInstance(ctx context.Context, inst *schema.Instance) error {
+	ret := _m.Called(ctx, inst)
+
+	if len(ret) == 0 {
+		panic("no return value specified for StartInstance")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, *schema.Instance) error); ok {
+		r0 = rf(ctx, inst)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockComputeV1_StartInstance_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'StartInstance'
+type MockComputeV1_StartInstance_Call struct {
+	*mock.Call
+}
+
+// StartInstance is a helper method to define mock.On call
+//   - ctx context.Context
+//   - inst *schema.Instance
+func (_e *MockComputeV1_Expecter) StartInstance(ctx interface{}, inst interface{}) *MockComputeV1_StartInstance_Call {
+	return &MockComputeV1_StartInstance_Call{Call: _e.mock.On("StartInstance", ctx, inst)}
+}
+
+func (_c *MockComputeV1_StartInstance_Call) Run(run func(ctx context.Context, inst *schema.Instance)) *MockComputeV1_StartInstance_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*schema.Instance))
+	})
+	return _c
+}
+
+func (_c *MockComputeV1_StartInstance_Call) Return(_a0 error) *MockComputeV1_StartInstance_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockComputeV1_StartInstance_Call) RunAndReturn(run func(context.Context, *schema.Instance) error) *MockComputeV1_StartInstance_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// StartInstanceWithParams provides a mock function with given fields: ctx, inst, params
+func (_m *MockComputeV1) StartInstanceWithParams(ctx context.Context, inst *schema.Instance, params *compute.StartInstanceParams) error {
+	ret := _m.Called(ctx, inst, params)
+
+	if len(ret) == 0 {
+		panic("no return value specified for StartInstanceWithParams")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, *schema.Instance, *compute.StartInstanceParams) error); ok {
+		r0 = rf(ctx, inst, params)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockComputeV1_StartInstanceWithParams_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'StartInstanceWithParams'
+type MockComputeV1_StartInstanceWithParams_Call struct {
+	*mock.Call
+}
+
+// StartInstanceWithParams is a helper method to define mock.On call
+//   - ctx context.Context
+//   - inst *schema.Instance
+//   - params *compute.StartInstanceParams
+func (_e *MockComputeV1_Expecter) StartInstanceWithParams(ctx interface{}, inst interface{}, params interface{}) *MockComputeV1_StartInstanceWithParams_Call {
+	return &MockComputeV1_StartInstanceWithParams_Call{Call: _e.mock.On("StartInstanceWithParams", ctx, inst, params)}
+}
+
+func (_c *MockComputeV1_StartInstanceWithParams_Call) Run(run func(ctx context.Context, inst *schema.Instance, params *compute.StartInstanceParams)) *MockComputeV1_StartInstanceWithParams_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*schema.Instance), args[2].(*compute.StartInstanceParams))
+	})
+	return _c
+}
+
+func (_c *MockComputeV1_StartInstanceWithParams_Call) Return(_a0 error) *MockComputeV1_StartInstanceWithParams_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockComputeV1_StartInstanceWithParams_Call) RunAndReturn(run func(context.Context, *schema.Instance, *compute.StartInstanceParams) error) *MockComputeV1_StartInstanceWithParams_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// StopInstance provides a mock function with given fields: ctx, inst
+func (_m *MockComputeV1) StopInstance(ctx context.Context, inst *schema.Instance) error {
+	ret := _m.Called(ctx, inst)
+
+	if len(ret) == 0 {
+		panic("no return value specified for StopInstance")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, *schema.Instance) error); ok {
+		r0 = rf(ctx, inst)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockComputeV1_StopInstance_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'StopInstance'
+type MockComputeV1_StopInstance_Call struct {
+	*mock.Call
+}
+
+// StopInstance is a helper method to define mock.On call
+//   - ctx context.Context
+//   - inst *schema.Instance
+func (_e *MockComputeV1_Expecter) StopInstance(ctx interface{}, inst interface{}) *MockComputeV1_StopInstance_Call {
+	return &MockComputeV1_StopInstance_Call{Call: _e.mock.On("StopInstance", ctx, inst)}
+}
+
+func (_c *MockComputeV1_StopInstance_Call) Run(run func(ctx context.Context, inst *schema.Instance)) *MockComputeV1_StopInstance_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*schema.Instance))
+	})
+	return _c
+}
+
+func (_c *MockComputeV1_StopInstance_Call) Return(_a0 error) *MockComputeV1_StopInstance_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockComputeV1_StopInstance_Call) RunAndReturn(run func(context.Context, *schema.Instance) error) *MockComputeV1_StopInstance_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// StopInstanceWithParams provides a mock function with given fields: ctx, inst, params
+func (_m *MockComputeV1) StopInstanceWithParams(ctx context.Context, inst *schema.Instance, params *compute.StopInstanceParams) error {
+	ret := _m.Called(ctx, inst, params)
+
+	if len(ret) == 0 {
+		panic("no return value specified for StopInstanceWithParams")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, *schema.Instance, *compute.StopInstanceParams) error); ok {
+		r0 = rf(ctx, inst, params)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockComputeV1_StopInstanceWithParams_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'StopInstanceWithParams'
+type MockComputeV1_StopInstanceWithParams_Call struct {
+	*mock.Call
+}
+
+// StopInstanceWithParams is a helper method to define mock.On call
+//   - ctx context.Context
+//   - inst *schema.Instance
+//   - params *compute.StopInstanceParams
+func (_e *MockComputeV1_Expecter) StopInstanceWithParams(ctx interface{}, inst interface{}, params interface{}) *MockComputeV1_StopInstanceWithParams_Call {
+	return &MockComputeV1_StopInstanceWithParams_Call{Call: _e.mock.On("StopInstanceWithParams", ctx, inst, params)}
+}
+
+func (_c *MockComputeV1_StopInstanceWithParams_Call) Run(run func(ctx context.Context, inst *schema.Instance, params *compute.StopInstanceParams)) *MockComputeV1_StopInstanceWithParams_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*schema.Instance), args[2].(*compute.StopInstanceParams))
+	})
+	return _c
+}
+
+func (_c *MockComputeV1_StopInstanceWithParams_Call) Return(_a0 error) *MockComputeV1_StopInstanceWithParams_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockComputeV1_StopInstanceWithParams_Call) RunAndReturn(run func(context.Context, *schema.Instance, *compute.StopInstanceParams) error) *MockComputeV1_StopInstanceWithParams_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// WatchInstanceUntilDeleted provides a mock function with given fields: ctx, wref, config
+func (_m *MockComputeV1) WatchInstanceUntilDeleted(ctx context.Context, wref secapi.WorkspaceReference, config secapi.ResourceObserverConfig) error {
+	ret := _m.Called(ctx, wref, config)
+
+	if len(ret) == 0 {
+		panic("no return value specified for WatchInstanceUntilDeleted")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, secapi.WorkspaceReference, secapi.ResourceObserverConfig) error); ok {
+		r0 = rf(ctx, wref, config)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockComputeV1_WatchInstanceUntilDeleted_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'WatchInstanceUntilDeleted'
+type MockComputeV1_WatchInstanceUntilDeleted_Call struct {
+	*mock.Call
+}
+
+// WatchInstanceUntilDeleted is a helper method to define mock.On call
+//   - ctx context.Context
+//   - wref secapi.WorkspaceReference
+//   - config secapi.ResourceObserverConfig
+func (_e *MockComputeV1_Expecter) WatchInstanceUntilDeleted(ctx interface{}, wref interface{}, config interface{}) *MockComputeV1_WatchInstanceUntilDeleted_Call {
+	return &MockComputeV1_WatchInstanceUntilDeleted_Call{Call: _e.mock.On("WatchInstanceUntilDeleted", ctx, wref, config)}
+}
+
+func (_c *MockComputeV1_WatchInstanceUntilDeleted_Call) Run(run func(ctx context.Context, wref secapi.WorkspaceReference, config secapi.ResourceObserverConfig)) *MockComputeV1_WatchInstanceUntilDeleted_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(secapi.WorkspaceReference), args[2].(secapi.ResourceObserverConfig))
+	})
+	return _c
+}
+
+func (_c *MockComputeV1_WatchInstanceUntilDeleted_Call) Return(_a0 error) *MockComputeV1_WatchInstanceUntilDeleted_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockComputeV1_WatchInstanceUntilDeleted_Call) RunAndReturn(run func(context.Context, secapi.WorkspaceReference, secapi.ResourceObserverConfig) error) *MockComputeV1_WatchInstanceUntilDeleted_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockComputeV1 creates a new instance of MockComputeV1. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockComputeV1(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockComputeV1 {
+	mock := &MockComputeV1{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -0,0 +1,4219 @@
+// Code generated by mockery v2.52.2. DO NOT EDIT.
+
+package mocksecapi
+
+import (
+	context "context"
+
+	network "github.com/eu-sovereign-cloud/go-sdk/pkg/spec/foundation.network.v1"
+	mock "github.com/stretchr/testify/mock"
+
+	schema "github.com/eu-sovereign-cloud/go-sdk/pkg/spec/schema"
+
+	secapi "github.com/eu-sovereign-cloud/go-sdk/secapi"
+)
+
+// MockNetworkV1 is an autogenerated mock type for the NetworkV1 type
+type MockNetworkV1 struct {
+	mock.Mock
+}
+
+type MockNetworkV1_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockNetworkV1) EXPECT() *MockNetworkV1_Expecter {
+	return &MockNetworkV1_Expecter{mock: &_m.Mock}
+}
+
+// CreateOrUpdateInternetGateway provides a mock function with given fields: ctx, gtw
+func (_m *MockNetworkV1) CreateOrUpdateInternetGateway(ctx context.Context, gtw *schema.InternetGateway) (*schema.InternetGateway, error) {
+	ret := _m.Called(ctx, gtw)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CreateOrUpdateInternetGateway")
+	}
+
+	var r0 *schema.InternetGateway
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *schema.InternetGateway) (*schema.InternetGateway, error)); ok {
+		return rf(ctx, gtw)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *schema.InternetGateway) *schema.InternetGateway); ok {
+		r0 = rf(ctx, gtw)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*schema.InternetGateway)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *schema.InternetGateway) error); ok {
+		r1 = rf(ctx, gtw)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockNetworkV1_CreateOrUpdateInternetGateway_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CreateOrUpdateInternetGateway'
+type MockNetworkV1_CreateOrUpdateInternetGateway_Call struct {
+	*mock.Call
+}
+
+// CreateOrUpdateInternetGateway is a helper method to define mock.On call
+//   - ctx context.Context
+//   - gtw *schema.InternetGateway
+func (_e *MockNetworkV1_Expecter) CreateOrUpdateInternetGateway(ctx interface{}, gtw interface{}) *MockNetworkV1_CreateOrUpdateInternetGateway_Call {
+	return &MockNetworkV1_CreateOrUpdateInternetGateway_Call{Call: _e.mock.On("CreateOrUpdateInternetGateway", ctx, gtw)}
+}
+
+func (_c *MockNetworkV1_CreateOrUpdateInternetGateway_Call) Run(run func(ctx context.Context, gtw *schema.InternetGateway)) *MockNetworkV1_CreateOrUpdateInternetGateway_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*schema.InternetGateway))
+	})
+	return _c
+}
+
+func (_c *MockNetworkV1_CreateOrUpdateInternetGateway_Call) Return(_a0 *schema.InternetGateway, _a1 error) *MockNetworkV1_CreateOrUpdateInternetGateway_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockNetworkV1_CreateOrUpdateInternetGateway_Call) RunAndReturn(run func(context.Context, *schema.InternetGateway) (*schema.InternetGateway, error)) *MockNetworkV1_CreateOrUpdateInternetGateway_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// CreateOrUpdateInternetGatewayWithParams provides a mock function with given fields: ctx, gtw, params
+func (_m *MockNetworkV1) CreateOrUpdateInternetGatewayWithParams(ctx context.Context, gtw *schema.InternetGateway, params *network.CreateOrUpdateInternetGatewayParams) (*schema.InternetGateway, error) {
+	ret := _m.Called(ctx, gtw, params)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CreateOrUpdateInternetGatewayWithParams")
+	}
+
+	var r0 *schema.InternetGateway
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *schema.InternetGateway, *network.CreateOrUpdateInternetGatewayParams) (*schema.InternetGateway, error)); ok {
+		return rf(ctx, gtw, params)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *schema.InternetGateway, *network.CreateOrUpdateInternetGatewayParams) *schema.InternetGateway); ok {
+		r0 = rf(ctx, gtw, params)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*schema.InternetGateway)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *schema.InternetGateway, *network.CreateOrUpdateInternetGatewayParams) error); ok {
+		r1 = rf(ctx, gtw, params)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockNetworkV1_CreateOrUpdateInternetGatewayWithParams_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CreateOrUpdateInternetGatewayWithParams'
+type MockNetworkV1_CreateOrUpdateInternetGatewayWithParams_Call struct {
+	*mock.Call
+}
+
+// CreateOrUpdateInternetGatewayWithParams is a helper method to define mock.On call
+//   - ctx context.Context
+//   - gtw *schema.InternetGateway
+//   - params *network.CreateOrUpdateInternetGatewayParams
+func (_e *MockNetworkV1_Expecter) CreateOrUpdateInternetGatewayWithParams(ctx interface{}, gtw interface{}, params interface{}) *MockNetworkV1_CreateOrUpdateInternetGatewayWithParams_Call {
+	return &MockNetworkV1_CreateOrUpdateInternetGatewayWithParams_Call{Call: _e.mock.On("CreateOrUpdateInternetGatewayWithParams", ctx, gtw, params)}
+}
+
+func (_c *MockNetworkV1_CreateOrUpdateInternetGatewayWithParams_Call) Run(run func(ctx context.Context, gtw *schema.InternetGateway, params *network.CreateOrUpdateInternetGatewayParams)) *MockNetworkV1_CreateOrUpdateInternetGatewayWithParams_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*schema.InternetGateway), args[2].(*network.CreateOrUpdateInternetGatewayParams))
+	})
+	return _c
+}
+
+func (_c *MockNetworkV1_CreateOrUpdateInternetGatewayWithParams_Call) Return(_a0 *schema.InternetGateway, _a1 error) *MockNetworkV1_CreateOrUpdateInternetGatewayWithParams_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockNetworkV1_CreateOrUpdateInternetGatewayWithParams_Call) RunAndReturn(run func(context.Context, *schema.InternetGateway, *network.CreateOrUpdateInternetGatewayParams) (*schema.InternetGateway, error)) *MockNetworkV1_CreateOrUpdateInternetGatewayWithParams_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// CreateOrUpdateNetwork provides a mock function with given fields: ctx, net
+func (_m *MockNetworkV1) CreateOrUpdateNetwork(ctx context.Context, net *schema.Network) (*schema.Network, error) {
+	ret := _m.Called(ctx, net)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CreateOrUpdateNetwork")
+	}
+
+	var r0 *schema.Network
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *schema.Network) (*schema.Network, error)); ok {
+		return rf(ctx, net)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *schema.Network) *schema.Network); ok {
+		r0 = rf(ctx, net)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*schema.Network)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *schema.Network) error); ok {
+		r1 = rf(ctx, net)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockNetworkV1_CreateOrUpdateNetwork_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CreateOrUpdateNetwork'
+type MockNetworkV1_CreateOrUpdateNetwork_Call struct {
+	*mock.Call
+}
+
+// CreateOrUpdateNetwork is a helper method to define mock.On call
+//   - ctx context.Context
+//   - net *schema.Network
+func (_e *MockNetworkV1_Expecter) CreateOrUpdateNetwork(ctx interface{}, net interface{}) *MockNetworkV1_CreateOrUpdateNetwork_Call {
+	return &MockNetworkV1_CreateOrUpdateNetwork_Call{Call: _e.mock.On("CreateOrUpdateNetwork", ctx, net)}
+}
+
+func (_c *MockNetworkV1_CreateOrUpdateNetwork_Call) Run(run func(ctx context.Context, net *schema.Network)) *MockNetworkV1_CreateOrUpdateNetwork_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*schema.Network))
+	})
+	return _c
+}
+
+func (_c *MockNetworkV1_CreateOrUpdateNetwork_Call) Return(_a0 *schema.Network, _a1 error) *MockNetworkV1_CreateOrUpdateNetwork_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockNetworkV1_CreateOrUpdateNetwork_Call) RunAndReturn(run func(context.Context, *schema.Network) (*schema.Network, error)) *MockNetworkV1_CreateOrUpdateNetwork_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// CreateOrUpdateNetworkWithParams provides a mock function with given fields: ctx, net, params
+func (_m *MockNetworkV1) CreateOrUpdateNetworkWithParams(ctx context.Context, net *schema.Network, params *network.CreateOrUpdateNetworkParams) (*schema.Network, error) {
+	ret := _m.Called(ctx, net, params)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CreateOrUpdateNetworkWithParams")
+	}
+
+	var r0 *schema.Network
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *schema.Network, *network.CreateOrUpdateNetworkParams) (*schema.Network, error)); ok {
+		return rf(ctx, net, params)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *schema.Network, *network.CreateOrUpdateNetworkParams) *schema.Network); ok {
+		r0 = rf(ctx, net, params)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*schema.Network)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *schema.Network, *network.CreateOrUpdateNetworkParams) error); ok {
+		r1 = rf(ctx, net, params)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockNetworkV1_CreateOrUpdateNetworkWithParams_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CreateOrUpdateNetworkWithParams'
+type MockNetworkV1_CreateOrUpdateNetworkWithParams_Call struct {
+	*mock.Call
+}
+
+// CreateOrUpdateNetworkWithParams is a helper method to define mock.On call
+//   - ctx context.Context
+//   - net *schema.Network
+//   - params *network.CreateOrUpdateNetworkParams
+func (_e *MockNetworkV1_Expecter) CreateOrUpdateNetworkWithParams(ctx interface{}, net interface{}, params interface{}) *MockNetworkV1_CreateOrUpdateNetworkWithParams_Call {
+	return &MockNetworkV1_CreateOrUpdateNetworkWithParams_Call{Call: _e.mock.On("CreateOrUpdateNetworkWithParams", ctx, net, params)}
+}
+
+func (_c *MockNetworkV1_CreateOrUpdateNetworkWithParams_Call) Run(run func(ctx context.Context, net *schema.Network, params *network.CreateOrUpdateNetworkParams)) *MockNetworkV1_CreateOrUpdateNetworkWithParams_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*schema.Network), args[2].(*network.CreateOrUpdateNetworkParams))
+	})
+	return _c
+}
+
+func (_c *MockNetworkV1_CreateOrUpdateNetworkWithParams_Call) Return(_a0 *schema.Network, _a1 error) *MockNetworkV1_CreateOrUpdateNetworkWithParams_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockNetworkV1_CreateOrUpdateNetworkWithParams_Call) RunAndReturn(run func(context.Context, *schema.Network, *network.CreateOrUpdateNetworkParams) (*schema.Network, error)) *MockNetworkV1_CreateOrUpdateNetworkWithParams_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// CreateOrUpdateNic provides a mock function with given fields: ctx, nic
+func (_m *MockNetworkV1) CreateOrUpdateNic(ctx context.Context, nic *schema.Nic) (*schema.Nic, error) {
+	ret := _m.Called(ctx, nic)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CreateOrUpdateNic")
+	}
+
+	var r0 *schema.Nic
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *schema.Nic) (*schema.Nic, error)); ok {
+		return rf(ctx, nic)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *schema.Nic) *schema.Nic); ok {
+		r0 = rf(ctx, nic)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*schema.Nic)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *schema.Nic) error); ok {
+		r1 = rf(ctx, nic)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockNetworkV1_CreateOrUpdateNic_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CreateOrUpdateNic'
+type MockNetworkV1_CreateOrUpdateNic_Call struct {
+	*mock.Call
+}
+
+// CreateOrUpdateNic is a helper method to define mock.On call
+//   - ctx context.Context
+//   - nic *schema.Nic
+func (_e *MockNetworkV1_Expecter) CreateOrUpdateNic(ctx interface{}, nic interface{}) *MockNetworkV1_CreateOrUpdateNic_Call {
+	return &MockNetworkV1_CreateOrUpdateNic_Call{Call: _e.mock.On("CreateOrUpdateNic", ctx, nic)}
+}
+
+func (_c *MockNetworkV1_CreateOrUpdateNic_Call) Run(run func(ctx context.Context, nic *schema.Nic)) *MockNetworkV1_CreateOrUpdateNic_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*schema.Nic))
+	})
+	return _c
+}
+
+func (_c *MockNetworkV1_CreateOrUpdateNic_Call) Return(_a0 *schema.Nic, _a1 error) *MockNetworkV1_CreateOrUpdateNic_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockNetworkV1_CreateOrUpdateNic_Call) RunAndReturn(run func(context.Context, *schema.Nic) (*schema.Nic, error)) *MockNetworkV1_CreateOrUpdateNic_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// CreateOrUpdateNicWithParams provides a mock function with given fields: ctx, nic, params
+func (_m *MockNetworkV1) CreateOrUpdateNicWithParams(ctx context.Context, nic *schema.Nic, params *network.CreateOrUpdateNicParams) (*schema.Nic, error) {
+	ret := _m.Called(ctx, nic, params)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CreateOrUpdateNicWithParams")
+	}
+
+	var r0 *schema.Nic
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *schema.Nic, *network.CreateOrUpdateNicParams) (*schema.Nic, error)); ok {
+		return rf(ctx, nic, params)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *schema.Nic, *network.CreateOrUpdateNicParams) *schema.Nic); ok {
+		r0 = rf(ctx, nic, params)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*schema.Nic)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *schema.Nic, *network.CreateOrUpdateNicParams) error); ok {
+		r1 = rf(ctx, nic, params)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockNetworkV1_CreateOrUpdateNicWithParams_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CreateOrUpdateNicWithParams'
+type MockNetworkV1_CreateOrUpdateNicWithParams_Call struct {
+	*mock.Call
+}
+
+// CreateOrUpdateNicWithParams is a helper method to define mock.On call
+//   - ctx context.Context
+//   - nic *schema.Nic
+//   - params *network.CreateOrUpdateNicParams
+func (_e *MockNetworkV1_Expecter) CreateOrUpdateNicWithParams(ctx interface{}, nic interface{}, params interface{}) *MockNetworkV1_CreateOrUpdateNicWithParams_Call {
+	return &MockNetworkV1_CreateOrUpdateNicWithParams_Call{Call: _e.mock.On("CreateOrUpdateNicWithParams", ctx, nic, params)}
+}
+
+func (_c *MockNetworkV1_CreateOrUpdateNicWithParams_Call) Run(run func(ctx context.Context, nic *schema.Nic, params *network.CreateOrUpdateNicParams)) *MockNetworkV1_CreateOrUpdateNicWithParams_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*schema.Nic), args[2].(*network.CreateOrUpdateNicParams))
+	})
+	return _c
+}
+
+func (_c *MockNetworkV1_CreateOrUpdateNicWithParams_Call) Return(_a0 *schema.Nic, _a1 error) *MockNetworkV1_CreateOrUpdateNicWithParams_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockNetworkV1_CreateOrUpdateNicWithParams_Call) RunAndReturn(run func(context.Context, *schema.Nic, *network.CreateOrUpdateNicParams) (*schema.Nic, error)) *MockNetworkV1_CreateOrUpdateNicWithParams_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// CreateOrUpdatePublicIp provides a mock function with given fields: ctx, ip
+func (_m *MockNetworkV1) CreateOrUpdatePublicIp(ctx context.Context, ip *schema.PublicIp) (*schema.PublicIp, error) {
+	ret := _m.Called(ctx, ip)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CreateOrUpdatePublicIp")
+	}
+
+	var r0 *schema.PublicIp
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *schema.PublicIp) (*schema.PublicIp, error)); ok {
+		return rf(ctx, ip)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *schema.PublicIp) *schema.PublicIp); ok {
+		r0 = rf(ctx, ip)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*schema.PublicIp)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *schema.PublicIp) error); ok {
+		r1 = rf(ctx, ip)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockNetworkV1_CreateOrUpdatePublicIp_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CreateOrUpdatePublicIp'
+type MockNetworkV1_CreateOrUpdatePublicIp_Call struct {
+	*mock.Call
+}
+
+// CreateOrUpdatePublicIp is a helper method to define mock.On call
+//   - ctx context.Context
+//   - ip *schema.PublicIp
+func (_e *MockNetworkV1_Expecter) CreateOrUpdatePublicIp(ctx interface{}, ip interface{}) *MockNetworkV1_CreateOrUpdatePublicIp_Call {
+	return &MockNetworkV1_CreateOrUpdatePublicIp_Call{Call: _e.mock.On("CreateOrUpdatePublicIp", ctx, ip)}
+}
+
+func (_c *MockNetworkV1_CreateOrUpdatePublicIp_Call) Run(run func(ctx context.Context, ip *schema.PublicIp)) *MockNetworkV1_CreateOrUpdatePublicIp_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*schema.PublicIp))
+	})
+	return _c
+}
+
+func (_c *MockNetworkV1_CreateOrUpdatePublicIp_Call) Return(_a0 *schema.PublicIp, _a1 error) *MockNetworkV1_CreateOrUpdatePublicIp_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockNetworkV1_CreateOrUpdatePublicIp_Call) RunAndReturn(run func(context.Context, *schema.PublicIp) (*schema.PublicIp, error)) *MockNetworkV1_CreateOrUpdatePublicIp_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// CreateOrUpdatePublicIpWithParams provides a mock function with given fields: ctx, ip, params
+func (_m *MockNetworkV1) CreateOrUpdatePublicIpWithParams(ctx context.Context, ip *schema.PublicIp, params *network.CreateOrUpdatePublicIpParams) (*schema.PublicIp, error) {
+	ret := _m.Called(ctx, ip, params)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CreateOrUpdatePublicIpWithParams")
+	}
+
+	var r0 *schema.PublicIp
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *schema.PublicIp, *network.CreateOrUpdatePublicIpParams) (*schema.PublicIp, error)); ok {
+		return rf(ctx, ip, params)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *schema.PublicIp, *network.CreateOrUpdatePublicIpParams) *schema.PublicIp); ok {
+		r0 = rf(ctx, ip, params)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*schema.PublicIp)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *schema.PublicIp, *network.CreateOrUpdatePublicIpParams) error); ok {
+		r1 = rf(ctx, ip, params)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockNetworkV1_CreateOrUpdatePublicIpWithParams_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CreateOrUpdatePublicIpWithParams'
+type MockNetworkV1_CreateOrUpdatePublicIpWithParams_Call struct {
+	*mock.Call
+}
+
+// CreateOrUpdatePublicIpWithParams is a helper method to define mock.On call
+//   - ctx context.Context
+//   - ip *schema.PublicIp
+//   - params *network.CreateOrUpdatePublicIpParams
+func (_e *MockNetworkV1_Expecter) CreateOrUpdatePublicIpWithParams(ctx interface{}, ip interface{}, params interface{}) *MockNetworkV1_CreateOrUpdatePublicIpWithParams_Call {
+	return &MockNetworkV1_CreateOrUpdatePublicIpWithParams_Call{Call: _e.mock.On("CreateOrUpdatePublicIpWithParams", ctx, ip, params)}
+}
+
+func (_c *MockNetworkV1_CreateOrUpdatePublicIpWithParams_Call) Run(run func(ctx context.Context, ip *schema.PublicIp, params *network.CreateOrUpdatePublicIpParams)) *MockNetworkV1_CreateOrUpdatePublicIpWithParams_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*schema.PublicIp), args[2].(*network.CreateOrUpdatePublicIpParams))
+	})
+	return _c
+}
+
+func (_c *MockNetworkV1_CreateOrUpdatePublicIpWithParams_Call) Return(_a0 *schema.PublicIp, _a1 error) *MockNetworkV1_CreateOrUpdatePublicIpWithParams_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockNetworkV1_CreateOrUpdatePublicIpWithParams_Call) RunAndReturn(run func(context.Context, *schema.PublicIp, *network.CreateOrUpdatePublicIpParams) (*schema.PublicIp, error)) *MockNetworkV1_CreateOrUpdatePublicIpWithParams_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// CreateOrUpdateRouteTable provides a mock function with given fields: ctx, route
+func (_m *MockNetworkV1) CreateOrUpdateRouteTable(ctx context.Context, route *schema.RouteTable) (*schema.RouteTable, error) {
+	ret := _m.Called(ctx, route)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CreateOrUpdateRouteTable")
+	}
+
+	var r0 *schema.RouteTable
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *schema.RouteTable) (*schema.RouteTable, error)); ok {
+		return rf(ctx, route)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *schema.RouteTable) *schema.RouteTable); ok {
+		r0 = rf(ctx, route)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*schema.RouteTable)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *schema.RouteTable) error); ok {
+		r1 = rf(ctx, route)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockNetworkV1_CreateOrUpdateRouteTable_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CreateOrUpdateRouteTable'
+type MockNetworkV1_CreateOrUpdateRouteTable_Call struct {
+	*mock.Call
+}
+
+// CreateOrUpdateRouteTable is a helper method to define mock.On call
+//   - ctx context.Context
+//   - route *schema.RouteTable
+func (_e *MockNetworkV1_Expecter) CreateOrUpdateRouteTable(ctx interface{}, route interface{}) *MockNetworkV1_CreateOrUpdateRouteTable_Call {
+	return &MockNetworkV1_CreateOrUpdateRouteTable_Call{Call: _e.mock.On("CreateOrUpdateRouteTable", ctx, route)}
+}
+
+func (_c *MockNetworkV1_CreateOrUpdateRouteTable_Call) Run(run func(ctx context.Context, route *schema.RouteTable)) *MockNetworkV1_CreateOrUpdateRouteTable_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*schema.RouteTable))
+	})
+	return _c
+}
+
+func (_c *MockNetworkV1_CreateOrUpdateRouteTable_Call) Return(_a0 *schema.RouteTable, _a1 error) *MockNetworkV1_CreateOrUpdateRouteTable_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockNetworkV1_CreateOrUpdateRouteTable_Call) RunAndReturn(run func(context.Context, *schema.RouteTable) (*schema.RouteTable, error)) *MockNetworkV1_CreateOrUpdateRouteTable_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// CreateOrUpdateRouteTableWithParams provides a mock function with given fields: ctx, route, params
+func (_m *MockNetworkV1) CreateOrUpdateRouteTableWithParams(ctx context.Context, route *schema.RouteTable, params *network.CreateOrUpdateRouteTableParams) (*schema.RouteTable, error) {
+	ret := _m.Called(ctx, route, params)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CreateOrUpdateRouteTableWithParams")
+	}
+
+	var r0 *schema.RouteTable
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *schema.RouteTable, *network.CreateOrUpdateRouteTableParams) (*schema.RouteTable, error)); ok {
+		return rf(ctx, route, params)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *schema.RouteTable, *network.CreateOrUpdateRouteTableParams) *schema.RouteTable); ok {
+		r0 = rf(ctx, route, params)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*schema.RouteTable)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *schema.RouteTable, *network.CreateOrUpdateRouteTableParams) error); ok {
+		r1 = rf(ctx, route, params)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockNetworkV1_CreateOrUpdateRouteTableWithParams_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CreateOrUpdateRouteTableWithParams'
+type MockNetworkV1_CreateOrUpdateRouteTableWithParams_Call struct {
+	*mock.Call
+}
+
+// CreateOrUpdateRouteTableWithParams is a helper method to define mock.On call
+//   - ctx context.Context
+//   - route *schema.RouteTable
+//   - params *network.CreateOrUpdateRouteTableParams
+func (_e *MockNetworkV1_Expecter) CreateOrUpdateRouteTableWithParams(ctx interface{}, route interface{}, params interface{}) *MockNetworkV1_CreateOrUpdateRouteTableWithParams_Call {
+	return &MockNetworkV1_CreateOrUpdateRouteTableWithParams_Call{Call: _e.mock.On("CreateOrUpdateRouteTableWithParams", ctx, route, params)}
+}
+
+func (_c *MockNetworkV1_CreateOrUpdateRouteTableWithParams_Call) Run(run func(ctx context.Context, route *schema.RouteTable, params *network.CreateOrUpdateRouteTableParams)) *MockNetworkV1_CreateOrUpdateRouteTableWithParams_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*schema.RouteTable), args[2].(*network.CreateOrUpdateRouteTableParams))
+	})
+	return _c
+}
+
+func (_c *MockNetworkV1_CreateOrUpdateRouteTableWithParams_Call) Return(_a0 *schema.RouteTable, _a1 error) *MockNetworkV1_CreateOrUpdateRouteTableWithParams_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockNetworkV1_CreateOrUpdateRouteTableWithParams_Call) RunAndReturn(run func(context.Context, *schema.RouteTable, *network.CreateOrUpdateRouteTableParams) (*schema.RouteTable, error)) *MockNetworkV1_CreateOrUpdateRouteTableWithParams_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// CreateOrUpdateSecurityGroup provides a mock function with given fields: ctx, group
+func (_m *MockNetworkV1) CreateOrUpdateSecurityGroup(ctx context.Context, group *schema.SecurityGroup) (*schema.SecurityGroup, error) {
+	ret := _m.Called(ctx, group)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CreateOrUpdateSecurityGroup")
+	}
+
+	var r0 *schema.SecurityGroup
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *schema.SecurityGroup) (*schema.SecurityGroup, error)); ok {
+		return rf(ctx, group)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *schema.SecurityGroup) *schema.SecurityGroup); ok {
+		r0 = rf(ctx, group)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*schema.SecurityGroup)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *schema.SecurityGroup) error); ok {
+		r1 = rf(ctx, group)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockNetworkV1_CreateOrUpdateSecurityGroup_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CreateOrUpdateSecurityGroup'
+type MockNetworkV1_CreateOrUpdateSecurityGroup_Call struct {
+	*mock.Call
+}
+
+// CreateOrUpdateSecurityGroup is a helper method to define mock.On call
+//   - ctx context.Context
+//   - group *schema.SecurityGroup
+func (_e *MockNetworkV1_Expecter) CreateOrUpdateSecurityGroup(ctx interface{}, group interface{}) *MockNetworkV1_CreateOrUpdateSecurityGroup_Call {
+	return &MockNetworkV1_CreateOrUpdateSecurityGroup_Call{Call: _e.mock.On("CreateOrUpdateSecurityGroup", ctx, group)}
+}
+
+func (_c *MockNetworkV1_CreateOrUpdateSecurityGroup_Call) Run(run func(ctx context.Context, group *schema.SecurityGroup)) *MockNetworkV1_CreateOrUpdateSecurityGroup_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*schema.SecurityGroup))
+	})
+	return _c
+}
+
+func (_c *MockNetworkV1_CreateOrUpdateSecurityGroup_Call) Return(_a0 *schema.SecurityGroup, _a1 error) *MockNetworkV1_CreateOrUpdateSecurityGroup_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockNetworkV1_CreateOrUpdateSecurityGroup_Call) RunAndReturn(run func(context.Context, *schema.SecurityGroup) (*schema.SecurityGroup, error)) *MockNetworkV1_CreateOrUpdateSecurityGroup_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// CreateOrUpdateSecurityGroupRule provides a mock function with given fields: ctx, rule
+func (_m *MockNetworkV1) CreateOrUpdateSecurityGroupRule(ctx context.Context, rule *schema.SecurityGroupRule) (*schema.SecurityGroupRule, error) {
+	ret := _m.Called(ctx, rule)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CreateOrUpdateSecurityGroupRule")
+	}
+
+	var r0 *schema.SecurityGroupRule
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *schema.SecurityGroupRule) (*schema.SecurityGroupRule, error)); ok {
+		return rf(ctx, rule)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *schema.SecurityGroupRule) *schema.SecurityGroupRule); ok {
+		r0 = rf(ctx, rule)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*schema.SecurityGroupRule)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *schema.SecurityGroupRule) error); ok {
+		r1 = rf(ctx, rule)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockNetworkV1_CreateOrUpdateSecurityGroupRule_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CreateOrUpdateSecurityGroupRule'
+type MockNetworkV1_CreateOrUpdateSecurityGroupRule_Call struct {
+	*mock.Call
+}
+
+// CreateOrUpdateSecurityGroupRule is a helper method to define mock.On call
+//   - ctx context.Context
+//   - rule *schema.SecurityGroupRule
+func (_e *MockNetworkV1_Expecter) CreateOrUpdateSecurityGroupRule(ctx interface{}, rule interface{}) *MockNetworkV1_CreateOrUpdateSecurityGroupRule_Call {
+	return &MockNetworkV1_CreateOrUpdateSecurityGroupRule_Call{Call: _e.mock.On("CreateOrUpdateSecurityGroupRule", ctx, rule)}
+}
+
+func (_c *MockNetworkV1_CreateOrUpdateSecurityGroupRule_Call) Run(run func(ctx context.Context, rule *schema.SecurityGroupRule)) *MockNetworkV1_CreateOrUpdateSecurityGroupRule_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*schema.SecurityGroupRule))
+	})
+	return _c
+}
+
+func (_c *MockNetworkV1_CreateOrUpdateSecurityGroupRule_Call) Return(_a0 *schema.SecurityGroupRule, _a1 error) *MockNetworkV1_CreateOrUpdateSecurityGroupRule_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockNetworkV1_CreateOrUpdateSecurityGroupRule_Call) RunAndReturn(run func(context.Context, *schema.SecurityGroupRule) (*schema.SecurityGroupRule, error)) *MockNetworkV1_CreateOrUpdateSecurityGroupRule_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// CreateOrUpdateSecurityGroupRuleWithParams provides a mock function with given fields: ctx, rule, params
+func (_m *MockNetworkV1) CreateOrUpdateSecurityGroupRuleWithParams(ctx context.Context, rule *schema.SecurityGroupRule, params *network.CreateOrUpdateSecurityGroupRuleParams) (*schema.SecurityGroupRule, error) {
+	ret := _m.Called(ctx, rule, params)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CreateOrUpdateSecurityGroupRuleWithParams")
+	}
+
+	var r0 *schema.SecurityGroupRule
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *schema.SecurityGroupRule, *network.CreateOrUpdateSecurityGroupRuleParams) (*schema.SecurityGroupRule, error)); ok {
+		return rf(ctx, rule, params)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *schema.SecurityGroupRule, *network.CreateOrUpdateSecurityGroupRuleParams) *schema.SecurityGroupRule); ok {
+		r0 = rf(ctx, rule, params)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*schema.SecurityGroupRule)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *schema.SecurityGroupRule, *network.CreateOrUpdateSecurityGroupRuleParams) error); ok {
+		r1 = rf(ctx, rule, params)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockNetworkV1_CreateOrUpdateSecurityGroupRuleWithParams_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CreateOrUpdateSecurityGroupRuleWithParams'
+type MockNetworkV1_CreateOrUpdateSecurityGroupRuleWithParams_Call struct {
+	*mock.Call
+}
+
+// CreateOrUpdateSecurityGroupRuleWithParams is a helper method to define mock.On call
+//   - ctx context.Context
+//   - rule *schema.SecurityGroupRule
+//   - params *network.CreateOrUpdateSecurityGroupRuleParams
+func (_e *MockNetworkV1_Expecter) CreateOrUpdateSecurityGroupRuleWithParams(ctx interface{}, rule interface{}, params interface{}) *MockNetworkV1_CreateOrUpdateSecurityGroupRuleWithParams_Call {
+	return &MockNetworkV1_CreateOrUpdateSecurityGroupRuleWithParams_Call{Call: _e.mock.On("CreateOrUpdateSecurityGroupRuleWithParams", ctx, rule, params)}
+}
+
+func (_c *MockNetworkV1_CreateOrUpdateSecurityGroupRuleWithParams_Call) Run(run func(ctx context.Context, rule *schema.SecurityGroupRule, params *network.CreateOrUpdateSecurityGroupRuleParams)) *MockNetworkV1_CreateOrUpdateSecurityGroupRuleWithParams_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*schema.SecurityGroupRule), args[2].(*network.CreateOrUpdateSecurityGroupRuleParams))
+	})
+	return _c
+}
+
+func (_c *MockNetworkV1_CreateOrUpdateSecurityGroupRuleWithParams_Call) Return(_a0 *schema.SecurityGroupRule, _a1 error) *MockNetworkV1_CreateOrUpdateSecurityGroupRuleWithParams_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockNetworkV1_CreateOrUpdateSecurityGroupRuleWithParams_Call) RunAndReturn(run func(context.Context, *schema.SecurityGroupRule, *network.CreateOrUpdateSecurityGroupRuleParams) (*schema.SecurityGroupRule, error)) *MockNetworkV1_CreateOrUpdateSecurityGroupRuleWithParams_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// CreateOrUpdateSecurityGroupWithParams provides a mock function with given fields: ctx, group, params
+func (_m *MockNetworkV1) CreateOrUpdateSecurityGroupWithParams(ctx context.Context, group *schema.SecurityGroup, params *network.CreateOrUpdateSecurityGroupParams) (*schema.SecurityGroup, error) {
+	ret := _m.Called(ctx, group, params)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CreateOrUpdateSecurityGroupWithParams")
+	}
+
+	var r0 *schema.SecurityGroup
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *schema.SecurityGroup, *network.CreateOrUpdateSecurityGroupParams) (*schema.SecurityGroup, error)); ok {
+		return rf(ctx, group, params)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *schema.SecurityGroup, *network.CreateOrUpdateSecurityGroupParams) *schema.SecurityGroup); ok {
+		r0 = rf(ctx, group, params)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*schema.SecurityGroup)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *schema.SecurityGroup, *network.CreateOrUpdateSecurityGroupParams) error); ok {
+		r1 = rf(ctx, group, params)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockNetworkV1_CreateOrUpdateSecurityGroupWithParams_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CreateOrUpdateSecurityGroupWithParams'
+type MockNetworkV1_CreateOrUpdateSecurityGroupWithParams_Call struct {
+	*mock.Call
+}
+
+// CreateOrUpdateSecurityGroupWithParams is a helper method to define mock.On call
+//   - ctx context.Context
+//   - group *schema.SecurityGroup
+//   - params *network.CreateOrUpdateSecurityGroupParams
+func (_e *MockNetworkV1_Expecter) CreateOrUpdateSecurityGroupWithParams(ctx interface{}, group interface{}, params interface{}) *MockNetworkV1_CreateOrUpdateSecurityGroupWithParams_Call {
+	return &MockNetworkV1_CreateOrUpdateSecurityGroupWithParams_Call{Call: _e.mock.On("CreateOrUpdateSecurityGroupWithParams", ctx, group, params)}
+}
+
+func (_c *MockNetworkV1_CreateOrUpdateSecurityGroupWithParams_Call) Run(run func(ctx context.Context, group *schema.SecurityGroup, params *network.CreateOrUpdateSecurityGroupParams)) *MockNetworkV1_CreateOrUpdateSecurityGroupWithParams_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*schema.SecurityGroup), args[2].(*network.CreateOrUpdateSecurityGroupParams))
+	})
+	return _c
+}
+
+func (_c *MockNetworkV1_CreateOrUpdateSecurityGroupWithParams_Call) Return(_a0 *schema.SecurityGroup, _a1 error) *MockNetworkV1_CreateOrUpdateSecurityGroupWithParams_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockNetworkV1_CreateOrUpdateSecurityGroupWithParams_Call) RunAndReturn(run func(context.Context, *schema.SecurityGroup, *network.CreateOrUpdateSecurityGroupParams) (*schema.SecurityGroup, error)) *MockNetworkV1_CreateOrUpdateSecurityGroupWithParams_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// CreateOrUpdateSubnet provides a mock function with given fields: ctx, sub
+func (_m *MockNetworkV1) CreateOrUpdateSubnet(ctx context.Context, sub *schema.Subnet) (*schema.Subnet, error) {
+	ret := _m.Called(ctx, sub)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CreateOrUpdateSubnet")
+	}
+
+	var r0 *schema.Subnet
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *schema.Subnet) (*schema.Subnet, error)); ok {
+		return rf(ctx, sub)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *schema.Subnet) *schema.Subnet); ok {
+		r0 = rf(ctx, sub)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*schema.Subnet)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *schema.Subnet) error); ok {
+		r1 = rf(ctx, sub)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockNetworkV1_CreateOrUpdateSubnet_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CreateOrUpdateSubnet'
+type MockNetworkV1_CreateOrUpdateSubnet_Call struct {
+	*mock.Call
+}
+
+// CreateOrUpdateSubnet is a helper method to define mock.On call
+//   - ctx context.Context
+//   - sub *schema.Subnet
+func (_e *MockNetworkV1_Expecter) CreateOrUpdateSubnet(ctx interface{}, sub interface{}) *MockNetworkV1_CreateOrUpdateSubnet_Call {
+	return &MockNetworkV1_CreateOrUpdateSubnet_Call{Call: _e.mock.On("CreateOrUpdateSubnet", ctx, sub)}
+}
+
+func (_c *MockNetworkV1_CreateOrUpdateSubnet_Call) Run(run func(ctx context.Context, sub *schema.Subnet)) *MockNetworkV1_CreateOrUpdateSubnet_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*schema.Subnet))
+	})
+	return _c
+}
+
+func (_c *MockNetworkV1_CreateOrUpdateSubnet_Call) Return(_a0 *schema.Subnet, _a1 error) *MockNetworkV1_CreateOrUpdateSubnet_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockNetworkV1_CreateOrUpdateSubnet_Call) RunAndReturn(run func(context.Context, *schema.Subnet) (*schema.Subnet, error)) *MockNetworkV1_CreateOrUpdateSubnet_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// CreateOrUpdateSubnetWithParams provides a mock function with given fields: ctx, sub, params
+func (_m *MockNetworkV1) CreateOrUpdateSubnetWithParams(ctx context.Context, sub *schema.Subnet, params *network.CreateOrUpdateSubnetParams) (*schema.Subnet, error) {
+	ret := _m.Called(ctx, sub, params)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CreateOrUpdateSubnetWithParams")
+	}
+
+	var r0 *schema.Subnet
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *schema.Subnet, *network.CreateOrUpdateSubnetParams) (*schema.Subnet, error)); ok {
+		return rf(ctx, sub, params)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *schema.Subnet, *network.CreateOrUpdateSubnetParams) *schema.Subnet); ok {
+		r0 = rf(ctx, sub, params)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*schema.Subnet)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *schema.Subnet, *network.CreateOrUpdateSubnetParams) error); ok {
+		r1 = rf(ctx, sub, params)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockNetworkV1_CreateOrUpdateSubnetWithParams_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CreateOrUpdateSubnetWithParams'
+type MockNetworkV1_CreateOrUpdateSubnetWithParams_Call struct {
+	*mock.Call
+}
+
+// CreateOrUpdateSubnetWithParams is a helper method to define mock.On call
+//   - ctx context.Context
+//   - sub *schema.Subnet
+//   - params *network.CreateOrUpdateSubnetParams
+func (_e *MockNetworkV1_Expecter) CreateOrUpdateSubnetWithParams(ctx interface{}, sub interface{}, params interface{}) *MockNetworkV1_CreateOrUpdateSubnetWithParams_Call {
+	return &MockNetworkV1_CreateOrUpdateSubnetWithParams_Call{Call: _e.mock.On("CreateOrUpdateSubnetWithParams", ctx, sub, params)}
+}
+
+func (_c *MockNetworkV1_CreateOrUpdateSubnetWithParams_Call) Run(run func(ctx context.Context, sub *schema.Subnet, params *network.CreateOrUpdateSubnetParams)) *MockNetworkV1_CreateOrUpdateSubnetWithParams_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*schema.Subnet), args[2].(*network.CreateOrUpdateSubnetParams))
+	})
+	return _c
+}
+
+func (_c *MockNetworkV1_CreateOrUpdateSubnetWithParams_Call) Return(_a0 *schema.Subnet, _a1 error) *MockNetworkV1_CreateOrUpdateSubnetWithParams_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockNetworkV1_CreateOrUpdateSubnetWithParams_Call) RunAndReturn(run func(context.Context, *schema.Subnet, *network.CreateOrUpdateSubnetParams) (*schema.Subnet, error)) *MockNetworkV1_CreateOrUpdateSubnetWithParams_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// DeleteInternetGateway provides a mock function with given fields: ctx, gtw
+func (_m *MockNetworkV1) DeleteInternetGateway(ctx context.Context, gtw *schema.InternetGateway) error {
+	ret := _m.Called(ctx, gtw)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DeleteInternetGateway")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, *schema.InternetGateway) error); ok {
+		r0 = rf(ctx, gtw)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockNetworkV1_DeleteInternetGateway_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DeleteInternetGateway'
+type MockNetworkV1_DeleteInternetGateway_Call struct {
+	*mock.Call
+}
+
+// DeleteInternetGateway is a helper method to define mock.On call
+//   - ctx context.Context
+//   - gtw *schema.InternetGateway
+func (_e *MockNetworkV1_Expecter) DeleteInternetGateway(ctx interface{}, gtw interface{}) *MockNetworkV1_DeleteInternetGateway_Call {
+	return &MockNetworkV1_DeleteInternetGateway_Call{Call: _e.mock.On("DeleteInternetGateway", ctx, gtw)}
+}
+
+func (_c *MockNetworkV1_DeleteInternetGateway_Call) Run(run func(ctx context.Context, gtw *schema.InternetGateway)) *MockNetworkV1_DeleteInternetGateway_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*schema.InternetGateway))
+	})
+	return _c
+}
+
+func (_c *MockNetworkV1_DeleteInternetGateway_Call) Return(_a0 error) *MockNetworkV1_DeleteInternetGateway_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockNetworkV1_DeleteInternetGateway_Call) RunAndReturn(run func(context.Context, *schema.InternetGateway) error) *MockNetworkV1_DeleteInternetGateway_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// DeleteInternetGatewayWithParams provides a mock function with given fields: ctx, gtw, params
+func (_m *MockNetworkV1) DeleteInternetGatewayWithParams(ctx context.Context, gtw *schema.InternetGateway, params *network.DeleteInternetGatewayParams) error {
+	ret := _m.Called(ctx, gtw, params)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DeleteInternetGatewayWithParams")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, *schema.InternetGateway, *network.DeleteInternetGatewayParams) error); ok {
+		r0 = rf(ctx, gtw, params)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockNetworkV1_DeleteInternetGatewayWithParams_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DeleteInternetGatewayWithParams'
+type MockNetworkV1_DeleteInternetGatewayWithParams_Call struct {
+	*mock.Call
+}
+
+// DeleteInternetGatewayWithParams is a helper method to define mock.On call
+//   - ctx context.Context
+//   - gtw *schema.InternetGateway
+//   - params *network.DeleteInternetGatewayParams
+func (_e *MockNetworkV1_Expecter) DeleteInternetGatewayWithParams(ctx interface{}, gtw interface{}, params interface{}) *MockNetworkV1_DeleteInternetGatewayWithParams_Call {
+	return &MockNetworkV1_DeleteInternetGatewayWithParams_Call{Call: _e.mock.On("DeleteInternetGatewayWithParams", ctx, gtw, params)}
+}
+
+func (_c *MockNetworkV1_DeleteInternetGatewayWithParams_Call) Run(run func(ctx context.Context, gtw *schema.InternetGateway, params *network.DeleteInternetGatewayParams)) *MockNetworkV1_DeleteInternetGatewayWithParams_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*schema.InternetGateway), args[2].(*network.DeleteInternetGatewayParams))
+	})
+	return _c
+}
+
+func (_c *MockNetworkV1_DeleteInternetGatewayWithParams_Call) Return(_a0 error) *MockNetworkV1_DeleteInternetGatewayWithParams_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockNetworkV1_DeleteInternetGatewayWithParams_Call) RunAndReturn(run func(context.Context, *schema.InternetGateway, *network.DeleteInternetGatewayParams) error) *MockNetworkV1_DeleteInternetGatewayWithParams_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// DeleteNetwork provides a mock function with given fields: ctx, net
+func (_m *MockNetworkV1) DeleteNetwork(ctx context.Context, net *schema.Network) error {
+	ret := _m.Called(ctx, net)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DeleteNetwork")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, *schema.Network) error); ok {
+		r0 = rf(ctx, net)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockNetworkV1_DeleteNetwork_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DeleteNetwork'
+type MockNetworkV1_DeleteNetwork_Call struct {
+	*mock.Call
+}
+
+// DeleteNetwork is a helper method to define mock.On call
+//   - ctx context.Context
+//   - net *schema.Network
+func (_e *MockNetworkV1_Expecter) DeleteNetwork(ctx interface{}, net interface{}) *MockNetworkV1_DeleteNetwork_Call {
+	return &MockNetworkV1_DeleteNetwork_Call{Call: _e.mock.On("DeleteNetwork", ctx, net)}
+}
+
+func (_c *MockNetworkV1_DeleteNetwork_Call) Run(run func(ctx context.Context, net *schema.Network)) *MockNetworkV1_DeleteNetwork_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*schema.Network))
+	})
+	return _c
+}
+
+func (_c *MockNetworkV1_DeleteNetwork_Call) Return(_a0 error) *MockNetworkV1_DeleteNetwork_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockNetworkV1_DeleteNetwork_Call) RunAndReturn(run func(context.Context, *schema.Network) error) *MockNetworkV1_DeleteNetwork_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// DeleteNetworkWithParams provides a mock function with given fields: ctx, net, params
+func (_m *MockNetworkV1) DeleteNetworkWithParams(ctx context.Context, net *schema.Network, params *network.DeleteNetworkParams) error {
+	ret := _m.Called(ctx, net, params)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DeleteNetworkWithParams")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, *schema.Network, *network.DeleteNetworkParams) error); ok {
+		r0 = rf(ctx, net, params)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockNetworkV1_DeleteNetworkWithParams_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DeleteNetworkWithParams'
+type MockNetworkV1_DeleteNetworkWithParams_Call struct {
+	*mock.Call
+}
+
+// DeleteNetworkWithParams is a helper method to define mock.On call
+//   - ctx context.Context
+//   - net *schema.Network
+//   - params *network.DeleteNetworkParams
+func (_e *MockNetworkV1_Expecter) DeleteNetworkWithParams(ctx interface{}, net interface{}, params interface{}) *MockNetworkV1_DeleteNetworkWithParams_Call {
+	return &MockNetworkV1_DeleteNetworkWithParams_Call{Call: _e.mock.On("DeleteNetworkWithParams", ctx, net, params)}
+}
+
+func (_c *MockNetworkV1_DeleteNetworkWithParams_Call) Run(run func(ctx context.Context, net *schema.Network, params *network.DeleteNetworkParams)) *MockNetworkV1_DeleteNetworkWithParams_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*schema.Network), args[2].(*network.DeleteNetworkParams))
+	})
+	return _c
+}
+
+func (_c *MockNetworkV1_DeleteNetworkWithParams_Call) Return(_a0 error) *MockNetworkV1_DeleteNetworkWithParams_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockNetworkV1_DeleteNetworkWithParams_Call) RunAndReturn(run func(context.Context, *schema.Network, *network.DeleteNetworkParams) error) *MockNetworkV1_DeleteNetworkWithParams_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// DeleteNic provides a mock function with given fields: ctx, nic
+func (_m *MockNetworkV1) DeleteNic(ctx context.Context, nic *schema.Nic) error {
+	ret := _m.Called(ctx, nic)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DeleteNic")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, *schema.Nic) error); ok {
+		r0 = rf(ctx, nic)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockNetworkV1_DeleteNic_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DeleteNic'
+type MockNetworkV1_DeleteNic_Call struct {
+	*mock.Call
+}
+
+// DeleteNic is a helper method to define mock.On call
+//   - ctx context.Context
+//   - nic *schema.Nic
+func (_e *MockNetworkV1_Expecter) DeleteNic(ctx interface{}, nic interface{}) *MockNetworkV1_DeleteNic_Call {
+	return &MockNetworkV1_DeleteNic_Call{Call: _e.mock.On("DeleteNic", ctx, nic)}
+}
+
+func (_c *MockNetworkV1_DeleteNic_Call) Run(run func(ctx context.Context, nic *schema.Nic)) *MockNetworkV1_DeleteNic_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*schema.Nic))
+	})
+	return _c
+}
+
+func (_c *MockNetworkV1_DeleteNic_Call) Return(_a0 error) *MockNetworkV1_DeleteNic_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockNetworkV1_DeleteNic_Call) RunAndReturn(run func(context.Context, *schema.Nic) error) *MockNetworkV1_DeleteNic_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// DeleteNicWithParams provides a mock function with given fields: ctx, nic, params
+func (_m *MockNetworkV1) DeleteNicWithParams(ctx context.Context, nic *schema.Nic, params *network.DeleteNicParams) error {
+	ret := _m.Called(ctx, nic, params)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DeleteNicWithParams")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, *schema.Nic, *network.DeleteNicParams) error); ok {
+		r0 = rf(ctx, nic, params)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockNetworkV1_DeleteNicWithParams_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DeleteNicWithParams'
+type MockNetworkV1_DeleteNicWithParams_Call struct {
+	*mock.Call
+}
+
+// DeleteNicWithParams is a helper method to define mock.On call
+//   - ctx context.Context
+//   - nic *schema.Nic
+//   - params *network.DeleteNicParams
+func (_e *MockNetworkV1_Expecter) DeleteNicWithParams(ctx interface{}, nic interface{}, params interface{}) *MockNetworkV1_DeleteNicWithParams_Call {
+	return &MockNetworkV1_DeleteNicWithParams_Call{Call: _e.mock.On("DeleteNicWithParams", ctx, nic, params)}
+}
+
+func (_c *MockNetworkV1_DeleteNicWithParams_Call) Run(run func(ctx context.Context, nic *schema.Nic, params *network.DeleteNicParams)) *MockNetworkV1_DeleteNicWithParams_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*schema.Nic), args[2].(*network.DeleteNicParams))
+	})
+	return _c
+}
+
+func (_c *MockNetworkV1_DeleteNicWithParams_Call) Return(_a0 error) *MockNetworkV1_DeleteNicWithParams_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockNetworkV1_DeleteNicWithParams_Call) RunAndReturn(run func(context.Context, *schema.Nic, *network.DeleteNicParams) error) *MockNetworkV1_DeleteNicWithParams_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// DeletePublicIp provides a mock function with given fields: ctx, ip
+func (_m *MockNetworkV1) DeletePublicIp(ctx context.Context, ip *schema.PublicIp) error {
+	ret := _m.Called(ctx, ip)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DeletePublicIp")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, *schema.PublicIp) error); ok {
+		r0 = rf(ctx, ip)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockNetworkV1_DeletePublicIp_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DeletePublicIp'
+type MockNetworkV1_DeletePublicIp_Call struct {
+	*mock.Call
+}
+
+// DeletePublicIp is a helper method to define mock.On call
+//   - ctx context.Context
+//   - ip *schema.PublicIp
+func (_e *MockNetworkV1_Expecter) DeletePublicIp(ctx interface{}, ip interface{}) *MockNetworkV1_DeletePublicIp_Call {
+	return &MockNetworkV1_DeletePublicIp_Call{Call: _e.mock.On("DeletePublicIp", ctx, ip)}
+}
+
+func (_c *MockNetworkV1_DeletePublicIp_Call) Run(run func(ctx context.Context, ip *schema.PublicIp)) *MockNetworkV1_DeletePublicIp_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*schema.PublicIp))
+	})
+	return _c
+}
+
+func (_c *MockNetworkV1_DeletePublicIp_Call) Return(_a0 error) *MockNetworkV1_DeletePublicIp_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockNetworkV1_DeletePublicIp_Call) RunAndReturn(run func(context.Context, *schema.PublicIp) error) *MockNetworkV1_DeletePublicIp_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// DeletePublicIpWithParams provides a mock function with given fields: ctx, ip, params
+func (_m *MockNetworkV1) DeletePublicIpWithParams(ctx context.Context, ip *schema.PublicIp, params *network.DeletePublicIpParams) error {
+	ret := _m.Called(ctx, ip, params)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DeletePublicIpWithParams")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, *schema.PublicIp, *network.DeletePublicIpParams) error); ok {
+		r0 = rf(ctx, ip, params)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockNetworkV1_DeletePublicIpWithParams_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DeletePublicIpWithParams'
+type MockNetworkV1_DeletePublicIpWithParams_Call struct {
+	*mock.Call
+}
+
+// DeletePublicIpWithParams is a helper method to define mock.On call
+//   - ctx context.Context
+//   - ip *schema.PublicIp
+//   - params *network.DeletePublicIpParams
+func (_e *MockNetworkV1_Expecter) DeletePublicIpWithParams(ctx interface{}, ip interface{}, params interface{}) *MockNetworkV1_DeletePublicIpWithParams_Call {
+	return &MockNetworkV1_DeletePublicIpWithParams_Call{Call: _e.mock.On("DeletePublicIpWithParams", ctx, ip, params)}
+}
+
+func (_c *MockNetworkV1_DeletePublicIpWithParams_Call) Run(run func(ctx context.Context, ip *schema.PublicIp, params *network.DeletePublicIpParams)) *MockNetworkV1_DeletePublicIpWithParams_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*schema.PublicIp), args[2].(*network.DeletePublicIpParams))
+	})
+	return _c
+}
+
+func (_c *MockNetworkV1_DeletePublicIpWithParams_Call) Return(_a0 error) *MockNetworkV1_DeletePublicIpWithParams_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockNetworkV1_DeletePublicIpWithParams_Call) RunAndReturn(run func(context.Context, *schema.PublicIp, *network.DeletePublicIpParams) error) *MockNetworkV1_DeletePublicIpWithParams_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// DeleteRouteTable provides a mock function with given fields: ctx, route
+func (_m *MockNetworkV1) DeleteRouteTable(ctx context.Context, route *schema.RouteTable) error {
+	ret := _m.Called(ctx, route)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DeleteRouteTable")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, *schema.RouteTable) error); ok {
+		r0 = rf(ctx, route)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockNetworkV1_DeleteRouteTable_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DeleteRouteTable'
+type MockNetworkV1_DeleteRouteTable_Call struct {
+	*mock.Call
+}
+
+// DeleteRouteTable is a helper method to define mock.On call
+//   - ctx context.Context
+//   - route *schema.RouteTable
+func (_e *MockNetworkV1_Expecter) DeleteRouteTable(ctx interface{}, route interface{}) *MockNetworkV1_DeleteRouteTable_Call {
+	return &MockNetworkV1_DeleteRouteTable_Call{Call: _e.mock.On("DeleteRouteTable", ctx, route)}
+}
+
+func (_c *MockNetworkV1_DeleteRouteTable_Call) Run(run func(ctx context.Context, route *schema.RouteTable)) *MockNetworkV1_DeleteRouteTable_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*schema.RouteTable))
+	})
+	return _c
+}
+
+func (_c *MockNetworkV1_DeleteRouteTable_Call) Return(_a0 error) *MockNetworkV1_DeleteRouteTable_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockNetworkV1_DeleteRouteTable_Call) RunAndReturn(run func(context.Context, *schema.RouteTable) error) *MockNetworkV1_DeleteRouteTable_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// DeleteRouteTableWithParams provides a mock function with given fields: ctx, route, params
+func (_m *MockNetworkV1) DeleteRouteTableWithParams(ctx context.Context, route *schema.RouteTable, params *network.DeleteRouteTableParams) error {
+	ret := _m.Called(ctx, route, params)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DeleteRouteTableWithParams")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, *schema.RouteTable, *network.DeleteRouteTableParams) error); ok {
+		r0 = rf(ctx, route, params)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockNetworkV1_DeleteRouteTableWithParams_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DeleteRouteTableWithParams'
+type MockNetworkV1_DeleteRouteTableWithParams_Call struct {
+	*mock.Call
+}
+
+// DeleteRouteTableWithParams is a helper method to define mock.On call
+//   - ctx context.Context
+//   - route *schema.RouteTable
+//   - params *network.DeleteRouteTableParams
+func (_e *MockNetworkV1_Expecter) DeleteRouteTableWithParams(ctx interface{}, route interface{}, params interface{}) *MockNetworkV1_DeleteRouteTableWithParams_Call {
+	return &MockNetworkV1_DeleteRouteTableWithParams_Call{Call: _e.mock.On("DeleteRouteTableWithParams", ctx, route, params)}
+}
+
+func (_c *MockNetworkV1_DeleteRouteTableWithParams_Call) Run(run func(ctx context.Context, route *schema.RouteTable, params *network.DeleteRouteTableParams)) *MockNetworkV1_DeleteRouteTableWithParams_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*schema.RouteTable), args[2].(*network.DeleteRouteTableParams))
+	})
+	return _c
+}
+
+func (_c *MockNetworkV1_DeleteRouteTableWithParams_Call) Return(_a0 error) *MockNetworkV1_DeleteRouteTableWithParams_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockNetworkV1_DeleteRouteTableWithParams_Call) RunAndReturn(run func(context.Context, *schema.RouteTable, *network.DeleteRouteTableParams) error) *MockNetworkV1_DeleteRouteTableWithParams_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// DeleteSecurityGroup provides a mock function with given fields: ctx, group
+func (_m *MockNetworkV1) DeleteSecurityGroup(ctx context.Context, group *schema.SecurityGroup) error {
+	ret := _m.Called(ctx, group)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DeleteSecurityGroup")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, *schema.SecurityGroup) error); ok {
+		r0 = rf(ctx, group)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockNetworkV1_DeleteSecurityGroup_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DeleteSecurityGroup'
+type MockNetworkV1_DeleteSecurityGroup_Call struct {
+	*mock.Call
+}
+
+// DeleteSecurityGroup is a helper method to define mock.On call
+//   - ctx context.Context
+//   - group *schema.SecurityGroup
+func (_e *MockNetworkV1_Expecter) DeleteSecurityGroup(ctx interface{}, group interface{}) *MockNetworkV1_DeleteSecurityGroup_Call {
+	return &MockNetworkV1_DeleteSecurityGroup_Call{Call: _e.mock.On("DeleteSecurityGroup", ctx, group)}
+}
+
+func (_c *MockNetworkV1_DeleteSecurityGroup_Call) Run(run func(ctx context.Context, group *schema.SecurityGroup)) *MockNetworkV1_DeleteSecurityGroup_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*schema.SecurityGroup))
+	})
+	return _c
+}
+
+func (_c *MockNetworkV1_DeleteSecurityGroup_Call) Return(_a0 error) *MockNetworkV1_DeleteSecurityGroup_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockNetworkV1_DeleteSecurityGroup_Call) RunAndReturn(run func(context.Context, *schema.SecurityGroup) error) *MockNetworkV1_DeleteSecurityGroup_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// DeleteSecurityGroupRule provides a mock function with given fields: ctx, rule
+func (_m *MockNetworkV1) DeleteSecurityGroupRule(ctx context.Context, rule *schema.SecurityGroupRule) error {
+	ret := _m.Called(ctx, rule)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DeleteSecurityGroupRule")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, *schema.SecurityGroupRule) error); ok {
+		r0 = rf(ctx, rule)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockNetworkV1_DeleteSecurityGroupRule_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DeleteSecurityGroupRule'
+type MockNetworkV1_DeleteSecurityGroupRule_Call struct {
+	*mock.Call
+}
+
+// DeleteSecurityGroupRule is a helper method to define mock.On call
+//   - ctx context.Context
+//   - rule *schema.SecurityGroupRule
+func (_e *MockNetworkV1_Expecter) DeleteSecurityGroupRule(ctx interface{}, rule interface{}) *MockNetworkV1_DeleteSecurityGroupRule_Call {
+	return &MockNetworkV1_DeleteSecurityGroupRule_Call{Call: _e.mock.On("DeleteSecurityGroupRule", ctx, rule)}
+}
+
+func (_c *MockNetworkV1_DeleteSecurityGroupRule_Call) Run(run func(ctx context.Context, rule *schema.SecurityGroupRule)) *MockNetworkV1_DeleteSecurityGroupRule_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*schema.SecurityGroupRule))
+	})
+	return _c
+}
+
+func (_c *MockNetworkV1_DeleteSecurityGroupRule_Call) Return(_a0 error) *MockNetworkV1_DeleteSecurityGroupRule_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockNetworkV1_DeleteSecurityGroupRule_Call) RunAndReturn(run func(context.Context, *schema.SecurityGroupRule) error) *MockNetworkV1_DeleteSecurityGroupRule_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// DeleteSecurityGroupRuleWithParams provides a mock function with given fields: ctx, rule, params
+func (_m *MockNetworkV1) DeleteSecurityGroupRuleWithParams(ctx context.Context, rule *schema.SecurityGroupRule, params *network.DeleteSecurityGroupRuleParams) error {
+	ret := _m.Called(ctx, rule, params)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DeleteSecurityGroupRuleWithParams")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, *schema.SecurityGroupRule, *network.DeleteSecurityGroupRuleParams) error); ok {
+		r0 = rf(ctx, rule, params)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockNetworkV1_DeleteSecurityGroupRuleWithParams_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DeleteSecurityGroupRuleWithParams'
+type MockNetworkV1_DeleteSecurityGroupRuleWithParams_Call struct {
+	*mock.Call
+}
+
+// DeleteSecurityGroupRuleWithParams is a helper method to define mock.On call
+//   - ctx context.Context
+//   - rule *schema.SecurityGroupRule
+//   - params *network.DeleteSecurityGroupRuleParams
+func (_e *MockNetworkV1_Expecter) DeleteSecurityGroupRuleWithParams(ctx interface{}, rule interface{}, params interface{}) *MockNetworkV1_DeleteSecurityGroupRuleWithParams_Call {
+	return &MockNetworkV1_DeleteSecurityGroupRuleWithParams_Call{Call: _e.mock.On("DeleteSecurityGroupRuleWithParams", ctx, rule, params)}
+}
+
+func (_c *MockNetworkV1_DeleteSecurityGroupRuleWithParams_Call) Run(run func(ctx context.Context, rule *schema.SecurityGroupRule, params *network.DeleteSecurityGroupRuleParams)) *MockNetworkV1_DeleteSecurityGroupRuleWithParams_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*schema.SecurityGroupRule), args[2].(*network.DeleteSecurityGroupRuleParams))
+	})
+	return _c
+}
+
+func (_c *MockNetworkV1_DeleteSecurityGroupRuleWithParams_Call) Return(_a0 error) *MockNetworkV1_DeleteSecurityGroupRuleWithParams_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockNetworkV1_DeleteSecurityGroupRuleWithParams_Call) RunAndReturn(run func(context.Context, *schema.SecurityGroupRule, *network.DeleteSecurityGroupRuleParams) error) *MockNetworkV1_DeleteSecurityGroupRuleWithParams_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// DeleteSecurityGroupWithParams provides a mock function with given fields: ctx, group, params
+func (_m *MockNetworkV1) DeleteSecurityGroupWithParams(ctx context.Context, group *schema.SecurityGroup, params *network.DeleteSecurityGroupParams) error {
+	ret := _m.Called(ctx, group, params)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DeleteSecurityGroupWithParams")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, *schema.SecurityGroup, *network.DeleteSecurityGroupParams) error); ok {
+		r0 = rf(ctx, group, params)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockNetworkV1_DeleteSecurityGroupWithParams_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DeleteSecurityGroupWithParams'
+type MockNetworkV1_DeleteSecurityGroupWithParams_Call struct {
+	*mock.Call
+}
+
+// DeleteSecurityGroupWithParams is a helper method to define mock.On call
+//   - ctx context.Context
+//   - group *schema.SecurityGroup
+//   - params *network.DeleteSecurityGroupParams
+func (_e *MockNetworkV1_Expecter) DeleteSecurityGroupWithParams(ctx interface{}, group interface{}, params interface{}) *MockNetworkV1_DeleteSecurityGroupWithParams_Call {
+	return &MockNetworkV1_DeleteSecurityGroupWithParams_Call{Call: _e.mock.On("DeleteSecurityGroupWithParams", ctx, group, params)}
+}
+
+func (_c *MockNetworkV1_DeleteSecurityGroupWithParams_Call) Run(run func(ctx context.Context, group *schema.SecurityGroup, params *network.DeleteSecurityGroupParams)) *MockNetworkV1_DeleteSecurityGroupWithParams_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*schema.SecurityGroup), args[2].(*network.DeleteSecurityGroupParams))
+	})
+	return _c
+}
+
+func (_c *MockNetworkV1_DeleteSecurityGroupWithParams_Call) Return(_a0 error) *MockNetworkV1_DeleteSecurityGroupWithParams_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockNetworkV1_DeleteSecurityGroupWithParams_Call) RunAndReturn(run func(context.Context, *schema.SecurityGroup, *network.DeleteSecurityGroupParams) error) *MockNetworkV1_DeleteSecurityGroupWithParams_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// DeleteSubnet provides a mock function with given fields: ctx, sub
+func (_m *MockNetworkV1) DeleteSubnet(ctx context.Context, sub *schema.Subnet) error {
+	ret := _m.Called(ctx, sub)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DeleteSubnet")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, *schema.Subnet) error); ok {
+		r0 = rf(ctx, sub)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockNetworkV1_DeleteSubnet_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DeleteSubnet'
+type MockNetworkV1_DeleteSubnet_Call struct {
+	*mock.Call
+}
+
+// DeleteSubnet is a helper method to define mock.On call
+//   - ctx context.Context
+//   - sub *schema.Subnet
+func (_e *MockNetworkV1_Expecter) DeleteSubnet(ctx interface{}, sub interface{}) *MockNetworkV1_DeleteSubnet_Call {
+	return &MockNetworkV1_DeleteSubnet_Call{Call: _e.mock.On("DeleteSubnet", ctx, sub)}
+}
+
+func (_c *MockNetworkV1_DeleteSubnet_Call) Run(run func(ctx context.Context, sub *schema.Subnet)) *MockNetworkV1_DeleteSubnet_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*schema.Subnet))
+	})
+	return _c
+}
+
+func (_c *MockNetworkV1_DeleteSubnet_Call) Return(_a0 error) *MockNetworkV1_DeleteSubnet_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockNetworkV1_DeleteSubnet_Call) RunAndReturn(run func(context.Context, *schema.Subnet) error) *MockNetworkV1_DeleteSubnet_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// DeleteSubnetWithParams provides a mock function with given fields: ctx, sub, params
+func (_m *MockNetworkV1) DeleteSubnetWithParams(ctx context.Context, sub *schema.Subnet, params *network.DeleteSubnetParams) error {
+	ret := _m.Called(ctx, sub, params)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DeleteSubnetWithParams")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, *schema.Subnet, *network.DeleteSubnetParams) error); ok {
+		r0 = rf(ctx, sub, params)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockNetworkV1_DeleteSubnetWithParams_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DeleteSubnetWithParams'
+type MockNetworkV1_DeleteSubnetWithParams_Call struct {
+	*mock.Call
+}
+
+// DeleteSubnetWithParams is a helper method to define mock.On call
+//   - ctx context.Context
+//   - sub *schema.Subnet
+//   - params *network.DeleteSubnetParams
+func (_e *MockNetworkV1_Expecter) DeleteSubnetWithParams(ctx interface{}, sub interface{}, params interface{}) *MockNetworkV1_DeleteSubnetWithParams_Call {
+	return &MockNetworkV1_DeleteSubnetWithParams_Call{Call: _e.mock.On("DeleteSubnetWithParams", ctx, sub, params)}
+}
+
+func (_c *MockNetworkV1_DeleteSubnetWithParams_Call) Run(run func(ctx context.Context, sub *schema.Subnet, params *network.DeleteSubnetParams)) *MockNetworkV1_DeleteSubnetWithParams_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*schema.Subnet), args[2].(*network.DeleteSubnetParams))
+	})
+	return _c
+}
+
+func (_c *MockNetworkV1_DeleteSubnetWithParams_Call) Return(_a0 error) *MockNetworkV1_DeleteSubnetWithParams_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockNetworkV1_DeleteSubnetWithParams_Call) RunAndReturn(run func(context.Context, *schema.Subnet, *network.DeleteSubnetParams) error) *MockNetworkV1_DeleteSubnetWithParams_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetInternetGateway provides a mock function with given fields: ctx, wref
+func (_m *MockNetworkV1) GetInternetGateway(ctx context.Context, wref secapi.WorkspaceReference) (*schema.InternetGateway, error) {
+	ret := _m.Called(ctx, wref)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetInternetGateway")
+	}
+
+	var r0 *schema.InternetGateway
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, secapi.WorkspaceReference) (*schema.InternetGateway, error)); ok {
+		return rf(ctx, wref)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, secapi.WorkspaceReference) *schema.InternetGateway); ok {
+		r0 = rf(ctx, wref)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*schema.InternetGateway)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, secapi.WorkspaceReference) error); ok {
+		r1 = rf(ctx, wref)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockNetworkV1_GetInternetGateway_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetInternetGateway'
+type MockNetworkV1_GetInternetGateway_Call struct {
+	*mock.Call
+}
+
+// GetInternetGateway is a helper method to define mock.On call
+//   - ctx context.Context
+//   - wref secapi.WorkspaceReference
+func (_e *MockNetworkV1_Expecter) GetInternetGateway(ctx interface{}, wref interface{}) *MockNetworkV1_GetInternetGateway_Call {
+	return &MockNetworkV1_GetInternetGateway_Call{Call: _e.mock.On("GetInternetGateway", ctx, wref)}
+}
+
+func (_c *MockNetworkV1_GetInternetGateway_Call) Run(run func(ctx context.Context, wref secapi.WorkspaceReference)) *MockNetworkV1_GetInternetGateway_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(secapi.WorkspaceReference))
+	})
+	return _c
+}
+
+func (_c *MockNetworkV1_GetInternetGateway_Call) Return(_a0 *schema.InternetGateway, _a1 error) *MockNetworkV1_GetInternetGateway_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockNetworkV1_GetInternetGateway_Call) RunAndReturn(run func(context.Context, secapi.WorkspaceReference) (*schema.InternetGateway, error)) *MockNetworkV1_GetInternetGateway_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetInternetGatewayUntilState provides a mock function with given fields: ctx, wref, config
+func (_m *MockNetworkV1) GetInternetGatewayUntilState(ctx context.Context, wref secapi.WorkspaceReference, config secapi.ResourceObserverUntilValueConfig[schema.ResourceState]) (*schema.InternetGateway, error) {
+	ret := _m.Called(ctx, wref, config)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetInternetGatewayUntilState")
+	}
+
+	var r0 *schema.InternetGateway
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, secapi.WorkspaceReference, secapi.ResourceObserverUntilValueConfig[schema.ResourceState]) (*schema.InternetGateway, error)); ok {
+		return rf(ctx, wref, config)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, secapi.WorkspaceReference, secapi.ResourceObserverUntilValueConfig[schema.ResourceState]) *schema.InternetGateway); ok {
+		r0 = rf(ctx, wref, config)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*schema.InternetGateway)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, secapi.WorkspaceReference, secapi.ResourceObserverUntilValueConfig[schema.ResourceState]) error); ok {
+		r1 = rf(ctx, wref, config)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockNetworkV1_GetInternetGatewayUntilState_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetInternetGatewayUntilState'
+type MockNetworkV1_GetInternetGatewayUntilState_Call struct {
+	*mock.Call
+}
+
+// GetInternetGatewayUntilState is a helper method to define mock.On call
+//   - ctx context.Context
+//   - wref secapi.WorkspaceReference
+//   - config secapi.ResourceObserverUntilValueConfig[schema.ResourceState]
+func (_e *MockNetworkV1_Expecter) GetInternetGatewayUntilState(ctx interface{}, wref interface{}, config interface{}) *MockNetworkV1_GetInternetGatewayUntilState_Call {
+	return &MockNetworkV1_GetInternetGatewayUntilState_Call{Call: _e.mock.On("GetInternetGatewayUntilState", ctx, wref, config)}
+}
+
+func (_c *MockNetworkV1_GetInternetGatewayUntilState_Call) Run(run func(ctx context.Context, wref secapi.WorkspaceReference, config secapi.ResourceObserverUntilValueConfig[schema.ResourceState])) *MockNetworkV1_GetInternetGatewayUntilState_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(secapi.WorkspaceReference), args[2].(secapi.ResourceObserverUntilValueConfig[schema.ResourceState]))
+	})
+	return _c
+}
+
+func (_c *MockNetworkV1_GetInternetGatewayUntilState_Call) Return(_a0 *schema.InternetGateway, _a1 error) *MockNetworkV1_GetInternetGatewayUntilState_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockNetworkV1_GetInternetGatewayUntilState_Call) RunAndReturn(run func(context.Context, secapi.WorkspaceReference, secapi.ResourceObserverUntilValueConfig[schema.ResourceState]) (*schema.InternetGateway, error)) *MockNetworkV1_GetInternetGatewayUntilState_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetNetwork provides a mock function with given fields: ctx, wref
+func (_m *MockNetworkV1) GetNetwork(ctx context.Context, wref secapi.WorkspaceReference) (*schema.Network, error) {
+	ret := _m.Called(ctx, wref)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetNetwork")
+	}
+
+	var r0 *schema.Network
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, secapi.WorkspaceReference) (*schema.Network, error)); ok {
+		return rf(ctx, wref)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, secapi.WorkspaceReference) *schema.Network); ok {
+		r0 = rf(ctx, wref)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*schema.Network)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, secapi.WorkspaceReference) error); ok {
+		r1 = rf(ctx, wref)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockNetworkV1_GetNetwork_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetNetwork'
+type MockNetworkV1_GetNetwork_Call struct {
+	*mock.Call
+}
+
+// GetNetwork is a helper method to define mock.On call
+//   - ctx context.Context
+//   - wref secapi.WorkspaceReference
+func (_e *MockNetworkV1_Expecter) GetNetwork(ctx interface{}, wref interface{}) *MockNetworkV1_GetNetwork_Call {
+	return &MockNetworkV1_GetNetwork_Call{Call: _e.mock.On("GetNetwork", ctx, wref)}
+}
+
+func (_c *MockNetworkV1_GetNetwork_Call) Run(run func(ctx context.Context, wref secapi.WorkspaceReference)) *MockNetworkV1_GetNetwork_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(secapi.WorkspaceReference))
+	})
+	return _c
+}
+
+func (_c *MockNetworkV1_GetNetwork_Call) Return(_a0 *schema.Network, _a1 error) *MockNetworkV1_GetNetwork_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockNetworkV1_GetNetwork_Call) RunAndReturn(run func(context.Context, secapi.WorkspaceReference) (*schema.Network, error)) *MockNetworkV1_GetNetwork_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetNetworkUntilState provides a mock function with given fields: ctx, wref, config
+func (_m *MockNetworkV1) GetNetworkUntilState(ctx context.Context, wref secapi.WorkspaceReference, config secapi.ResourceObserverUntilValueConfig[schema.ResourceState]) (*schema.Network, error) {
+	ret := _m.Called(ctx, wref, config)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetNetworkUntilState")
+	}
+
+	var r0 *schema.Network
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, secapi.WorkspaceReference, secapi.ResourceObserverUntilValueConfig[schema.ResourceState]) (*schema.Network, error)); ok {
+		return rf(ctx, wref, config)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, secapi.WorkspaceReference, secapi.ResourceObserverUntilValueConfig[schema.ResourceState]) *schema.Network); ok {
+		r0 = rf(ctx, wref, config)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*schema.Network)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, secapi.WorkspaceReference, secapi.ResourceObserverUntilValueConfig[schema.ResourceState]) error); ok {
+		r1 = rf(ctx, wref, config)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockNetworkV1_GetNetworkUntilState_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetNetworkUntilState'
+type MockNetworkV1_GetNetworkUntilState_Call struct {
+	*mock.Call
+}
+
+// GetNetworkUntilState is a helper method to define mock.On call
+//   - ctx context.Context
+//   - wref secapi.WorkspaceReference
+//   - config secapi.ResourceObserverUntilValueConfig[schema.ResourceState]
+func (_e *MockNetworkV1_Expecter) GetNetworkUntilState(ctx interface{}, wref interface{}, config interface{}) *MockNetworkV1_GetNetworkUntilState_Call {
+	return &MockNetworkV1_GetNetworkUntilState_Call{Call: _e.mock.On("GetNetworkUntilState", ctx, wref, config)}
+}
+
+func (_c *MockNetworkV1_GetNetworkUntilState_Call) Run(run func(ctx context.Context, wref secapi.WorkspaceReference, config secapi.ResourceObserverUntilValueConfig[schema.ResourceState])) *MockNetworkV1_GetNetworkUntilState_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(secapi.WorkspaceReference), args[2].(secapi.ResourceObserverUntilValueConfig[schema.ResourceState]))
+	})
+	return _c
+}
+
+func (_c *MockNetworkV1_GetNetworkUntilState_Call) Return(_a0 *schema.Network, _a1 error) *MockNetworkV1_GetNetworkUntilState_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockNetworkV1_GetNetworkUntilState_Call) RunAndReturn(run func(context.Context, secapi.WorkspaceReference, secapi.ResourceObserverUntilValueConfig[schema.ResourceState]) (*schema.Network, error)) *MockNetworkV1_GetNetworkUntilState_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetNic provides a mock function with given fields: ctx, wref
+func (_m *MockNetworkV1) GetNic(ctx context.Context, wref secapi.WorkspaceReference) (*schema.Nic, error) {
+	ret := _m.Called(ctx, wref)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetNic")
+	}
+
+	var r0 *schema.Nic
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, secapi.WorkspaceReference) (*schema.Nic, error)); ok {
+		return rf(ctx, wref)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, secapi.WorkspaceReference) *schema.Nic); ok {
+		r0 = rf(ctx, wref)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*schema.Nic)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, secapi.WorkspaceReference) error); ok {
+		r1 = rf(ctx, wref)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockNetworkV1_GetNic_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetNic'
+type MockNetworkV1_GetNic_Call struct {
+	*mock.Call
+}
+
+// GetNic is a helper method to define mock.On call
+//   - ctx context.Context
+//   - wref secapi.WorkspaceReference
+func (_e *MockNetworkV1_Expecter) GetNic(ctx interface{}, wref interface{}) *MockNetworkV1_GetNic_Call {
+	return &MockNetworkV1_GetNic_Call{Call: _e.mock.On("GetNic", ctx, wref)}
+}
+
+func (_c *MockNetworkV1_GetNic_Call) Run(run func(ctx context.Context, wref secapi.WorkspaceReference)) *MockNetworkV1_GetNic_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(secapi.WorkspaceReference))
+	})
+	return _c
+}
+
+func (_c *MockNetworkV1_GetNic_Call) Return(_a0 *schema.Nic, _a1 error) *MockNetworkV1_GetNic_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockNetworkV1_GetNic_Call) RunAndReturn(run func(context.Context, secapi.WorkspaceReference) (*schema.Nic, error)) *MockNetworkV1_GetNic_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetNicUntilState provides a mock function with given fields: ctx, wref, config
+func (_m *MockNetworkV1) GetNicUntilState(ctx context.Context, wref secapi.WorkspaceReference, config secapi.ResourceObserverUntilValueConfig[schema.ResourceState]) (*schema.Nic, error) {
+	ret := _m.Called(ctx, wref, config)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetNicUntilState")
+	}
+
+	var r0 *schema.Nic
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, secapi.WorkspaceReference, secapi.ResourceObserverUntilValueConfig[schema.ResourceState]) (*schema.Nic, error)); ok {
+		return rf(ctx, wref, config)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, secapi.WorkspaceReference, secapi.ResourceObserverUntilValueConfig[schema.ResourceState]) *schema.Nic); ok {
+		r0 = rf(ctx, wref, config)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*schema.Nic)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, secapi.WorkspaceReference, secapi.ResourceObserverUntilValueConfig[schema.ResourceState]) error); ok {
+		r1 = rf(ctx, wref, config)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockNetworkV1_GetNicUntilState_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetNicUntilState'
+type MockNetworkV1_GetNicUntilState_Call struct {
+	*mock.Call
+}
+
+// GetNicUntilState is a helper method to define mock.On call
+//   - ctx context.Context
+//   - wref secapi.WorkspaceReference
+//   - config secapi.ResourceObserverUntilValueConfig[schema.ResourceState]
+func (_e *MockNetworkV1_Expecter) GetNicUntilState(ctx interface{}, wref interface{}, config interface{}) *MockNetworkV1_GetNicUntilState_Call {
+	return &MockNetworkV1_GetNicUntilState_Call{Call: _e.mock.On("GetNicUntilState", ctx, wref, config)}
+}
+
+func (_c *MockNetworkV1_GetNicUntilState_Call) Run(run func(ctx context.Context, wref secapi.WorkspaceReference, config secapi.ResourceObserverUntilValueConfig[schema.ResourceState])) *MockNetworkV1_GetNicUntilState_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(secapi.WorkspaceReference), args[2].(secapi.ResourceObserverUntilValueConfig[schema.ResourceState]))
+	})
+	return _c
+}
+
+func (_c *MockNetworkV1_GetNicUntilState_Call) Return(_a0 *schema.Nic, _a1 error) *MockNetworkV1_GetNicUntilState_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockNetworkV1_GetNicUntilState_Call) RunAndReturn(run func(context.Context, secapi.WorkspaceReference, secapi.ResourceObserverUntilValueConfig[schema.ResourceState]) (*schema.Nic, error)) *MockNetworkV1_GetNicUntilState_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetPublicIp provides a mock function with given fields: ctx, wref
+func (_m *MockNetworkV1) GetPublicIp(ctx context.Context, wref secapi.WorkspaceReference) (*schema.PublicIp, error) {
+	ret := _m.Called(ctx, wref)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetPublicIp")
+	}
+
+	var r0 *schema.PublicIp
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, secapi.WorkspaceReference) (*schema.PublicIp, error)); ok {
+		return rf(ctx, wref)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, secapi.WorkspaceReference) *schema.PublicIp); ok {
+		r0 = rf(ctx, wref)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*schema.PublicIp)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, secapi.WorkspaceReference) error); ok {
+		r1 = rf(ctx, wref)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockNetworkV1_GetPublicIp_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetPublicIp'
+type MockNetworkV1_GetPublicIp_Call struct {
+	*mock.Call
+}
+
+// GetPublicIp is a helper method to define mock.On call
+//   - ctx context.Context
+//   - wref secapi.WorkspaceReference
+func (_e *MockNetworkV1_Expecter) GetPublicIp(ctx interface{}, wref interface{}) *MockNetworkV1_GetPublicIp_Call {
+	return &MockNetworkV1_GetPublicIp_Call{Call: _e.mock.On("GetPublicIp", ctx, wref)}
+}
+
+func (_c *MockNetworkV1_GetPublicIp_Call) Run(run func(ctx context.Context, wref secapi.WorkspaceReference)) *MockNetworkV1_GetPublicIp_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(secapi.WorkspaceReference))
+	})
+	return _c
+}
+
+func (_c *MockNetworkV1_GetPublicIp_Call) Return(_a0 *schema.PublicIp, _a1 error) *MockNetworkV1_GetPublicIp_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockNetworkV1_GetPublicIp_Call) RunAndReturn(run func(context.Context, secapi.WorkspaceReference) (*schema.PublicIp, error)) *MockNetworkV1_GetPublicIp_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetPublicIpUntilState provides a mock function with given fields: ctx, wref, config
+func (_m *MockNetworkV1) GetPublicIpUntilState(ctx context.Context, wref secapi.WorkspaceReference, config secapi.ResourceObserverUntilValueConfig[schema.ResourceState]) (*schema.PublicIp, error) {
+	ret := _m.Called(ctx, wref, config)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetPublicIpUntilState")
+	}
+
+	var r0 *schema.PublicIp
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, secapi.WorkspaceReference, secapi.ResourceObserverUntilValueConfig[schema.ResourceState]) (*schema.PublicIp, error)); ok {
+		return rf(ctx, wref, config)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, secapi.WorkspaceReference, secapi.ResourceObserverUntilValueConfig[schema.ResourceState]) *schema.PublicIp); ok {
+		r0 = rf(ctx, wref, config)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*schema.PublicIp)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, secapi.WorkspaceReference, secapi.ResourceObserverUntilValueConfig[schema.ResourceState]) error); ok {
+		r1 = rf(ctx, wref, config)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockNetworkV1_GetPublicIpUntilState_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetPublicIpUntilState'
+type MockNetworkV1_GetPublicIpUntilState_Call struct {
+	*mock.Call
+}
+
+// GetPublicIpUntilState is a helper method to define mock.On call
+//   - ctx context.Context
+//   - wref secapi.WorkspaceReference
+//   - config secapi.ResourceObserverUntilValueConfig[schema.ResourceState]
+func (_e *MockNetworkV1_Expecter) GetPublicIpUntilState(ctx interface{}, wref interface{}, config interface{}) *MockNetworkV1_GetPublicIpUntilState_Call {
+	return &MockNetworkV1_GetPublicIpUntilState_Call{Call: _e.mock.On("GetPublicIpUntilState", ctx, wref, config)}
+}
+
+func (_c *MockNetworkV1_GetPublicIpUntilState_Call) Run(run func(ctx context.Context, wref secapi.WorkspaceReference, config secapi.ResourceObserverUntilValueConfig[schema.ResourceState])) *MockNetworkV1_GetPublicIpUntilState_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(secapi.WorkspaceReference), args[2].(secapi.ResourceObserverUntilValueConfig[schema.ResourceState]))
+	})
+	return _c
+}
+
+func (_c *MockNetworkV1_GetPublicIpUntilState_Call) Return(_a0 *schema.PublicIp, _a1 error) *MockNetworkV1_GetPublicIpUntilState_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockNetworkV1_GetPublicIpUntilState_Call) RunAndReturn(run func(context.Context, secapi.WorkspaceReference, secapi.ResourceObserverUntilValueConfig[schema.ResourceState]) (*schema.PublicIp, error)) *MockNetworkV1_GetPublicIpUntilState_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetRouteTable provides a mock function with given fields: ctx, nref
+func (_m *MockNetworkV1) GetRouteTable(ctx context.Context, nref secapi.NetworkReference) (*schema.RouteTable, error) {
+	ret := _m.Called(ctx, nref)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetRouteTable")
+	}
+
+	var r0 *schema.RouteTable
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, secapi.NetworkReference) (*schema.RouteTable, error)); ok {
+		return rf(ctx, nref)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, secapi.NetworkReference) *schema.RouteTable); ok {
+		r0 = rf(ctx, nref)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*schema.RouteTable)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, secapi.NetworkReference) error); ok {
+		r1 = rf(ctx, nref)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockNetworkV1_GetRouteTable_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetRouteTable'
+type MockNetworkV1_GetRouteTable_Call struct {
+	*mock.Call
+}
+
+// GetRouteTable is a helper method to define mock.On call
+//   - ctx context.Context
+//   - nref secapi.NetworkReference
+func (_e *MockNetworkV1_Expecter) GetRouteTable(ctx interface{}, nref interface{}) *MockNetworkV1_GetRouteTable_Call {
+	return &MockNetworkV1_GetRouteTable_Call{Call: _e.mock.On("GetRouteTable", ctx, nref)}
+}
+
+func (_c *MockNetworkV1_GetRouteTable_Call) Run(run func(ctx context.Context, nref secapi.NetworkReference)) *MockNetworkV1_GetRouteTable_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(secapi.NetworkReference))
+	})
+	return _c
+}
+
+func (_c *MockNetworkV1_GetRouteTable_Call) Return(_a0 *schema.RouteTable, _a1 error) *MockNetworkV1_GetRouteTable_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockNetworkV1_GetRouteTable_Call) RunAndReturn(run func(context.Context, secapi.NetworkReference) (*schema.RouteTable, error)) *MockNetworkV1_GetRouteTable_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetRouteTableUntilState provides a mock function with given fields: ctx, nref, config
+func (_m *MockNetworkV1) GetRouteTableUntilState(ctx context.Context, nref secapi.NetworkReference, config secapi.ResourceObserverUntilValueConfig[schema.ResourceState]) (*schema.RouteTable, error) {
+	ret := _m.Called(ctx, nref, config)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetRouteTableUntilState")
+	}
+
+	var r0 *schema.RouteTable
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, secapi.NetworkReference, secapi.ResourceObserverUntilValueConfig[schema.ResourceState]) (*schema.RouteTable, error)); ok {
+		return rf(ctx, nref, config)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, secapi.NetworkReference, secapi.ResourceObserverUntilValueConfig[schema.ResourceState]) *schema.RouteTable); ok {
+		r0 = rf(ctx, nref, config)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*schema.RouteTable)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, secapi.NetworkReference, secapi.ResourceObserverUntilValueConfig[schema.ResourceState]) error); ok {
+		r1 = rf(ctx, nref, config)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockNetworkV1_GetRouteTableUntilState_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetRouteTableUntilState'
+type MockNetworkV1_GetRouteTableUntilState_Call struct {
+	*mock.Call
+}
+
+// GetRouteTableUntilState is a helper method to define mock.On call
+//   - ctx context.Context
+//   - nref secapi.NetworkReference
+//   - config secapi.ResourceObserverUntilValueConfig[schema.ResourceState]
+func (_e *MockNetworkV1_Expecter) GetRouteTableUntilState(ctx interface{}, nref interface{}, config interface{}) *MockNetworkV1_GetRouteTableUntilState_Call {
+	return &MockNetworkV1_GetRouteTableUntilState_Call{Call: _e.mock.On("GetRouteTableUntilState", ctx, nref, config)}
+}
+
+func (_c *MockNetworkV1_GetRouteTableUntilState_Call) Run(run func(ctx context.Context, nref secapi.NetworkReference, config secapi.ResourceObserverUntilValueConfig[schema.ResourceState])) *MockNetworkV1_GetRouteTableUntilState_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(secapi.NetworkReference), args[2].(secapi.ResourceObserverUntilValueConfig[schema.ResourceState]))
+	})
+	return _c
+}
+
+func (_c *MockNetworkV1_GetRouteTableUntilState_Call) Return(_a0 *schema.RouteTable, _a1 error) *MockNetworkV1_GetRouteTableUntilState_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockNetworkV1_GetRouteTableUntilState_Call) RunAndReturn(run func(context.Context, secapi.NetworkReference, secapi.ResourceObserverUntilValueConfig[schema.ResourceState]) (*schema.RouteTable, error)) *MockNetworkV1_GetRouteTableUntilState_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetSecurityGroup provides a mock function with given fields: ctx, wref
+func (_m *MockNetworkV1) GetSecurityGroup(ctx context.Context, wref secapi.WorkspaceReference) (*schema.SecurityGroup, error) {
+	ret := _m.Called(ctx, wref)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetSecurityGroup")
+	}
+
+	var r0 *schema.SecurityGroup
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, secapi.WorkspaceReference) (*schema.SecurityGroup, error)); ok {
+		return rf(ctx, wref)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, secapi.WorkspaceReference) *schema.SecurityGroup); ok {
+		r0 = rf(ctx, wref)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*schema.SecurityGroup)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, secapi.WorkspaceReference) error); ok {
+		r1 = rf(ctx, wref)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockNetworkV1_GetSecurityGroup_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetSecurityGroup'
+type MockNetworkV1_GetSecurityGroup_Call struct {
+	*mock.Call
+}
+
+// GetSecurityGroup is a helper method to define mock.On call
+//   - ctx context.Context
+//   - wref secapi.WorkspaceReference
+func (_e *MockNetworkV1_Expecter) GetSecurityGroup(ctx interface{}, wref interface{}) *MockNetworkV1_GetSecurityGroup_Call {
+	return &MockNetworkV1_GetSecurityGroup_Call{Call: _e.mock.On("GetSecurityGroup", ctx, wref)}
+}
+
+func (_c *MockNetworkV1_GetSecurityGroup_Call) Run(run func(ctx context.Context, wref secapi.WorkspaceReference)) *MockNetworkV1_GetSecurityGroup_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(secapi.WorkspaceReference))
+	})
+	return _c
+}
+
+func (_c *MockNetworkV1_GetSecurityGroup_Call) Return(_a0 *schema.SecurityGroup, _a1 error) *MockNetworkV1_GetSecurityGroup_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockNetworkV1_GetSecurityGroup_Call) RunAndReturn(run func(context.Context, secapi.WorkspaceReference) (*schema.SecurityGroup, error)) *MockNetworkV1_GetSecurityGroup_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetSecurityGroupRule provides a mock function with given fields: ctx, wref
+func (_m *MockNetworkV1) GetSecurityGroupRule(ctx context.Context, wref secapi.WorkspaceReference) (*schema.SecurityGroupRule, error) {
+	ret := _m.Called(ctx, wref)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetSecurityGroupRule")
+	}
+
+	var r0 *schema.SecurityGroupRule
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, secapi.WorkspaceReference) (*schema.SecurityGroupRule, error)); ok {
+		return rf(ctx, wref)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, secapi.WorkspaceReference) *schema.SecurityGroupRule); ok {
+		r0 = rf(ctx, wref)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*schema.SecurityGroupRule)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, secapi.WorkspaceReference) error); ok {
+		r1 = rf(ctx, wref)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockNetworkV1_GetSecurityGroupRule_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetSecurityGroupRule'
+type MockNetworkV1_GetSecurityGroupRule_Call struct {
+	*mock.Call
+}
+
+// GetSecurityGroupRule is a helper method to define mock.On call
+//   - ctx context.Context
+//   - wref secapi.WorkspaceReference
+func (_e *MockNetworkV1_Expecter) GetSecurityGroupRule(ctx interface{}, wref interface{}) *MockNetworkV1_GetSecurityGroupRule_Call {
+	return &MockNetworkV1_GetSecurityGroupRule_Call{Call: _e.mock.On("GetSecurityGroupRule", ctx, wref)}
+}
+
+func (_c *MockNetworkV1_GetSecurityGroupRule_Call) Run(run func(ctx context.Context, wref secapi.WorkspaceReference)) *MockNetworkV1_GetSecurityGroupRule_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(secapi.WorkspaceReference))
+	})
+	return _c
+}
+
+func (_c *MockNetworkV1_GetSecurityGroupRule_Call) Return(_a0 *schema.SecurityGroupRule, _a1 error) *MockNetworkV1_GetSecurityGroupRule_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockNetworkV1_GetSecurityGroupRule_Call) RunAndReturn(run func(context.Context, secapi.WorkspaceReference) (*schema.SecurityGroupRule, error)) *MockNetworkV1_GetSecurityGroupRule_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetSecurityGroupRuleUntilState provides a mock function with given fields: ctx, wref, config
+func (_m *MockNetworkV1) GetSecurityGroupRuleUntilState(ctx context.Context, wref secapi.WorkspaceReference, config secapi.ResourceObserverUntilValueConfig[schema.ResourceState]) (*schema.SecurityGroupRule, error) {
+	ret := _m.Called(ctx, wref, config)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetSecurityGroupRuleUntilState")
+	}
+
+	var r0 *schema.SecurityGroupRule
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, secapi.WorkspaceReference, secapi.ResourceObserverUntilValueConfig[schema.ResourceState]) (*schema.SecurityGroupRule, error)); ok {
+		return rf(ctx, wref, config)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, secapi.WorkspaceReference, secapi.ResourceObserverUntilValueConfig[schema.ResourceState]) *schema.SecurityGroupRule); ok {
+		r0 = rf(ctx, wref, config)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*schema.SecurityGroupRule)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, secapi.WorkspaceReference, secapi.ResourceObserverUntilValueConfig[schema.ResourceState]) error); ok {
+		r1 = rf(ctx, wref, config)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockNetworkV1_GetSecurityGroupRuleUntilState_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetSecurityGroupRuleUntilState'
+type MockNetworkV1_GetSecurityGroupRuleUntilState_Call struct {
+	*mock.Call
+}
+
+// GetSecurityGroupRuleUntilState is a helper method to define mock.On call
+//   - ctx context.Context
+//   - wref secapi.WorkspaceReference
+//   - config secapi.ResourceObserverUntilValueConfig[schema.ResourceState]
+func (_e *MockNetworkV1_Expecter) GetSecurityGroupRuleUntilState(ctx interface{}, wref interface{}, config interface{}) *MockNetworkV1_GetSecurityGroupRuleUntilState_Call {
+	return &MockNetworkV1_GetSecurityGroupRuleUntilState_Call{Call: _e.mock.On("GetSecurityGroupRuleUntilState", ctx, wref, config)}
+}
+
+func (_c *MockNetworkV1_GetSecurityGroupRuleUntilState_Call) Run(run func(ctx context.Context, wref secapi.WorkspaceReference, config secapi.ResourceObserverUntilValueConfig[schema.ResourceState])) *MockNetworkV1_GetSecurityGroupRuleUntilState_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(secapi.WorkspaceReference), args[2].(secapi.ResourceObserverUntilValueConfig[schema.ResourceState]))
+	})
+	return _c
+}
+
+func (_c *MockNetworkV1_GetSecurityGroupRuleUntilState_Call) Return(_a0 *schema.SecurityGroupRule, _a1 error) *MockNetworkV1_GetSecurityGroupRuleUntilState_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockNetworkV1_GetSecurityGroupRuleUntilState_Call) RunAndReturn(run func(context.Context, secapi.WorkspaceReference, secapi.ResourceObserverUntilValueConfig[schema.ResourceState]) (*schema.SecurityGroupRule, error)) *MockNetworkV1_GetSecurityGroupRuleUntilState_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetSecurityGroupUntilState provides a mock function with given fields: ctx, wref, config
+func (_m *MockNetworkV1) GetSecurityGroupUntilState(ctx context.Context, wref secapi.WorkspaceReference, config secapi.ResourceObserverUntilValueConfig[schema.ResourceState]) (*schema.SecurityGroup, error) {
+	ret := _m.Called(ctx, wref, config)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetSecurityGroupUntilState")
+	}
+
+	var r0 *schema.SecurityGroup
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, secapi.WorkspaceReference, secapi.ResourceObserverUntilValueConfig[schema.ResourceState]) (*schema.SecurityGroup, error)); ok {
+		return rf(ctx, wref, config)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, secapi.WorkspaceReference, secapi.ResourceObserverUntilValueConfig[schema.ResourceState]) *schema.SecurityGroup); ok {
+		r0 = rf(ctx, wref, config)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*schema.SecurityGroup)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, secapi.WorkspaceReference, secapi.ResourceObserverUntilValueConfig[schema.ResourceState]) error); ok {
+		r1 = rf(ctx, wref, config)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockNetworkV1_GetSecurityGroupUntilState_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetSecurityGroupUntilState'
+type MockNetworkV1_GetSecurityGroupUntilState_Call struct {
+	*mock.Call
+}
+
+// GetSecurityGroupUntilState is a helper method to define mock.On call
+//   - ctx context.Context
+//   - wref secapi.WorkspaceReference
+//   - config secapi.ResourceObserverUntilValueConfig[schema.ResourceState]
+func (_e *MockNetworkV1_Expecter) GetSecurityGroupUntilState(ctx interface{}, wref interface{}, config interface{}) *MockNetworkV1_GetSecurityGroupUntilState_Call {
+	return &MockNetworkV1_GetSecurityGroupUntilState_Call{Call: _e.mock.On("GetSecurityGroupUntilState", ctx, wref, config)}
+}
+
+func (_c *MockNetworkV1_GetSecurityGroupUntilState_Call) Run(run func(ctx context.Context, wref secapi.WorkspaceReference, config secapi.ResourceObserverUntilValueConfig[schema.ResourceState])) *MockNetworkV1_GetSecurityGroupUntilState_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(secapi.WorkspaceReference), args[2].(secapi.ResourceObserverUntilValueConfig[schema.ResourceState]))
+	})
+	return _c
+}
+
+func (_c *MockNetworkV1_GetSecurityGroupUntilState_Call) Return(_a0 *schema.SecurityGroup, _a1 error) *MockNetworkV1_GetSecurityGroupUntilState_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockNetworkV1_GetSecurityGroupUntilState_Call) RunAndReturn(run func(context.Context, secapi.WorkspaceReference, secapi.ResourceObserverUntilValueConfig[schema.ResourceState]) (*schema.SecurityGroup, error)) *MockNetworkV1_GetSecurityGroupUntilState_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetSku provides a mock function with given fields: ctx, tref
+func (_m *MockNetworkV1) GetSku(ctx context.Context, tref secapi.TenantReference) (*schema.NetworkSku, error) {
+	ret := _m.Called(ctx, tref)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetSku")
+	}
+
+	var r0 *schema.NetworkSku
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, secapi.TenantReference) (*schema.NetworkSku, error)); ok {
+		return rf(ctx, tref)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, secapi.TenantReference) *schema.NetworkSku); ok {
+		r0 = rf(ctx, tref)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*schema.NetworkSku)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, secapi.TenantReference) error); ok {
+		r1 = rf(ctx, tref)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockNetworkV1_GetSku_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetSku'
+type MockNetworkV1_GetSku_Call struct {
+	*mock.Call
+}
+
+// GetSku is a helper method to define mock.On call
+//   - ctx context.Context
+//   - tref secapi.TenantReference
+func (_e *MockNetworkV1_Expecter) GetSku(ctx interface{}, tref interface{}) *MockNetworkV1_GetSku_Call {
+	return &MockNetworkV1_GetSku_Call{Call: _e.mock.On("GetSku", ctx, tref)}
+}
+
+func (_c *MockNetworkV1_GetSku_Call) Run(run func(ctx context.Context, tref secapi.TenantReference)) *MockNetworkV1_GetSku_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(secapi.TenantReference))
+	})
+	return _c
+}
+
+func (_c *MockNetworkV1_GetSku_Call) Return(_a0 *schema.NetworkSku, _a1 error) *MockNetworkV1_GetSku_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockNetworkV1_GetSku_Call) RunAndReturn(run func(context.Context, secapi.TenantReference) (*schema.NetworkSku, error)) *MockNetworkV1_GetSku_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetSubnet provides a mock function with given fields: ctx, nref
+func (_m *MockNetworkV1) GetSubnet(ctx context.Context, nref secapi.NetworkReference) (*schema.Subnet, error) {
+	ret := _m.Called(ctx, nref)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetSubnet")
+	}
+
+	var r0 *schema.Subnet
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, secapi.NetworkReference) (*schema.Subnet, error)); ok {
+		return rf(ctx, nref)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, secapi.NetworkReference) *schema.Subnet); ok {
+		r0 = rf(ctx, nref)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*schema.Subnet)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, secapi.NetworkReference) error); ok {
+		r1 = rf(ctx, nref)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockNetworkV1_GetSubnet_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetSubnet'
+type MockNetworkV1_GetSubnet_Call struct {
+	*mock.Call
+}
+
+// GetSubnet is a helper method to define mock.On call
+//   - ctx context.Context
+//   - nref secapi.NetworkReference
+func (_e *MockNetworkV1_Expecter) GetSubnet(ctx interface{}, nref interface{}) *MockNetworkV1_GetSubnet_Call {
+	return &MockNetworkV1_GetSubnet_Call{Call: _e.mock.On("GetSubnet", ctx, nref)}
+}
+
+func (_c *MockNetworkV1_GetSubnet_Call) Run(run func(ctx context.Context, nref secapi.NetworkReference)) *MockNetworkV1_GetSubnet_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(secapi.NetworkReference))
+	})
+	return _c
+}
+
+func (_c *MockNetworkV1_GetSubnet_Call) Return(_a0 *schema.Subnet, _a1 error) *MockNetworkV1_GetSubnet_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockNetworkV1_GetSubnet_Call) RunAndReturn(run func(context.Context, secapi.NetworkReference) (*schema.Subnet, error)) *MockNetworkV1_GetSubnet_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetSubnetUntilState provides a mock function with given fields: ctx, nref, config
+func (_m *MockNetworkV1) GetSubnetUntilState(ctx context.Context, nref secapi.NetworkReference, config secapi.ResourceObserverUntilValueConfig[schema.ResourceState]) (*schema.Subnet, error) {
+	ret := _m.Called(ctx, nref, config)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetSubnetUntilState")
+	}
+
+	var r0 *schema.Subnet
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, secapi.NetworkReference, secapi.ResourceObserverUntilValueConfig[schema.ResourceState]) (*schema.Subnet, error)); ok {
+		return rf(ctx, nref, config)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, secapi.NetworkReference, secapi.ResourceObserverUntilValueConfig[schema.ResourceState]) *schema.Subnet); ok {
+		r0 = rf(ctx, nref, config)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*schema.Subnet)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, secapi.NetworkReference, secapi.ResourceObserverUntilValueConfig[schema.ResourceState]) error); ok {
+		r1 = rf(ctx, nref, config)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockNetworkV1_GetSubnetUntilState_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetSubnetUntilState'
+type MockNetworkV1_GetSubnetUntilState_Call struct {
+	*mock.Call
+}
+
+// GetSubnetUntilState is a helper method to define mock.On call
+//   - ctx context.Context
+//   - nref secapi.NetworkReference
+//   - config secapi.ResourceObserverUntilValueConfig[schema.ResourceState]
+func (_e *MockNetworkV1_Expecter) GetSubnetUntilState(ctx interface{}, nref interface{}, config interface{}) *MockNetworkV1_GetSubnetUntilState_Call {
+	return &MockNetworkV1_GetSubnetUntilState_Call{Call: _e.mock.On("GetSubnetUntilState", ctx, nref, config)}
+}
+
+func (_c *MockNetworkV1_GetSubnetUntilState_Call) Run(run func(ctx context.Context, nref secapi.NetworkReference, config secapi.ResourceObserverUntilValueConfig[schema.ResourceState])) *MockNetworkV1_GetSubnetUntilState_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(secapi.NetworkReference), args[2].(secapi.ResourceObserverUntilValueConfig[schema.ResourceState]))
+	})
+	return _c
+}
+
+func (_c *MockNetworkV1_GetSubnetUntilState_Call) Return(_a0 *schema.Subnet, _a1 error) *MockNetworkV1_GetSubnetUntilState_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockNetworkV1_GetSubnetUntilState_Call) RunAndReturn(run func(context.Context, secapi.NetworkReference, secapi.ResourceObserverUntilValueConfig[schema.ResourceState]) (*schema.Subnet, error)) *MockNetworkV1_GetSubnetUntilState_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ListInternetGateways provides a mock function with given fields: ctx, wpath
+func (_m *MockNetworkV1) ListInternetGateways(ctx context.Context, wpath secapi.WorkspacePath) (*secapi.Iterator[schema.InternetGateway], error) {
+	ret := _m.Called(ctx, wpath)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListInternetGateways")
+	}
+
+	var r0 *secapi.Iterator[schema.InternetGateway]
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, secapi.WorkspacePath) (*secapi.Iterator[schema.InternetGateway], error)); ok {
+		return rf(ctx, wpath)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, secapi.WorkspacePath) *secapi.Iterator[schema.InternetGateway]); ok {
+		r0 = rf(ctx, wpath)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*secapi.Iterator[schema.InternetGateway])
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, secapi.WorkspacePath) error); ok {
+		r1 = rf(ctx, wpath)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockNetworkV1_ListInternetGateways_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListInternetGateways'
+type MockNetworkV1_ListInternetGateways_Call struct {
+	*mock.Call
+}
+
+// ListInternetGateways is a helper method to define mock.On call
+//   - ctx context.Context
+//   - wpath secapi.WorkspacePath
+func (_e *MockNetworkV1_Expecter) ListInternetGateways(ctx interface{}, wpath interface{}) *MockNetworkV1_ListInternetGateways_Call {
+	return &MockNetworkV1_ListInternetGateways_Call{Call: _e.mock.On("ListInternetGateways", ctx, wpath)}
+}
+
+func (_c *MockNetworkV1_ListInternetGateways_Call) Run(run func(ctx context.Context, wpath secapi.WorkspacePath)) *MockNetworkV1_ListInternetGateways_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(secapi.WorkspacePath))
+	})
+	return _c
+}
+
+func (_c *MockNetworkV1_ListInternetGateways_Call) Return(_a0 *secapi.Iterator[schema.InternetGateway], _a1 error) *MockNetworkV1_ListInternetGateways_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockNetworkV1_ListInternetGateways_Call) RunAndReturn(run func(context.Context, secapi.WorkspacePath) (*secapi.Iterator[schema.InternetGateway], error)) *MockNetworkV1_ListInternetGateways_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ListInternetGatewaysWithOptions provides a mock function with given fields: ctx, wpath, options
+func (_m *MockNetworkV1) ListInternetGatewaysWithOptions(ctx context.Context, wpath secapi.WorkspacePath, options *secapi.ListOptions) (*secapi.Iterator[schema.InternetGateway], error) {
+	ret := _m.Called(ctx, wpath, options)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListInternetGatewaysWithOptions")
+	}
+
+	var r0 *secapi.Iterator[schema.InternetGateway]
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, secapi.WorkspacePath, *secapi.ListOptions) (*secapi.Iterator[schema.InternetGateway], error)); ok {
+		return rf(ctx, wpath, options)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, secapi.WorkspacePath, *secapi.ListOptions) *secapi.Iterator[schema.InternetGateway]); ok {
+		r0 = rf(ctx, wpath, options)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*secapi.Iterator[schema.InternetGateway])
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, secapi.WorkspacePath, *secapi.ListOptions) error); ok {
+		r1 = rf(ctx, wpath, options)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockNetworkV1_ListInternetGatewaysWithOptions_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListInternetGatewaysWithOptions'
+type MockNetworkV1_ListInternetGatewaysWithOptions_Call struct {
+	*mock.Call
+}
+
+// ListInternetGatewaysWithOptions is a helper method to define mock.On call
+//   - ctx context.Context
+//   - wpath secapi.WorkspacePath
+//   - options *secapi.ListOptions
+func (_e *MockNetworkV1_Expecter) ListInternetGatewaysWithOptions(ctx interface{}, wpath interface{}, options interface{}) *MockNetworkV1_ListInternetGatewaysWithOptions_Call {
+	return &MockNetworkV1_ListInternetGatewaysWithOptions_Call{Call: _e.mock.On("ListInternetGatewaysWithOptions", ctx, wpath, options)}
+}
+
+func (_c *MockNetworkV1_ListInternetGatewaysWithOptions_Call) Run(run func(ctx context.Context, wpath secapi.WorkspacePath, options *secapi.ListOptions)) *MockNetworkV1_ListInternetGatewaysWithOptions_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(secapi.WorkspacePath), args[2].(*secapi.ListOptions))
+	})
+	return _c
+}
+
+func (_c *MockNetworkV1_ListInternetGatewaysWithOptions_Call) Return(_a0 *secapi.Iterator[schema.InternetGateway], _a1 error) *MockNetworkV1_ListInternetGatewaysWithOptions_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockNetworkV1_ListInternetGatewaysWithOptions_Call) RunAndReturn(run func(context.Context, secapi.WorkspacePath, *secapi.ListOptions) (*secapi.Iterator[schema.InternetGateway], error)) *MockNetworkV1_ListInternetGatewaysWithOptions_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ListNetworks provides a mock function with given fields: ctx, wpath
+func (_m *MockNetworkV1) ListNetworks(ctx context.Context, wpath secapi.WorkspacePath) (*secapi.Iterator[schema.Network], error) {
+	ret := _m.Called(ctx, wpath)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListNetworks")
+	}
+
+	var r0 *secapi.Iterator[schema.Network]
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, secapi.WorkspacePath) (*secapi.Iterator[schema.Network], error)); ok {
+		return rf(ctx, wpath)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, secapi.WorkspacePath) *secapi.Iterator[schema.Network]); ok {
+		r0 = rf(ctx, wpath)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*secapi.Iterator[schema.Network])
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, secapi.WorkspacePath) error); ok {
+		r1 = rf(ctx, wpath)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockNetworkV1_ListNetworks_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListNetworks'
+type MockNetworkV1_ListNetworks_Call struct {
+	*mock.Call
+}
+
+// ListNetworks is a helper method to define mock.On call
+//   - ctx context.Context
+//   - wpath secapi.WorkspacePath
+func (_e *MockNetworkV1_Expecter) ListNetworks(ctx interface{}, wpath interface{}) *MockNetworkV1_ListNetworks_Call {
+	return &MockNetworkV1_ListNetworks_Call{Call: _e.mock.On("ListNetworks", ctx, wpath)}
+}
+
+func (_c *MockNetworkV1_ListNetworks_Call) Run(run func(ctx context.Context, wpath secapi.WorkspacePath)) *MockNetworkV1_ListNetworks_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(secapi.WorkspacePath))
+	})
+	return _c
+}
+
+func (_c *MockNetworkV1_ListNetworks_Call) Return(_a0 *secapi.Iterator[schema.Network], _a1 error) *MockNetworkV1_ListNetworks_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockNetworkV1_ListNetworks_Call) RunAndReturn(run func(context.Context, secapi.WorkspacePath) (*secapi.Iterator[schema.Network], error)) *MockNetworkV1_ListNetworks_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ListNetworksWithOptions provides a mock function with given fields: ctx, wpath, options
+func (_m *MockNetworkV1) ListNetworksWithOptions(ctx context.Context, wpath secapi.WorkspacePath, options *secapi.ListOptions) (*secapi.Iterator[schema.Network], error) {
+	ret := _m.Called(ctx, wpath, options)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListNetworksWithOptions")
+	}
+
+	var r0 *secapi.Iterator[schema.Network]
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, secapi.WorkspacePath, *secapi.ListOptions) (*secapi.Iterator[schema.Network], error)); ok {
+		return rf(ctx, wpath, options)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, secapi.WorkspacePath, *secapi.ListOptions) *secapi.Iterator[schema.Network]); ok {
+		r0 = rf(ctx, wpath, options)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*secapi.Iterator[schema.Network])
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, secapi.WorkspacePath, *secapi.ListOptions) error); ok {
+		r1 = rf(ctx, wpath, options)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockNetworkV1_ListNetworksWithOptions_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListNetworksWithOptions'
+type MockNetworkV1_ListNetworksWithOptions_Call struct {
+	*mock.Call
+}
+
+// ListNetworksWithOptions is a helper method to define mock.On call
+//   - ctx context.Context
+//   - wpath secapi.WorkspacePath
+//   - options *secapi.ListOptions
+func (_e *MockNetworkV1_Expecter) ListNetworksWithOptions(ctx interface{}, wpath interface{}, options interface{}) *MockNetworkV1_ListNetworksWithOptions_Call {
+	return &MockNetworkV1_ListNetworksWithOptions_Call{Call: _e.mock.On("ListNetworksWithOptions", ctx, wpath, options)}
+}
+
+func (_c *MockNetworkV1_ListNetworksWithOptions_Call) Run(run func(ctx context.Context, wpath secapi.WorkspacePath, options *secapi.ListOptions)) *MockNetworkV1_ListNetworksWithOptions_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(secapi.WorkspacePath), args[2].(*secapi.ListOptions))
+	})
+	return _c
+}
+
+func (_c *MockNetworkV1_ListNetworksWithOptions_Call) Return(_a0 *secapi.Iterator[schema.Network], _a1 error) *MockNetworkV1_ListNetworksWithOptions_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockNetworkV1_ListNetworksWithOptions_Call) RunAndReturn(run func(context.Context, secapi.WorkspacePath, *secapi.ListOptions) (*secapi.Iterator[schema.Network], error)) *MockNetworkV1_ListNetworksWithOptions_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ListNics provides a mock function with given fields: ctx, wpath
+func (_m *MockNetworkV1) ListNics(ctx context.Context, wpath secapi.WorkspacePath) (*secapi.Iterator[schema.Nic], error) {
+	ret := _m.Called(ctx, wpath)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListNics")
+	}
+
+	var r0 *secapi.Iterator[schema.Nic]
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, secapi.WorkspacePath) (*secapi.Iterator[schema.Nic], error)); ok {
+		return rf(ctx, wpath)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, secapi.WorkspacePath) *secapi.Iterator[schema.Nic]); ok {
+		r0 = rf(ctx, wpath)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*secapi.Iterator[schema.Nic])
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, secapi.WorkspacePath) error); ok {
+		r1 = rf(ctx, wpath)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockNetworkV1_ListNics_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListNics'
+type MockNetworkV1_ListNics_Call struct {
+	*mock.Call
+}
+
+// ListNics is a helper method to define mock.On call
+//   - ctx context.Context
+//   - wpath secapi.WorkspacePath
+func (_e *MockNetworkV1_Expecter) ListNics(ctx interface{}, wpath interface{}) *MockNetworkV1_ListNics_Call {
+	return &MockNetworkV1_ListNics_Call{Call: _e.mock.On("ListNics", ctx, wpath)}
+}
+
+func (_c *MockNetworkV1_ListNics_Call) Run(run func(ctx context.Context, wpath secapi.WorkspacePath)) *MockNetworkV1_ListNics_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(secapi.WorkspacePath))
+	})
+	return _c
+}
+
+func (_c *MockNetworkV1_ListNics_Call) Return(_a0 *secapi.Iterator[schema.Nic], _a1 error) *MockNetworkV1_ListNics_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockNetworkV1_ListNics_Call) RunAndReturn(run func(context.Context, secapi.WorkspacePath) (*secapi.Iterator[schema.Nic], error)) *MockNetworkV1_ListNics_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ListNicsWithOptions provides a mock function with given fields: ctx, wpath, options
+func (_m *MockNetworkV1) ListNicsWithOptions(ctx context.Context, wpath secapi.WorkspacePath, options *secapi.ListOptions) (*secapi.Iterator[schema.Nic], error) {
+	ret := _m.Called(ctx, wpath, options)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListNicsWithOptions")
+	}
+
+	var r0 *secapi.Iterator[schema.Nic]
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, secapi.WorkspacePath, *secapi.ListOptions) (*secapi.Iterator[schema.Nic], error)); ok {
+		return rf(ctx, wpath, options)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, secapi.WorkspacePath, *secapi.ListOptions) *secapi.Iterator[schema.Nic]); ok {
+		r0 = rf(ctx, wpath, options)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*secapi.Iterator[schema.Nic])
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, secapi.WorkspacePath, *secapi.ListOptions) error); ok {
+		r1 = rf(ctx, wpath, options)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockNetworkV1_ListNicsWithOptions_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListNicsWithOptions'
+type MockNetworkV1_ListNicsWithOptions_Call struct {
+	*mock.Call
+}
+
+// ListNicsWithOptions is a helper method to define mock.On call
+//   - ctx context.Context
+//   - wpath secapi.WorkspacePath
+//   - options *secapi.ListOptions
+func (_e *MockNetworkV1_Expecter) ListNicsWithOptions(ctx interface{}, wpath interface{}, options interface{}) *MockNetworkV1_ListNicsWithOptions_Call {
+	return &MockNetworkV1_ListNicsWithOptions_Call{Call: _e.mock.On("ListNicsWithOptions", ctx, wpath, options)}
+}
+
+func (_c *MockNetworkV1_ListNicsWithOptions_Call) Run(run func(ctx context.Context, wpath secapi.WorkspacePath, options *secapi.ListOptions)) *MockNetworkV1_ListNicsWithOptions_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(secapi.WorkspacePath), args[2].(*secapi.ListOptions))
+	})
+	return _c
+}
+
+func (_c *MockNetworkV1_ListNicsWithOptions_Call) Return(_a0 *secapi.Iterator[schema.Nic], _a1 error) *MockNetworkV1_ListNicsWithOptions_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockNetworkV1_ListNicsWithOptions_Call) RunAndReturn(run func(context.Context, secapi.WorkspacePath, *secapi.ListOptions) (*secapi.Iterator[schema.Nic], error)) *MockNetworkV1_ListNicsWithOptions_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ListPublicIps provides a mock function with given fields: ctx, wpath
+func (_m *MockNetworkV1) ListPublicIps(ctx context.Context, wpath secapi.WorkspacePath) (*secapi.Iterator[schema.PublicIp], error) {
+	ret := _m.Called(ctx, wpath)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListPublicIps")
+	}
+
+	var r0 *secapi.Iterator[schema.PublicIp]
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, secapi.WorkspacePath) (*secapi.Iterator[schema.PublicIp], error)); ok {
+		return rf(ctx, wpath)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, secapi.WorkspacePath) *secapi.Iterator[schema.PublicIp]); ok {
+		r0 = rf(ctx, wpath)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*secapi.Iterator[schema.PublicIp])
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, secapi.WorkspacePath) error); ok {
+		r1 = rf(ctx, wpath)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockNetworkV1_ListPublicIps_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListPublicIps'
+type MockNetworkV1_ListPublicIps_Call struct {
+	*mock.Call
+}
+
+// ListPublicIps is a helper method to define mock.On call
+//   - ctx context.Context
+//   - wpath secapi.WorkspacePath
+func (_e *MockNetworkV1_Expecter) ListPublicIps(ctx interface{}, wpath interface{}) *MockNetworkV1_ListPublicIps_Call {
+	return &MockNetworkV1_ListPublicIps_Call{Call: _e.mock.On("ListPublicIps", ctx, wpath)}
+}
+
+func (_c *MockNetworkV1_ListPublicIps_Call) Run(run func(ctx context.Context, wpath secapi.WorkspacePath)) *MockNetworkV1_ListPublicIps_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(secapi.WorkspacePath))
+	})
+	return _c
+}
+
+func (_c *MockNetworkV1_ListPublicIps_Call) Return(_a0 *secapi.Iterator[schema.PublicIp], _a1 error) *MockNetworkV1_ListPublicIps_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockNetworkV1_ListPublicIps_Call) RunAndReturn(run func(context.Context, secapi.WorkspacePath) (*secapi.Iterator[schema.PublicIp], error)) *MockNetworkV1_ListPublicIps_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ListPublicIpsWithOptions provides a mock function with given fields: ctx, wpath, options
+func (_m *MockNetworkV1) ListPublicIpsWithOptions(ctx context.Context, wpath secapi.WorkspacePath, options *secapi.ListOptions) (*secapi.Iterator[schema.PublicIp], error) {
+	ret := _m.Called(ctx, wpath, options)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListPublicIpsWithOptions")
+	}
+
+	var r0 *secapi.Iterator[schema.PublicIp]
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, secapi.WorkspacePath, *secapi.ListOptions) (*secapi.Iterator[schema.PublicIp], error)); ok {
+		return rf(ctx, wpath, options)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, secapi.WorkspacePath, *secapi.ListOptions) *secapi.Iterator[schema.PublicIp]); ok {
+		r0 = rf(ctx, wpath, options)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*secapi.Iterator[schema.PublicIp])
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, secapi.WorkspacePath, *secapi.ListOptions) error); ok {
+		r1 = rf(ctx, wpath, options)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockNetworkV1_ListPublicIpsWithOptions_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListPublicIpsWithOptions'
+type MockNetworkV1_ListPublicIpsWithOptions_Call struct {
+	*mock.Call
+}
+
+// ListPublicIpsWithOptions is a helper method to define mock.On call
+//   - ctx context.Context
+//   - wpath secapi.WorkspacePath
+//   - options *secapi.ListOptions
+func (_e *MockNetworkV1_Expecter) ListPublicIpsWithOptions(ctx interface{}, wpath interface{}, options interface{}) *MockNetworkV1_ListPublicIpsWithOptions_Call {
+	return &MockNetworkV1_ListPublicIpsWithOptions_Call{Call: _e.mock.On("ListPublicIpsWithOptions", ctx, wpath, options)}
+}
+
+func (_c *MockNetworkV1_ListPublicIpsWithOptions_Call) Run(run func(ctx context.Context, wpath secapi.WorkspacePath, options *secapi.ListOptions)) *MockNetworkV1_ListPublicIpsWithOptions_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(secapi.WorkspacePath), args[2].(*secapi.ListOptions))
+	})
+	return _c
+}
+
+func (_c *MockNetworkV1_ListPublicIpsWithOptions_Call) Return(_a0 *secapi.Iterator[schema.PublicIp], _a1 error) *MockNetworkV1_ListPublicIpsWithOptions_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockNetworkV1_ListPublicIpsWithOptions_Call) RunAndReturn(run func(context.Context, secapi.WorkspacePath, *secapi.ListOptions) (*secapi.Iterator[schema.PublicIp], error)) *MockNetworkV1_ListPublicIpsWithOptions_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ListRouteTables provides a mock function with given fields: ctx, npath
+func (_m *MockNetworkV1) ListRouteTables(ctx context.Context, npath secapi.NetworkPath) (*secapi.Iterator[schema.RouteTable], error) {
+	ret := _m.Called(ctx, npath)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListRouteTables")
+	}
+
+	var r0 *secapi.Iterator[schema.RouteTable]
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, secapi.NetworkPath) (*secapi.Iterator[schema.RouteTable], error)); ok {
+		return rf(ctx, npath)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, secapi.NetworkPath) *secapi.Iterator[schema.RouteTable]); ok {
+		r0 = rf(ctx, npath)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*secapi.Iterator[schema.RouteTable])
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, secapi.NetworkPath) error); ok {
+		r1 = rf(ctx, npath)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockNetworkV1_ListRouteTables_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListRouteTables'
+type MockNetworkV1_ListRouteTables_Call struct {
+	*mock.Call
+}
+
+// ListRouteTables is a helper method to define mock.On call
+//   - ctx context.Context
+//   - npath secapi.NetworkPath
+func (_e *MockNetworkV1_Expecter) ListRouteTables(ctx interface{}, npath interface{}) *MockNetworkV1_ListRouteTables_Call {
+	return &MockNetworkV1_ListRouteTables_Call{Call: _e.mock.On("ListRouteTables", ctx, npath)}
+}
+
+func (_c *MockNetworkV1_ListRouteTables_Call) Run(run func(ctx context.Context, npath secapi.NetworkPath)) *MockNetworkV1_ListRouteTables_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(secapi.NetworkPath))
+	})
+	return _c
+}
+
+func (_c *MockNetworkV1_ListRouteTables_Call) Return(_a0 *secapi.Iterator[schema.RouteTable], _a1 error) *MockNetworkV1_ListRouteTables_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockNetworkV1_ListRouteTables_Call) RunAndReturn(run func(context.Context, secapi.NetworkPath) (*secapi.Iterator[schema.RouteTable], error)) *MockNetworkV1_ListRouteTables_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ListRouteTablesWithOptions provides a mock function with given fields: ctx, npath, options
+func (_m *MockNetworkV1) ListRouteTablesWithOptions(ctx context.Context, npath secapi.NetworkPath, options *secapi.ListOptions) (*secapi.Iterator[schema.RouteTable], error) {
+	ret := _m.Called(ctx, npath, options)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListRouteTablesWithOptions")
+	}
+
+	var r0 *secapi.Iterator[schema.RouteTable]
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, secapi.NetworkPath, *secapi.ListOptions) (*secapi.Iterator[schema.RouteTable], error)); ok {
+		return rf(ctx, npath, options)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, secapi.NetworkPath, *secapi.ListOptions) *secapi.Iterator[schema.RouteTable]); ok {
+		r0 = rf(ctx, npath, options)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*secapi.Iterator[schema.RouteTable])
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, secapi.NetworkPath, *secapi.ListOptions) error); ok {
+		r1 = rf(ctx, npath, options)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockNetworkV1_ListRouteTablesWithOptions_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListRouteTablesWithOptions'
+type MockNetworkV1_ListRouteTablesWithOptions_Call struct {
+	*mock.Call
+}
+
+// ListRouteTablesWithOptions is a helper method to define mock.On call
+//   - ctx context.Context
+//   - npath secapi.NetworkPath
+//   - options *secapi.ListOptions
+func (_e *MockNetworkV1_Expecter) ListRouteTablesWithOptions(ctx interface{}, npath interface{}, options interface{}) *MockNetworkV1_ListRouteTablesWithOptions_Call {
+	return &MockNetworkV1_ListRouteTablesWithOptions_Call{Call: _e.mock.On("ListRouteTablesWithOptions", ctx, npath, options)}
+}
+
+func (_c *MockNetworkV1_ListRouteTablesWithOptions_Call) Run(run func(ctx context.Context, npath secapi.NetworkPath, options *secapi.ListOptions)) *MockNetworkV1_ListRouteTablesWithOptions_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(secapi.NetworkPath), args[2].(*secapi.ListOptions))
+	})
+	return _c
+}
+
+func (_c *MockNetworkV1_ListRouteTablesWithOptions_Call) Return(_a0 *secapi.Iterator[schema.RouteTable], _a1 error) *MockNetworkV1_ListRouteTablesWithOptions_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockNetworkV1_ListRouteTablesWithOptions_Call) RunAndReturn(run func(context.Context, secapi.NetworkPath, *secapi.ListOptions) (*secapi.Iterator[schema.RouteTable], error)) *MockNetworkV1_ListRouteTablesWithOptions_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ListSecurityGroupRules provides a mock function with given fields: ctx, wpath
+func (_m *MockNetworkV1) ListSecurityGroupRules(ctx context.Context, wpath secapi.WorkspacePath) (*secapi.Iterator[schema.SecurityGroupRule], error) {
+	ret := _m.Called(ctx, wpath)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListSecurityGroupRules")
+	}
+
+	var r0 *secapi.Iterator[schema.SecurityGroupRule]
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, secapi.WorkspacePath) (*secapi.Iterator[schema.SecurityGroupRule], error)); ok {
+		return rf(ctx, wpath)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, secapi.WorkspacePath) *secapi.Iterator[schema.SecurityGroupRule]); ok {
+		r0 = rf(ctx, wpath)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*secapi.Iterator[schema.SecurityGroupRule])
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, secapi.WorkspacePath) error); ok {
+		r1 = rf(ctx, wpath)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockNetworkV1_ListSecurityGroupRules_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListSecurityGroupRules'
+type MockNetworkV1_ListSecurityGroupRules_Call struct {
+	*mock.Call
+}
+
+// ListSecurityGroupRules is a helper method to define mock.On call
+//   - ctx context.Context
+//   - wpath secapi.WorkspacePath
+func (_e *MockNetworkV1_Expecter) ListSecurityGroupRules(ctx interface{}, wpath interface{}) *MockNetworkV1_ListSecurityGroupRules_Call {
+	return &MockNetworkV1_ListSecurityGroupRules_Call{Call: _e.mock.On("ListSecurityGroupRules", ctx, wpath)}
+}
+
+func (_c *MockNetworkV1_ListSecurityGroupRules_Call) Run(run func(ctx context.Context, wpath secapi.WorkspacePath)) *MockNetworkV1_ListSecurityGroupRules_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(secapi.WorkspacePath))
+	})
+	return _c
+}
+
+func (_c *MockNetworkV1_ListSecurityGroupRules_Call) Return(_a0 *secapi.Iterator[schema.SecurityGroupRule], _a1 error) *MockNetworkV1_ListSecurityGroupRules_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockNetworkV1_ListSecurityGroupRules_Call) RunAndReturn(run func(context.Context, secapi.WorkspacePath) (*secapi.Iterator[schema.SecurityGroupRule], error)) *MockNetworkV1_ListSecurityGroupRules_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ListSecurityGroupRulesWithOptions provides a mock function with given fields: ctx, wpath, options
+func (_m *MockNetworkV1) ListSecurityGroupRulesWithOptions(ctx context.Context, wpath secapi.WorkspacePath, options *secapi.ListOptions) (*secapi.Iterator[schema.SecurityGroupRule], error) {
+	ret := _m.Called(ctx, wpath, options)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListSecurityGroupRulesWithOptions")
+	}
+
+	var r0 *secapi.Iterator[schema.SecurityGroupRule]
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, secapi.WorkspacePath, *secapi.ListOptions) (*secapi.Iterator[schema.SecurityGroupRule], error)); ok {
+		return rf(ctx, wpath, options)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, secapi.WorkspacePath, *secapi.ListOptions) *secapi.Iterator[schema.SecurityGroupRule]); ok {
+		r0 = rf(ctx, wpath, options)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*secapi.Iterator[schema.SecurityGroupRule])
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, secapi.WorkspacePath, *secapi.ListOptions) error); ok {
+		r1 = rf(ctx, wpath, options)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockNetworkV1_ListSecurityGroupRulesWithOptions_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListSecurityGroupRulesWithOptions'
+type MockNetworkV1_ListSecurityGroupRulesWithOptions_Call struct {
+	*mock.Call
+}
+
+// ListSecurityGroupRulesWithOptions is a helper method to define mock.On call
+//   - ctx context.Context
+//   - wpath secapi.WorkspacePath
+//   - options *secapi.ListOptions
+func (_e *MockNetworkV1_Expecter) ListSecurityGroupRulesWithOptions(ctx interface{}, wpath interface{}, options interface{}) *MockNetworkV1_ListSecurityGroupRulesWithOptions_Call {
+	return &MockNetworkV1_ListSecurityGroupRulesWithOptions_Call{Call: _e.mock.On("ListSecurityGroupRulesWithOptions", ctx, wpath, options)}
+}
+
+func (_c *MockNetworkV1_ListSecurityGroupRulesWithOptions_Call) Run(run func(ctx context.Context, wpath secapi.WorkspacePath, options *secapi.ListOptions)) *MockNetworkV1_ListSecurityGroupRulesWithOptions_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(secapi.WorkspacePath), args[2].(*secapi.ListOptions))
+	})
+	return _c
+}
+
+func (_c *MockNetworkV1_ListSecurityGroupRulesWithOptions_Call) Return(_a0 *secapi.Iterator[schema.SecurityGroupRule], _a1 error) *MockNetworkV1_ListSecurityGroupRulesWithOptions_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockNetworkV1_ListSecurityGroupRulesWithOptions_Call) RunAndReturn(run func(context.Context, secapi.WorkspacePath, *secapi.ListOptions) (*secapi.Iterator[schema.SecurityGroupRule], error)) *MockNetworkV1_ListSecurityGroupRulesWithOptions_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ListSecurityGroups provides a mock function with given fields: ctx, wpath
+func (_m *MockNetworkV1) ListSecurityGroups(ctx context.Context, wpath secapi.WorkspacePath) (*secapi.Iterator[schema.SecurityGroup], error) {
+	ret := _m.Called(ctx, wpath)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListSecurityGroups")
+	}
+
+	var r0 *secapi.Iterator[schema.SecurityGroup]
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, secapi.WorkspacePath) (*secapi.Iterator[schema.SecurityGroup], error)); ok {
+		return rf(ctx, wpath)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, secapi.WorkspacePath) *secapi.Iterator[schema.SecurityGroup]); ok {
+		r0 = rf(ctx, wpath)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*secapi.Iterator[schema.SecurityGroup])
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, secapi.WorkspacePath) error); ok {
+		r1 = rf(ctx, wpath)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockNetworkV1_ListSecurityGroups_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListSecurityGroups'
+type MockNetworkV1_ListSecurityGroups_Call struct {
+	*mock.Call
+}
+
+// ListSecurityGroups is a helper method to define mock.On call
+//   - ctx context.Context
+//   - wpath secapi.WorkspacePath
+func (_e *MockNetworkV1_Expecter) ListSecurityGroups(ctx interface{}, wpath interface{}) *MockNetworkV1_ListSecurityGroups_Call {
+	return &MockNetworkV1_ListSecurityGroups_Call{Call: _e.mock.On("ListSecurityGroups", ctx, wpath)}
+}
+
+func (_c *MockNetworkV1_ListSecurityGroups_Call) Run(run func(ctx context.Context, wpath secapi.WorkspacePath)) *MockNetworkV1_ListSecurityGroups_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(secapi.WorkspacePath))
+	})
+	return _c
+}
+
+func (_c *MockNetworkV1_ListSecurityGroups_Call) Return(_a0 *secapi.Iterator[schema.SecurityGroup], _a1 error) *MockNetworkV1_ListSecurityGroups_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockNetworkV1_ListSecurityGroups_Call) RunAndReturn(run func(context.Context, secapi.WorkspacePath) (*secapi.Iterator[schema.SecurityGroup], error)) *MockNetworkV1_ListSecurityGroups_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ListSecurityGroupsWithOptions provides a mock function with given fields: ctx, wpath, options
+func (_m *MockNetworkV1) ListSecurityGroupsWithOptions(ctx context.Context, wpath secapi.WorkspacePath, options *secapi.ListOptions) (*secapi.Iterator[schema.SecurityGroup], error) {
+	ret := _m.Called(ctx, wpath, options)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListSecurityGroupsWithOptions")
+	}
+
+	var r0 *secapi.Iterator[schema.SecurityGroup]
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, secapi.WorkspacePath, *secapi.ListOptions) (*secapi.Iterator[schema.SecurityGroup], error)); ok {
+		return rf(ctx, wpath, options)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, secapi.WorkspacePath, *secapi.ListOptions) *secapi.Iterator[schema.SecurityGroup]); ok {
+		r0 = rf(ctx, wpath, options)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*secapi.Iterator[schema.SecurityGroup])
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, secapi.WorkspacePath, *secapi.ListOptions) error); ok {
+		r1 = rf(ctx, wpath, options)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockNetworkV1_ListSecurityGroupsWithOptions_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListSecurityGroupsWithOptions'
+type MockNetworkV1_ListSecurityGroupsWithOptions_Call struct {
+	*mock.Call
+}
+
+// ListSecurityGroupsWithOptions is a helper method to define mock.On call
+//   - ctx context.Context
+//   - wpath secapi.WorkspacePath
+//   - options *secapi.ListOptions
+func (_e *MockNetworkV1_Expecter) ListSecurityGroupsWithOptions(ctx interface{}, wpath interface{}, options interface{}) *MockNetworkV1_ListSecurityGroupsWithOptions_Call {
+	return &MockNetworkV1_ListSecurityGroupsWithOptions_Call{Call: _e.mock.On("ListSecurityGroupsWithOptions", ctx, wpath, options)}
+}
+
+func (_c *MockNetworkV1_ListSecurityGroupsWithOptions_Call) Run(run func(ctx context.Context, wpath secapi.WorkspacePath, options *secapi.ListOptions)) *MockNetworkV1_ListSecurityGroupsWithOptions_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(secapi.WorkspacePath), args[2].(*secapi.ListOptions))
+	})
+	return _c
+}
+
+func (_c *MockNetworkV1_ListSecurityGroupsWithOptions_Call) Return(_a0 *secapi.Iterator[schema.SecurityGroup], _a1 error) *MockNetworkV1_ListSecurityGroupsWithOptions_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockNetworkV1_ListSecurityGroupsWithOptions_Call) RunAndReturn(run func(context.Context, secapi.WorkspacePath, *secapi.ListOptions) (*secapi.Iterator[schema.SecurityGroup], error)) *MockNetworkV1_ListSecurityGroupsWithOptions_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ListSkus provides a mock function with given fields: ctx, tpath
+func (_m *MockNetworkV1) ListSkus(ctx context.Context, tpath secapi.TenantPath) (*secapi.Iterator[schema.NetworkSku], error) {
+	ret := _m.Called(ctx, tpath)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListSkus")
+	}
+
+	var r0 *secapi.Iterator[schema.NetworkSku]
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, secapi.TenantPath) (*secapi.Iterator[schema.NetworkSku], error)); ok {
+		return rf(ctx, tpath)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, secapi.TenantPath) *secapi.Iterator[schema.NetworkSku]); ok {
+		r0 = rf(ctx, tpath)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*secapi.Iterator[schema.NetworkSku])
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, secapi.TenantPath) error); ok {
+		r1 = rf(ctx, tpath)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockNetworkV1_ListSkus_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListSkus'
+type MockNetworkV1_ListSkus_Call struct {
+	*mock.Call
+}
+
+// ListSkus is a helper method to define mock.On call
+//   - ctx context.Context
+//   - tpath secapi.TenantPath
+func (_e *MockNetworkV1_Expecter) ListSkus(ctx interface{}, tpath interface{}) *MockNetworkV1_ListSkus_Call {
+	return &MockNetworkV1_ListSkus_Call{Call: _e.mock.On("ListSkus", ctx, tpath)}
+}
+
+func (_c *MockNetworkV1_ListSkus_Call) Run(run func(ctx context.Context, tpath secapi.TenantPath)) *MockNetworkV1_ListSkus_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(secapi.TenantPath))
+	})
+	return _c
+}
+
+func (_c *MockNetworkV1_ListSkus_Call) Return(_a0 *secapi.Iterator[schema.NetworkSku], _a1 error) *MockNetworkV1_ListSkus_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockNetworkV1_ListSkus_Call) RunAndReturn(run func(context.Context, secapi.TenantPath) (*secapi.Iterator[schema.NetworkSku], error)) *MockNetworkV1_ListSkus_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ListSkusWithOptions provides a mock function with given fields: ctx, tpath, options
+func (_m *MockNetworkV1) ListSkusWithOptions(ctx context.Context, tpath secapi.TenantPath, options *secapi.ListOptions) (*secapi.Iterator[schema.NetworkSku], error) {
+	ret := _m.Called(ctx, tpath, options)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListSkusWithOptions")
+	}
+
+	var r0 *secapi.Iterator[schema.NetworkSku]
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, secapi.TenantPath, *secapi.ListOptions) (*secapi.Iterator[schema.NetworkSku], error)); ok {
+		return rf(ctx, tpath, options)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, secapi.TenantPath, *secapi.ListOptions) *secapi.Iterator[schema.NetworkSku]); ok {
+		r0 = rf(ctx, tpath, options)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*secapi.Iterator[schema.NetworkSku])
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, secapi.TenantPath, *secapi.ListOptions) error); ok {
+		r1 = rf(ctx, tpath, options)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockNetworkV1_ListSkusWithOptions_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListSkusWithOptions'
+type MockNetworkV1_ListSkusWithOptions_Call struct {
+	*mock.Call
+}
+
+// ListSkusWithOptions is a helper method to define mock.On call
+//   - ctx context.Context
+//   - tpath secapi.TenantPath
+//   - options *secapi.ListOptions
+func (_e *MockNetworkV1_Expecter) ListSkusWithOptions(ctx interface{}, tpath interface{}, options interface{}) *MockNetworkV1_ListSkusWithOptions_Call {
+	return &MockNetworkV1_ListSkusWithOptions_Call{Call: _e.mock.On("ListSkusWithOptions", ctx, tpath, options)}
+}
+
+func (_c *MockNetworkV1_ListSkusWithOptions_Call) Run(run func(ctx context.Context, tpath secapi.TenantPath, options *secapi.ListOptions)) *MockNetworkV1_ListSkusWithOptions_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(secapi.TenantPath), args[2].(*secapi.ListOptions))
+	})
+	return _c
+}
+
+func (_c *MockNetworkV1_ListSkusWithOptions_Call) Return(_a0 *secapi.Iterator[schema.NetworkSku], _a1 error) *MockNetworkV1_ListSkusWithOptions_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockNetworkV1_ListSkusWithOptions_Call) RunAndReturn(run func(context.Context, secapi.TenantPath, *secapi.ListOptions) (*secapi.Iterator[schema.NetworkSku], error)) *MockNetworkV1_ListSkusWithOptions_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ListSubnets provides a mock function with given fields: ctx, npath
+func (_m *MockNetworkV1) ListSubnets(ctx context.Context, npath secapi.NetworkPath) (*secapi.Iterator[schema.Subnet], error) {
+	ret := _m.Called(ctx, npath)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListSubnets")
+	}
+
+	var r0 *secapi.Iterator[schema.Subnet]
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, secapi.NetworkPath) (*secapi.Iterator[schema.Subnet], error)); ok {
+		return rf(ctx, npath)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, secapi.NetworkPath) *secapi.Iterator[schema.Subnet]); ok {
+		r0 = rf(ctx, npath)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*secapi.Iterator[schema.Subnet])
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, secapi.NetworkPath) error); ok {
+		r1 = rf(ctx, npath)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockNetworkV1_ListSubnets_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListSubnets'
+type MockNetworkV1_ListSubnets_Call struct {
+	*mock.Call
+}
+
+// ListSubnets is a helper method to define mock.On call
+//   - ctx context.Context
+//   - npath secapi.NetworkPath
+func (_e *MockNetworkV1_Expecter) ListSubnets(ctx interface{}, npath interface{}) *MockNetworkV1_ListSubnets_Call {
+	return &MockNetworkV1_ListSubnets_Call{Call: _e.mock.On("ListSubnets", ctx, npath)}
+}
+
+func (_c *MockNetworkV1_ListSubnets_Call) Run(run func(ctx context.Context, npath secapi.NetworkPath)) *MockNetworkV1_ListSubnets_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(secapi.NetworkPath))
+	})
+	return _c
+}
+
+func (_c *MockNetworkV1_ListSubnets_Call) Return(_a0 *secapi.Iterator[schema.Subnet], _a1 error) *MockNetworkV1_ListSubnets_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockNetworkV1_ListSubnets_Call) RunAndReturn(run func(context.Context, secapi.NetworkPath) (*secapi.Iterator[schema.Subnet], error)) *MockNetworkV1_ListSubnets_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ListSubnetsWithOptions provides a mock function with given fields: ctx, npath, options
+func (_m *MockNetworkV1) ListSubnetsWithOptions(ctx context.Context, npath secapi.NetworkPath, options *secapi.ListOptions) (*secapi.Iterator[schema.Subnet], error) {
+	ret := _m.Called(ctx, npath, options)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListSubnetsWithOptions")
+	}
+
+	var r0 *secapi.Iterator[schema.Subnet]
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, secapi.NetworkPath, *secapi.ListOptions) (*secapi.Iterator[schema.Subnet], error)); ok {
+		return rf(ctx, npath, options)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, secapi.NetworkPath, *secapi.ListOptions) *secapi.Iterator[schema.Subnet]); ok {
+		r0 = rf(ctx, npath, options)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*secapi.Iterator[schema.Subnet])
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, secapi.NetworkPath, *secapi.ListOptions) error); ok {
+		r1 = rf(ctx, npath, options)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockNetworkV1_ListSubnetsWithOptions_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListSubnetsWithOptions'
+type MockNetworkV1_ListSubnetsWithOptions_Call struct {
+	*mock.Call
+}
+
+// ListSubnetsWithOptions is a helper method to define mock.On call
+//   - ctx context.Context
+//   - npath secapi.NetworkPath
+//   - options *secapi.ListOptions
+func (_e *MockNetworkV1_Expecter) ListSubnetsWithOptions(ctx interface{}, npath interface{}, options interface{}) *MockNetworkV1_ListSubnetsWithOptions_Call {
+	return &MockNetworkV1_ListSubnetsWithOptions_Call{Call: _e.mock.On("ListSubnetsWithOptions", ctx, npath, options)}
+}
+
+func (_c *MockNetworkV1_ListSubnetsWithOptions_Call) Run(run func(ctx context.Context, npath secapi.NetworkPath, options *secapi.ListOptions)) *MockNetworkV1_ListSubnetsWithOptions_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(secapi.NetworkPath), args[2].(*secapi.ListOptions))
+	})
+	return _c
+}
+
+func (_c *MockNetworkV1_ListSubnetsWithOptions_Call) Return(_a0 *secapi.Iterator[schema.Subnet], _a1 error) *MockNetworkV1_ListSubnetsWithOptions_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockNetworkV1_ListSubnetsWithOptions_Call) RunAndReturn(run func(context.Context, secapi.NetworkPath, *secapi.ListOptions) (*secapi.Iterator[schema.Subnet], error)) *MockNetworkV1_ListSubnetsWithOptions_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// WatchInternetGatewayUntilDeleted provides a mock function with given fields: ctx, wref, config
+func (_m *MockNetworkV1) WatchInternetGatewayUntilDeleted(ctx context.Context, wref secapi.WorkspaceReference, config secapi.ResourceObserverConfig) error {
+	ret := _m.Called(ctx, wref, config)
+
+	if len(ret) == 0 {
+		panic("no return value specified for WatchInternetGatewayUntilDeleted")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, secapi.WorkspaceReference, secapi.ResourceObserverConfig) error); ok {
+		r0 = rf(ctx, wref, config)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockNetworkV1_WatchInternetGatewayUntilDeleted_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'WatchInternetGatewayUntilDeleted'
+type MockNetworkV1_WatchInternetGatewayUntilDeleted_Call struct {
+	*mock.Call
+}
+
+// WatchInternetGatewayUntilDeleted is a helper method to define mock.On call
+//   - ctx context.Context
+//   - wref secapi.WorkspaceReference
+//   - config secapi.ResourceObserverConfig
+func (_e *MockNetworkV1_Expecter) WatchInternetGatewayUntilDeleted(ctx interface{}, wref interface{}, config interface{}) *MockNetworkV1_WatchInternetGatewayUntilDeleted_Call {
+	return &MockNetworkV1_WatchInternetGatewayUntilDeleted_Call{Call: _e.mock.On("WatchInternetGatewayUntilDeleted", ctx, wref, config)}
+}
+
+func (_c *MockNetworkV1_WatchInternetGatewayUntilDeleted_Call) Run(run func(ctx context.Context, wref secapi.WorkspaceReference, config secapi.ResourceObserverConfig)) *MockNetworkV1_WatchInternetGatewayUntilDeleted_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(secapi.WorkspaceReference), args[2].(secapi.ResourceObserverConfig))
+	})
+	return _c
+}
+
+func (_c *MockNetworkV1_WatchInternetGatewayUntilDeleted_Call) Return(_a0 error) *MockNetworkV1_WatchInternetGatewayUntilDeleted_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockNetworkV1_WatchInternetGatewayUntilDeleted_Call) RunAndReturn(run func(context.Context, secapi.WorkspaceReference, secapi.ResourceObserverConfig) error) *MockNetworkV1_WatchInternetGatewayUntilDeleted_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// WatchNetworkUntilDeleted provides a mock function with given fields: ctx, wref, config
+func (_m *MockNetworkV1) WatchNetworkUntilDeleted(ctx context.Context, wref secapi.WorkspaceReference, config secapi.ResourceObserverConfig) error {
+	ret := _m.Called(ctx, wref, config)
+
+	if len(ret) == 0 {
+		panic("no return value specified for WatchNetworkUntilDeleted")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, secapi.WorkspaceReference, secapi.ResourceObserverConfig) error); ok {
+		r0 = rf(ctx, wref, config)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockNetworkV1_WatchNetworkUntilDeleted_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'WatchNetworkUntilDeleted'
+type MockNetworkV1_WatchNetworkUntilDeleted_Call struct {
+	*mock.Call
+}
+
+// WatchNetworkUntilDeleted is a helper method to define mock.On call
+//   - ctx context.Context
+//   - wref secapi.WorkspaceReference
+//   - config secapi.ResourceObserverConfig
+func (_e *MockNetworkV1_Expecter) WatchNetworkUntilDeleted(ctx interface{}, wref interface{}, config interface{}) *MockNetworkV1_WatchNetworkUntilDeleted_Call {
+	return &MockNetworkV1_WatchNetworkUntilDeleted_Call{Call: _e.mock.On("WatchNetworkUntilDeleted", ctx, wref, config)}
+}
+
+func (_c *MockNetworkV1_WatchNetworkUntilDeleted_Call) Run(run func(ctx context.Context, wref secapi.WorkspaceReference, config secapi.ResourceObserverConfig)) *MockNetworkV1_WatchNetworkUntilDeleted_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(secapi.WorkspaceReference), args[2].(secapi.ResourceObserverConfig))
+	})
+	return _c
+}
+
+func (_c *MockNetworkV1_WatchNetworkUntilDeleted_Call) Return(_a0 error) *MockNetworkV1_WatchNetworkUntilDeleted_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockNetworkV1_WatchNetworkUntilDeleted_Call) RunAndReturn(run func(context.Context, secapi.WorkspaceReference, secapi.ResourceObserverConfig) error) *MockNetworkV1_WatchNetworkUntilDeleted_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// WatchNicUntilDeleted provides a mock function with given fields: ctx, wref, config
+func (_m *MockNetworkV1) WatchNicUntilDeleted(ctx context.Context, wref secapi.WorkspaceReference, config secapi.ResourceObserverConfig) error {
+	ret := _m.Called(ctx, wref, config)
+
+	if len(ret) == 0 {
+		panic("no return value specified for WatchNicUntilDeleted")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, secapi.WorkspaceReference, secapi.ResourceObserverConfig) error); ok {
+		r0 = rf(ctx, wref, config)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockNetworkV1_WatchNicUntilDeleted_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'WatchNicUntilDeleted'
+type MockNetworkV1_WatchNicUntilDeleted_Call struct {
+	*mock.Call
+}
+
+// WatchNicUntilDeleted is a helper method to define mock.On call
+//   - ctx context.Context
+//   - wref secapi.WorkspaceReference
+//   - config secapi.ResourceObserverConfig
+func (_e *MockNetworkV1_Expecter) WatchNicUntilDeleted(ctx interface{}, wref interface{}, config interface{}) *MockNetworkV1_WatchNicUntilDeleted_Call {
+	return &MockNetworkV1_WatchNicUntilDeleted_Call{Call: _e.mock.On("WatchNicUntilDeleted", ctx, wref, config)}
+}
+
+func (_c *MockNetworkV1_WatchNicUntilDeleted_Call) Run(run func(ctx context.Context, wref secapi.WorkspaceReference, config secapi.ResourceObserverConfig)) *MockNetworkV1_WatchNicUntilDeleted_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(secapi.WorkspaceReference), args[2].(secapi.ResourceObserverConfig))
+	})
+	return _c
+}
+
+func (_c *MockNetworkV1_WatchNicUntilDeleted_Call) Return(_a0 error) *MockNetworkV1_WatchNicUntilDeleted_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockNetworkV1_WatchNicUntilDeleted_Call) RunAndReturn(run func(context.Context, secapi.WorkspaceReference, secapi.ResourceObserverConfig) error) *MockNetworkV1_WatchNicUntilDeleted_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// WatchPublicIpUntilDeleted provides a mock function with given fields: ctx, wref, config
+func (_m *MockNetworkV1) WatchPublicIpUntilDeleted(ctx context.Context, wref secapi.WorkspaceReference, config secapi.ResourceObserverConfig) error {
+	ret := _m.Called(ctx, wref, config)
+
+	if len(ret) == 0 {
+		panic("no return value specified for WatchPublicIpUntilDeleted")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, secapi.WorkspaceReference, secapi.ResourceObserverConfig) error); ok {
+		r0 = rf(ctx, wref, config)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockNetworkV1_WatchPublicIpUntilDeleted_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'WatchPublicIpUntilDeleted'
+type MockNetworkV1_WatchPublicIpUntilDeleted_Call struct {
+	*mock.Call
+}
+
+// WatchPublicIpUntilDeleted is a helper method to define mock.On call
+//   - ctx context.Context
+//   - wref secapi.WorkspaceReference
+//   - config secapi.ResourceObserverConfig
+func (_e *MockNetworkV1_Expecter) WatchPublicIpUntilDeleted(ctx interface{}, wref interface{}, config interface{}) *MockNetworkV1_WatchPublicIpUntilDeleted_Call {
+	return &MockNetworkV1_WatchPublicIpUntilDeleted_Call{Call: _e.mock.On("WatchPublicIpUntilDeleted", ctx, wref, config)}
+}
+
+func (_c *MockNetworkV1_WatchPublicIpUntilDeleted_Call) Run(run func(ctx context.Context, wref secapi.WorkspaceReference, config secapi.ResourceObserverConfig)) *MockNetworkV1_WatchPublicIpUntilDeleted_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(secapi.WorkspaceReference), args[2].(secapi.ResourceObserverConfig))
+	})
+	return _c
+}
+
+func (_c *MockNetworkV1_WatchPublicIpUntilDeleted_Call) Return(_a0 error) *MockNetworkV1_WatchPublicIpUntilDeleted_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockNetworkV1_WatchPublicIpUntilDeleted_Call) RunAndReturn(run func(context.Context, secapi.WorkspaceReference, secapi.ResourceObserverConfig) error) *MockNetworkV1_WatchPublicIpUntilDeleted_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// WatchRouteTableUntilDeleted provides a mock function with given fields: ctx, nref, config
+func (_m *MockNetworkV1) WatchRouteTableUntilDeleted(ctx context.Context, nref secapi.NetworkReference, config secapi.ResourceObserverConfig) error {
+	ret := _m.Called(ctx, nref, config)
+
+	if len(ret) == 0 {
+		panic("no return value specified for WatchRouteTableUntilDeleted")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, secapi.NetworkReference, secapi.ResourceObserverConfig) error); ok {
+		r0 = rf(ctx, nref, config)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockNetworkV1_WatchRouteTableUntilDeleted_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'WatchRouteTableUntilDeleted'
+type MockNetworkV1_WatchRouteTableUntilDeleted_Call struct {
+	*mock.Call
+}
+
+// WatchRouteTableUntilDeleted is a helper method to define mock.On call
+//   - ctx context.Context
+//   - nref secapi.NetworkReference
+//   - config secapi.ResourceObserverConfig
+func (_e *MockNetworkV1_Expecter) WatchRouteTableUntilDeleted(ctx interface{}, nref interface{}, config interface{}) *MockNetworkV1_WatchRouteTableUntilDeleted_Call {
+	return &MockNetworkV1_WatchRouteTableUntilDeleted_Call{Call: _e.mock.On("WatchRouteTableUntilDeleted", ctx, nref, config)}
+}
+
+func (_c *MockNetworkV1_WatchRouteTableUntilDeleted_Call) Run(run func(ctx context.Context, nref secapi.NetworkReference, config secapi.ResourceObserverConfig)) *MockNetworkV1_WatchRouteTableUntilDeleted_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(secapi.NetworkReference), args[2].(secapi.ResourceObserverConfig))
+	})
+	return _c
+}
+
+func (_c *MockNetworkV1_WatchRouteTableUntilDeleted_Call) Return(_a0 error) *MockNetworkV1_WatchRouteTableUntilDeleted_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockNetworkV1_WatchRouteTableUntilDeleted_Call) RunAndReturn(run func(context.Context, secapi.NetworkReference, secapi.ResourceObserverConfig) error) *MockNetworkV1_WatchRouteTableUntilDeleted_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// WatchSecurityGroupRuleUntilDeleted provides a mock function with given fields: ctx, wref, config
+func (_m *MockNetworkV1) WatchSecurityGroupRuleUntilDeleted(ctx context.Context, wref secapi.WorkspaceReference, config secapi.ResourceObserverConfig) error {
+	ret := _m.Called(ctx, wref, config)
+
+	if len(ret) == 0 {
+		panic("no return value specified for WatchSecurityGroupRuleUntilDeleted")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, secapi.WorkspaceReference, secapi.ResourceObserverConfig) error); ok {
+		r0 = rf(ctx, wref, config)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockNetworkV1_WatchSecurityGroupRuleUntilDeleted_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'WatchSecurityGroupRuleUntilDeleted'
+type MockNetworkV1_WatchSecurityGroupRuleUntilDeleted_Call struct {
+	*mock.Call
+}
+
+// WatchSecurityGroupRuleUntilDeleted is a helper method to define mock.On call
+//   - ctx context.Context
+//   - wref secapi.WorkspaceReference
+//   - config secapi.ResourceObserverConfig
+func (_e *MockNetworkV1_Expecter) WatchSecurityGroupRuleUntilDeleted(ctx interface{}, wref interface{}, config interface{}) *MockNetworkV1_WatchSecurityGroupRuleUntilDeleted_Call {
+	return &MockNetworkV1_WatchSecurityGroupRuleUntilDeleted_Call{Call: _e.mock.On("WatchSecurityGroupRuleUntilDeleted", ctx, wref, config)}
+}
+
+func (_c *MockNetworkV1_WatchSecurityGroupRuleUntilDeleted_Call) Run(run func(ctx context.Context, wref secapi.WorkspaceReference, config secapi.ResourceObserverConfig)) *MockNetworkV1_WatchSecurityGroupRuleUntilDeleted_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(secapi.WorkspaceReference), args[2].(secapi.ResourceObserverConfig))
+	})
+	return _c
+}
+
+func (_c *MockNetworkV1_WatchSecurityGroupRuleUntilDeleted_Call) Return(_a0 error) *MockNetworkV1_WatchSecurityGroupRuleUntilDeleted_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockNetworkV1_WatchSecurityGroupRuleUntilDeleted_Call) RunAndReturn(run func(context.Context, secapi.WorkspaceReference, secapi.ResourceObserverConfig) error) *MockNetworkV1_WatchSecurityGroupRuleUntilDeleted_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// WatchSecurityGroupUntilDeleted provides a mock function with given fields: ctx, wref, config
+func (_m *MockNetworkV1) WatchSecurityGroupUntilDeleted(ctx context.Context, wref secapi.WorkspaceReference, config secapi.ResourceObserverConfig) error {
+	ret := _m.Called(ctx, wref, config)
+
+	if len(ret) == 0 {
+		panic("no return value specified for WatchSecurityGroupUntilDeleted")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, secapi.WorkspaceReference, secapi.ResourceObserverConfig) error); ok {
+		r0 = rf(ctx, wref, config)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockNetworkV1_WatchSecurityGroupUntilDeleted_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'WatchSecurityGroupUntilDeleted'
+type MockNetworkV1_WatchSecurityGroupUntilDeleted_Call struct {
+	*mock.Call
+}
+
+// WatchSecurityGroupUntilDeleted is a helper method to define mock.On call
+//   - ctx context.Context
+//   - wref secapi.WorkspaceReference
+//   - config secapi.ResourceObserverConfig
+func (_e *MockNetworkV1_Expecter) WatchSecurityGroupUntilDeleted(ctx interface{}, wref interface{}, config interface{}) *MockNetworkV1_WatchSecurityGroupUntilDeleted_Call {
+	return &MockNetworkV1_WatchSecurityGroupUntilDeleted_Call{Call: _e.mock.On("WatchSecurityGroupUntilDeleted", ctx, wref, config)}
+}
+
+func (_c *MockNetworkV1_WatchSecurityGroupUntilDeleted_Call) Run(run func(ctx context.Context, wref secapi.WorkspaceReference, config secapi.ResourceObserverConfig)) *MockNetworkV1_WatchSecurityGroupUntilDeleted_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(secapi.WorkspaceReference), args[2].(secapi.ResourceObserverConfig))
+	})
+	return _c
+}
+
+func (_c *MockNetworkV1_WatchSecurityGroupUntilDeleted_Call) Return(_a0 error) *MockNetworkV1_WatchSecurityGroupUntilDeleted_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockNetworkV1_WatchSecurityGroupUntilDeleted_Call) RunAndReturn(run func(context.Context, secapi.WorkspaceReference, secapi.ResourceObserverConfig) error) *MockNetworkV1_WatchSecurityGroupUntilDeleted_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// WatchSubnetUntilDeleted provides a mock function with given fields: ctx, nref, config
+func (_m *MockNetworkV1) WatchSubnetUntilDeleted(ctx context.Context, nref secapi.NetworkReference, config secapi.ResourceObserverConfig) error {
+	ret := _m.Called(ctx, nref, config)
+
+	if len(ret) == 0 {
+		panic("no return value specified for WatchSubnetUntilDeleted")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, secapi.NetworkReference, secapi.ResourceObserverConfig) error); ok {
+		r0 = rf(ctx, nref, config)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockNetworkV1_WatchSubnetUntilDeleted_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'WatchSubnetUntilDeleted'
+type MockNetworkV1_WatchSubnetUntilDeleted_Call struct {
+	*mock.Call
+}
+
+// WatchSubnetUntilDeleted is a helper method to define mock.On call
+//   - ctx context.Context
+//   - nref secapi.NetworkReference
+//   - config secapi.ResourceObserverConfig
+func (_e *MockNetworkV1_Expecter) WatchSubnetUntilDeleted(ctx interface{}, nref interface{}, config interface{}) *MockNetworkV1_WatchSubnetUntilDeleted_Call {
+	return &MockNetworkV1_WatchSubnetUntilDeleted_Call{Call: _e.mock.On("WatchSubnetUntilDeleted", ctx, nref, config)}
+}
+
+func (_c *MockNetworkV1_WatchSubnetUntilDeleted_Call) Run(run func(ctx context.Context, nref secapi.NetworkReference, config secapi.ResourceObserverConfig)) *MockNetworkV1_WatchSubnetUntilDeleted_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(secapi.NetworkReference), args[2].(secapi.ResourceObserverConfig))
+	})
+	return _c
+}
+
+func (_c *MockNetworkV1_WatchSubnetUntilDeleted_Call) Return(_a0 error) *MockNetworkV1_WatchSubnetUntilDeleted_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockNetworkV1_WatchSubnetUntilDeleted_Call) RunAndReturn(run func(context.Context, secapi.NetworkReference, secapi.ResourceObserverConfig) error) *MockNetworkV1_WatchSubnetUntilDeleted_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockNetworkV1 creates a new instance of MockNetworkV1. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockNetworkV1(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockNetworkV1 {
+	mock := &MockNetworkV1{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -0,0 +1,215 @@
+// Code generated by mockery v2.52.2. DO NOT EDIT.
+
+package mocksecapi
+
+import (
+	context "context"
+
+	schema "github.com/eu-sovereign-cloud/go-sdk/pkg/spec/schema"
+	mock "github.com/stretchr/testify/mock"
+
+	secapi "github.com/eu-sovereign-cloud/go-sdk/secapi"
+)
+
+// MockRegionV1 is an autogenerated mock type for the RegionV1 type
+type MockRegionV1 struct {
+	mock.Mock
+}
+
+type MockRegionV1_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockRegionV1) EXPECT() *MockRegionV1_Expecter {
+	return &MockRegionV1_Expecter{mock: &_m.Mock}
+}
+
+// GetRegion provides a mock function with given fields: ctx, name
+func (_m *MockRegionV1) GetRegion(ctx context.Context, name string) (*schema.Region, error) {
+	ret := _m.Called(ctx, name)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetRegion")
+	}
+
+	var r0 *schema.Region
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) (*schema.Region, error)); ok {
+		return rf(ctx, name)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string) *schema.Region); ok {
+		r0 = rf(ctx, name)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*schema.Region)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, name)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockRegionV1_GetRegion_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetRegion'
+type MockRegionV1_GetRegion_Call struct {
+	*mock.Call
+}
+
+// GetRegion is a helper method to define mock.On call
+//   - ctx context.Context
+//   - name string
+func (_e *MockRegionV1_Expecter) GetRegion(ctx interface{}, name interface{}) *MockRegionV1_GetRegion_Call {
+	return &MockRegionV1_GetRegion_Call{Call: _e.mock.On("GetRegion", ctx, name)}
+}
+
+func (_c *MockRegionV1_GetRegion_Call) Run(run func(ctx context.Context, name string)) *MockRegionV1_GetRegion_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *MockRegionV1_GetRegion_Call) Return(_a0 *schema.Region, _a1 error) *MockRegionV1_GetRegion_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockRegionV1_GetRegion_Call) RunAndReturn(run func(context.Context, string) (*schema.Region, error)) *MockRegionV1_GetRegion_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ListRegions provides a mock function with given fields: ctx
+func (_m *MockRegionV1) ListRegions(ctx context.Context) (*secapi.Iterator[schema.Region], error) {
+	ret := _m.Called(ctx)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListRegions")
+	}
+
+	var r0 *secapi.Iterator[schema.Region]
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context) (*secapi.Iterator[schema.Region], error)); ok {
+		return rf(ctx)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context) *secapi.Iterator[schema.Region]); ok {
+		r0 = rf(ctx)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*secapi.Iterator[schema.Region])
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = rf(ctx)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockRegionV1_ListRegions_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListRegions'
+type MockRegionV1_ListRegions_Call struct {
+	*mock.Call
+}
+
+// ListRegions is a helper method to define mock.On call
+//   - ctx context.Context
+func (_e *MockRegionV1_Expecter) ListRegions(ctx interface{}) *MockRegionV1_ListRegions_Call {
+	return &MockRegionV1_ListRegions_Call{Call: _e.mock.On("ListRegions", ctx)}
+}
+
+func (_c *MockRegionV1_ListRegions_Call) Run(run func(ctx context.Context)) *MockRegionV1_ListRegions_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context))
+	})
+	return _c
+}
+
+func (_c *MockRegionV1_ListRegions_Call) Return(_a0 *secapi.Iterator[schema.Region], _a1 error) *MockRegionV1_ListRegions_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockRegionV1_ListRegions_Call) RunAndReturn(run func(context.Context) (*secapi.Iterator[schema.Region], error)) *MockRegionV1_ListRegions_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ListRegionsWithOptions provides a mock function with given fields: ctx, options
+func (_m *MockRegionV1) ListRegionsWithOptions(ctx context.Context, options *secapi.ListOptions) (*secapi.Iterator[schema.Region], error) {
+	ret := _m.Called(ctx, options)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListRegionsWithOptions")
+	}
+
+	var r0 *secapi.Iterator[schema.Region]
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *secapi.ListOptions) (*secapi.Iterator[schema.Region], error)); ok {
+		return rf(ctx, options)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *secapi.ListOptions) *secapi.Iterator[schema.Region]); ok {
+		r0 = rf(ctx, options)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*secapi.Iterator[schema.Region])
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *secapi.ListOptions) error); ok {
+		r1 = rf(ctx, options)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockRegionV1_ListRegionsWithOptions_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListRegionsWithOptions'
+type MockRegionV1_ListRegionsWithOptions_Call struct {
+	*mock.Call
+}
+
+// ListRegionsWithOptions is a helper method to define mock.On call
+//   - ctx context.Context
+//   - options *secapi.ListOptions
+func (_e *MockRegionV1_Expecter) ListRegionsWithOptions(ctx interface{}, options interface{}) *MockRegionV1_ListRegionsWithOptions_Call {
+	return &MockRegionV1_ListRegionsWithOptions_Call{Call: _e.mock.On("ListRegionsWithOptions", ctx, options)}
+}
+
+func (_c *MockRegionV1_ListRegionsWithOptions_Call) Run(run func(ctx context.Context, options *secapi.ListOptions)) *MockRegionV1_ListRegionsWithOptions_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*secapi.ListOptions))
+	})
+	return _c
+}
+
+func (_c *MockRegionV1_ListRegionsWithOptions_Call) Return(_a0 *secapi.Iterator[schema.Region], _a1 error) *MockRegionV1_ListRegionsWithOptions_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockRegionV1_ListRegionsWithOptions_Call) RunAndReturn(run func(context.Context, *secapi.ListOptions) (*secapi.Iterator[schema.Region], error)) *MockRegionV1_ListRegionsWithOptions_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockRegionV1 creates a new instance of MockRegionV1. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockRegionV1(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockRegionV1 {
+	mock := &MockRegionV1{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -0,0 +1,1219 @@
+// Code generated by mockery v2.52.2. DO NOT EDIT.
+
+package mocksecapi
+
+import (
+	context "context"
+
+	schema "github.com/eu-sovereign-cloud/go-sdk/pkg/spec/schema"
+	mock "github.com/stretchr/testify/mock"
+
+	secapi "github.com/eu-sovereign-cloud/go-sdk/secapi"
+
+	storage "github.com/eu-sovereign-cloud/go-sdk/pkg/spec/foundation.storage.v1"
+)
+
+// MockStorageV1 is an autogenerated mock type for the StorageV1 type
+type MockStorageV1 struct {
+	mock.Mock
+}
+
+type MockStorageV1_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockStorageV1) EXPECT() *MockStorageV1_Expecter {
+	return &MockStorageV1_Expecter{mock: &_m.Mock}
+}
+
+// CreateOrUpdateBlockStorage provides a mock function with given fields: ctx, block
+func (_m *MockStorageV1) CreateOrUpdateBlockStorage(ctx context.Context, block *schema.BlockStorage) (*schema.BlockStorage, error) {
+	ret := _m.Called(ctx, block)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CreateOrUpdateBlockStorage")
+	}
+
+	var r0 *schema.BlockStorage
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *schema.BlockStorage) (*schema.BlockStorage, error)); ok {
+		return rf(ctx, block)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *schema.BlockStorage) *schema.BlockStorage); ok {
+		r0 = rf(ctx, block)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*schema.BlockStorage)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *schema.BlockStorage) error); ok {
+		r1 = rf(ctx, block)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockStorageV1_CreateOrUpdateBlockStorage_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CreateOrUpdateBlockStorage'
+type MockStorageV1_CreateOrUpdateBlockStorage_Call struct {
+	*mock.Call
+}
+
+// CreateOrUpdateBlockStorage is a helper method to define mock.On call
+//   - ctx context.Context
+//   - block *schema.BlockStorage
+func (_e *MockStorageV1_Expecter) CreateOrUpdateBlockStorage(ctx interface{}, block interface{}) *MockStorageV1_CreateOrUpdateBlockStorage_Call {
+	return &MockStorageV1_CreateOrUpdateBlockStorage_Call{Call: _e.mock.On("CreateOrUpdateBlockStorage", ctx, block)}
+}
+
+func (_c *MockStorageV1_CreateOrUpdateBlockStorage_Call) Run(run func(ctx context.Context, block *schema.BlockStorage)) *MockStorageV1_CreateOrUpdateBlockStorage_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*schema.BlockStorage))
+	})
+	return _c
+}
+
+func (_c *MockStorageV1_CreateOrUpdateBlockStorage_Call) Return(_a0 *schema.BlockStorage, _a1 error) *MockStorageV1_CreateOrUpdateBlockStorage_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockStorageV1_CreateOrUpdateBlockStorage_Call) RunAndReturn(run func(context.Context, *schema.BlockStorage) (*schema.BlockStorage, error)) *MockStorageV1_CreateOrUpdateBlockStorage_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// CreateOrUpdateBlockStorageWithParams provides a mock function with given fields: ctx, block, params
+func (_m *MockStorageV1) CreateOrUpdateBlockStorageWithParams(ctx context.Context, block *schema.BlockStorage, params *storage.CreateOrUpdateBlockStorageParams) (*schema.BlockStorage, error) {
+	ret := _m.Called(ctx, block, params)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CreateOrUpdateBlockStorageWithParams")
+	}
+
+	var r0 *schema.BlockStorage
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *schema.BlockStorage, *storage.CreateOrUpdateBlockStorageParams) (*schema.BlockStorage, error)); ok {
+		return rf(ctx, block, params)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *schema.BlockStorage, *storage.CreateOrUpdateBlockStorageParams) *schema.BlockStorage); ok {
+		r0 = rf(ctx, block, params)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*schema.BlockStorage)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *schema.BlockStorage, *storage.CreateOrUpdateBlockStorageParams) error); ok {
+		r1 = rf(ctx, block, params)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockStorageV1_CreateOrUpdateBlockStorageWithParams_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CreateOrUpdateBlockStorageWithParams'
+type MockStorageV1_CreateOrUpdateBlockStorageWithParams_Call struct {
+	*mock.Call
+}
+
+// CreateOrUpdateBlockStorageWithParams is a helper method to define mock.On call
+//   - ctx context.Context
+//   - block *schema.BlockStorage
+//   - params *storage.CreateOrUpdateBlockStorageParams
+func (_e *MockStorageV1_Expecter) CreateOrUpdateBlockStorageWithParams(ctx interface{}, block interface{}, params interface{}) *MockStorageV1_CreateOrUpdateBlockStorageWithParams_Call {
+	return &MockStorageV1_CreateOrUpdateBlockStorageWithParams_Call{Call: _e.mock.On("CreateOrUpdateBlockStorageWithParams", ctx, block, params)}
+}
+
+func (_c *MockStorageV1_CreateOrUpdateBlockStorageWithParams_Call) Run(run func(ctx context.Context, block *schema.BlockStorage, params *storage.CreateOrUpdateBlockStorageParams)) *MockStorageV1_CreateOrUpdateBlockStorageWithParams_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*schema.BlockStorage), args[2].(*storage.CreateOrUpdateBlockStorageParams))
+	})
+	return _c
+}
+
+func (_c *MockStorageV1_CreateOrUpdateBlockStorageWithParams_Call) Return(_a0 *schema.BlockStorage, _a1 error) *MockStorageV1_CreateOrUpdateBlockStorageWithParams_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockStorageV1_CreateOrUpdateBlockStorageWithParams_Call) RunAndReturn(run func(context.Context, *schema.BlockStorage, *storage.CreateOrUpdateBlockStorageParams) (*schema.BlockStorage, error)) *MockStorageV1_CreateOrUpdateBlockStorageWithParams_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// CreateOrUpdateImage provides a mock function with given fields: ctx, image
+func (_m *MockStorageV1) CreateOrUpdateImage(ctx context.Context, image *schema.Image) (*schema.Image, error) {
+	ret := _m.Called(ctx, image)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CreateOrUpdateImage")
+	}
+
+	var r0 *schema.Image
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *schema.Image) (*schema.Image, error)); ok {
+		return rf(ctx, image)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *schema.Image) *schema.Image); ok {
+		r0 = rf(ctx, image)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*schema.Image)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *schema.Image) error); ok {
+		r1 = rf(ctx, image)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockStorageV1_CreateOrUpdateImage_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CreateOrUpdateImage'
+type MockStorageV1_CreateOrUpdateImage_Call struct {
+	*mock.Call
+}
+
+// CreateOrUpdateImage is a helper method to define mock.On call
+//   - ctx context.Context
+//   - image *schema.Image
+func (_e *MockStorageV1_Expecter) CreateOrUpdateImage(ctx interface{}, image interface{}) *MockStorageV1_CreateOrUpdateImage_Call {
+	return &MockStorageV1_CreateOrUpdateImage_Call{Call: _e.mock.On("CreateOrUpdateImage", ctx, image)}
+}
+
+func (_c *MockStorageV1_CreateOrUpdateImage_Call) Run(run func(ctx context.Context, image *schema.Image)) *MockStorageV1_CreateOrUpdateImage_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*schema.Image))
+	})
+	return _c
+}
+
+func (_c *MockStorageV1_CreateOrUpdateImage_Call) Return(_a0 *schema.Image, _a1 error) *MockStorageV1_CreateOrUpdateImage_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockStorageV1_CreateOrUpdateImage_Call) RunAndReturn(run func(context.Context, *schema.Image) (*schema.Image, error)) *MockStorageV1_CreateOrUpdateImage_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// CreateOrUpdateImageWithParams provides a mock function with given fields: ctx, image, params
+func (_m *MockStorageV1) CreateOrUpdateImageWithParams(ctx context.Context, image *schema.Image, params *storage.CreateOrUpdateImageParams) (*schema.Image, error) {
+	ret := _m.Called(ctx, image, params)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CreateOrUpdateImageWithParams")
+	}
+
+	var r0 *schema.Image
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *schema.Image, *storage.CreateOrUpdateImageParams) (*schema.Image, error)); ok {
+		return rf(ctx, image, params)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *schema.Image, *storage.CreateOrUpdateImageParams) *schema.Image); ok {
+		r0 = rf(ctx, image, params)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*schema.Image)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *schema.Image, *storage.CreateOrUpdateImageParams) error); ok {
+		r1 = rf(ctx, image, params)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockStorageV1_CreateOrUpdateImageWithParams_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CreateOrUpdateImageWithParams'
+type MockStorageV1_CreateOrUpdateImageWithParams_Call struct {
+	*mock.Call
+}
+
+// CreateOrUpdateImageWithParams is a helper method to define mock.On call
+//   - ctx context.Context
+//   - image *schema.Image
+//   - params *storage.CreateOrUpdateImageParams
+func (_e *MockStorageV1_Expecter) CreateOrUpdateImageWithParams(ctx interface{}, image interface{}, params interface{}) *MockStorageV1_CreateOrUpdateImageWithParams_Call {
+	return &MockStorageV1_CreateOrUpdateImageWithParams_Call{Call: _e.mock.On("CreateOrUpdateImageWithParams", ctx, image, params)}
+}
+
+func (_c *MockStorageV1_CreateOrUpdateImageWithParams_Call) Run(run func(ctx context.Context, image *schema.Image, params *storage.CreateOrUpdateImageParams)) *MockStorageV1_CreateOrUpdateImageWithParams_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*schema.Image), args[2].(*storage.CreateOrUpdateImageParams))
+	})
+	return _c
+}
+
+func (_c *MockStorageV1_CreateOrUpdateImageWithParams_Call) Return(_a0 *schema.Image, _a1 error) *MockStorageV1_CreateOrUpdateImageWithParams_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockStorageV1_CreateOrUpdateImageWithParams_Call) RunAndReturn(run func(context.Context, *schema.Image, *storage.CreateOrUpdateImageParams) (*schema.Image, error)) *MockStorageV1_CreateOrUpdateImageWithParams_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// DeleteBlockStorage provides a mock function with given fields: ctx, block
+func (_m *MockStorageV1) DeleteBlockStorage(ctx context.Context, block *schema.BlockStorage) error {
+	ret := _m.Called(ctx, block)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DeleteBlockStorage")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, *schema.BlockStorage) error); ok {
+		r0 = rf(ctx, block)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockStorageV1_DeleteBlockStorage_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DeleteBlockStorage'
+type MockStorageV1_DeleteBlockStorage_Call struct {
+	*mock.Call
+}
+
+// DeleteBlockStorage is a helper method to define mock.On call
+//   - ctx context.Context
+//   - block *schema.BlockStorage
+func (_e *MockStorageV1_Expecter) DeleteBlockStorage(ctx interface{}, block interface{}) *MockStorageV1_DeleteBlockStorage_Call {
+	return &MockStorageV1_DeleteBlockStorage_Call{Call: _e.mock.On("DeleteBlockStorage", ctx, block)}
+}
+
+func (_c *MockStorageV1_DeleteBlockStorage_Call) Run(run func(ctx context.Context, block *schema.BlockStorage)) *MockStorageV1_DeleteBlockStorage_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*schema.BlockStorage))
+	})
+	return _c
+}
+
+func (_c *MockStorageV1_DeleteBlockStorage_Call) Return(_a0 error) *MockStorageV1_DeleteBlockStorage_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockStorageV1_DeleteBlockStorage_Call) RunAndReturn(run func(context.Context, *schema.BlockStorage) error) *MockStorageV1_DeleteBlockStorage_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// DeleteBlockStorageWithParams provides a mock function with given fields: ctx, block, params
+func (_m *MockStorageV1) DeleteBlockStorageWithParams(ctx context.Context, block *schema.BlockStorage, params *storage.DeleteBlockStorageParams) error {
+	ret := _m.Called(ctx, block, params)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DeleteBlockStorageWithParams")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, *schema.BlockStorage, *storage.DeleteBlockStorageParams) error); ok {
+		r0 = rf(ctx, block, params)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockStorageV1_DeleteBlockStorageWithParams_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DeleteBlockStorageWithParams'
+type MockStorageV1_DeleteBlockStorageWithParams_Call struct {
+	*mock.Call
+}
+
+// DeleteBlockStorageWithParams is a helper method to define mock.On call
+//   - ctx context.Context
+//   - block *schema.BlockStorage
+//   - params *storage.DeleteBlockStorageParams
+func (_e *MockStorageV1_Expecter) DeleteBlockStorageWithParams(ctx interface{}, block interface{}, params interface{}) *MockStorageV1_DeleteBlockStorageWithParams_Call {
+	return &MockStorageV1_DeleteBlockStorageWithParams_Call{Call: _e.mock.On("DeleteBlockStorageWithParams", ctx, block, params)}
+}
+
+func (_c *MockStorageV1_DeleteBlockStorageWithParams_Call) Run(run func(ctx context.Context, block *schema.BlockStorage, params *storage.DeleteBlockStorageParams)) *MockStorageV1_DeleteBlockStorageWithParams_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*schema.BlockStorage), args[2].(*storage.DeleteBlockStorageParams))
+	})
+	return _c
+}
+
+func (_c *MockStorageV1_DeleteBlockStorageWithParams_Call) Return(_a0 error) *MockStorageV1_DeleteBlockStorageWithParams_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockStorageV1_DeleteBlockStorageWithParams_Call) RunAndReturn(run func(context.Context, *schema.BlockStorage, *storage.DeleteBlockStorageParams) error) *MockStorageV1_DeleteBlockStorageWithParams_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// DeleteImage provides a mock function with given fields: ctx, image
+func (_m *MockStorageV1) DeleteImage(ctx context.Context, image *schema.Image) error {
+	ret := _m.Called(ctx, image)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DeleteImage")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, *schema.Image) error); ok {
+		r0 = rf(ctx, image)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockStorageV1_DeleteImage_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DeleteImage'
+type MockStorageV1_DeleteImage_Call struct {
+	*mock.Call
+}
+
+// DeleteImage is a helper method to define mock.On call
+//   - ctx context.Context
+//   - image *schema.Image
+func (_e *MockStorageV1_Expecter) DeleteImage(ctx interface{}, image interface{}) *MockStorageV1_DeleteImage_Call {
+	return &MockStorageV1_DeleteImage_Call{Call: _e.mock.On("DeleteImage", ctx, image)}
+}
+
+func (_c *MockStorageV1_DeleteImage_Call) Run(run func(ctx context.Context, image *schema.Image)) *MockStorageV1_DeleteImage_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*schema.Image))
+	})
+	return _c
+}
+
+func (_c *MockStorageV1_DeleteImage_Call) Return(_a0 error) *MockStorageV1_DeleteImage_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockStorageV1_DeleteImage_Call) RunAndReturn(run func(context.Context, *schema.Image) error) *MockStorageV1_DeleteImage_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// DeleteImageWithParams provides a mock function with given fields: ctx, image, params
+func (_m *MockStorageV1) DeleteImageWithParams(ctx context.Context, image *schema.Image, params *storage.DeleteImageParams) error {
+	ret := _m.Called(ctx, image, params)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DeleteImageWithParams")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, *schema.Image, *storage.DeleteImageParams) error); ok {
+		r0 = rf(ctx, image, params)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockStorageV1_DeleteImageWithParams_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DeleteImageWithParams'
+type MockStorageV1_DeleteImageWithParams_Call struct {
+	*mock.Call
+}
+
+// DeleteImageWithParams is a helper method to define mock.On call
+//   - ctx context.Context
+//   - image *schema.Image
+//   - params *storage.DeleteImageParams
+func (_e *MockStorageV1_Expecter) DeleteImageWithParams(ctx interface{}, image interface{}, params interface{}) *MockStorageV1_DeleteImageWithParams_Call {
+	return &MockStorageV1_DeleteImageWithParams_Call{Call: _e.mock.On("DeleteImageWithParams", ctx, image, params)}
+}
+
+func (_c *MockStorageV1_DeleteImageWithParams_Call) Run(run func(ctx context.Context, image *schema.Image, params *storage.DeleteImageParams)) *MockStorageV1_DeleteImageWithParams_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*schema.Image), args[2].(*storage.DeleteImageParams))
+	})
+	return _c
+}
+
+func (_c *MockStorageV1_DeleteImageWithParams_Call) Return(_a0 error) *MockStorageV1_DeleteImageWithParams_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockStorageV1_DeleteImageWithParams_Call) RunAndReturn(run func(context.Context, *schema.Image, *storage.DeleteImageParams) error) *MockStorageV1_DeleteImageWithParams_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetBlockStorage provides a mock function with given fields: ctx, wref
+func (_m *MockStorageV1) GetBlockStorage(ctx context.Context, wref secapi.WorkspaceReference) (*schema.BlockStorage, error) {
+	ret := _m.Called(ctx, wref)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetBlockStorage")
+	}
+
+	var r0 *schema.BlockStorage
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, secapi.WorkspaceReference) (*schema.BlockStorage, error)); ok {
+		return rf(ctx, wref)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, secapi.WorkspaceReference) *schema.BlockStorage); ok {
+		r0 = rf(ctx, wref)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*schema.BlockStorage)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, secapi.WorkspaceReference) error); ok {
+		r1 = rf(ctx, wref)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockStorageV1_GetBlockStorage_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetBlockStorage'
+type MockStorageV1_GetBlockStorage_Call struct {
+	*mock.Call
+}
+
+// GetBlockStorage is a helper method to define mock.On call
+//   - ctx context.Context
+//   - wref secapi.WorkspaceReference
+func (_e *MockStorageV1_Expecter) GetBlockStorage(ctx interface{}, wref interface{}) *MockStorageV1_GetBlockStorage_Call {
+	return &MockStorageV1_GetBlockStorage_Call{Call: _e.mock.On("GetBlockStorage", ctx, wref)}
+}
+
+func (_c *MockStorageV1_GetBlockStorage_Call) Run(run func(ctx context.Context, wref secapi.WorkspaceReference)) *MockStorageV1_GetBlockStorage_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(secapi.WorkspaceReference))
+	})
+	return _c
+}
+
+func (_c *MockStorageV1_GetBlockStorage_Call) Return(_a0 *schema.BlockStorage, _a1 error) *MockStorageV1_GetBlockStorage_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockStorageV1_GetBlockStorage_Call) RunAndReturn(run func(context.Context, secapi.WorkspaceReference) (*schema.BlockStorage, error)) *MockStorageV1_GetBlockStorage_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetBlockStorageUntilState provides a mock function with given fields: ctx, wref, config
+func (_m *MockStorageV1) GetBlockStorageUntilState(ctx context.Context, wref secapi.WorkspaceReference, config secapi.ResourceObserverUntilValueConfig[schema.ResourceState]) (*schema.BlockStorage, error) {
+	ret := _m.Called(ctx, wref, config)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetBlockStorageUntilState")
+	}
+
+	var r0 *schema.BlockStorage
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, secapi.WorkspaceReference, secapi.ResourceObserverUntilValueConfig[schema.ResourceState]) (*schema.BlockStorage, error)); ok {
+		return rf(ctx, wref, config)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, secapi.WorkspaceReference, secapi.ResourceObserverUntilValueConfig[schema.ResourceState]) *schema.BlockStorage); ok {
+		r0 = rf(ctx, wref, config)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*schema.BlockStorage)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, secapi.WorkspaceReference, secapi.ResourceObserverUntilValueConfig[schema.ResourceState]) error); ok {
+		r1 = rf(ctx, wref, config)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockStorageV1_GetBlockStorageUntilState_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetBlockStorageUntilState'
+type MockStorageV1_GetBlockStorageUntilState_Call struct {
+	*mock.Call
+}
+
+// GetBlockStorageUntilState is a helper method to define mock.On call
+//   - ctx context.Context
+//   - wref secapi.WorkspaceReference
+//   - config secapi.ResourceObserverUntilValueConfig[schema.ResourceState]
+func (_e *MockStorageV1_Expecter) GetBlockStorageUntilState(ctx interface{}, wref interface{}, config interface{}) *MockStorageV1_GetBlockStorageUntilState_Call {
+	return &MockStorageV1_GetBlockStorageUntilState_Call{Call: _e.mock.On("GetBlockStorageUntilState", ctx, wref, config)}
+}
+
+func (_c *MockStorageV1_GetBlockStorageUntilState_Call) Run(run func(ctx context.Context, wref secapi.WorkspaceReference, config secapi.ResourceObserverUntilValueConfig[schema.ResourceState])) *MockStorageV1_GetBlockStorageUntilState_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(secapi.WorkspaceReference), args[2].(secapi.ResourceObserverUntilValueConfig[schema.ResourceState]))
+	})
+	return _c
+}
+
+func (_c *MockStorageV1_GetBlockStorageUntilState_Call) Return(_a0 *schema.BlockStorage, _a1 error) *MockStorageV1_GetBlockStorageUntilState_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockStorageV1_GetBlockStorageUntilState_Call) RunAndReturn(run func(context.Context, secapi.WorkspaceReference, secapi.ResourceObserverUntilValueConfig[schema.ResourceState]) (*schema.BlockStorage, error)) *MockStorageV1_GetBlockStorageUntilState_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetImage provides a mock function with given fields: ctx, tref
+func (_m *MockStorageV1) GetImage(ctx context.Context, tref secapi.TenantReference) (*schema.Image, error) {
+	ret := _m.Called(ctx, tref)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetImage")
+	}
+
+	var r0 *schema.Image
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, secapi.TenantReference) (*schema.Image, error)); ok {
+		return rf(ctx, tref)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, secapi.TenantReference) *schema.Image); ok {
+		r0 = rf(ctx, tref)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*schema.Image)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, secapi.TenantReference) error); ok {
+		r1 = rf(ctx, tref)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockStorageV1_GetImage_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetImage'
+type MockStorageV1_GetImage_Call struct {
+	*mock.Call
+}
+
+// GetImage is a helper method to define mock.On call
+//   - ctx context.Context
+//   - tref secapi.TenantReference
+func (_e *MockStorageV1_Expecter) GetImage(ctx interface{}, tref interface{}) *MockStorageV1_GetImage_Call {
+	return &MockStorageV1_GetImage_Call{Call: _e.mock.On("GetImage", ctx, tref)}
+}
+
+func (_c *MockStorageV1_GetImage_Call) Run(run func(ctx context.Context, tref secapi.TenantReference)) *MockStorageV1_GetImage_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(secapi.TenantReference))
+	})
+	return _c
+}
+
+func (_c *MockStorageV1_GetImage_Call) Return(_a0 *schema.Image, _a1 error) *MockStorageV1_GetImage_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockStorageV1_GetImage_Call) RunAndReturn(run func(context.Context, secapi.TenantReference) (*schema.Image, error)) *MockStorageV1_GetImage_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetImageUntilState provides a mock function with given fields: ctx, tref, config
+func (_m *MockStorageV1) GetImageUntilState(ctx context.Context, tref secapi.TenantReference, config secapi.ResourceObserverUntilValueConfig[schema.ResourceState]) (*schema.Image, error) {
+	ret := _m.Called(ctx, tref, config)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetImageUntilState")
+	}
+
+	var r0 *schema.Image
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, secapi.TenantReference, secapi.ResourceObserverUntilValueConfig[schema.ResourceState]) (*schema.Image, error)); ok {
+		return rf(ctx, tref, config)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, secapi.TenantReference, secapi.ResourceObserverUntilValueConfig[schema.ResourceState]) *schema.Image); ok {
+		r0 = rf(ctx, tref, config)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*schema.Image)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, secapi.TenantReference, secapi.ResourceObserverUntilValueConfig[schema.ResourceState]) error); ok {
+		r1 = rf(ctx, tref, config)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockStorageV1_GetImageUntilState_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetImageUntilState'
+type MockStorageV1_GetImageUntilState_Call struct {
+	*mock.Call
+}
+
+// GetImageUntilState is a helper method to define mock.On call
+//   - ctx context.Context
+//   - tref secapi.TenantReference
+//   - config secapi.ResourceObserverUntilValueConfig[schema.ResourceState]
+func (_e *MockStorageV1_Expecter) GetImageUntilState(ctx interface{}, tref interface{}, config interface{}) *MockStorageV1_GetImageUntilState_Call {
+	return &MockStorageV1_GetImageUntilState_Call{Call: _e.mock.On("GetImageUntilState", ctx, tref, config)}
+}
+
+func (_c *MockStorageV1_GetImageUntilState_Call) Run(run func(ctx context.Context, tref secapi.TenantReference, config secapi.ResourceObserverUntilValueConfig[schema.ResourceState])) *MockStorageV1_GetImageUntilState_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(secapi.TenantReference), args[2].(secapi.ResourceObserverUntilValueConfig[schema.ResourceState]))
+	})
+	return _c
+}
+
+func (_c *MockStorageV1_GetImageUntilState_Call) Return(_a0 *schema.Image, _a1 error) *MockStorageV1_GetImageUntilState_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockStorageV1_GetImageUntilState_Call) RunAndReturn(run func(context.Context, secapi.TenantReference, secapi.ResourceObserverUntilValueConfig[schema.ResourceState]) (*schema.Image, error)) *MockStorageV1_GetImageUntilState_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetSku provides a mock function with given fields: ctx, tref
+func (_m *MockStorageV1) GetSku(ctx context.Context, tref secapi.TenantReference) (*schema.StorageSku, error) {
+	ret := _m.Called(ctx, tref)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetSku")
+	}
+
+	var r0 *schema.StorageSku
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, secapi.TenantReference) (*schema.StorageSku, error)); ok {
+		return rf(ctx, tref)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, secapi.TenantReference) *schema.StorageSku); ok {
+		r0 = rf(ctx, tref)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*schema.StorageSku)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, secapi.TenantReference) error); ok {
+		r1 = rf(ctx, tref)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockStorageV1_GetSku_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetSku'
+type MockStorageV1_GetSku_Call struct {
+	*mock.Call
+}
+
+// GetSku is a helper method to define mock.On call
+//   - ctx context.Context
+//   - tref secapi.TenantReference
+func (_e *MockStorageV1_Expecter) GetSku(ctx interface{}, tref interface{}) *MockStorageV1_GetSku_Call {
+	return &MockStorageV1_GetSku_Call{Call: _e.mock.On("GetSku", ctx, tref)}
+}
+
+func (_c *MockStorageV1_GetSku_Call) Run(run func(ctx context.Context, tref secapi.TenantReference)) *MockStorageV1_GetSku_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(secapi.TenantReference))
+	})
+	return _c
+}
+
+func (_c *MockStorageV1_GetSku_Call) Return(_a0 *schema.StorageSku, _a1 error) *MockStorageV1_GetSku_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockStorageV1_GetSku_Call) RunAndReturn(run func(context.Context, secapi.TenantReference) (*schema.StorageSku, error)) *MockStorageV1_GetSku_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ListBlockStorages provides a mock function with given fields: ctx, wpath
+func (_m *MockStorageV1) ListBlockStorages(ctx context.Context, wpath secapi.WorkspacePath) (*secapi.Iterator[schema.BlockStorage], error) {
+	ret := _m.Called(ctx, wpath)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListBlockStorages")
+	}
+
+	var r0 *secapi.Iterator[schema.BlockStorage]
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, secapi.WorkspacePath) (*secapi.Iterator[schema.BlockStorage], error)); ok {
+		return rf(ctx, wpath)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, secapi.WorkspacePath) *secapi.Iterator[schema.BlockStorage]); ok {
+		r0 = rf(ctx, wpath)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*secapi.Iterator[schema.BlockStorage])
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, secapi.WorkspacePath) error); ok {
+		r1 = rf(ctx, wpath)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockStorageV1_ListBlockStorages_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListBlockStorages'
+type MockStorageV1_ListBlockStorages_Call struct {
+	*mock.Call
+}
+
+// ListBlockStorages is a helper method to define mock.On call
+//   - ctx context.Context
+//   - wpath secapi.WorkspacePath
+func (_e *MockStorageV1_Expecter) ListBlockStorages(ctx interface{}, wpath interface{}) *MockStorageV1_ListBlockStorages_Call {
+	return &MockStorageV1_ListBlockStorages_Call{Call: _e.mock.On("ListBlockStorages", ctx, wpath)}
+}
+
+func (_c *MockStorageV1_ListBlockStorages_Call) Run(run func(ctx context.Context, wpath secapi.WorkspacePath)) *MockStorageV1_ListBlockStorages_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(secapi.WorkspacePath))
+	})
+	return _c
+}
+
+func (_c *MockStorageV1_ListBlockStorages_Call) Return(_a0 *secapi.Iterator[schema.BlockStorage], _a1 error) *MockStorageV1_ListBlockStorages_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockStorageV1_ListBlockStorages_Call) RunAndReturn(run func(context.Context, secapi.WorkspacePath) (*secapi.Iterator[schema.BlockStorage], error)) *MockStorageV1_ListBlockStorages_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ListBlockStoragesWithOptions provides a mock function with given fields: ctx, wpath, options
+func (_m *MockStorageV1) ListBlockStoragesWithOptions(ctx context.Context, wpath secapi.WorkspacePath, options *secapi.ListOptions) (*secapi.Iterator[schema.BlockStorage], error) {
+	ret := _m.Called(ctx, wpath, options)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListBlockStoragesWithOptions")
+	}
+
+	var r0 *secapi.Iterator[schema.BlockStorage]
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, secapi.WorkspacePath, *secapi.ListOptions) (*secapi.Iterator[schema.BlockStorage], error)); ok {
+		return rf(ctx, wpath, options)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, secapi.WorkspacePath, *secapi.ListOptions) *secapi.Iterator[schema.BlockStorage]); ok {
+		r0 = rf(ctx, wpath, options)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*secapi.Iterator[schema.BlockStorage])
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, secapi.WorkspacePath, *secapi.ListOptions) error); ok {
+		r1 = rf(ctx, wpath, options)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockStorageV1_ListBlockStoragesWithOptions_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListBlockStoragesWithOptions'
+type MockStorageV1_ListBlockStoragesWithOptions_Call struct {
+	*mock.Call
+}
+
+// ListBlockStoragesWithOptions is a helper method to define mock.On call
+//   - ctx context.Context
+//   - wpath secapi.WorkspacePath
+//   - options *secapi.ListOptions
+func (_e *MockStorageV1_Expecter) ListBlockStoragesWithOptions(ctx interface{}, wpath interface{}, options interface{}) *MockStorageV1_ListBlockStoragesWithOptions_Call {
+	return &MockStorageV1_ListBlockStoragesWithOptions_Call{Call: _e.mock.On("ListBlockStoragesWithOptions", ctx, wpath, options)}
+}
+
+func (_c *MockStorageV1_ListBlockStoragesWithOptions_Call) Run(run func(ctx context.Context, wpath secapi.WorkspacePath, options *secapi.ListOptions)) *MockStorageV1_ListBlockStoragesWithOptions_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(secapi.WorkspacePath), args[2].(*secapi.ListOptions))
+	})
+	return _c
+}
+
+func (_c *MockStorageV1_ListBlockStoragesWithOptions_Call) Return(_a0 *secapi.Iterator[schema.BlockStorage], _a1 error) *MockStorageV1_ListBlockStoragesWithOptions_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockStorageV1_ListBlockStoragesWithOptions_Call) RunAndReturn(run func(context.Context, secapi.WorkspacePath, *secapi.ListOptions) (*secapi.Iterator[schema.BlockStorage], error)) *MockStorageV1_ListBlockStoragesWithOptions_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ListImages provides a mock function with given fields: ctx, tpath
+func (_m *MockStorageV1) ListImages(ctx context.Context, tpath secapi.TenantPath) (*secapi.Iterator[schema.Image], error) {
+	ret := _m.Called(ctx, tpath)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListImages")
+	}
+
+	var r0 *secapi.Iterator[schema.Image]
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, secapi.TenantPath) (*secapi.Iterator[schema.Image], error)); ok {
+		return rf(ctx, tpath)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, secapi.TenantPath) *secapi.Iterator[schema.Image]); ok {
+		r0 = rf(ctx, tpath)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*secapi.Iterator[schema.Image])
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, secapi.TenantPath) error); ok {
+		r1 = rf(ctx, tpath)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockStorageV1_ListImages_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListImages'
+type MockStorageV1_ListImages_Call struct {
+	*mock.Call
+}
+
+// ListImages is a helper method to define mock.On call
+//   - ctx context.Context
+//   - tpath secapi.TenantPath
+func (_e *MockStorageV1_Expecter) ListImages(ctx interface{}, tpath interface{}) *MockStorageV1_ListImages_Call {
+	return &MockStorageV1_ListImages_Call{Call: _e.mock.On("ListImages", ctx, tpath)}
+}
+
+func (_c *MockStorageV1_ListImages_Call) Run(run func(ctx context.Context, tpath secapi.TenantPath)) *MockStorageV1_ListImages_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(secapi.TenantPath))
+	})
+	return _c
+}
+
+func (_c *MockStorageV1_ListImages_Call) Return(_a0 *secapi.Iterator[schema.Image], _a1 error) *MockStorageV1_ListImages_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockStorageV1_ListImages_Call) RunAndReturn(run func(context.Context, secapi.TenantPath) (*secapi.Iterator[schema.Image], error)) *MockStorageV1_ListImages_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ListImagesWithOptions provides a mock function with given fields: ctx, tpath, options
+func (_m *MockStorageV1) ListImagesWithOptions(ctx context.Context, tpath secapi.TenantPath, options *secapi.ListOptions) (*secapi.Iterator[schema.Image], error) {
+	ret := _m.Called(ctx, tpath, options)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListImagesWithOptions")
+	}
+
+	var r0 *secapi.Iterator[schema.Image]
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, secapi.TenantPath, *secapi.ListOptions) (*secapi.Iterator[schema.Image], error)); ok {
+		return rf(ctx, tpath, options)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, secapi.TenantPath, *secapi.ListOptions) *secapi.Iterator[schema.Image]); ok {
+		r0 = rf(ctx, tpath, options)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*secapi.Iterator[schema.Image])
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, secapi.TenantPath, *secapi.ListOptions) error); ok {
+		r1 = rf(ctx, tpath, options)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockStorageV1_ListImagesWithOptions_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListImagesWithOptions'
+type MockStorageV1_ListImagesWithOptions_Call struct {
+	*mock.Call
+}
+
+// ListImagesWithOptions is a helper method to define mock.On call
+//   - ctx context.Context
+//   - tpath secapi.TenantPath
+//   - options *secapi.ListOptions
+func (_e *MockStorageV1_Expecter) ListImagesWithOptions(ctx interface{}, tpath interface{}, options interface{}) *MockStorageV1_ListImagesWithOptions_Call {
+	return &MockStorageV1_ListImagesWithOptions_Call{Call: _e.mock.On("ListImagesWithOptions", ctx, tpath, options)}
+}
+
+func (_c *MockStorageV1_ListImagesWithOptions_Call) Run(run func(ctx context.Context, tpath secapi.TenantPath, options *secapi.ListOptions)) *MockStorageV1_ListImagesWithOptions_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(secapi.TenantPath), args[2].(*secapi.ListOptions))
+	})
+	return _c
+}
+
+func (_c *MockStorageV1_ListImagesWithOptions_Call) Return(_a0 *secapi.Iterator[schema.Image], _a1 error) *MockStorageV1_ListImagesWithOptions_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockStorageV1_ListImagesWithOptions_Call) RunAndReturn(run func(context.Context, secapi.TenantPath, *secapi.ListOptions) (*secapi.Iterator[schema.Image], error)) *MockStorageV1_ListImagesWithOptions_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ListSkus provides a mock function with given fields: ctx, tpath
+func (_m *MockStorageV1) ListSkus(ctx context.Context, tpath secapi.TenantPath) (*secapi.Iterator[schema.StorageSku], error) {
+	ret := _m.Called(ctx, tpath)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListSkus")
+	}
+
+	var r0 *secapi.Iterator[schema.StorageSku]
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, secapi.TenantPath) (*secapi.Iterator[schema.StorageSku], error)); ok {
+		return rf(ctx, tpath)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, secapi.TenantPath) *secapi.Iterator[schema.StorageSku]); ok {
+		r0 = rf(ctx, tpath)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*secapi.Iterator[schema.StorageSku])
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, secapi.TenantPath) error); ok {
+		r1 = rf(ctx, tpath)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockStorageV1_ListSkus_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListSkus'
+type MockStorageV1_ListSkus_Call struct {
+	*mock.Call
+}
+
+// ListSkus is a helper method to define mock.On call
+//   - ctx context.Context
+//   - tpath secapi.TenantPath
+func (_e *MockStorageV1_Expecter) ListSkus(ctx interface{}, tpath interface{}) *MockStorageV1_ListSkus_Call {
+	return &MockStorageV1_ListSkus_Call{Call: _e.mock.On("ListSkus", ctx, tpath)}
+}
+
+func (_c *MockStorageV1_ListSkus_Call) Run(run func(ctx context.Context, tpath secapi.TenantPath)) *MockStorageV1_ListSkus_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(secapi.TenantPath))
+	})
+	return _c
+}
+
+func (_c *MockStorageV1_ListSkus_Call) Return(_a0 *secapi.Iterator[schema.StorageSku], _a1 error) *MockStorageV1_ListSkus_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockStorageV1_ListSkus_Call) RunAndReturn(run func(context.Context, secapi.TenantPath) (*secapi.Iterator[schema.StorageSku], error)) *MockStorageV1_ListSkus_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ListSkusWithOptions provides a mock function with given fields: ctx, tpath, options
+func (_m *MockStorageV1) ListSkusWithOptions(ctx context.Context, tpath secapi.TenantPath, options *secapi.ListOptions) (*secapi.Iterator[schema.StorageSku], error) {
+	ret := _m.Called(ctx, tpath, options)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListSkusWithOptions")
+	}
+
+	var r0 *secapi.Iterator[schema.StorageSku]
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, secapi.TenantPath, *secapi.ListOptions) (*secapi.Iterator[schema.StorageSku], error)); ok {
+		return rf(ctx, tpath, options)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, secapi.TenantPath, *secapi.ListOptions) *secapi.Iterator[schema.StorageSku]); ok {
+		r0 = rf(ctx, tpath, options)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*secapi.Iterator[schema.StorageSku])
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, secapi.TenantPath, *secapi.ListOptions) error); ok {
+		r1 = rf(ctx, tpath, options)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockStorageV1_ListSkusWithOptions_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListSkusWithOptions'
+type MockStorageV1_ListSkusWithOptions_Call struct {
+	*mock.Call
+}
+
+// ListSkusWithOptions is a helper method to define mock.On call
+//   - ctx context.Context
+//   - tpath secapi.TenantPath
+//   - options *secapi.ListOptions
+func (_e *MockStorageV1_Expecter) ListSkusWithOptions(ctx interface{}, tpath interface{}, options interface{}) *MockStorageV1_ListSkusWithOptions_Call {
+	return &MockStorageV1_ListSkusWithOptions_Call{Call: _e.mock.On("ListSkusWithOptions", ctx, tpath, options)}
+}
+
+func (_c *MockStorageV1_ListSkusWithOptions_Call) Run(run func(ctx context.Context, tpath secapi.TenantPath, options *secapi.ListOptions)) *MockStorageV1_ListSkusWithOptions_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(secapi.TenantPath), args[2].(*secapi.ListOptions))
+	})
+	return _c
+}
+
+func (_c *MockStorageV1_ListSkusWithOptions_Call) Return(_a0 *secapi.Iterator[schema.StorageSku], _a1 error) *MockStorageV1_ListSkusWithOptions_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockStorageV1_ListSkusWithOptions_Call) RunAndReturn(run func(context.Context, secapi.TenantPath, *secapi.ListOptions) (*secapi.Iterator[schema.StorageSku], error)) *MockStorageV1_ListSkusWithOptions_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// WatchBlockStorageUntilDeleted provides a mock function with given fields: ctx, wref, config
+func (_m *MockStorageV1) WatchBlockStorageUntilDeleted(ctx context.Context, wref secapi.WorkspaceReference, config secapi.ResourceObserverConfig) error {
+	ret := _m.Called(ctx, wref, config)
+
+	if len(ret) == 0 {
+		panic("no return value specified for WatchBlockStorageUntilDeleted")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, secapi.WorkspaceReference, secapi.ResourceObserverConfig) error); ok {
+		r0 = rf(ctx, wref, config)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockStorageV1_WatchBlockStorageUntilDeleted_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'WatchBlockStorageUntilDeleted'
+type MockStorageV1_WatchBlockStorageUntilDeleted_Call struct {
+	*mock.Call
+}
+
+// WatchBlockStorageUntilDeleted is a helper method to define mock.On call
+//   - ctx context.Context
+//   - wref secapi.WorkspaceReference
+//   - config secapi.ResourceObserverConfig
+func (_e *MockStorageV1_Expecter) WatchBlockStorageUntilDeleted(ctx interface{}, wref interface{}, config interface{}) *MockStorageV1_WatchBlockStorageUntilDeleted_Call {
+	return &MockStorageV1_WatchBlockStorageUntilDeleted_Call{Call: _e.mock.On("WatchBlockStorageUntilDeleted", ctx, wref, config)}
+}
+
+func (_c *MockStorageV1_WatchBlockStorageUntilDeleted_Call) Run(run func(ctx context.Context, wref secapi.WorkspaceReference, config secapi.ResourceObserverConfig)) *MockStorageV1_WatchBlockStorageUntilDeleted_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(secapi.WorkspaceReference), args[2].(secapi.ResourceObserverConfig))
+	})
+	return _c
+}
+
+func (_c *MockStorageV1_WatchBlockStorageUntilDeleted_Call) Return(_a0 error) *MockStorageV1_WatchBlockStorageUntilDeleted_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockStorageV1_WatchBlockStorageUntilDeleted_Call) RunAndReturn(run func(context.Context, secapi.WorkspaceReference, secapi.ResourceObserverConfig) error) *MockStorageV1_WatchBlockStorageUntilDeleted_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// WatchImageUntilDeleted provides a mock function with given fields: ctx, tref, config
+func (_m *MockStorageV1) WatchImageUntilDeleted(ctx context.Context, tref secapi.TenantReference, config secapi.ResourceObserverConfig) error {
+	ret := _m.Called(ctx, tref, config)
+
+	if len(ret) == 0 {
+		panic("no return value specified for WatchImageUntilDeleted")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, secapi.TenantReference, secapi.ResourceObserverConfig) error); ok {
+		r0 = rf(ctx, tref, config)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockStorageV1_WatchImageUntilDeleted_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'WatchImageUntilDeleted'
+type MockStorageV1_WatchImageUntilDeleted_Call struct {
+	*mock.Call
+}
+
+// WatchImageUntilDeleted is a helper method to define mock.On call
+//   - ctx context.Context
+//   - tref secapi.TenantReference
+//   - config secapi.ResourceObserverConfig
+func (_e *MockStorageV1_Expecter) WatchImageUntilDeleted(ctx interface{}, tref interface{}, config interface{}) *MockStorageV1_WatchImageUntilDeleted_Call {
+	return &MockStorageV1_WatchImageUntilDeleted_Call{Call: _e.mock.On("WatchImageUntilDeleted", ctx, tref, config)}
+}
+
+func (_c *MockStorageV1_WatchImageUntilDeleted_Call) Run(run func(ctx context.Context, tref secapi.TenantReference, config secapi.ResourceObserverConfig)) *MockStorageV1_WatchImageUntilDeleted_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(secapi.TenantReference), args[2].(secapi.ResourceObserverConfig))
+	})
+	return _c
+}
+
+func (_c *MockStorageV1_WatchImageUntilDeleted_Call) Return(_a0 error) *MockStorageV1_WatchImageUntilDeleted_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockStorageV1_WatchImageUntilDeleted_Call) RunAndReturn(run func(context.Context, secapi.TenantReference, secapi.ResourceObserverConfig) error) *MockStorageV1_WatchImageUntilDeleted_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockStorageV1 creates a new instance of MockStorageV1. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockStorageV1(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockStorageV1 {
+	mock := &MockStorageV1{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -0,0 +1,95 @@
+// Code generated by mockery v2.52.2. DO NOT EDIT.
+
+package mocksecapi
+
+import (
+	context "context"
+
+	schema "github.com/eu-sovereign-cloud/go-sdk/pkg/spec/schema"
+	mock "github.com/stretchr/testify/mock"
+)
+
+// MockWellknownV1 is an autogenerated mock type for the WellknownV1 type
+type MockWellknownV1 struct {
+	mock.Mock
+}
+
+type MockWellknownV1_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockWellknownV1) EXPECT() *MockWellknownV1_Expecter {
+	return &MockWellknownV1_Expecter{mock: &_m.Mock}
+}
+
+// GetWellknown provides a mock function with given fields: ctx
+func (_m *MockWellknownV1) GetWellknown(ctx context.Context) (*schema.Wellknown, error) {
+	ret := _m.Called(ctx)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetWellknown")
+	}
+
+	var r0 *schema.Wellknown
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context) (*schema.Wellknown, error)); ok {
+		return rf(ctx)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context) *schema.Wellknown); ok {
+		r0 = rf(ctx)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*schema.Wellknown)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = rf(ctx)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockWellknownV1_GetWellknown_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetWellknown'
+type MockWellknownV1_GetWellknown_Call struct {
+	*mock.Call
+}
+
+// GetWellknown is a helper method to define mock.On call
+//   - ctx context.Context
+func (_e *MockWellknownV1_Expecter) GetWellknown(ctx interface{}) *MockWellknownV1_GetWellknown_Call {
+	return &MockWellknownV1_GetWellknown_Call{Call: _e.mock.On("GetWellknown", ctx)}
+}
+
+func (_c *MockWellknownV1_GetWellknown_Call) Run(run func(ctx context.Context)) *MockWellknownV1_GetWellknown_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context))
+	})
+	return _c
+}
+
+func (_c *MockWellknownV1_GetWellknown_Call) Return(_a0 *schema.Wellknown, _a1 error) *MockWellknownV1_GetWellknown_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockWellknownV1_GetWellknown_Call) RunAndReturn(run func(context.Context) (*schema.Wellknown, error)) *MockWellknownV1_GetWellknown_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockWellknownV1 creates a new instance of MockWellknownV1. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockWellknownV1(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockWellknownV1 {
+	mock := &MockWellknownV1{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -0,0 +1,541 @@
+// Code generated by mockery v2.52.2. DO NOT EDIT.
+
+package mocksecapi
+
+import (
+	context "context"
+
+	schema "github.com/eu-sovereign-cloud/go-sdk/pkg/spec/schema"
+	mock "github.com/stretchr/testify/mock"
+
+	secapi "github.com/eu-sovereign-cloud/go-sdk/secapi"
+
+	workspace "github.com/eu-sovereign-cloud/go-sdk/pkg/spec/foundation.workspace.v1"
+)
+
+// MockWorkspaceV1 is an autogenerated mock type for the WorkspaceV1 type
+type MockWorkspaceV1 struct {
+	mock.Mock
+}
+
+type MockWorkspaceV1_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockWorkspaceV1) EXPECT() *MockWorkspaceV1_Expecter {
+	return &MockWorkspaceV1_Expecter{mock: &_m.Mock}
+}
+
+// CreateOrUpdateWorkspace provides a mock function with given fields: ctx, ws
+func (_m *MockWorkspaceV1) CreateOrUpdateWorkspace(ctx context.Context, ws *schema.Workspace) (*schema.Workspace, error) {
+	ret := _m.Called(ctx, ws)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CreateOrUpdateWorkspace")
+	}
+
+	var r0 *schema.Workspace
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *schema.Workspace) (*schema.Workspace, error)); ok {
+		return rf(ctx, ws)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *schema.Workspace) *schema.Workspace); ok {
+		r0 = rf(ctx, ws)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*schema.Workspace)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *schema.Workspace) error); ok {
+		r1 = rf(ctx, ws)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockWorkspaceV1_CreateOrUpdateWorkspace_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CreateOrUpdateWorkspace'
+type MockWorkspaceV1_CreateOrUpdateWorkspace_Call struct {
+	*mock.Call
+}
+
+// CreateOrUpdateWorkspace is a helper method to define mock.On call
+//   - ctx context.Context
+//   - ws *schema.Workspace
+func (_e *MockWorkspaceV1_Expecter) CreateOrUpdateWorkspace(ctx interface{}, ws interface{}) *MockWorkspaceV1_CreateOrUpdateWorkspace_Call {
+	return &MockWorkspaceV1_CreateOrUpdateWorkspace_Call{Call: _e.mock.On("CreateOrUpdateWorkspace", ctx, ws)}
+}
+
+func (_c *MockWorkspaceV1_CreateOrUpdateWorkspace_Call) Run(run func(ctx context.Context, ws *schema.Workspace)) *MockWorkspaceV1_CreateOrUpdateWorkspace_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*schema.Workspace))
+	})
+	return _c
+}
+
+func (_c *MockWorkspaceV1_CreateOrUpdateWorkspace_Call) Return(_a0 *schema.Workspace, _a1 error) *MockWorkspaceV1_CreateOrUpdateWorkspace_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockWorkspaceV1_CreateOrUpdateWorkspace_Call) RunAndReturn(run func(context.Context, *schema.Workspace) (*schema.Workspace, error)) *MockWorkspaceV1_CreateOrUpdateWorkspace_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// CreateOrUpdateWorkspaceWithParams provides a mock function with given fields: ctx, ws, params
+func (_m *MockWorkspaceV1) CreateOrUpdateWorkspaceWithParams(ctx context.Context, ws *schema.Workspace, params *workspace.CreateOrUpdateWorkspaceParams) (*schema.Workspace, error) {
+	ret := _m.Called(ctx, ws, params)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CreateOrUpdateWorkspaceWithParams")
+	}
+
+	var r0 *schema.Workspace
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *schema.Workspace, *workspace.CreateOrUpdateWorkspaceParams) (*schema.Workspace, error)); ok {
+		return rf(ctx, ws, params)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *schema.Workspace, *workspace.CreateOrUpdateWorkspaceParams) *schema.Workspace); ok {
+		r0 = rf(ctx, ws, params)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*schema.Workspace)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *schema.Workspace, *workspace.CreateOrUpdateWorkspaceParams) error); ok {
+		r1 = rf(ctx, ws, params)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockWorkspaceV1_CreateOrUpdateWorkspaceWithParams_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CreateOrUpdateWorkspaceWithParams'
+type MockWorkspaceV1_CreateOrUpdateWorkspaceWithParams_Call struct {
+	*mock.Call
+}
+
+// CreateOrUpdateWorkspaceWithParams is a helper method to define mock.On call
+//   - ctx context.Context
+//   - ws *schema.Workspace
+//   - params *workspace.CreateOrUpdateWorkspaceParams
+func (_e *MockWorkspaceV1_Expecter) CreateOrUpdateWorkspaceWithParams(ctx interface{}, ws interface{}, params interface{}) *MockWorkspaceV1_CreateOrUpdateWorkspaceWithParams_Call {
+	return &MockWorkspaceV1_CreateOrUpdateWorkspaceWithParams_Call{Call: _e.mock.On("CreateOrUpdateWorkspaceWithParams", ctx, ws, params)}
+}
+
+func (_c *MockWorkspaceV1_CreateOrUpdateWorkspaceWithParams_Call) Run(run func(ctx context.Context, ws *schema.Workspace, params *workspace.CreateOrUpdateWorkspaceParams)) *MockWorkspaceV1_CreateOrUpdateWorkspaceWithParams_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*schema.Workspace), args[2].(*workspace.CreateOrUpdateWorkspaceParams))
+	})
+	return _c
+}
+
+func (_c *MockWorkspaceV1_CreateOrUpdateWorkspaceWithParams_Call) Return(_a0 *schema.Workspace, _a1 error) *MockWorkspaceV1_CreateOrUpdateWorkspaceWithParams_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockWorkspaceV1_CreateOrUpdateWorkspaceWithParams_Call) RunAndReturn(run func(context.Context, *schema.Workspace, *workspace.CreateOrUpdateWorkspaceParams) (*schema.Workspace, error)) *MockWorkspaceV1_CreateOrUpdateWorkspaceWithParams_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// DeleteWorkspace provides a mock function with given fields: ctx, ws
+func (_m *MockWorkspaceV1) DeleteWorkspace(ctx context.Context, ws *schema.Workspace) error {
+	ret := _m.Called(ctx, ws)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DeleteWorkspace")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, *schema.Workspace) error); ok {
+		r0 = rf(ctx, ws)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockWorkspaceV1_DeleteWorkspace_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DeleteWorkspace'
+type MockWorkspaceV1_DeleteWorkspace_Call struct {
+	*mock.Call
+}
+
+// DeleteWorkspace is a helper method to define mock.On call
+//   - ctx context.Context
+//   - ws *schema.Workspace
+func (_e *MockWorkspaceV1_Expecter) DeleteWorkspace(ctx interface{}, ws interface{}) *MockWorkspaceV1_DeleteWorkspace_Call {
+	return &MockWorkspaceV1_DeleteWorkspace_Call{Call: _e.mock.On("DeleteWorkspace", ctx, ws)}
+}
+
+func (_c *MockWorkspaceV1_DeleteWorkspace_Call) Run(run func(ctx context.Context, ws *schema.Workspace)) *MockWorkspaceV1_DeleteWorkspace_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*schema.Workspace))
+	})
+	return _c
+}
+
+func (_c *MockWorkspaceV1_DeleteWorkspace_Call) Return(_a0 error) *MockWorkspaceV1_DeleteWorkspace_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockWorkspaceV1_DeleteWorkspace_Call) RunAndReturn(run func(context.Context, *schema.Workspace) error) *MockWorkspaceV1_DeleteWorkspace_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// DeleteWorkspaceWithParams provides a mock function with given fields: ctx, ws, params
+func (_m *MockWorkspaceV1) DeleteWorkspaceWithParams(ctx context.Context, ws *schema.Workspace, params *workspace.DeleteWorkspaceParams) error {
+	ret := _m.Called(ctx, ws, params)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DeleteWorkspaceWithParams")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, *schema.Workspace, *workspace.DeleteWorkspaceParams) error); ok {
+		r0 = rf(ctx, ws, params)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockWorkspaceV1_DeleteWorkspaceWithParams_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DeleteWorkspaceWithParams'
+type MockWorkspaceV1_DeleteWorkspaceWithParams_Call struct {
+	*mock.Call
+}
+
+// DeleteWorkspaceWithParams is a helper method to define mock.On call
+//   - ctx context.Context
+//   - ws *schema.Workspace
+//   - params *workspace.DeleteWorkspaceParams
+func (_e *MockWorkspaceV1_Expecter) DeleteWorkspaceWithParams(ctx interface{}, ws interface{}, params interface{}) *MockWorkspaceV1_DeleteWorkspaceWithParams_Call {
+	return &MockWorkspaceV1_DeleteWorkspaceWithParams_Call{Call: _e.mock.On("DeleteWorkspaceWithParams", ctx, ws, params)}
+}
+
+func (_c *MockWorkspaceV1_DeleteWorkspaceWithParams_Call) Run(run func(ctx context.Context, ws *schema.Workspace, params *workspace.DeleteWorkspaceParams)) *MockWorkspaceV1_DeleteWorkspaceWithParams_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*schema.Workspace), args[2].(*workspace.DeleteWorkspaceParams))
+	})
+	return _c
+}
+
+func (_c *MockWorkspaceV1_DeleteWorkspaceWithParams_Call) Return(_a0 error) *MockWorkspaceV1_DeleteWorkspaceWithParams_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockWorkspaceV1_DeleteWorkspaceWithParams_Call) RunAndReturn(run func(context.Context, *schema.Workspace, *workspace.DeleteWorkspaceParams) error) *MockWorkspaceV1_DeleteWorkspaceWithParams_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetWorkspace provides a mock function with given fields: ctx, tref
+func (_m *MockWorkspaceV1) GetWorkspace(ctx context.Context, tref secapi.TenantReference) (*schema.Workspace, error) {
+	ret := _m.Called(ctx, tref)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetWorkspace")
+	}
+
+	var r0 *schema.Workspace
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, secapi.TenantReference) (*schema.Workspace, error)); ok {
+		return rf(ctx, tref)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, secapi.TenantReference) *schema.Workspace); ok {
+		r0 = rf(ctx, tref)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*schema.Workspace)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, secapi.TenantReference) error); ok {
+		r1 = rf(ctx, tref)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockWorkspaceV1_GetWorkspace_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetWorkspace'
+type MockWorkspaceV1_GetWorkspace_Call struct {
+	*mock.Call
+}
+
+// GetWorkspace is a helper method to define mock.On call
+//   - ctx context.Context
+//   - tref secapi.TenantReference
+func (_e *MockWorkspaceV1_Expecter) GetWorkspace(ctx interface{}, tref interface{}) *MockWorkspaceV1_GetWorkspace_Call {
+	return &MockWorkspaceV1_GetWorkspace_Call{Call: _e.mock.On("GetWorkspace", ctx, tref)}
+}
+
+func (_c *MockWorkspaceV1_GetWorkspace_Call) Run(run func(ctx context.Context, tref secapi.TenantReference)) *MockWorkspaceV1_GetWorkspace_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(secapi.TenantReference))
+	})
+	return _c
+}
+
+func (_c *MockWorkspaceV1_GetWorkspace_Call) Return(_a0 *schema.Workspace, _a1 error) *MockWorkspaceV1_GetWorkspace_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockWorkspaceV1_GetWorkspace_Call) RunAndReturn(run func(context.Context, secapi.TenantReference) (*schema.Workspace, error)) *MockWorkspaceV1_GetWorkspace_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetWorkspaceUntilState provides a mock function with given fields: ctx, tref, config
+func (_m *MockWorkspaceV1) GetWorkspaceUntilState(ctx context.Context, tref secapi.TenantReference, config secapi.ResourceObserverUntilValueConfig[schema.ResourceState]) (*schema.Workspace, error) {
+	ret := _m.Called(ctx, tref, config)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetWorkspaceUntilState")
+	}
+
+	var r0 *schema.Workspace
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, secapi.TenantReference, secapi.ResourceObserverUntilValueConfig[schema.ResourceState]) (*schema.Workspace, error)); ok {
+		return rf(ctx, tref, config)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, secapi.TenantReference, secapi.ResourceObserverUntilValueConfig[schema.ResourceState]) *schema.Workspace); ok {
+		r0 = rf(ctx, tref, config)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*schema.Workspace)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, secapi.TenantReference, secapi.ResourceObserverUntilValueConfig[schema.ResourceState]) error); ok {
+		r1 = rf(ctx, tref, config)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockWorkspaceV1_GetWorkspaceUntilState_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetWorkspaceUntilState'
+type MockWorkspaceV1_GetWorkspaceUntilState_Call struct {
+	*mock.Call
+}
+
+// GetWorkspaceUntilState is a helper method to define mock.On call
+//   - ctx context.Context
+//   - tref secapi.TenantReference
+//   - config secapi.ResourceObserverUntilValueConfig[schema.ResourceState]
+func (_e *MockWorkspaceV1_Expecter) GetWorkspaceUntilState(ctx interface{}, tref interface{}, config interface{}) *MockWorkspaceV1_GetWorkspaceUntilState_Call {
+	return &MockWorkspaceV1_GetWorkspaceUntilState_Call{Call: _e.mock.On("GetWorkspaceUntilState", ctx, tref, config)}
+}
+
+func (_c *MockWorkspaceV1_GetWorkspaceUntilState_Call) Run(run func(ctx context.Context, tref secapi.TenantReference, config secapi.ResourceObserverUntilValueConfig[schema.ResourceState])) *MockWorkspaceV1_GetWorkspaceUntilState_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(secapi.TenantReference), args[2].(secapi.ResourceObserverUntilValueConfig[schema.ResourceState]))
+	})
+	return _c
+}
+
+func (_c *MockWorkspaceV1_GetWorkspaceUntilState_Call) Return(_a0 *schema.Workspace, _a1 error) *MockWorkspaceV1_GetWorkspaceUntilState_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockWorkspaceV1_GetWorkspaceUntilState_Call) RunAndReturn(run func(context.Context, secapi.TenantReference, secapi.ResourceObserverUntilValueConfig[schema.ResourceState]) (*schema.Workspace, error)) *MockWorkspaceV1_GetWorkspaceUntilState_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ListWorkspaces provides a mock function with given fields: ctx, tpath
+func (_m *MockWorkspaceV1) ListWorkspaces(ctx context.Context, tpath secapi.TenantPath) (*secapi.Iterator[schema.Workspace], error) {
+	ret := _m.Called(ctx, tpath)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListWorkspaces")
+	}
+
+	var r0 *secapi.Iterator[schema.Workspace]
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, secapi.TenantPath) (*secapi.Iterator[schema.Workspace], error)); ok {
+		return rf(ctx, tpath)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, secapi.TenantPath) *secapi.Iterator[schema.Workspace]); ok {
+		r0 = rf(ctx, tpath)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*secapi.Iterator[schema.Workspace])
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, secapi.TenantPath) error); ok {
+		r1 = rf(ctx, tpath)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockWorkspaceV1_ListWorkspaces_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListWorkspaces'
+type MockWorkspaceV1_ListWorkspaces_Call struct {
+	*mock.Call
+}
+
+// ListWorkspaces is a helper method to define mock.On call
+//   - ctx context.Context
+//   - tpath secapi.TenantPath
+func (_e *MockWorkspaceV1_Expecter) ListWorkspaces(ctx interface{}, tpath interface{}) *MockWorkspaceV1_ListWorkspaces_Call {
+	return &MockWorkspaceV1_ListWorkspaces_Call{Call: _e.mock.On("ListWorkspaces", ctx, tpath)}
+}
+
+func (_c *MockWorkspaceV1_ListWorkspaces_Call) Run(run func(ctx context.Context, tpath secapi.TenantPath)) *MockWorkspaceV1_ListWorkspaces_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(secapi.TenantPath))
+	})
+	return _c
+}
+
+func (_c *MockWorkspaceV1_ListWorkspaces_Call) Return(_a0 *secapi.Iterator[schema.Workspace], _a1 error) *MockWorkspaceV1_ListWorkspaces_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockWorkspaceV1_ListWorkspaces_Call) RunAndReturn(run func(context.Context, secapi.TenantPath) (*secapi.Iterator[schema.Workspace], error)) *MockWorkspaceV1_ListWorkspaces_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ListWorkspacesWithOptions provides a mock function with given fields: ctx, tpath, options
+func (_m *MockWorkspaceV1) ListWorkspacesWithOptions(ctx context.Context, tpath secapi.TenantPath, options *secapi.ListOptions) (*secapi.Iterator[schema.Workspace], error) {
+	ret := _m.Called(ctx, tpath, options)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListWorkspacesWithOptions")
+	}
+
+	var r0 *secapi.Iterator[schema.Workspace]
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, secapi.TenantPath, *secapi.ListOptions) (*secapi.Iterator[schema.Workspace], error)); ok {
+		return rf(ctx, tpath, options)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, secapi.TenantPath, *secapi.ListOptions) *secapi.Iterator[schema.Workspace]); ok {
+		r0 = rf(ctx, tpath, options)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*secapi.Iterator[schema.Workspace])
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, secapi.TenantPath, *secapi.ListOptions) error); ok {
+		r1 = rf(ctx, tpath, options)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockWorkspaceV1_ListWorkspacesWithOptions_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListWorkspacesWithOptions'
+type MockWorkspaceV1_ListWorkspacesWithOptions_Call struct {
+	*mock.Call
+}
+
+// ListWorkspacesWithOptions is a helper method to define mock.On call
+//   - ctx context.Context
+//   - tpath secapi.TenantPath
+//   - options *secapi.ListOptions
+func (_e *MockWorkspaceV1_Expecter) ListWorkspacesWithOptions(ctx interface{}, tpath interface{}, options interface{}) *MockWorkspaceV1_ListWorkspacesWithOptions_Call {
+	return &MockWorkspaceV1_ListWorkspacesWithOptions_Call{Call: _e.mock.On("ListWorkspacesWithOptions", ctx, tpath, options)}
+}
+
+func (_c *MockWorkspaceV1_ListWorkspacesWithOptions_Call) Run(run func(ctx context.Context, tpath secapi.TenantPath, options *secapi.ListOptions)) *MockWorkspaceV1_ListWorkspacesWithOptions_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(secapi.TenantPath), args[2].(*secapi.ListOptions))
+	})
+	return _c
+}
+
+func (_c *MockWorkspaceV1_ListWorkspacesWithOptions_Call) Return(_a0 *secapi.Iterator[schema.Workspace], _a1 error) *MockWorkspaceV1_ListWorkspacesWithOptions_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockWorkspaceV1_ListWorkspacesWithOptions_Call) RunAndReturn(run func(context.Context, secapi.TenantPath, *secapi.ListOptions) (*secapi.Iterator[schema.Workspace], error)) *MockWorkspaceV1_ListWorkspacesWithOptions_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// WatchWorkspaceUntilDeleted provides a mock function with given fields: ctx, tref, config
+func (_m *MockWorkspaceV1) WatchWorkspaceUntilDeleted(ctx context.Context, tref secapi.TenantReference, config secapi.ResourceObserverConfig) error {
+	ret := _m.Called(ctx, tref, config)
+
+	if len(ret) == 0 {
+		panic("no return value specified for WatchWorkspaceUntilDeleted")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, secapi.TenantReference, secapi.ResourceObserverConfig) error); ok {
+		r0 = rf(ctx, tref, config)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockWorkspaceV1_WatchWorkspaceUntilDeleted_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'WatchWorkspaceUntilDeleted'
+type MockWorkspaceV1_WatchWorkspaceUntilDeleted_Call struct {
+	*mock.Call
+}
+
+// WatchWorkspaceUntilDeleted is a helper method to define mock.On call
+//   - ctx context.Context
+//   - tref secapi.TenantReference
+//   - config secapi.ResourceObserverConfig
+func (_e *MockWorkspaceV1_Expecter) WatchWorkspaceUntilDeleted(ctx interface{}, tref interface{}, config interface{}) *MockWorkspaceV1_WatchWorkspaceUntilDeleted_Call {
+	return &MockWorkspaceV1_WatchWorkspaceUntilDeleted_Call{Call: _e.mock.On("WatchWorkspaceUntilDeleted", ctx, tref, config)}
+}
+
+func (_c *MockWorkspaceV1_WatchWorkspaceUntilDeleted_Call) Run(run func(ctx context.Context, tref secapi.TenantReference, config secapi.ResourceObserverConfig)) *MockWorkspaceV1_WatchWorkspaceUntilDeleted_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(secapi.TenantReference), args[2].(secapi.ResourceObserverConfig))
+	})
+	return _c
+}
+
+func (_c *MockWorkspaceV1_WatchWorkspaceUntilDeleted_Call) Return(_a0 error) *MockWorkspaceV1_WatchWorkspaceUntilDeleted_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockWorkspaceV1_WatchWorkspaceUntilDeleted_Call) RunAndReturn(run func(context.Context, secapi.TenantReference, secapi.ResourceObserverConfig) error) *MockWorkspaceV1_WatchWorkspaceUntilDeleted_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockWorkspaceV1 creates a new instance of MockWorkspaceV1. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockWorkspaceV1(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockWorkspaceV1 {
+	mock := &MockWorkspaceV1{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -1,3 +1,5 @@
+// Code generated by secagen. DO NOT EDIT.
+
 package types
 
 import (
@@ -14,27 +16,27 @@ type ResourceType interface {
 		schema.Role |
 		schema.RoleAssignment |
 		schema.Workspace |
-		schema.BlockStorage |
+		schema.InstanceSku |
+		schema.Instance |
 		schema.StorageSku |
+		schema.BlockStorage |
 		schema.Image |
-		schema.Instance |
-		schema.InstanceSku |
-		schema.Network |
 		schema.NetworkSku |
-		schema.InternetGateway |
-		schema.RouteTable |
+		schema.Network |
 		schema.Subnet |
-		schema.PublicIp |
-		schema.Nic |
+		schema.RouteTable |
+		schema.InternetGateway |
 		schema.SecurityGroupRule |
-		schema.SecurityGroup
+		schema.SecurityGroup |
+		schema.Nic |
+		schema.PublicIp
 }
 
 type MetadataType interface {
 	schema.GlobalResourceMetadata |
 		schema.GlobalTenantResourceMetadata |
-		schema.SkuResourceMetadata |
 		schema.RegionalResourceMetadata |
+		schema.SkuResourceMetadata |
 		schema.RegionalWorkspaceResourceMetadata |
 		schema.RegionalNetworkResourceMetadata
 }
@@ -44,51 +46,51 @@ type SpecType interface {
 		schema.RoleSpec |
 		schema.RoleAssignmentSpec |
 		schema.WorkspaceSpec |
-		schema.BlockStorageSpec |
+		schema.InstanceSkuSpec |
+		schema.InstanceSpec |
 		schema.StorageSkuSpec |
+		schema.BlockStorageSpec |
 		schema.ImageSpec |
-		schema.InstanceSpec |
-		schema.InstanceSkuSpec |
-		schema.NetworkSpec |
 		schema.NetworkSkuSpec |
-		schema.InternetGatewaySpec |
-		schema.RouteTableSpec |
+		schema.NetworkSpec |
 		schema.SubnetSpec |
-		schema.PublicIpSpec |
-		schema.NicSpec |
+		schema.RouteTableSpec |
+		schema.InternetGatewaySpec |
 		schema.SecurityGroupRuleSpec |
-		schema.SecurityGroupSpec
+		schema.SecurityGroupSpec |
+		schema.NicSpec |
+		schema.PublicIpSpec
 }
 
 type StatusType interface {
 	schema.Status |
 		schema.WorkspaceStatus |
+		schema.InstanceStatus |
 		schema.BlockStorageStatus |
 		schema.ImageStatus |
-		schema.InstanceStatus |
 		schema.NetworkStatus |
 		schema.SubnetStatus |
 		schema.RouteTableStatus |
+		schema.SecurityGroupStatus |
 		schema.NicStatus |
-		schema.PublicIpStatus |
-		schema.SecurityGroupStatus
+		schema.PublicIpStatus
 }
 
 type IteratorType interface {
 	authorization.RoleIterator |
 		authorization.RoleAssignmentIterator |
 		workspace.WorkspaceIterator |
+		compute.InstanceIterator |
 		storage.BlockStorageIterator |
 		storage.ImageIterator |
-		compute.InstanceIterator |
 		network.NetworkIterator |
-		network.InternetGatewayIterator |
-		network.RouteTableIterator |
 		network.SubnetIterator |
-		network.PublicIpIterator |
-		network.NicIterator |
+		network.RouteTableIterator |
+		network.InternetGatewayIterator |
 		network.SecurityGroupRuleIterator |
-		network.SecurityGroupIterator
+		network.SecurityGroupIterator |
+		network.NicIterator |
+		network.PublicIpIterator
 }
 
 func GetStatusState[S StatusType](status *S) schema.ResourceState {
@@ -101,24 +103,24 @@ func GetStatusState[S StatusType](status *S) schema.ResourceState {
 		return v.State
 	case schema.WorkspaceStatus:
 		return v.State
+	case schema.InstanceStatus:
+		return v.State
 	case schema.BlockStorageStatus:
 		return v.State
 	case schema.ImageStatus:
 		return v.State
-	case schema.InstanceStatus:
-		return v.State
 	case schema.NetworkStatus:
 		return v.State
 	case schema.SubnetStatus:
 		return v.State
 	case schema.RouteTableStatus:
 		return v.State
+	case schema.SecurityGroupStatus:
+		return v.State
 	case schema.NicStatus:
 		return v.State
 	case schema.PublicIpStatus:
 		return v.State
-	case schema.SecurityGroupStatus:
-		return v.State
 	default:
 		return ""
 	}
@@ -134,24 +136,24 @@ func GetStatusConditions[S StatusType](status *S) []schema.StatusCondition {
 		return v.Conditions
 	case schema.WorkspaceStatus:
 		return v.Conditions
+	case schema.InstanceStatus:
+		return v.Conditions
 	case schema.BlockStorageStatus:
 		return v.Conditions
 	case schema.ImageStatus:
 		return v.Conditions
-	case schema.InstanceStatus:
-		return v.Conditions
 	case schema.NetworkStatus:
 		return v.Conditions
 	case schema.SubnetStatus:
 		return v.Conditions
 	case schema.RouteTableStatus:
 		return v.Conditions
+	case schema.SecurityGroupStatus:
+		return v.Conditions
 	case schema.NicStatus:
 		return v.Conditions
 	case schema.PublicIpStatus:
 		return v.Conditions
-	case schema.SecurityGroupStatus:
-		return v.Conditions
 	default:
 		return nil
 	}
@@ -70,3 +70,23 @@ func (api *API) validateWorkspaceMetadata(metadata *schema.RegionalWorkspaceReso
 
 	return nil
 }
+
+func (api *API) validateNetworkMetadata(metadata *schema.RegionalNetworkResourceMetadata) error {
+	if metadata == nil {
+		return ErrNoMetadata
+	}
+
+	if metadata.Tenant == "" {
+		return ErrNoMetadataTenant
+	}
+
+	if metadata.Workspace == "" {
+		return ErrNoMetadataWorkspace
+	}
+
+	if metadata.Network == "" {
+		return ErrNoMetadataNetwork
+	}
+
+	return nil
+}
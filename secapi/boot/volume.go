@@ -0,0 +1,216 @@
+// Package boot creates the boot volumes of the instances: a block storage created from an image,
+// sized for the image and checked against the instance SKU, ready to be wired as the boot volume
+// of an instance.
+package boot
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/eu-sovereign-cloud/go-sdk/pkg/spec/schema"
+	"github.com/eu-sovereign-cloud/go-sdk/secapi"
+	"github.com/eu-sovereign-cloud/go-sdk/secapi/builders"
+	"github.com/eu-sovereign-cloud/go-sdk/secapi/skus"
+)
+
+// The instance SKU labels with the CPU architecture and the boot modes supported by the instances.
+// The boot modes are comma separated, as UEFI,BIOS. A SKU without a label supports any value.
+const (
+	ArchitectureLabel = "architecture"
+	BootLabel         = "boot"
+)
+
+const megabytesPerGigabyte = 1024
+
+// DefaultObserver is the wait for the volume to be active used for the zero fields of
+// Request.Observer.
+var DefaultObserver = secapi.ResourceObserverConfig{
+	Delay:       time.Second,
+	Interval:    2 * time.Second,
+	MaxAttempts: 60,
+}
+
+var (
+	ErrImageNotReady         = errors.New("image is not active")
+	ErrUnknownImageSize      = errors.New("image size is unknown")
+	ErrArchitectureMismatch  = errors.New("image cpu architecture is not supported by the instance sku")
+	ErrBootMismatch          = errors.New("image boot mode is not supported by the instance sku")
+	ErrVolumeTooSmall        = errors.New("volume is smaller than the minimum size of the storage sku")
+	ErrVolumeNotReady        = errors.New("volume did not become active")
+	ErrInvalidImageReference = errors.New("invalid image reference")
+	ErrInvalidSkuReference   = errors.New("invalid sku reference")
+	ErrNoStorageSku          = errors.New("no storage sku nor storage requirements")
+)
+
+// Request describes a boot volume. The storage SKU is either given or selected by the storage
+// requirements, whose size is set from the volume size. The volume is at least as large as the
+// image, SizeGB only makes it larger. Observer configures the wait for the volume to be active,
+// its zero fields are taken from DefaultObserver.
+type Request struct {
+	Name      string
+	Tenant    string
+	Workspace string
+	Region    string
+	Labels    schema.Labels
+
+	Image       schema.Reference
+	InstanceSku schema.Reference
+	StorageSku  *schema.Reference
+	Storage     *skus.StorageRequirements
+	SizeGB      int
+
+	Observer secapi.ResourceObserverConfig
+}
+
+// CreateVolume creates the block storage of the request from its image, once the image is checked
+// against the instance SKU, then waits for it to be active. It returns the reference of the volume,
+// to be set as the instance boot volume.
+func CreateVolume(ctx context.Context, compute secapi.ComputeV1, storage secapi.StorageV1, req *Request) (schema.Reference, error) {
+	tenant := secapi.TenantID(req.Tenant)
+
+	imageName, err := refName(req.Image, "images", ErrInvalidImageReference)
+	if err != nil {
+		return schema.Reference{}, err
+	}
+	image, err := storage.GetImage(ctx, secapi.TenantReference{Tenant: tenant, Name: imageName})
+	if err != nil {
+		return schema.Reference{}, err
+	}
+	if image.Status == nil || image.Status.State != schema.ResourceStateActive {
+		return schema.Reference{}, fmt.Errorf("%w: %s", ErrImageNotReady, imageName)
+	}
+
+	skuName, err := refName(req.InstanceSku, "skus", ErrInvalidSkuReference)
+	if err != nil {
+		return schema.Reference{}, err
+	}
+	instanceSku, err := compute.GetSku(ctx, secapi.TenantReference{Tenant: tenant, Name: skuName})
+	if err != nil {
+		return schema.Reference{}, err
+	}
+	if err := CheckImage(image, instanceSku); err != nil {
+		return schema.Reference{}, err
+	}
+
+	if image.Status.SizeMB == nil || *image.Status.SizeMB <= 0 {
+		return schema.Reference{}, fmt.Errorf("%w: %s", ErrUnknownImageSize, imageName)
+	}
+	// The image size rounded up to the next gigabyte
+	sizeGB := max((*image.Status.SizeMB+megabytesPerGigabyte-1)/megabytesPerGigabyte, req.SizeGB)
+
+	storageSku, err := selectStorageSku(ctx, storage, tenant, req, sizeGB)
+	if err != nil {
+		return schema.Reference{}, err
+	}
+
+	volume, err := builders.NewBlockStorageBuilder().
+		Name(req.Name).Tenant(req.Tenant).Workspace(req.Workspace).Region(req.Region).Labels(req.Labels).
+		Sku(storageSku).SizeGB(sizeGB).SourceImage(req.Image).
+		Build()
+	if err != nil {
+		return schema.Reference{}, err
+	}
+	if _, err := storage.CreateOrUpdateBlockStorage(ctx, volume); err != nil {
+		return schema.Reference{}, err
+	}
+
+	wref := secapi.WorkspaceReference{Tenant: tenant, Workspace: secapi.WorkspaceID(req.Workspace), Name: req.Name}
+	observer := req.observer()
+	ready, err := storage.GetBlockStorageUntilState(ctx, wref, secapi.ResourceObserverUntilValueConfig[schema.ResourceState]{
+		ExpectedValues: []schema.ResourceState{schema.ResourceStateActive, schema.ResourceStateError},
+		Delay:          observer.Delay,
+		Interval:       observer.Interval,
+		MaxAttempts:    observer.MaxAttempts,
+	})
+	if err != nil {
+		return schema.Reference{}, err
+	}
+	if ready.Status == nil || ready.Status.State != schema.ResourceStateActive {
+		return schema.Reference{}, fmt.Errorf("%w: %s", ErrVolumeNotReady, req.Name)
+	}
+
+	return builders.Ref(volume), nil
+}
+
+// CheckImage checks that the CPU architecture and the boot mode of the image are supported by the
+// instance SKU, as told by its labels. An image without a boot mode boots with UEFI.
+func CheckImage(image *schema.Image, sku *schema.InstanceSku) error {
+	if arch, found := sku.Labels[ArchitectureLabel]; found && !strings.EqualFold(arch, string(image.Spec.CpuArchitecture)) {
+		return fmt.Errorf("%w: %s on %s", ErrArchitectureMismatch, image.Spec.CpuArchitecture, arch)
+	}
+
+	boot := image.Spec.Boot
+	if boot == "" {
+		boot = schema.ImageSpecBootUEFI
+	}
+	if modes, found := sku.Labels[BootLabel]; found && !containsFold(strings.Split(modes, ","), string(boot)) {
+		return fmt.Errorf("%w: %s on %s", ErrBootMismatch, boot, modes)
+	}
+	return nil
+}
+
+// Returns the observer configuration with its zero fields taken from DefaultObserver
+func (r *Request) observer() secapi.ResourceObserverConfig {
+	observer := r.Observer
+	if observer.Delay <= 0 {
+		observer.Delay = DefaultObserver.Delay
+	}
+	if observer.Interval <= 0 {
+		observer.Interval = DefaultObserver.Interval
+	}
+	if observer.MaxAttempts <= 0 {
+		observer.MaxAttempts = DefaultObserver.MaxAttempts
+	}
+	return observer
+}
+
+// Returns the given storage SKU, or the closest one meeting the requirements
+func selectStorageSku(ctx context.Context, storage secapi.StorageV1, tenant secapi.TenantID, req *Request, sizeGB int) (schema.Reference, error) {
+	if req.StorageSku != nil {
+		skuName, err := refName(*req.StorageSku, "skus", ErrInvalidSkuReference)
+		if err != nil {
+			return schema.Reference{}, err
+		}
+		sku, err := storage.GetSku(ctx, secapi.TenantReference{Tenant: tenant, Name: skuName})
+		if err != nil {
+			return schema.Reference{}, err
+		}
+		if sku.Spec != nil && sku.Spec.MinVolumeSize > sizeGB {
+			return schema.Reference{}, fmt.Errorf("%w: %d GB below %d GB", ErrVolumeTooSmall, sizeGB, sku.Spec.MinVolumeSize)
+		}
+		return *req.StorageSku, nil
+	}
+
+	if req.Storage == nil {
+		return schema.Reference{}, ErrNoStorageSku
+	}
+	requirements := *req.Storage
+	requirements.SizeGB = sizeGB
+
+	sku, err := skus.FindStorageSku(ctx, storage, secapi.TenantPath{Tenant: tenant}, requirements)
+	if err != nil {
+		return schema.Reference{}, err
+	}
+	return builders.Ref(sku), nil
+}
+
+// Returns the name of a reference of the collection, as images/<name>
+func refName(ref schema.Reference, collection string, invalid error) (string, error) {
+	name, found := strings.CutPrefix(ref.Resource, collection+"/")
+	if !found || name == "" || strings.Contains(name, "/") {
+		return "", fmt.Errorf("%w: %s", invalid, ref.Resource)
+	}
+	return name, nil
+}
+
+func containsFold(values []string, value string) bool {
+	for _, v := range values {
+		if strings.EqualFold(strings.TrimSpace(v), value) {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,200 @@
+package boot
+
+import (
+	"context"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/eu-sovereign-cloud/go-sdk/internal/secatest"
+	"github.com/eu-sovereign-cloud/go-sdk/internal/secatest/clients"
+	mocksecapi "github.com/eu-sovereign-cloud/go-sdk/mock/secapi"
+	"github.com/eu-sovereign-cloud/go-sdk/pkg/spec/schema"
+	"github.com/eu-sovereign-cloud/go-sdk/secapi"
+	"github.com/eu-sovereign-cloud/go-sdk/secapi/fake"
+	"github.com/eu-sovereign-cloud/go-sdk/secapi/skus"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+const volumeName = "boot-1"
+
+var (
+	imageRef       = secapi.TenantReference{Tenant: secatest.Tenant1Name, Name: secatest.Image1Name}
+	instanceSkuRef = secapi.TenantReference{Tenant: secatest.Tenant1Name, Name: secatest.InstanceSku1Name}
+	volumeRef      = secapi.WorkspaceReference{Tenant: secatest.Tenant1Name, Workspace: secatest.Workspace1Name, Name: volumeName}
+)
+
+func TestCreateVolume_SelectedSku(t *testing.T) {
+	compute := mocksecapi.NewMockComputeV1(t)
+	storage := mocksecapi.NewMockStorageV1(t)
+
+	storage.EXPECT().GetImage(mock.Anything, imageRef).Return(buildImage(schema.ImageSpecCpuArchitectureArm64, "", 10300), nil)
+	compute.EXPECT().GetSku(mock.Anything, instanceSkuRef).Return(buildInstanceSku("arm64", "UEFI"), nil)
+	storage.EXPECT().ListSkusWithOptions(mock.Anything, secapi.TenantPath{Tenant: secatest.Tenant1Name}, mock.Anything).
+		Return(secapi.NewIterator(func(ctx context.Context, skipToken *string) ([]schema.StorageSku, *schema.ResponseMetadata, error) {
+			return []schema.StorageSku{
+				*buildStorageSku("rd-large", 5000, 50),
+				*buildStorageSku("rd-small", 1000, 1),
+			}, &schema.ResponseMetadata{}, nil
+		}), nil)
+
+	var created *schema.BlockStorage
+	storage.EXPECT().CreateOrUpdateBlockStorage(mock.Anything, mock.Anything).
+		RunAndReturn(func(ctx context.Context, block *schema.BlockStorage) (*schema.BlockStorage, error) {
+			created = block
+			return block, nil
+		})
+	storage.EXPECT().GetBlockStorageUntilState(mock.Anything, volumeRef, mock.Anything).
+		Return(&schema.BlockStorage{Status: &schema.BlockStorageStatus{State: schema.ResourceStateActive}}, nil)
+
+	req := buildRequest()
+	req.Storage = &skus.StorageRequirements{Iops: skus.Range{Min: 500}}
+
+	ref, err := CreateVolume(context.Background(), compute, storage, req)
+	require.NoError(t, err)
+	assert.Equal(t, "block-storages/"+volumeName, ref.Resource)
+
+	require.NotNil(t, created)
+	assert.Equal(t, 11, created.Spec.SizeGB)
+	assert.Equal(t, "skus/rd-small", created.Spec.SkuRef.Resource)
+	assert.Equal(t, "images/"+secatest.Image1Name, created.Spec.SourceImageRef.Resource)
+	assert.Equal(t, secatest.Workspace1Name, created.Metadata.Workspace)
+}
+
+func TestCreateVolume_GivenSku(t *testing.T) {
+	storageSkuRef := secapi.TenantReference{Tenant: secatest.Tenant1Name, Name: "rd-large"}
+
+	compute := mocksecapi.NewMockComputeV1(t)
+	storage := mocksecapi.NewMockStorageV1(t)
+	storage.EXPECT().GetImage(mock.Anything, imageRef).Return(buildImage(schema.ImageSpecCpuArchitectureAmd64, schema.ImageSpecBootBIOS, 2048), nil)
+	compute.EXPECT().GetSku(mock.Anything, instanceSkuRef).Return(buildInstanceSku("amd64", "UEFI,BIOS"), nil)
+	storage.EXPECT().GetSku(mock.Anything, storageSkuRef).Return(buildStorageSku("rd-large", 5000, 50), nil)
+
+	req := buildRequest()
+	req.StorageSku = &schema.Reference{Resource: "skus/rd-large"}
+
+	_, err := CreateVolume(context.Background(), compute, storage, req)
+	assert.ErrorIs(t, err, ErrVolumeTooSmall)
+
+	storage.EXPECT().CreateOrUpdateBlockStorage(mock.Anything, mock.MatchedBy(func(block *schema.BlockStorage) bool {
+		return block.Spec.SizeGB == 50 && block.Spec.SkuRef.Resource == "skus/rd-large"
+	})).Return(&schema.BlockStorage{}, nil)
+	storage.EXPECT().GetBlockStorageUntilState(mock.Anything, volumeRef, mock.Anything).
+		Return(&schema.BlockStorage{Status: &schema.BlockStorageStatus{State: schema.ResourceStateError}}, nil)
+
+	req.SizeGB = 50
+	_, err = CreateVolume(context.Background(), compute, storage, req)
+	assert.ErrorIs(t, err, ErrVolumeNotReady)
+}
+
+func TestCreateVolume_Fake(t *testing.T) {
+	ctx := context.Background()
+
+	// The zero observer of the request waits as the default one, faster for the test
+	defaultObserver := DefaultObserver
+	DefaultObserver = secapi.ResourceObserverConfig{Delay: time.Millisecond, Interval: 5 * time.Millisecond, MaxAttempts: 100}
+	t.Cleanup(func() { DefaultObserver = defaultObserver })
+
+	fakeServer := fake.NewServer(&fake.Config{Schedule: fake.Schedule{Creating: 20 * time.Millisecond}})
+	require.NoError(t, fakeServer.Seed(fake.DefaultRegion,
+		buildImage(schema.ImageSpecCpuArchitectureAmd64, "", 2048),
+		buildInstanceSku("amd64", ""),
+		buildStorageSku("rd-small", 1000, 1),
+	))
+	server := httptest.NewServer(fakeServer.Handler())
+	defer server.Close()
+
+	_, regional := clients.New(t, ctx, secapi.GlobalConfig{Endpoints: fakeServer.Endpoints(server.URL)})
+
+	req := buildRequest()
+	req.StorageSku = &schema.Reference{Resource: "skus/rd-small"}
+	ref, err := CreateVolume(ctx, regional.ComputeV1, regional.StorageV1, req)
+	require.NoError(t, err)
+	assert.Equal(t, "block-storages/"+volumeName, ref.Resource)
+
+	volume, err := regional.StorageV1.GetBlockStorage(ctx, volumeRef)
+	require.NoError(t, err)
+	assert.Equal(t, schema.ResourceStateActive, volume.Status.State)
+	assert.Equal(t, 2, volume.Spec.SizeGB)
+}
+
+func TestCreateVolume_Errors(t *testing.T) {
+	compute := mocksecapi.NewMockComputeV1(t)
+	storage := mocksecapi.NewMockStorageV1(t)
+
+	req := buildRequest()
+	req.Image = schema.Reference{Resource: "block-storages/" + secatest.BlockStorage1Name}
+	_, err := CreateVolume(context.Background(), compute, storage, req)
+	assert.ErrorIs(t, err, ErrInvalidImageReference)
+
+	notReady := buildImage(schema.ImageSpecCpuArchitectureAmd64, "", 2048)
+	notReady.Status.State = schema.ResourceStateCreating
+	storage.EXPECT().GetImage(mock.Anything, imageRef).Return(notReady, nil).Once()
+	_, err = CreateVolume(context.Background(), compute, storage, buildRequest())
+	assert.ErrorIs(t, err, ErrImageNotReady)
+
+	storage.EXPECT().GetImage(mock.Anything, imageRef).Return(buildImage(schema.ImageSpecCpuArchitectureAmd64, "", 2048), nil).Once()
+	compute.EXPECT().GetSku(mock.Anything, instanceSkuRef).Return(buildInstanceSku("arm64", ""), nil).Once()
+	_, err = CreateVolume(context.Background(), compute, storage, buildRequest())
+	assert.ErrorIs(t, err, ErrArchitectureMismatch)
+
+	storage.EXPECT().GetImage(mock.Anything, imageRef).Return(buildImage(schema.ImageSpecCpuArchitectureAmd64, "", 2048), nil).Once()
+	compute.EXPECT().GetSku(mock.Anything, instanceSkuRef).Return(buildInstanceSku("", ""), nil).Once()
+	_, err = CreateVolume(context.Background(), compute, storage, buildRequest())
+	assert.ErrorIs(t, err, ErrNoStorageSku)
+}
+
+func TestCheckImage(t *testing.T) {
+	image := buildImage(schema.ImageSpecCpuArchitectureAmd64, "", 1024)
+
+	assert.NoError(t, CheckImage(image, buildInstanceSku("", "")))
+	assert.NoError(t, CheckImage(image, buildInstanceSku("AMD64", "bios, uefi")))
+	assert.ErrorIs(t, CheckImage(image, buildInstanceSku("amd64", "BIOS")), ErrBootMismatch)
+
+	image.Spec.Boot = schema.ImageSpecBootBIOS
+	assert.NoError(t, CheckImage(image, buildInstanceSku("amd64", "BIOS")))
+}
+
+func buildRequest() *Request {
+	return &Request{
+		Name:        volumeName,
+		Tenant:      secatest.Tenant1Name,
+		Workspace:   secatest.Workspace1Name,
+		Region:      secatest.Region1Name,
+		Image:       schema.Reference{Resource: "images/" + secatest.Image1Name},
+		InstanceSku: schema.Reference{Resource: secatest.InstanceSku1Ref},
+	}
+}
+
+func buildImage(arch schema.ImageSpecCpuArchitecture, boot schema.ImageSpecBoot, sizeMB int) *schema.Image {
+	return &schema.Image{
+		Metadata: secatest.NewRegionalResourceMetadata(secatest.Image1Name, secatest.Tenant1Name, secatest.Region1Name),
+		Spec:     schema.ImageSpec{CpuArchitecture: arch, Boot: boot},
+		Status:   &schema.ImageStatus{State: schema.ResourceStateActive, SizeMB: &sizeMB},
+	}
+}
+
+func buildInstanceSku(arch, boot string) *schema.InstanceSku {
+	labels := schema.Labels{}
+	if arch != "" {
+		labels[ArchitectureLabel] = arch
+	}
+	if boot != "" {
+		labels[BootLabel] = boot
+	}
+	return &schema.InstanceSku{
+		Metadata: secatest.NewSkuResourceMetadata(secatest.InstanceSku1Name, secatest.Tenant1Name),
+		Labels:   labels,
+		Spec:     &schema.InstanceSkuSpec{VCPU: secatest.InstanceSku1VCPU, Ram: secatest.InstanceSku1RAM},
+	}
+}
+
+func buildStorageSku(name string, iops, minVolumeSize int) *schema.StorageSku {
+	return &schema.StorageSku{
+		Metadata: secatest.NewSkuResourceMetadata(name, secatest.Tenant1Name),
+		Spec:     &schema.StorageSkuSpec{Iops: iops, MinVolumeSize: minVolumeSize, Type: schema.StorageSkuTypeRemoteDurable},
+	}
+}
@@ -0,0 +1,145 @@
+package builders
+
+import (
+	"maps"
+	"slices"
+
+	"github.com/eu-sovereign-cloud/go-sdk/pkg/spec/schema"
+)
+
+type RoleBuilder struct {
+	tenantBase[*RoleBuilder]
+
+	spec schema.RoleSpec
+}
+
+func NewRoleBuilder() *RoleBuilder {
+	b := &RoleBuilder{}
+	b.self = b
+	return b
+}
+
+// Permission allows the verbs on the resources of a provider, as seca.compute, instances and get.
+func (b *RoleBuilder) Permission(provider string, resources []string, verbs ...string) *RoleBuilder {
+	b.spec.Permissions = append(b.spec.Permissions, schema.Permission{Provider: provider, Resources: resources, Verb: verbs})
+	return b
+}
+
+func (b *RoleBuilder) Build() (*schema.Role, error) {
+	errs := b.check()
+	if len(b.spec.Permissions) == 0 {
+		errs = append(errs, invalid("permissions", "is required"))
+	}
+	for _, permission := range b.spec.Permissions {
+		if permission.Provider == "" {
+			errs = append(errs, invalid("permissions.provider", "is required"))
+		}
+		if len(permission.Resources) == 0 {
+			errs = append(errs, invalid("permissions.resources", "is required"))
+		}
+		if len(permission.Verb) == 0 {
+			errs = append(errs, invalid("permissions.verb", "is required"))
+		}
+	}
+
+	return build(&schema.Role{
+		Metadata:    b.globalTenantMetadata(schema.ResourceMetadataKindResourceKindRole),
+		Labels:      maps.Clone(b.labels),
+		Annotations: maps.Clone(b.annotations),
+		Extensions:  maps.Clone(b.extensions),
+		Spec:        schema.RoleSpec{Permissions: clonePermissions(b.spec.Permissions)},
+	}, errs)
+}
+
+type RoleAssignmentBuilder struct {
+	tenantBase[*RoleAssignmentBuilder]
+
+	spec schema.RoleAssignmentSpec
+}
+
+func NewRoleAssignmentBuilder() *RoleAssignmentBuilder {
+	b := &RoleAssignmentBuilder{}
+	b.self = b
+	return b
+}
+
+func (b *RoleAssignmentBuilder) Role(roles ...string) *RoleAssignmentBuilder {
+	b.spec.Roles = append(b.spec.Roles, roles...)
+	return b
+}
+
+// RoleOf assigns a role built or read from the provider.
+func (b *RoleAssignmentBuilder) RoleOf(role *schema.Role) *RoleAssignmentBuilder {
+	if role != nil && role.Metadata != nil {
+		b.spec.Roles = append(b.spec.Roles, role.Metadata.Name)
+	}
+	return b
+}
+
+func (b *RoleAssignmentBuilder) Subject(subs ...string) *RoleAssignmentBuilder {
+	b.spec.Subs = append(b.spec.Subs, subs...)
+	return b
+}
+
+func (b *RoleAssignmentBuilder) Scope(scope schema.RoleAssignmentScope) *RoleAssignmentBuilder {
+	b.spec.Scopes = append(b.spec.Scopes, scope)
+	return b
+}
+
+// WorkspaceScope scopes the assignment to a workspace built or read from the provider.
+func (b *RoleAssignmentBuilder) WorkspaceScope(workspace *schema.Workspace) *RoleAssignmentBuilder {
+	if workspace != nil && workspace.Metadata != nil {
+		scope := schema.RoleAssignmentScope{Tenants: []string{workspace.Metadata.Tenant}, Workspaces: []string{workspace.Metadata.Name}}
+		if workspace.Metadata.Region != "" {
+			scope.Regions = []string{workspace.Metadata.Region}
+		}
+		b.spec.Scopes = append(b.spec.Scopes, scope)
+	}
+	return b
+}
+
+func (b *RoleAssignmentBuilder) Build() (*schema.RoleAssignment, error) {
+	errs := b.check()
+	if len(b.spec.Roles) == 0 {
+		errs = append(errs, invalid("roles", "is required"))
+	}
+	if len(b.spec.Subs) == 0 {
+		errs = append(errs, invalid("subs", "is required"))
+	}
+	if len(b.spec.Scopes) == 0 {
+		errs = append(errs, invalid("scopes", "is required"))
+	}
+
+	return build(&schema.RoleAssignment{
+		Metadata:    b.globalTenantMetadata(schema.ResourceMetadataKindResourceKindRoleAssignment),
+		Labels:      maps.Clone(b.labels),
+		Annotations: maps.Clone(b.annotations),
+		Extensions:  maps.Clone(b.extensions),
+		Spec: schema.RoleAssignmentSpec{
+			Roles:  slices.Clone(b.spec.Roles),
+			Scopes: cloneScopes(b.spec.Scopes),
+			Subs:   slices.Clone(b.spec.Subs),
+		},
+	}, errs)
+}
+
+// Returns a copy of the permissions which shares nothing with the builder
+func clonePermissions(permissions []schema.Permission) []schema.Permission {
+	permissions = slices.Clone(permissions)
+	for i := range permissions {
+		permissions[i].Resources = slices.Clone(permissions[i].Resources)
+		permissions[i].Verb = slices.Clone(permissions[i].Verb)
+	}
+	return permissions
+}
+
+// Returns a copy of the scopes which shares nothing with the builder
+func cloneScopes(scopes []schema.RoleAssignmentScope) []schema.RoleAssignmentScope {
+	scopes = slices.Clone(scopes)
+	for i := range scopes {
+		scopes[i].Regions = slices.Clone(scopes[i].Regions)
+		scopes[i].Tenants = slices.Clone(scopes[i].Tenants)
+		scopes[i].Workspaces = slices.Clone(scopes[i].Workspaces)
+	}
+	return scopes
+}
@@ -0,0 +1,62 @@
+package builders
+
+import (
+	"testing"
+
+	"github.com/eu-sovereign-cloud/go-sdk/internal/secatest"
+	"github.com/eu-sovereign-cloud/go-sdk/pkg/spec/schema"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRoleBuilder_Build(t *testing.T) {
+	role, err := NewRoleBuilder().
+		Name(secatest.Role1Name).
+		Tenant(secatest.Tenant1Name).
+		Permission("seca.compute", []string{"instances"}, "get", "list").
+		Build()
+	require.NoError(t, err)
+
+	assert.Equal(t, &schema.GlobalTenantResourceMetadata{
+		Name:   secatest.Role1Name,
+		Tenant: secatest.Tenant1Name,
+		Kind:   schema.GlobalTenantResourceMetadataKindResourceKindRole,
+	}, role.Metadata)
+	assert.Equal(t, []schema.Permission{{Provider: "seca.compute", Resources: []string{"instances"}, Verb: []string{"get", "list"}}}, role.Spec.Permissions)
+
+	_, err = NewRoleBuilder().Name(secatest.Role1Name).Tenant(secatest.Tenant1Name).Build()
+	assert.ErrorContains(t, err, "permissions is required")
+
+	_, err = NewRoleBuilder().Name(secatest.Role1Name).Tenant(secatest.Tenant1Name).Permission("", nil).Build()
+	assert.ErrorContains(t, err, "permissions.provider is required")
+	assert.ErrorContains(t, err, "permissions.resources is required")
+	assert.ErrorContains(t, err, "permissions.verb is required")
+}
+
+func TestRoleAssignmentBuilder_Build(t *testing.T) {
+	role := &schema.Role{Metadata: &schema.GlobalTenantResourceMetadata{Name: secatest.Role1Name, Tenant: secatest.Tenant1Name}}
+	workspace := &schema.Workspace{Metadata: &schema.RegionalResourceMetadata{Name: secatest.Workspace1Name, Tenant: secatest.Tenant1Name, Region: secatest.Region1Name}}
+
+	assignment, err := NewRoleAssignmentBuilder().
+		Name(secatest.RoleAssignment1Name).
+		Tenant(secatest.Tenant1Name).
+		RoleOf(role).
+		Subject("user@example.com").
+		WorkspaceScope(workspace).
+		Build()
+	require.NoError(t, err)
+
+	assert.Equal(t, schema.GlobalTenantResourceMetadataKindResourceKindRoleAssignment, assignment.Metadata.Kind)
+	assert.Equal(t, []string{secatest.Role1Name}, assignment.Spec.Roles)
+	assert.Equal(t, []schema.RoleAssignmentScope{{
+		Tenants:    []string{secatest.Tenant1Name},
+		Workspaces: []string{secatest.Workspace1Name},
+		Regions:    []string{secatest.Region1Name},
+	}}, assignment.Spec.Scopes)
+
+	_, err = NewRoleAssignmentBuilder().Name(secatest.RoleAssignment1Name).Tenant(secatest.Tenant1Name).Build()
+	assert.ErrorContains(t, err, "roles is required")
+	assert.ErrorContains(t, err, "subs is required")
+	assert.ErrorContains(t, err, "scopes is required")
+}
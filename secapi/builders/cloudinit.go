@@ -0,0 +1,260 @@
+package builders
+
+import (
+	"bytes"
+	"compress/gzip"
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"mime/multipart"
+	"net/textproto"
+	"strings"
+	"unicode/utf8"
+
+	"github.com/eu-sovereign-cloud/go-sdk/pkg/spec/schema"
+
+	"gopkg.in/yaml.v3"
+)
+
+var ErrUserDataTooLarge = errors.New("user data is too large")
+
+const (
+	cloudConfigHeader  = "#cloud-config\n"
+	cloudInitBoundary  = "seca-cloud-init-boundary"
+	gzipBase64Encoding = "gz+b64"
+)
+
+// CloudInitUser is a user created by cloud-init.
+type CloudInitUser struct {
+	Name              string   `yaml:"name"`
+	Groups            []string `yaml:"groups,omitempty"`
+	Shell             string   `yaml:"shell,omitempty"`
+	Sudo              string   `yaml:"sudo,omitempty"`
+	SshAuthorizedKeys []string `yaml:"ssh_authorized_keys,omitempty"`
+	LockPasswd        *bool    `yaml:"lock_passwd,omitempty"`
+}
+
+// CloudInitFile is a file written by cloud-init, the permissions are in octal as 0644.
+type CloudInitFile struct {
+	Path        string `yaml:"path"`
+	Content     string `yaml:"content"`
+	Owner       string `yaml:"owner,omitempty"`
+	Permissions string `yaml:"permissions,omitempty"`
+	Encoding    string `yaml:"encoding,omitempty"`
+	Append      bool   `yaml:"append,omitempty"`
+}
+
+type cloudConfig struct {
+	Users             []CloudInitUser `yaml:"users,omitempty"`
+	SshAuthorizedKeys []string        `yaml:"ssh_authorized_keys,omitempty"`
+	PackageUpdate     bool            `yaml:"package_update,omitempty"`
+	PackageUpgrade    bool            `yaml:"package_upgrade,omitempty"`
+	Packages          []string        `yaml:"packages,omitempty"`
+	WriteFiles        []CloudInitFile `yaml:"write_files,omitempty"`
+	RunCmd            []string        `yaml:"runcmd,omitempty"`
+}
+
+type cloudInitScript struct {
+	name    string
+	content string
+}
+
+// Part of a multipart MIME archive
+type mimePart struct {
+	contentType string
+	filename    string
+	content     string
+}
+
+// CloudInitBuilder builds the user data of an instance for the images initialized by cloud-init:
+// a cloud-config document, in a multipart MIME archive with the shell scripts when there's any.
+type CloudInitBuilder struct {
+	config        cloudConfig
+	scripts       []cloudInitScript
+	compressFiles bool
+	gzip          bool
+	image         *schema.Image
+}
+
+func NewCloudInitBuilder() *CloudInitBuilder {
+	return &CloudInitBuilder{}
+}
+
+// User adds a user, the default user is kept only when the users include "default".
+func (b *CloudInitBuilder) User(user CloudInitUser) *CloudInitBuilder {
+	b.config.Users = append(b.config.Users, user)
+	return b
+}
+
+// SshKey authorizes the keys for the default user.
+func (b *CloudInitBuilder) SshKey(keys ...string) *CloudInitBuilder {
+	b.config.SshAuthorizedKeys = append(b.config.SshAuthorizedKeys, keys...)
+	return b
+}
+
+// Package installs the packages, after updating the package database.
+func (b *CloudInitBuilder) Package(packages ...string) *CloudInitBuilder {
+	b.config.PackageUpdate = true
+	b.config.Packages = append(b.config.Packages, packages...)
+	return b
+}
+
+func (b *CloudInitBuilder) PackageUpgrade(upgrade bool) *CloudInitBuilder {
+	b.config.PackageUpgrade = upgrade
+	return b
+}
+
+func (b *CloudInitBuilder) WriteFile(file CloudInitFile) *CloudInitBuilder {
+	b.config.WriteFiles = append(b.config.WriteFiles, file)
+	return b
+}
+
+// RunCmd runs the commands with the shell at the first boot, in order.
+func (b *CloudInitBuilder) RunCmd(commands ...string) *CloudInitBuilder {
+	b.config.RunCmd = append(b.config.RunCmd, commands...)
+	return b
+}
+
+// Script adds a shell script run at the first boot, after the cloud-config. The content starts with a shebang.
+func (b *CloudInitBuilder) Script(name, content string) *CloudInitBuilder {
+	b.scripts = append(b.scripts, cloudInitScript{name: name, content: content})
+	return b
+}
+
+// CompressFiles writes the files with their content gzipped and base64 encoded.
+func (b *CloudInitBuilder) CompressFiles() *CloudInitBuilder {
+	b.compressFiles = true
+	return b
+}
+
+// Gzip compresses the whole user data and encodes it in base64, for the providers decoding it before cloud-init.
+func (b *CloudInitBuilder) Gzip() *CloudInitBuilder {
+	b.gzip = true
+	return b
+}
+
+// Image sets the image booting the instance, to warn when it isn't initialized by cloud-init.
+func (b *CloudInitBuilder) Image(image *schema.Image) *CloudInitBuilder {
+	b.image = image
+	return b
+}
+
+// Warnings returns the issues not preventing the build, as an image ignoring the user data.
+func (b *CloudInitBuilder) Warnings() []string {
+	var warnings []string
+	if b.image != nil {
+		// The initializer defaults to none
+		if initializer := b.image.Spec.Initializer; initializer == "" || initializer == schema.ImageSpecInitializerNone {
+			warnings = append(warnings, fmt.Sprintf("image %s has no initializer, the user data is ignored", nameOf(b.image.Metadata)))
+		}
+	}
+	return warnings
+}
+
+// Build returns the user data, it fails when it exceeds the size accepted by the instances.
+func (b *CloudInitBuilder) Build() (string, error) {
+	config := b.config
+	if b.compressFiles {
+		config.WriteFiles = make([]CloudInitFile, 0, len(b.config.WriteFiles))
+		for _, file := range b.config.WriteFiles {
+			if file.Encoding == "" {
+				content, err := gzipBase64(file.Content)
+				if err != nil {
+					return "", err
+				}
+				file.Content, file.Encoding = content, gzipBase64Encoding
+			}
+			config.WriteFiles = append(config.WriteFiles, file)
+		}
+	}
+
+	var errs []error
+	for _, user := range config.Users {
+		if user.Name == "" {
+			errs = append(errs, invalid("users.name", "is required"))
+		}
+	}
+	for _, file := range config.WriteFiles {
+		if file.Path == "" {
+			errs = append(errs, invalid("write_files.path", "is required"))
+		}
+	}
+	for _, script := range b.scripts {
+		if !strings.HasPrefix(script.content, "#!") {
+			errs = append(errs, invalid("script", "%s has no shebang", script.name))
+		}
+	}
+	if err := errors.Join(errs...); err != nil {
+		return "", err
+	}
+
+	document, err := yaml.Marshal(config)
+	if err != nil {
+		return "", err
+	}
+	userData := cloudConfigHeader + string(document)
+
+	if len(b.scripts) > 0 {
+		if userData, err = b.multipart(userData); err != nil {
+			return "", err
+		}
+	}
+
+	if b.gzip {
+		if userData, err = gzipBase64(userData); err != nil {
+			return "", err
+		}
+	}
+
+	if size := utf8.RuneCountInString(userData); size > maxInstanceUserData {
+		return "", fmt.Errorf("%w: %d characters exceed %d", ErrUserDataTooLarge, size, maxInstanceUserData)
+	}
+	return userData, nil
+}
+
+// Returns the multipart MIME archive of the cloud-config document and the scripts
+func (b *CloudInitBuilder) multipart(document string) (string, error) {
+	var buf bytes.Buffer
+	writer := multipart.NewWriter(&buf)
+	if err := writer.SetBoundary(cloudInitBoundary); err != nil {
+		return "", err
+	}
+
+	fmt.Fprintf(&buf, "Content-Type: multipart/mixed; boundary=%q\r\nMIME-Version: 1.0\r\n\r\n", cloudInitBoundary)
+
+	parts := []mimePart{{"text/cloud-config", "cloud-config.yaml", document}}
+	for _, script := range b.scripts {
+		parts = append(parts, mimePart{"text/x-shellscript", script.name, script.content})
+	}
+
+	for _, part := range parts {
+		header := textproto.MIMEHeader{}
+		header.Set("Content-Type", part.contentType+`; charset="utf-8"`)
+		header.Set("MIME-Version", "1.0")
+		header.Set("Content-Disposition", fmt.Sprintf("attachment; filename=%q", part.filename))
+		w, err := writer.CreatePart(header)
+		if err != nil {
+			return "", err
+		}
+		if _, err := w.Write([]byte(part.content)); err != nil {
+			return "", err
+		}
+	}
+
+	if err := writer.Close(); err != nil {
+		return "", err
+	}
+	return buf.String(), nil
+}
+
+func gzipBase64(content string) (string, error) {
+	var buf bytes.Buffer
+	writer := gzip.NewWriter(&buf)
+	if _, err := writer.Write([]byte(content)); err != nil {
+		return "", err
+	}
+	if err := writer.Close(); err != nil {
+		return "", err
+	}
+	return base64.StdEncoding.EncodeToString(buf.Bytes()), nil
+}
@@ -0,0 +1,172 @@
+package builders
+
+import (
+	"bytes"
+	"compress/gzip"
+	"encoding/base64"
+	"io"
+	"mime"
+	"mime/multipart"
+	"strings"
+	"testing"
+
+	"github.com/eu-sovereign-cloud/go-sdk/internal/secatest"
+	"github.com/eu-sovereign-cloud/go-sdk/pkg/spec/schema"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"gopkg.in/yaml.v3"
+)
+
+func TestCloudInitBuilder_Build(t *testing.T) {
+	userData, err := NewCloudInitBuilder().
+		User(CloudInitUser{Name: "admin", Groups: []string{"sudo"}, Shell: "/bin/bash", SshAuthorizedKeys: []string{"ssh-ed25519 AAAA admin"}}).
+		SshKey("ssh-ed25519 BBBB default").
+		Package("nginx", "curl").
+		WriteFile(CloudInitFile{Path: "/etc/motd", Content: "welcome\n", Permissions: "0644"}).
+		RunCmd("systemctl enable --now nginx").
+		Build()
+	require.NoError(t, err)
+
+	require.True(t, strings.HasPrefix(userData, "#cloud-config\n"))
+
+	var config map[string]any
+	require.NoError(t, yaml.Unmarshal([]byte(userData), &config))
+	assert.Equal(t, true, config["package_update"])
+	assert.Equal(t, []any{"nginx", "curl"}, config["packages"])
+	assert.Equal(t, []any{"ssh-ed25519 BBBB default"}, config["ssh_authorized_keys"])
+	assert.Equal(t, []any{"systemctl enable --now nginx"}, config["runcmd"])
+	assert.Equal(t, []any{map[string]any{"path": "/etc/motd", "content": "welcome\n", "permissions": "0644"}}, config["write_files"])
+	assert.Equal(t, []any{map[string]any{
+		"name":                "admin",
+		"groups":              []any{"sudo"},
+		"shell":               "/bin/bash",
+		"ssh_authorized_keys": []any{"ssh-ed25519 AAAA admin"},
+	}}, config["users"])
+}
+
+func TestCloudInitBuilder_Multipart(t *testing.T) {
+	userData, err := NewCloudInitBuilder().
+		Package("nginx").
+		Script("setup.sh", "#!/bin/sh\necho ready\n").
+		Build()
+	require.NoError(t, err)
+
+	header, body, found := strings.Cut(userData, "\r\n\r\n")
+	require.True(t, found)
+	mediaType, params, err := mime.ParseMediaType(strings.TrimPrefix(strings.Split(header, "\r\n")[0], "Content-Type: "))
+	require.NoError(t, err)
+	assert.Equal(t, "multipart/mixed", mediaType)
+
+	reader := multipart.NewReader(strings.NewReader(body), params["boundary"])
+
+	part, err := reader.NextPart()
+	require.NoError(t, err)
+	assert.Equal(t, `text/cloud-config; charset="utf-8"`, part.Header.Get("Content-Type"))
+	content, err := io.ReadAll(part)
+	require.NoError(t, err)
+	assert.Equal(t, "#cloud-config\npackage_update: true\npackages:\n    - nginx\n", string(content))
+
+	part, err = reader.NextPart()
+	require.NoError(t, err)
+	assert.Equal(t, `text/x-shellscript; charset="utf-8"`, part.Header.Get("Content-Type"))
+	assert.Equal(t, "setup.sh", part.FileName())
+	content, err = io.ReadAll(part)
+	require.NoError(t, err)
+	assert.Equal(t, "#!/bin/sh\necho ready\n", string(content))
+
+	_, err = reader.NextPart()
+	assert.ErrorIs(t, err, io.EOF)
+}
+
+func TestCloudInitBuilder_Compression(t *testing.T) {
+	userData, err := NewCloudInitBuilder().
+		WriteFile(CloudInitFile{Path: "/etc/app.conf", Content: "key=value\n"}).
+		CompressFiles().
+		Gzip().
+		Build()
+	require.NoError(t, err)
+
+	document := gunzipBase64(t, userData)
+	require.True(t, strings.HasPrefix(document, "#cloud-config\n"))
+
+	var config struct {
+		WriteFiles []CloudInitFile `yaml:"write_files"`
+	}
+	require.NoError(t, yaml.Unmarshal([]byte(document), &config))
+	require.Len(t, config.WriteFiles, 1)
+	assert.Equal(t, gzipBase64Encoding, config.WriteFiles[0].Encoding)
+	assert.Equal(t, "key=value\n", gunzipBase64(t, config.WriteFiles[0].Content))
+}
+
+func TestCloudInitBuilder_Errors(t *testing.T) {
+	_, err := NewCloudInitBuilder().
+		User(CloudInitUser{}).
+		WriteFile(CloudInitFile{Content: "orphan"}).
+		Script("setup.sh", "echo missing shebang").
+		Build()
+	assert.ErrorContains(t, err, "users.name is required")
+	assert.ErrorContains(t, err, "write_files.path is required")
+	assert.ErrorContains(t, err, "script setup.sh has no shebang")
+
+	large := NewCloudInitBuilder().WriteFile(CloudInitFile{Path: "/var/data", Content: strings.Repeat("a", maxInstanceUserData)})
+	_, err = large.Build()
+	assert.ErrorIs(t, err, ErrUserDataTooLarge)
+
+	// Compressed, the repetitive content fits
+	_, err = large.Gzip().Build()
+	assert.NoError(t, err)
+}
+
+func TestCloudInitBuilder_Warnings(t *testing.T) {
+	image := &schema.Image{
+		Metadata: &schema.RegionalResourceMetadata{Name: secatest.Image1Name},
+		Spec:     schema.ImageSpec{Initializer: schema.ImageSpecInitializerNone},
+	}
+
+	builder := NewCloudInitBuilder().Package("nginx")
+	assert.Empty(t, builder.Warnings())
+
+	builder.Image(image)
+	assert.Equal(t, []string{"image image-1 has no initializer, the user data is ignored"}, builder.Warnings())
+
+	image.Spec.Initializer = schema.ImageSpecInitializerCloudinit22
+	assert.Empty(t, builder.Warnings())
+}
+
+func TestInstanceBuilder_CloudInit(t *testing.T) {
+	instance, err := NewInstanceBuilder().
+		Name(secatest.Instance1Name).
+		Tenant(secatest.Tenant1Name).
+		Workspace(secatest.Workspace1Name).
+		Sku(ResourceRef("skus", secatest.InstanceSku1Name)).
+		Zone(secatest.ZoneA).
+		BootVolume(ResourceRef("block-storages", secatest.BlockStorage1Name)).
+		CloudInit(NewCloudInitBuilder().RunCmd("echo hello")).
+		Build()
+	require.NoError(t, err)
+	assert.Equal(t, "#cloud-config\nruncmd:\n    - echo hello\n", instance.Spec.UserData)
+
+	_, err = NewInstanceBuilder().
+		Name(secatest.Instance1Name).
+		Tenant(secatest.Tenant1Name).
+		Workspace(secatest.Workspace1Name).
+		Sku(ResourceRef("skus", secatest.InstanceSku1Name)).
+		Zone(secatest.ZoneA).
+		BootVolume(ResourceRef("block-storages", secatest.BlockStorage1Name)).
+		CloudInit(NewCloudInitBuilder().Script("setup.sh", "echo")).
+		Build()
+	assert.ErrorContains(t, err, "has no shebang")
+}
+
+func gunzipBase64(t *testing.T, content string) string {
+	t.Helper()
+
+	compressed, err := base64.StdEncoding.DecodeString(content)
+	require.NoError(t, err)
+	reader, err := gzip.NewReader(bytes.NewReader(compressed))
+	require.NoError(t, err)
+	decompressed, err := io.ReadAll(reader)
+	require.NoError(t, err)
+	return string(decompressed)
+}
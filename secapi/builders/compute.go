@@ -0,0 +1,141 @@
+package builders
+
+import (
+	"maps"
+	"slices"
+	"unicode/utf8"
+
+	"github.com/eu-sovereign-cloud/go-sdk/pkg/spec/schema"
+)
+
+const (
+	maxInstanceNics        = 16
+	maxInstanceDataVolumes = 64
+	maxInstanceSshKeys     = 32
+	maxInstanceUserData    = 65536
+)
+
+type InstanceBuilder struct {
+	workspaceBase[*InstanceBuilder]
+
+	spec      schema.InstanceSpec
+	cloudInit *CloudInitBuilder
+}
+
+func NewInstanceBuilder() *InstanceBuilder {
+	b := &InstanceBuilder{}
+	b.self = b
+	return b
+}
+
+func (b *InstanceBuilder) Sku(ref schema.Reference) *InstanceBuilder {
+	b.spec.SkuRef = ref
+	return b
+}
+
+func (b *InstanceBuilder) Zone(zone schema.Zone) *InstanceBuilder {
+	b.spec.Zone = zone
+	return b
+}
+
+func (b *InstanceBuilder) BootVolume(ref schema.Reference) *InstanceBuilder {
+	b.spec.BootVolume = schema.VolumeReference{DeviceRef: ref}
+	return b
+}
+
+func (b *InstanceBuilder) DataVolume(ref schema.Reference) *InstanceBuilder {
+	b.spec.DataVolumes = append(b.spec.DataVolumes, schema.VolumeReference{DeviceRef: ref})
+	return b
+}
+
+// Nic attaches a NIC to the instance, the first one is the primary NIC.
+func (b *InstanceBuilder) Nic(ref schema.Reference) *InstanceBuilder {
+	if b.spec.PrimaryNicRef == nil {
+		b.spec.PrimaryNicRef = &ref
+	} else {
+		b.spec.AdditionalNicRefs = append(b.spec.AdditionalNicRefs, ref)
+	}
+	return b
+}
+
+func (b *InstanceBuilder) SecurityGroup(ref schema.Reference) *InstanceBuilder {
+	b.spec.SecurityGroupRef = &ref
+	return b
+}
+
+func (b *InstanceBuilder) AntiAffinityGroup(group string) *InstanceBuilder {
+	b.spec.AntiAffinityGroup = group
+	return b
+}
+
+func (b *InstanceBuilder) SshKey(keys ...string) *InstanceBuilder {
+	b.spec.SshKeys = append(b.spec.SshKeys, keys...)
+	return b
+}
+
+func (b *InstanceBuilder) UserData(userData string) *InstanceBuilder {
+	b.spec.UserData = userData
+	return b
+}
+
+// CloudInit sets the user data built by the cloud-init builder, replacing the raw user data.
+func (b *InstanceBuilder) CloudInit(cloudInit *CloudInitBuilder) *InstanceBuilder {
+	b.cloudInit = cloudInit
+	return b
+}
+
+func (b *InstanceBuilder) Build() (*schema.Instance, error) {
+	errs := b.check()
+	spec := cloneInstanceSpec(b.spec)
+	if b.cloudInit != nil {
+		userData, err := b.cloudInit.Build()
+		if err != nil {
+			errs = append(errs, err)
+		}
+		spec.UserData = userData
+	}
+	if err := checkRef("skuRef", b.spec.SkuRef); err != nil {
+		errs = append(errs, err)
+	}
+	if b.spec.Zone == "" {
+		errs = append(errs, invalid("zone", "is required"))
+	}
+	if err := checkRef("bootVolume.deviceRef", b.spec.BootVolume.DeviceRef); err != nil {
+		errs = append(errs, err)
+	}
+	for _, volume := range b.spec.DataVolumes {
+		if err := checkRef("dataVolumes.deviceRef", volume.DeviceRef); err != nil {
+			errs = append(errs, err)
+		}
+	}
+	if len(b.spec.AdditionalNicRefs) > maxInstanceNics {
+		errs = append(errs, invalid("additionalNicRefs", "exceeds %d nics", maxInstanceNics))
+	}
+	if len(b.spec.DataVolumes) > maxInstanceDataVolumes {
+		errs = append(errs, invalid("dataVolumes", "exceeds %d volumes", maxInstanceDataVolumes))
+	}
+	if len(b.spec.SshKeys) > maxInstanceSshKeys {
+		errs = append(errs, invalid("sshKeys", "exceeds %d keys", maxInstanceSshKeys))
+	}
+	if utf8.RuneCountInString(spec.UserData) > maxInstanceUserData {
+		errs = append(errs, invalid("userData", "exceeds %d characters", maxInstanceUserData))
+	}
+
+	return build(&schema.Instance{
+		Metadata:    b.regionalWorkspaceMetadata(schema.ResourceMetadataKindResourceKindInstance),
+		Labels:      maps.Clone(b.labels),
+		Annotations: maps.Clone(b.annotations),
+		Extensions:  maps.Clone(b.extensions),
+		Spec:        spec,
+	}, errs)
+}
+
+// Returns a copy of the spec which shares nothing with the builder
+func cloneInstanceSpec(spec schema.InstanceSpec) schema.InstanceSpec {
+	spec.AdditionalNicRefs = slices.Clone(spec.AdditionalNicRefs)
+	spec.DataVolumes = slices.Clone(spec.DataVolumes)
+	spec.PrimaryNicRef = clonePtr(spec.PrimaryNicRef)
+	spec.SecurityGroupRef = clonePtr(spec.SecurityGroupRef)
+	spec.SshKeys = slices.Clone(spec.SshKeys)
+	return spec
+}
@@ -0,0 +1,93 @@
+package builders
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/eu-sovereign-cloud/go-sdk/internal/secatest"
+	"github.com/eu-sovereign-cloud/go-sdk/pkg/spec/schema"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestInstanceBuilder_Build(t *testing.T) {
+	volume := &schema.BlockStorage{Metadata: secatest.NewRegionalWorkspaceResourceMetadata(secatest.BlockStorage1Name, secatest.Tenant1Name, secatest.Workspace1Name, secatest.Region1Name)}
+
+	instance, err := NewInstanceBuilder().
+		Name(secatest.Instance1Name).
+		Tenant(secatest.Tenant1Name).
+		Workspace(secatest.Workspace1Name).
+		Region(secatest.Region1Name).
+		Sku(ResourceRef("skus", secatest.InstanceSku1Name)).
+		Zone(secatest.ZoneA).
+		BootVolume(Ref(volume)).
+		DataVolume(ResourceRef("block-storages", "data-1")).
+		Nic(ResourceRef("nics", "nic-1")).
+		Nic(ResourceRef("nics", "nic-2")).
+		SecurityGroup(ResourceRef("security-groups", secatest.SecurityGroup1Name)).
+		AntiAffinityGroup("web").
+		SshKey("ssh-ed25519 AAAA").
+		UserData("#cloud-config").
+		Build()
+	require.NoError(t, err)
+
+	assert.Equal(t, secatest.Instance1Name, instance.Metadata.Name)
+	assert.Equal(t, schema.RegionalWorkspaceResourceMetadataKindResourceKindInstance, instance.Metadata.Kind)
+	assert.Equal(t, secatest.InstanceSku1Ref, instance.Spec.SkuRef.Resource)
+	assert.Equal(t, "block-storages/"+secatest.BlockStorage1Name, instance.Spec.BootVolume.DeviceRef.Resource)
+	assert.Equal(t, "block-storages/data-1", instance.Spec.DataVolumes[0].DeviceRef.Resource)
+	assert.Equal(t, "nics/nic-1", instance.Spec.PrimaryNicRef.Resource)
+	assert.Equal(t, []schema.Reference{{Resource: "nics/nic-2"}}, instance.Spec.AdditionalNicRefs)
+	assert.Equal(t, "security-groups/"+secatest.SecurityGroup1Name, instance.Spec.SecurityGroupRef.Resource)
+	assert.Equal(t, schema.Zone(secatest.ZoneA), instance.Spec.Zone)
+}
+
+func TestInstanceBuilder_Reuse(t *testing.T) {
+	builder := NewInstanceBuilder().
+		Name(secatest.Instance1Name).
+		Tenant(secatest.Tenant1Name).
+		Workspace(secatest.Workspace1Name).
+		Label("tier", "web").
+		Sku(ResourceRef("skus", secatest.InstanceSku1Name)).
+		Zone(secatest.ZoneA).
+		BootVolume(ResourceRef("block-storages", secatest.BlockStorage1Name)).
+		DataVolume(ResourceRef("block-storages", "data-1")).
+		Nic(ResourceRef("nics", "nic-1")).
+		SshKey("ssh-ed25519 AAAA")
+
+	first, err := builder.Build()
+	require.NoError(t, err)
+
+	// Changing a built instance changes neither the builder nor the next instances
+	first.Labels["tier"] = "db"
+	first.Spec.DataVolumes[0].DeviceRef.Resource = "block-storages/data-2"
+	first.Spec.PrimaryNicRef.Resource = "nics/nic-2"
+	first.Spec.SshKeys[0] = "ssh-rsa AAAA"
+
+	second, err := builder.Label("zone", "a").DataVolume(ResourceRef("block-storages", "data-3")).SshKey("ssh-rsa BBBB").Build()
+	require.NoError(t, err)
+	assert.Equal(t, schema.Labels{"tier": "web", "zone": "a"}, second.Labels)
+	assert.Equal(t, "block-storages/data-1", second.Spec.DataVolumes[0].DeviceRef.Resource)
+	assert.Equal(t, "nics/nic-1", second.Spec.PrimaryNicRef.Resource)
+	assert.Equal(t, []string{"ssh-ed25519 AAAA", "ssh-rsa BBBB"}, second.Spec.SshKeys)
+
+	// Nor do the next calls of the builder change the built instances
+	assert.Equal(t, schema.Labels{"tier": "db"}, first.Labels)
+	assert.Len(t, first.Spec.DataVolumes, 1)
+	assert.Equal(t, []string{"ssh-rsa AAAA"}, first.Spec.SshKeys)
+}
+
+func TestInstanceBuilder_Errors(t *testing.T) {
+	_, err := NewInstanceBuilder().
+		Name(secatest.Instance1Name).
+		Tenant(secatest.Tenant1Name).
+		Workspace(secatest.Workspace1Name).
+		UserData(strings.Repeat("x", maxInstanceUserData+1)).
+		Build()
+	require.ErrorIs(t, err, ErrInvalidSpec)
+	assert.ErrorContains(t, err, "skuRef is required")
+	assert.ErrorContains(t, err, "zone is required")
+	assert.ErrorContains(t, err, "bootVolume.deviceRef is required")
+	assert.ErrorContains(t, err, "userData exceeds")
+}
@@ -2,9 +2,13 @@ package builders
 
 import (
 	"fmt"
+	"strconv"
 	"strings"
 )
 
+// Operators of the label selectors, the longest first as they are matched in order
+var labelOperators = []string{"!=", ">=", "<=", "=", ">", "<"}
+
 type LabelsBuilder struct {
 	items []string
 }
@@ -28,6 +32,11 @@ func (b *LabelsBuilder) Neq(key, value string) *LabelsBuilder {
 	return b
 }
 
+func (b *LabelsBuilder) NsNeq(namespace, key, value string) *LabelsBuilder {
+	b.items = append(b.items, fmt.Sprintf("%s:%s!=%s", namespace, key, value))
+	return b
+}
+
 func (b *LabelsBuilder) Gt(key string, value int) *LabelsBuilder {
 	b.items = append(b.items, fmt.Sprintf("%s>%d", key, value))
 	return b
@@ -61,3 +70,111 @@ func (b *LabelsBuilder) BuildPtr() *string {
 
 	return &labelsStr
 }
+
+// Matches reports whether the labels satisfy all the selectors, the numeric comparisons
+// need numeric label values. The namespaced keys are matched as namespace:key.
+func (b *LabelsBuilder) Matches(labels map[string]string) bool {
+	for _, item := range b.items {
+		operator, key, expected := splitLabel(item)
+		value, found := labels[key]
+
+		switch operator {
+		case "=":
+			if !found || value != expected {
+				return false
+			}
+		case "!=":
+			if found && value == expected {
+				return false
+			}
+		default:
+			actual, err := strconv.Atoi(value)
+			if err != nil {
+				return false
+			}
+			bound, err := strconv.Atoi(expected)
+			if err != nil {
+				return false
+			}
+			if !compareLabel(operator, actual, bound) {
+				return false
+			}
+		}
+	}
+	return true
+}
+
+// Splits a selector in its operator, key and value, the operator is empty when there's none
+func splitLabel(item string) (string, string, string) {
+	for _, op := range labelOperators {
+		if k, v, found := strings.Cut(item, op); found {
+			return op, strings.TrimSpace(k), strings.TrimSpace(v)
+		}
+	}
+	return "", item, ""
+}
+
+func compareLabel(operator string, actual, bound int) bool {
+	switch operator {
+	case ">":
+		return actual > bound
+	case "<":
+		return actual < bound
+	case ">=":
+		return actual >= bound
+	case "<=":
+		return actual <= bound
+	default:
+		return false
+	}
+}
+
+// ParseLabels parses a selector in the syntax built by LabelsBuilder, as env=prod,tier!=web,size>=2.
+// The namespaced keys are written namespace:key=value or namespace:key!=value.
+func ParseLabels(selector string) (*LabelsBuilder, error) {
+	b := NewLabelsBuilder()
+	if strings.TrimSpace(selector) == "" {
+		return b, nil
+	}
+
+	for _, item := range strings.Split(selector, ",") {
+		item = strings.TrimSpace(item)
+
+		operator, key, value := splitLabel(item)
+		if operator == "" || key == "" {
+			return nil, fmt.Errorf("invalid label selector %q", item)
+		}
+
+		switch operator {
+		case "=":
+			if namespace, name, found := strings.Cut(key, ":"); found {
+				b.NsEquals(namespace, name, value)
+			} else {
+				b.Equals(key, value)
+			}
+		case "!=":
+			if namespace, name, found := strings.Cut(key, ":"); found {
+				b.NsNeq(namespace, name, value)
+			} else {
+				b.Neq(key, value)
+			}
+		default:
+			number, err := strconv.Atoi(value)
+			if err != nil {
+				return nil, fmt.Errorf("invalid label selector %q: %s needs a number", item, operator)
+			}
+			switch operator {
+			case ">":
+				b.Gt(key, number)
+			case "<":
+				b.Lt(key, number)
+			case ">=":
+				b.Gte(key, number)
+			case "<=":
+				b.Lte(key, number)
+			}
+		}
+	}
+
+	return b, nil
+}
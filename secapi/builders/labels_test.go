@@ -1,6 +1,7 @@
 package builders
 
 import (
+	"strings"
 	"testing"
 
 	"github.com/eu-sovereign-cloud/go-sdk/internal/secatest"
@@ -38,6 +39,14 @@ func TestLabelsBuilder_Neq(t *testing.T) {
 	assert.Equal(t, secatest.LabelTierKey+"!=free", builder.Build())
 }
 
+func TestLabelsBuilder_NsNeq(t *testing.T) {
+	builder := NewLabelsBuilder().NsNeq(secatest.LabelMonitoringValue, secatest.LabelAlertLevelValue, secatest.LabelHightValue)
+
+	assert.Len(t, builder.items, 1)
+	assert.Equal(t, secatest.LabelMonitoringValue+":"+secatest.LabelAlertLevelValue+"!="+secatest.LabelHightValue, builder.items[0])
+	assert.Equal(t, secatest.LabelMonitoringValue+":"+secatest.LabelAlertLevelValue+"!="+secatest.LabelHightValue, builder.Build())
+}
+
 func TestLabelsBuilder_Gt(t *testing.T) {
 	builder := NewLabelsBuilder().Gt(secatest.LabelVersion, 1)
 
@@ -100,3 +109,47 @@ func TestLabelsBuilder_BuildPtr_MultipleFilters(t *testing.T) {
 	assert.NotNil(t, result)
 	assert.Equal(t, secatest.LabelEnvKey+"=prod,"+secatest.LabelVersion+">1", *result)
 }
+
+func TestParseLabels(t *testing.T) {
+	selector := secatest.LabelEnvKey + "=prod, tier!=web,monitoring:alert=high,monitoring:alert!=low,size>1,size<9,version>=2,version<=3"
+
+	builder, err := ParseLabels(selector)
+	assert.NoError(t, err)
+	assert.Equal(t, strings.ReplaceAll(selector, " ", ""), builder.Build())
+
+	builder, err = ParseLabels("")
+	assert.NoError(t, err)
+	assert.Nil(t, builder.BuildPtr())
+}
+
+func TestParseLabels_Errors(t *testing.T) {
+	for _, selector := range []string{"env", "=prod", "size>big", "env=prod,"} {
+		_, err := ParseLabels(selector)
+		assert.Error(t, err, selector)
+	}
+}
+
+func TestLabelsBuilder_Matches(t *testing.T) {
+	builder := NewLabelsBuilder().
+		Equals(secatest.LabelEnvKey, "prod").
+		Neq(secatest.LabelTierKey, "free").
+		Gte(secatest.LabelVersion, 2).
+		Lt(secatest.LabelVersion, 4)
+
+	assert.True(t, builder.Matches(map[string]string{secatest.LabelEnvKey: "prod", secatest.LabelVersion: "3"}))
+	assert.False(t, builder.Matches(map[string]string{secatest.LabelEnvKey: "prod", secatest.LabelTierKey: "free", secatest.LabelVersion: "3"}))
+	assert.False(t, builder.Matches(map[string]string{secatest.LabelEnvKey: "dev", secatest.LabelVersion: "3"}))
+	assert.False(t, builder.Matches(map[string]string{secatest.LabelEnvKey: "prod", secatest.LabelVersion: "4"}))
+	assert.False(t, builder.Matches(map[string]string{secatest.LabelEnvKey: "prod", secatest.LabelVersion: "latest"}))
+	assert.False(t, builder.Matches(map[string]string{secatest.LabelVersion: "3"}))
+
+	namespaced := NewLabelsBuilder().NsEquals(secatest.LabelMonitoringValue, secatest.LabelAlertLevelValue, secatest.LabelHightValue)
+	assert.True(t, namespaced.Matches(map[string]string{secatest.LabelMonitoringValue + ":" + secatest.LabelAlertLevelValue: secatest.LabelHightValue}))
+
+	parsed, err := ParseLabels(secatest.LabelMonitoringValue + ":" + secatest.LabelAlertLevelValue + "!=" + secatest.LabelHightValue)
+	assert.NoError(t, err)
+	assert.False(t, parsed.Matches(map[string]string{secatest.LabelMonitoringValue + ":" + secatest.LabelAlertLevelValue: secatest.LabelHightValue}))
+	assert.True(t, parsed.Matches(map[string]string{secatest.LabelAlertLevelValue: secatest.LabelHightValue}))
+
+	assert.True(t, NewLabelsBuilder().Matches(nil))
+}
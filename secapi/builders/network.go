@@ -0,0 +1,560 @@
+package builders
+
+import (
+	"errors"
+	"maps"
+	"net/netip"
+	"slices"
+
+	"github.com/eu-sovereign-cloud/go-sdk/internal/secautil"
+	"github.com/eu-sovereign-cloud/go-sdk/pkg/spec/schema"
+)
+
+const (
+	maxNetworkAdditionalCidrs = 32
+	maxRoutes                 = 1000
+	maxSecurityGroupRules     = 500
+	maxRulePorts              = 100
+	maxNicAddresses           = 32
+	maxNicRefs                = 16
+	minPort                   = 1
+	maxPort                   = 65535
+	maxIcmpType               = 8
+	maxIcmpCode               = 5
+)
+
+type NetworkBuilder struct {
+	workspaceBase[*NetworkBuilder]
+
+	spec schema.NetworkSpec
+}
+
+func NewNetworkBuilder() *NetworkBuilder {
+	b := &NetworkBuilder{}
+	b.self = b
+	return b
+}
+
+func (b *NetworkBuilder) Sku(ref schema.Reference) *NetworkBuilder {
+	b.spec.SkuRef = ref
+	return b
+}
+
+// Cidr sets the primary CIDR blocks of the network, either of them can be empty.
+func (b *NetworkBuilder) Cidr(ipv4, ipv6 string) *NetworkBuilder {
+	b.spec.Cidr = schema.Cidr{Ipv4: ipv4, Ipv6: ipv6}
+	return b
+}
+
+func (b *NetworkBuilder) AdditionalCidr(ipv4, ipv6 string) *NetworkBuilder {
+	b.spec.AdditionalCidrs = append(b.spec.AdditionalCidrs, schema.Cidr{Ipv4: ipv4, Ipv6: ipv6})
+	return b
+}
+
+func (b *NetworkBuilder) Build() (*schema.Network, error) {
+	errs := b.check()
+	if err := checkRef("skuRef", b.spec.SkuRef); err != nil {
+		errs = append(errs, err)
+	}
+	errs = append(errs, checkCidr("cidr", b.spec.Cidr)...)
+	for _, cidr := range b.spec.AdditionalCidrs {
+		errs = append(errs, checkCidr("additionalCidrs", cidr)...)
+	}
+	if len(b.spec.AdditionalCidrs) > maxNetworkAdditionalCidrs {
+		errs = append(errs, invalid("additionalCidrs", "exceeds %d blocks", maxNetworkAdditionalCidrs))
+	}
+
+	return build(&schema.Network{
+		Metadata:    b.regionalWorkspaceMetadata(schema.ResourceMetadataKindResourceKindNetwork),
+		Labels:      maps.Clone(b.labels),
+		Annotations: maps.Clone(b.annotations),
+		Extensions:  maps.Clone(b.extensions),
+		Spec:        schema.NetworkSpec{AdditionalCidrs: slices.Clone(b.spec.AdditionalCidrs), Cidr: b.spec.Cidr, SkuRef: b.spec.SkuRef},
+	}, errs)
+}
+
+type SubnetBuilder struct {
+	networkBase[*SubnetBuilder]
+
+	spec schema.SubnetSpec
+}
+
+func NewSubnetBuilder() *SubnetBuilder {
+	b := &SubnetBuilder{}
+	b.self = b
+	return b
+}
+
+// Cidr sets the CIDR blocks of the subnet, either of them can be empty.
+func (b *SubnetBuilder) Cidr(ipv4, ipv6 string) *SubnetBuilder {
+	b.spec.Cidr = schema.Cidr{Ipv4: ipv4, Ipv6: ipv6}
+	return b
+}
+
+func (b *SubnetBuilder) Zone(zone schema.Zone) *SubnetBuilder {
+	b.spec.Zone = zone
+	return b
+}
+
+func (b *SubnetBuilder) RouteTable(ref schema.Reference) *SubnetBuilder {
+	b.spec.RouteTableRef = ref
+	return b
+}
+
+func (b *SubnetBuilder) Sku(ref schema.Reference) *SubnetBuilder {
+	b.spec.SkuRef = &ref
+	return b
+}
+
+func (b *SubnetBuilder) Build() (*schema.Subnet, error) {
+	errs := b.check()
+	errs = append(errs, checkCidr("cidr", b.spec.Cidr)...)
+	if b.spec.Zone == "" {
+		errs = append(errs, invalid("zone", "is required"))
+	}
+	if err := checkRef("routeTableRef", b.spec.RouteTableRef); err != nil {
+		errs = append(errs, err)
+	}
+
+	return build(&schema.Subnet{
+		Metadata:    b.regionalNetworkMetadata(schema.ResourceMetadataKindResourceKindSubnet),
+		Labels:      maps.Clone(b.labels),
+		Annotations: maps.Clone(b.annotations),
+		Extensions:  maps.Clone(b.extensions),
+		Spec:        schema.SubnetSpec{Cidr: b.spec.Cidr, RouteTableRef: b.spec.RouteTableRef, SkuRef: clonePtr(b.spec.SkuRef), Zone: b.spec.Zone},
+	}, errs)
+}
+
+type RouteTableBuilder struct {
+	networkBase[*RouteTableBuilder]
+
+	spec schema.RouteTableSpec
+}
+
+func NewRouteTableBuilder() *RouteTableBuilder {
+	b := &RouteTableBuilder{}
+	b.self = b
+	return b
+}
+
+// Route routes a destination CIDR block to an internet gateway, a NIC or a NAT gateway.
+func (b *RouteTableBuilder) Route(destination string, target schema.Reference) *RouteTableBuilder {
+	b.spec.Routes = append(b.spec.Routes, schema.RouteSpec{DestinationCidrBlock: destination, TargetRef: target})
+	return b
+}
+
+func (b *RouteTableBuilder) Build() (*schema.RouteTable, error) {
+	errs := b.check()
+	if len(b.spec.Routes) == 0 || len(b.spec.Routes) > maxRoutes {
+		errs = append(errs, invalid("routes", "must have between 1 and %d routes", maxRoutes))
+	}
+	for _, route := range b.spec.Routes {
+		if _, err := netip.ParsePrefix(route.DestinationCidrBlock); err != nil {
+			errs = append(errs, invalid("routes.destinationCidrBlock", "%q is not a CIDR block", route.DestinationCidrBlock))
+		}
+		if err := checkRef("routes.targetRef", route.TargetRef); err != nil {
+			errs = append(errs, err)
+		}
+	}
+
+	return build(&schema.RouteTable{
+		Metadata:    b.regionalNetworkMetadata(schema.ResourceMetadataKindResourceKindRoutingTable),
+		Labels:      maps.Clone(b.labels),
+		Annotations: maps.Clone(b.annotations),
+		Extensions:  maps.Clone(b.extensions),
+		Spec:        schema.RouteTableSpec{Routes: slices.Clone(b.spec.Routes)},
+	}, errs)
+}
+
+type InternetGatewayBuilder struct {
+	workspaceBase[*InternetGatewayBuilder]
+
+	spec schema.InternetGatewaySpec
+}
+
+func NewInternetGatewayBuilder() *InternetGatewayBuilder {
+	b := &InternetGatewayBuilder{}
+	b.self = b
+	return b
+}
+
+func (b *InternetGatewayBuilder) EgressOnly(egressOnly bool) *InternetGatewayBuilder {
+	b.spec.EgressOnly = egressOnly
+	return b
+}
+
+func (b *InternetGatewayBuilder) Build() (*schema.InternetGateway, error) {
+	return build(&schema.InternetGateway{
+		Metadata:    b.regionalWorkspaceMetadata(schema.ResourceMetadataKindResourceKindInternetGateway),
+		Labels:      maps.Clone(b.labels),
+		Annotations: maps.Clone(b.annotations),
+		Extensions:  maps.Clone(b.extensions),
+		Spec:        b.spec,
+	}, b.check())
+}
+
+type SecurityGroupRuleBuilder struct {
+	workspaceBase[*SecurityGroupRuleBuilder]
+
+	spec schema.SecurityGroupRuleSpec
+}
+
+func NewSecurityGroupRuleBuilder() *SecurityGroupRuleBuilder {
+	b := &SecurityGroupRuleBuilder{}
+	b.self = b
+	return b
+}
+
+func (b *SecurityGroupRuleBuilder) Ingress() *SecurityGroupRuleBuilder {
+	b.spec.Direction = schema.SecurityGroupRuleDirectionIngress
+	return b
+}
+
+func (b *SecurityGroupRuleBuilder) Egress() *SecurityGroupRuleBuilder {
+	b.spec.Direction = schema.SecurityGroupRuleDirectionEgress
+	return b
+}
+
+func (b *SecurityGroupRuleBuilder) Protocol(protocol schema.SecurityGroupRuleSpecProtocol) *SecurityGroupRuleBuilder {
+	b.spec.Protocol = protocol
+	return b
+}
+
+func (b *SecurityGroupRuleBuilder) Ports(ports ...int) *SecurityGroupRuleBuilder {
+	if b.spec.Ports == nil {
+		b.spec.Ports = &schema.Ports{}
+	}
+	b.spec.Ports.List = append(b.spec.Ports.List, ports...)
+	return b
+}
+
+func (b *SecurityGroupRuleBuilder) PortRange(from, to int) *SecurityGroupRuleBuilder {
+	if b.spec.Ports == nil {
+		b.spec.Ports = &schema.Ports{}
+	}
+	b.spec.Ports.From, b.spec.Ports.To = from, to
+	return b
+}
+
+func (b *SecurityGroupRuleBuilder) Icmp(icmpType, code int) *SecurityGroupRuleBuilder {
+	b.spec.Icmp = &schema.IcmpConfig{Type: icmpType, Code: code}
+	return b
+}
+
+func (b *SecurityGroupRuleBuilder) Version(version schema.IPVersion) *SecurityGroupRuleBuilder {
+	b.spec.Version = version
+	return b
+}
+
+// Source restricts the rule to the traffic with the referenced resources, as a security group or an instance.
+func (b *SecurityGroupRuleBuilder) Source(refs ...schema.Reference) *SecurityGroupRuleBuilder {
+	b.spec.SourceRef = append(b.spec.SourceRef, refs...)
+	return b
+}
+
+// SourceCidr restricts the rule to the traffic with a CIDR block or an IP address.
+func (b *SecurityGroupRuleBuilder) SourceCidr(cidrs ...string) *SecurityGroupRuleBuilder {
+	for _, cidr := range cidrs {
+		b.spec.SourceRef = append(b.spec.SourceRef, schema.Reference{Resource: cidr})
+	}
+	return b
+}
+
+// Spec returns the validated spec of the rule, to be inlined in a security group.
+func (b *SecurityGroupRuleBuilder) Spec() (schema.SecurityGroupRuleSpec, error) {
+	if err := errors.Join(checkRuleSpec(b.spec)...); err != nil {
+		return schema.SecurityGroupRuleSpec{}, err
+	}
+	return cloneRuleSpec(b.spec), nil
+}
+
+func (b *SecurityGroupRuleBuilder) Build() (*schema.SecurityGroupRule, error) {
+	errs := append(b.check(), checkRuleSpec(b.spec)...)
+
+	return build(&schema.SecurityGroupRule{
+		Metadata:    b.regionalWorkspaceMetadata(schema.ResourceMetadataKindResourceKindSecurityGroupRule),
+		Labels:      maps.Clone(b.labels),
+		Annotations: maps.Clone(b.annotations),
+		Extensions:  maps.Clone(b.extensions),
+		Spec:        cloneRuleSpec(b.spec),
+	}, errs)
+}
+
+type SecurityGroupBuilder struct {
+	workspaceBase[*SecurityGroupBuilder]
+
+	spec schema.SecurityGroupSpec
+}
+
+func NewSecurityGroupBuilder() *SecurityGroupBuilder {
+	b := &SecurityGroupBuilder{}
+	b.self = b
+	return b
+}
+
+// Rule inlines rules in the security group.
+func (b *SecurityGroupBuilder) Rule(specs ...schema.SecurityGroupRuleSpec) *SecurityGroupBuilder {
+	b.spec.Rules = append(b.spec.Rules, specs...)
+	return b
+}
+
+// RuleRef references shared rules, as built by a SecurityGroupRuleBuilder.
+func (b *SecurityGroupBuilder) RuleRef(refs ...schema.Reference) *SecurityGroupBuilder {
+	b.spec.RuleRefs = append(b.spec.RuleRefs, refs...)
+	return b
+}
+
+func (b *SecurityGroupBuilder) Build() (*schema.SecurityGroup, error) {
+	errs := b.check()
+	for _, spec := range b.spec.Rules {
+		errs = append(errs, checkRuleSpec(spec)...)
+	}
+	for _, ref := range b.spec.RuleRefs {
+		if err := checkRef("ruleRefs", ref); err != nil {
+			errs = append(errs, err)
+		}
+	}
+	if len(b.spec.Rules) > maxSecurityGroupRules {
+		errs = append(errs, invalid("rules", "exceeds %d rules", maxSecurityGroupRules))
+	}
+	if len(b.spec.RuleRefs) > maxSecurityGroupRules {
+		errs = append(errs, invalid("ruleRefs", "exceeds %d rules", maxSecurityGroupRules))
+	}
+
+	return build(&schema.SecurityGroup{
+		Metadata:    b.regionalWorkspaceMetadata(schema.ResourceMetadataKindResourceKindSecurityGroup),
+		Labels:      maps.Clone(b.labels),
+		Annotations: maps.Clone(b.annotations),
+		Extensions:  maps.Clone(b.extensions),
+		Spec:        schema.SecurityGroupSpec{RuleRefs: slices.Clone(b.spec.RuleRefs), Rules: cloneRuleSpecs(b.spec.Rules)},
+	}, errs)
+}
+
+type NicBuilder struct {
+	workspaceBase[*NicBuilder]
+
+	spec schema.NicSpec
+}
+
+func NewNicBuilder() *NicBuilder {
+	b := &NicBuilder{}
+	b.self = b
+	return b
+}
+
+func (b *NicBuilder) Subnet(ref schema.Reference) *NicBuilder {
+	b.spec.SubnetRef = ref
+	return b
+}
+
+func (b *NicBuilder) Address(addresses ...schema.NicIp) *NicBuilder {
+	b.spec.Addresses = append(b.spec.Addresses, addresses...)
+	return b
+}
+
+func (b *NicBuilder) SecurityGroup(refs ...schema.Reference) *NicBuilder {
+	b.spec.SecurityGroupRefs = append(b.spec.SecurityGroupRefs, refs...)
+	return b
+}
+
+func (b *NicBuilder) PublicIp(refs ...schema.Reference) *NicBuilder {
+	b.spec.PublicIpRefs = append(b.spec.PublicIpRefs, refs...)
+	return b
+}
+
+func (b *NicBuilder) Sku(ref schema.Reference) *NicBuilder {
+	b.spec.SkuRef = &ref
+	return b
+}
+
+func (b *NicBuilder) Build() (*schema.Nic, error) {
+	errs := b.check()
+	if err := checkRef("subnetRef", b.spec.SubnetRef); err != nil {
+		errs = append(errs, err)
+	}
+	if len(b.spec.Addresses) == 0 || len(b.spec.Addresses) > maxNicAddresses {
+		errs = append(errs, invalid("addresses", "must have between 1 and %d addresses", maxNicAddresses))
+	}
+	for _, address := range b.spec.Addresses {
+		if _, err := netip.ParseAddr(address); err != nil {
+			errs = append(errs, invalid("addresses", "%q is not an IP address", address))
+		}
+	}
+	if len(b.spec.SecurityGroupRefs) > maxNicRefs {
+		errs = append(errs, invalid("securityGroupRefs", "exceeds %d security groups", maxNicRefs))
+	}
+	if len(b.spec.PublicIpRefs) > maxNicRefs {
+		errs = append(errs, invalid("publicIpRefs", "exceeds %d public ips", maxNicRefs))
+	}
+
+	return build(&schema.Nic{
+		Metadata:    b.regionalWorkspaceMetadata(schema.ResourceMetadataKindResourceKindNic),
+		Labels:      maps.Clone(b.labels),
+		Annotations: maps.Clone(b.annotations),
+		Extensions:  maps.Clone(b.extensions),
+		Spec: schema.NicSpec{
+			Addresses:         slices.Clone(b.spec.Addresses),
+			PublicIpRefs:      slices.Clone(b.spec.PublicIpRefs),
+			SecurityGroupRefs: slices.Clone(b.spec.SecurityGroupRefs),
+			SkuRef:            clonePtr(b.spec.SkuRef),
+			SubnetRef:         b.spec.SubnetRef,
+		},
+	}, errs)
+}
+
+type PublicIpBuilder struct {
+	workspaceBase[*PublicIpBuilder]
+
+	spec schema.PublicIpSpec
+}
+
+func NewPublicIpBuilder() *PublicIpBuilder {
+	b := &PublicIpBuilder{}
+	b.self = b
+	return b
+}
+
+func (b *PublicIpBuilder) Version(version schema.IPVersion) *PublicIpBuilder {
+	b.spec.Version = version
+	return b
+}
+
+// Address requests a specific address, the provider allocates one when it's empty.
+func (b *PublicIpBuilder) Address(address string) *PublicIpBuilder {
+	b.spec.Address = address
+	return b
+}
+
+func (b *PublicIpBuilder) Build() (*schema.PublicIp, error) {
+	errs := b.check()
+	if b.spec.Version != schema.IPVersionIPv4 && b.spec.Version != schema.IPVersionIPv6 {
+		errs = append(errs, invalid("version", "must be IPv4 or IPv6"))
+	}
+	if b.spec.Address != "" {
+		addr, err := netip.ParseAddr(b.spec.Address)
+		if err != nil {
+			errs = append(errs, invalid("address", "%q is not an IP address", b.spec.Address))
+		} else if !secautil.VersionMatches(b.spec.Version, addr) {
+			errs = append(errs, invalid("address", "%s is not an %s address", addr, b.spec.Version))
+		}
+	}
+
+	return build(&schema.PublicIp{
+		Metadata:    b.regionalWorkspaceMetadata(schema.ResourceMetadataKindResourceKindPublicIP),
+		Labels:      maps.Clone(b.labels),
+		Annotations: maps.Clone(b.annotations),
+		Extensions:  maps.Clone(b.extensions),
+		Spec:        b.spec,
+	}, errs)
+}
+
+// Returns the errors of a rule spec, shared by the rules and the security groups
+func checkRuleSpec(spec schema.SecurityGroupRuleSpec) []error {
+	var errs []error
+	switch spec.Direction {
+	case schema.SecurityGroupRuleDirectionIngress, schema.SecurityGroupRuleDirectionEgress:
+	default:
+		errs = append(errs, invalid("direction", "must be ingress or egress"))
+	}
+
+	switch spec.Protocol {
+	case "", schema.SecurityGroupRuleProtocolTCP, schema.SecurityGroupRuleProtocolUDP, schema.SecurityGroupRuleProtocolTCPUDP, schema.SecurityGroupRuleProtocolICMP:
+	default:
+		errs = append(errs, invalid("protocol", "must be tcp, udp, tcp+udp or icmp"))
+	}
+
+	if ports := spec.Ports; ports != nil {
+		if spec.Protocol == "" || spec.Protocol == schema.SecurityGroupRuleProtocolICMP {
+			errs = append(errs, invalid("ports", "need the tcp or the udp protocol"))
+		}
+		for _, port := range append([]int{ports.From, ports.To}, ports.List...) {
+			if port != 0 && (port < minPort || port > maxPort) {
+				errs = append(errs, invalid("ports", "%d is not between %d and %d", port, minPort, maxPort))
+			}
+		}
+		if ports.From != 0 && ports.To != 0 && ports.From > ports.To {
+			errs = append(errs, invalid("ports", "range %d-%d is reversed", ports.From, ports.To))
+		}
+		if len(ports.List) > maxRulePorts {
+			errs = append(errs, invalid("ports.list", "exceeds %d ports", maxRulePorts))
+		}
+	}
+
+	if icmp := spec.Icmp; icmp != nil {
+		if spec.Protocol != schema.SecurityGroupRuleProtocolICMP {
+			errs = append(errs, invalid("icmp", "needs the icmp protocol"))
+		}
+		if icmp.Type < 0 || icmp.Type > maxIcmpType {
+			errs = append(errs, invalid("icmp.type", "must be between 0 and %d", maxIcmpType))
+		}
+		if icmp.Code < 0 || icmp.Code > maxIcmpCode {
+			errs = append(errs, invalid("icmp.code", "must be between 0 and %d", maxIcmpCode))
+		}
+	}
+
+	switch spec.Version {
+	case "", schema.IPVersionIPv4, schema.IPVersionIPv6:
+	default:
+		errs = append(errs, invalid("version", "must be IPv4 or IPv6"))
+	}
+
+	for _, ref := range spec.SourceRef {
+		if err := checkRef("sourceRef", ref); err != nil {
+			errs = append(errs, err)
+			continue
+		}
+		if prefix, err := netip.ParsePrefix(ref.Resource); err == nil && !secautil.VersionMatches(spec.Version, prefix.Addr()) {
+			errs = append(errs, invalid("sourceRef", "%s is not an %s block", ref.Resource, spec.Version))
+		}
+	}
+	return errs
+}
+
+// Returns a copy of the rule spec which shares nothing with the builder
+func cloneRuleSpec(spec schema.SecurityGroupRuleSpec) schema.SecurityGroupRuleSpec {
+	spec.Annotations = maps.Clone(spec.Annotations)
+	spec.Icmp = clonePtr(spec.Icmp)
+	spec.Ports = clonePtr(spec.Ports)
+	if spec.Ports != nil {
+		spec.Ports.List = slices.Clone(spec.Ports.List)
+	}
+	spec.SourceRef = slices.Clone(spec.SourceRef)
+	return spec
+}
+
+// Returns copies of the rule specs, as cloneRuleSpec
+func cloneRuleSpecs(specs []schema.SecurityGroupRuleSpec) []schema.SecurityGroupRuleSpec {
+	if specs == nil {
+		return nil
+	}
+	clones := make([]schema.SecurityGroupRuleSpec, 0, len(specs))
+	for _, spec := range specs {
+		clones = append(clones, cloneRuleSpec(spec))
+	}
+	return clones
+}
+
+// Returns the errors of the CIDR blocks, at least one of them is required
+func checkCidr(field string, cidr schema.Cidr) []error {
+	if cidr.Ipv4 == "" && cidr.Ipv6 == "" {
+		return []error{invalid(field, "is required")}
+	}
+
+	var errs []error
+	for _, block := range []struct {
+		value   string
+		version schema.IPVersion
+	}{
+		{cidr.Ipv4, schema.IPVersionIPv4},
+		{cidr.Ipv6, schema.IPVersionIPv6},
+	} {
+		if block.value == "" {
+			continue
+		}
+		prefix, err := netip.ParsePrefix(block.value)
+		if err != nil || !secautil.VersionMatches(block.version, prefix.Addr()) {
+			errs = append(errs, invalid(field, "%q is not an %s CIDR block", block.value, block.version))
+		}
+	}
+	return errs
+}
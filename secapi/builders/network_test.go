@@ -0,0 +1,256 @@
+package builders
+
+import (
+	"testing"
+
+	"github.com/eu-sovereign-cloud/go-sdk/internal/secatest"
+	"github.com/eu-sovereign-cloud/go-sdk/pkg/spec/schema"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNetworkBuilder_Build(t *testing.T) {
+	network, err := NewNetworkBuilder().
+		Name(secatest.Network1Name).
+		Tenant(secatest.Tenant1Name).
+		Workspace(secatest.Workspace1Name).
+		Region(secatest.Region1Name).
+		Sku(ResourceRef("skus", secatest.NetworkSku1Name)).
+		Cidr("10.0.0.0/16", "fd00::/48").
+		AdditionalCidr("10.1.0.0/16", "").
+		Build()
+	require.NoError(t, err)
+
+	assert.Equal(t, schema.Cidr{Ipv4: "10.0.0.0/16", Ipv6: "fd00::/48"}, network.Spec.Cidr)
+	assert.Equal(t, []schema.Cidr{{Ipv4: "10.1.0.0/16"}}, network.Spec.AdditionalCidrs)
+
+	_, err = NewNetworkBuilder().
+		Name(secatest.Network1Name).
+		Tenant(secatest.Tenant1Name).
+		Workspace(secatest.Workspace1Name).
+		Cidr("fd00::/48", "10.0.0.0/16").
+		Build()
+	assert.ErrorContains(t, err, "skuRef is required")
+	assert.ErrorContains(t, err, `cidr "fd00::/48" is not an IPv4 CIDR block`)
+	assert.ErrorContains(t, err, `cidr "10.0.0.0/16" is not an IPv6 CIDR block`)
+}
+
+func TestSubnetBuilder_Build(t *testing.T) {
+	network := &schema.Network{Metadata: secatest.NewRegionalWorkspaceResourceMetadata(secatest.Network1Name, secatest.Tenant1Name, secatest.Workspace1Name, secatest.Region1Name)}
+
+	subnet, err := NewSubnetBuilder().
+		Name(secatest.Subnet1Name).
+		InNetwork(network).
+		Cidr("10.0.1.0/24", "").
+		Zone(secatest.ZoneA).
+		RouteTable(ResourceRef("route-tables", secatest.RouteTable1Name)).
+		Build()
+	require.NoError(t, err)
+
+	assert.Equal(t, &schema.RegionalNetworkResourceMetadata{
+		Name:      secatest.Subnet1Name,
+		Tenant:    secatest.Tenant1Name,
+		Workspace: secatest.Workspace1Name,
+		Network:   secatest.Network1Name,
+		Region:    secatest.Region1Name,
+		Kind:      schema.RegionalNetworkResourceMetadataKindResourceKindSubnet,
+	}, subnet.Metadata)
+	assert.Equal(t, secatest.RouteTable1Ref, subnet.Spec.RouteTableRef.Resource)
+
+	_, err = NewSubnetBuilder().Name(secatest.Subnet1Name).InNetwork(network).Build()
+	assert.ErrorContains(t, err, "cidr is required")
+	assert.ErrorContains(t, err, "zone is required")
+	assert.ErrorContains(t, err, "routeTableRef is required")
+}
+
+func TestRouteTableBuilder_Build(t *testing.T) {
+	gateway := &schema.InternetGateway{Metadata: secatest.NewRegionalWorkspaceResourceMetadata(secatest.InternetGateway1Name, secatest.Tenant1Name, secatest.Workspace1Name, secatest.Region1Name)}
+
+	table, err := NewRouteTableBuilder().
+		Name(secatest.RouteTable1Name).
+		Tenant(secatest.Tenant1Name).
+		Workspace(secatest.Workspace1Name).
+		Network(secatest.Network1Name).
+		Route("0.0.0.0/0", Ref(gateway)).
+		Build()
+	require.NoError(t, err)
+
+	assert.Equal(t, schema.RegionalNetworkResourceMetadataKindResourceKindRoutingTable, table.Metadata.Kind)
+	assert.Equal(t, []schema.RouteSpec{{DestinationCidrBlock: "0.0.0.0/0", TargetRef: schema.Reference{Resource: "internet-gateways/" + secatest.InternetGateway1Name}}}, table.Spec.Routes)
+
+	_, err = NewRouteTableBuilder().
+		Name(secatest.RouteTable1Name).
+		Tenant(secatest.Tenant1Name).
+		Workspace(secatest.Workspace1Name).
+		Network(secatest.Network1Name).
+		Build()
+	assert.ErrorContains(t, err, "routes must have between 1 and 1000 routes")
+
+	_, err = NewRouteTableBuilder().
+		Name(secatest.RouteTable1Name).
+		Tenant(secatest.Tenant1Name).
+		Workspace(secatest.Workspace1Name).
+		Network(secatest.Network1Name).
+		Route("default", schema.Reference{}).
+		Build()
+	assert.ErrorContains(t, err, `routes.destinationCidrBlock "default" is not a CIDR block`)
+	assert.ErrorContains(t, err, "routes.targetRef is required")
+}
+
+func TestSecurityGroupRuleBuilder_Build(t *testing.T) {
+	rule, err := NewSecurityGroupRuleBuilder().
+		Name(secatest.SecurityGroupRule1Name).
+		Tenant(secatest.Tenant1Name).
+		Workspace(secatest.Workspace1Name).
+		Ingress().
+		Protocol(schema.SecurityGroupRuleProtocolTCP).
+		PortRange(8000, 8080).
+		Ports(443).
+		Version(schema.IPVersionIPv4).
+		SourceCidr("10.0.0.0/8").
+		Source(ResourceRef("security-groups", secatest.SecurityGroup1Name)).
+		Build()
+	require.NoError(t, err)
+
+	assert.Equal(t, schema.RegionalWorkspaceResourceMetadataKindResourceKindSecurityGroupRule, rule.Metadata.Kind)
+	assert.Equal(t, schema.SecurityGroupRuleSpec{
+		Direction: schema.SecurityGroupRuleDirectionIngress,
+		Protocol:  schema.SecurityGroupRuleProtocolTCP,
+		Ports:     &schema.Ports{From: 8000, To: 8080, List: []int{443}},
+		Version:   schema.IPVersionIPv4,
+		SourceRef: []schema.Reference{{Resource: "10.0.0.0/8"}, {Resource: "security-groups/" + secatest.SecurityGroup1Name}},
+	}, rule.Spec)
+
+	_, err = NewSecurityGroupRuleBuilder().
+		Name(secatest.SecurityGroupRule1Name).
+		Tenant(secatest.Tenant1Name).
+		Workspace(secatest.Workspace1Name).
+		Protocol(schema.SecurityGroupRuleProtocolICMP).
+		PortRange(90, 80).
+		Icmp(9, 0).
+		Version(schema.IPVersionIPv6).
+		SourceCidr("10.0.0.0/8").
+		Build()
+	assert.ErrorContains(t, err, "direction must be ingress or egress")
+	assert.ErrorContains(t, err, "ports need the tcp or the udp protocol")
+	assert.ErrorContains(t, err, "range 90-80 is reversed")
+	assert.ErrorContains(t, err, "icmp.type must be between 0 and 8")
+	assert.ErrorContains(t, err, "sourceRef 10.0.0.0/8 is not an IPv6 block")
+}
+
+func TestSecurityGroupRuleBuilder_Reuse(t *testing.T) {
+	builder := NewSecurityGroupRuleBuilder().
+		Ingress().
+		Protocol(schema.SecurityGroupRuleProtocolTCP).
+		Ports(22).
+		SourceCidr("10.0.0.0/8")
+
+	spec, err := builder.Spec()
+	require.NoError(t, err)
+	spec.Ports.List[0] = 2222
+	spec.SourceRef[0].Resource = "192.168.0.0/16"
+
+	group, err := NewSecurityGroupBuilder().
+		Name(secatest.SecurityGroup1Name).
+		Tenant(secatest.Tenant1Name).
+		Workspace(secatest.Workspace1Name).
+		Rule(spec).
+		Build()
+	require.NoError(t, err)
+	group.Spec.Rules[0].Ports.From = 8000
+
+	// Neither the spec nor the security group share the ports and the sources of the builder
+	reused, err := builder.Ports(443).Spec()
+	require.NoError(t, err)
+	assert.Equal(t, &schema.Ports{List: []int{22, 443}}, reused.Ports)
+	assert.Equal(t, []schema.Reference{{Resource: "10.0.0.0/8"}}, reused.SourceRef)
+	assert.Equal(t, &schema.Ports{List: []int{2222}}, spec.Ports)
+}
+
+func TestSecurityGroupBuilder_Build(t *testing.T) {
+	ssh, err := NewSecurityGroupRuleBuilder().Ingress().Protocol(schema.SecurityGroupRuleProtocolTCP).Ports(22).Spec()
+	require.NoError(t, err)
+
+	shared := &schema.SecurityGroupRule{Metadata: secatest.NewRegionalWorkspaceResourceMetadata(secatest.SecurityGroupRule1Name, secatest.Tenant1Name, secatest.Workspace1Name, secatest.Region1Name)}
+
+	group, err := NewSecurityGroupBuilder().
+		Name(secatest.SecurityGroup1Name).
+		Tenant(secatest.Tenant1Name).
+		Workspace(secatest.Workspace1Name).
+		Rule(ssh).
+		RuleRef(Ref(shared)).
+		Build()
+	require.NoError(t, err)
+
+	assert.Equal(t, []schema.SecurityGroupRuleSpec{ssh}, group.Spec.Rules)
+	assert.Equal(t, []schema.Reference{{Resource: "security-group-rules/" + secatest.SecurityGroupRule1Name}}, group.Spec.RuleRefs)
+
+	_, err = NewSecurityGroupRuleBuilder().Egress().Icmp(0, 0).Spec()
+	assert.ErrorContains(t, err, "icmp needs the icmp protocol")
+
+	_, err = NewSecurityGroupBuilder().
+		Name(secatest.SecurityGroup1Name).
+		Tenant(secatest.Tenant1Name).
+		Workspace(secatest.Workspace1Name).
+		Rule(schema.SecurityGroupRuleSpec{Direction: "inbound"}).
+		Build()
+	assert.ErrorContains(t, err, "direction must be ingress or egress")
+}
+
+func TestNicBuilder_Build(t *testing.T) {
+	subnet := &schema.Subnet{Metadata: secatest.NewRegionalNetworkResourceMetadata(secatest.Subnet1Name, secatest.Tenant1Name, secatest.Workspace1Name, secatest.Network1Name, secatest.Region1Name)}
+
+	nic, err := NewNicBuilder().
+		Name(secatest.Nic1Name).
+		Tenant(secatest.Tenant1Name).
+		Workspace(secatest.Workspace1Name).
+		Subnet(Ref(subnet)).
+		Address("10.0.1.10", "fd00::10").
+		SecurityGroup(ResourceRef("security-groups", secatest.SecurityGroup1Name)).
+		PublicIp(ResourceRef("public-ips", secatest.PublicIp1Name)).
+		Build()
+	require.NoError(t, err)
+
+	assert.Equal(t, secatest.Subnet1Ref, nic.Spec.SubnetRef.Resource)
+	assert.Equal(t, []schema.NicIp{"10.0.1.10", "fd00::10"}, nic.Spec.Addresses)
+
+	_, err = NewNicBuilder().
+		Name(secatest.Nic1Name).
+		Tenant(secatest.Tenant1Name).
+		Workspace(secatest.Workspace1Name).
+		Address("10.0.1").
+		Build()
+	assert.ErrorContains(t, err, "subnetRef is required")
+	assert.ErrorContains(t, err, `addresses "10.0.1" is not an IP address`)
+}
+
+func TestPublicIpBuilder_Build(t *testing.T) {
+	ip, err := NewPublicIpBuilder().
+		Name(secatest.PublicIp1Name).
+		Tenant(secatest.Tenant1Name).
+		Workspace(secatest.Workspace1Name).
+		Version(schema.IPVersionIPv4).
+		Address("203.0.113.10").
+		Build()
+	require.NoError(t, err)
+
+	assert.Equal(t, schema.PublicIpSpec{Version: schema.IPVersionIPv4, Address: "203.0.113.10"}, ip.Spec)
+
+	_, err = NewPublicIpBuilder().
+		Name(secatest.PublicIp1Name).
+		Tenant(secatest.Tenant1Name).
+		Workspace(secatest.Workspace1Name).
+		Address("203.0.113.10").
+		Build()
+	assert.ErrorContains(t, err, "version must be IPv4 or IPv6")
+
+	_, err = NewPublicIpBuilder().
+		Name(secatest.PublicIp1Name).
+		Tenant(secatest.Tenant1Name).
+		Workspace(secatest.Workspace1Name).
+		Version(schema.IPVersionIPv6).
+		Address("203.0.113.10").
+		Build()
+	assert.ErrorContains(t, err, "address 203.0.113.10 is not an IPv6 address")
+}
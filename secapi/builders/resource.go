@@ -0,0 +1,308 @@
+package builders
+
+import (
+	"errors"
+	"fmt"
+	"maps"
+	"strings"
+
+	"github.com/eu-sovereign-cloud/go-sdk/pkg/spec/schema"
+)
+
+var (
+	ErrNoName      = errors.New("name is required")
+	ErrNoTenant    = errors.New("tenant is required")
+	ErrNoWorkspace = errors.New("workspace is required")
+	ErrNoNetwork   = errors.New("network is required")
+	ErrInvalidSpec = errors.New("invalid spec")
+)
+
+// Resource is a resource which can be referenced by another one
+type Resource interface {
+	schema.Instance |
+		schema.InstanceSku |
+		schema.BlockStorage |
+		schema.Image |
+		schema.StorageSku |
+		schema.Network |
+		schema.NetworkSku |
+		schema.Subnet |
+		schema.RouteTable |
+		schema.InternetGateway |
+		schema.SecurityGroupRule |
+		schema.SecurityGroup |
+		schema.Nic |
+		schema.PublicIp |
+		schema.Workspace |
+		schema.Role
+}
+
+// Ref returns the reference of a resource, as <collection>/<name>.
+func Ref[T Resource](resource *T) schema.Reference {
+	var collection, name string
+	switch r := any(resource).(type) {
+	case *schema.Instance:
+		collection, name = "instances", nameOf(r.Metadata)
+	case *schema.InstanceSku:
+		collection, name = "skus", nameOf(r.Metadata)
+	case *schema.BlockStorage:
+		collection, name = "block-storages", nameOf(r.Metadata)
+	case *schema.Image:
+		collection, name = "images", nameOf(r.Metadata)
+	case *schema.StorageSku:
+		collection, name = "skus", nameOf(r.Metadata)
+	case *schema.Network:
+		collection, name = "networks", nameOf(r.Metadata)
+	case *schema.NetworkSku:
+		collection, name = "skus", nameOf(r.Metadata)
+	case *schema.Subnet:
+		collection, name = "subnets", nameOf(r.Metadata)
+	case *schema.RouteTable:
+		collection, name = "route-tables", nameOf(r.Metadata)
+	case *schema.InternetGateway:
+		collection, name = "internet-gateways", nameOf(r.Metadata)
+	case *schema.SecurityGroupRule:
+		collection, name = "security-group-rules", nameOf(r.Metadata)
+	case *schema.SecurityGroup:
+		collection, name = "security-groups", nameOf(r.Metadata)
+	case *schema.Nic:
+		collection, name = "nics", nameOf(r.Metadata)
+	case *schema.PublicIp:
+		collection, name = "public-ips", nameOf(r.Metadata)
+	case *schema.Workspace:
+		collection, name = "workspaces", nameOf(r.Metadata)
+	case *schema.Role:
+		collection, name = "roles", nameOf(r.Metadata)
+	}
+	return ResourceRef(collection, name)
+}
+
+// ResourceRef returns the reference of a resource of a collection by its name, as skus/<name>.
+func ResourceRef(collection, name string) schema.Reference {
+	return schema.Reference{Resource: collection + "/" + name}
+}
+
+func nameOf[M schema.GlobalTenantResourceMetadata | schema.RegionalResourceMetadata | schema.RegionalWorkspaceResourceMetadata | schema.RegionalNetworkResourceMetadata | schema.SkuResourceMetadata](metadata *M) string {
+	if metadata == nil {
+		return ""
+	}
+	switch m := any(metadata).(type) {
+	case *schema.GlobalTenantResourceMetadata:
+		return m.Name
+	case *schema.RegionalResourceMetadata:
+		return m.Name
+	case *schema.RegionalWorkspaceResourceMetadata:
+		return m.Name
+	case *schema.RegionalNetworkResourceMetadata:
+		return m.Name
+	case *schema.SkuResourceMetadata:
+		return m.Name
+	}
+	return ""
+}
+
+// Metadata of the tenant resources, the builders embed it with themselves as B to chain the setters
+type tenantBase[B any] struct {
+	self B
+
+	name        string
+	tenant      string
+	labels      schema.Labels
+	annotations schema.Annotations
+	extensions  schema.Extensions
+}
+
+func (b *tenantBase[B]) Name(name string) B {
+	b.name = name
+	return b.self
+}
+
+func (b *tenantBase[B]) Tenant(tenant string) B {
+	b.tenant = tenant
+	return b.self
+}
+
+func (b *tenantBase[B]) Label(key, value string) B {
+	if b.labels == nil {
+		b.labels = schema.Labels{}
+	}
+	b.labels[key] = value
+	return b.self
+}
+
+func (b *tenantBase[B]) Labels(labels schema.Labels) B {
+	if b.labels == nil {
+		b.labels = schema.Labels{}
+	}
+	maps.Copy(b.labels, labels)
+	return b.self
+}
+
+func (b *tenantBase[B]) Annotation(key, value string) B {
+	if b.annotations == nil {
+		b.annotations = schema.Annotations{}
+	}
+	b.annotations[key] = value
+	return b.self
+}
+
+func (b *tenantBase[B]) Extension(key, value string) B {
+	if b.extensions == nil {
+		b.extensions = schema.Extensions{}
+	}
+	b.extensions[key] = value
+	return b.self
+}
+
+func (b *tenantBase[B]) check() []error {
+	var errs []error
+	if b.name == "" {
+		errs = append(errs, ErrNoName)
+	}
+	if b.tenant == "" {
+		errs = append(errs, ErrNoTenant)
+	}
+	return errs
+}
+
+func (b *tenantBase[B]) globalTenantMetadata(kind schema.ResourceMetadataKind) *schema.GlobalTenantResourceMetadata {
+	return &schema.GlobalTenantResourceMetadata{
+		Name:   b.name,
+		Tenant: b.tenant,
+		Kind:   schema.GlobalTenantResourceMetadataKind(kind),
+	}
+}
+
+// Metadata of the regional resources
+type regionalBase[B any] struct {
+	tenantBase[B]
+
+	region string
+}
+
+func (b *regionalBase[B]) Region(region string) B {
+	b.region = region
+	return b.self
+}
+
+func (b *regionalBase[B]) regionalMetadata(kind schema.ResourceMetadataKind) *schema.RegionalResourceMetadata {
+	return &schema.RegionalResourceMetadata{
+		Name:   b.name,
+		Tenant: b.tenant,
+		Region: b.region,
+		Kind:   schema.RegionalResourceMetadataKind(kind),
+	}
+}
+
+// Metadata of the workspace resources
+type workspaceBase[B any] struct {
+	regionalBase[B]
+
+	workspace string
+}
+
+func (b *workspaceBase[B]) Workspace(workspace string) B {
+	b.workspace = workspace
+	return b.self
+}
+
+// InWorkspace sets the tenant, the workspace and the region of the resource from a workspace.
+func (b *workspaceBase[B]) InWorkspace(workspace *schema.Workspace) B {
+	if workspace != nil && workspace.Metadata != nil {
+		b.tenant = workspace.Metadata.Tenant
+		b.workspace = workspace.Metadata.Name
+		b.region = workspace.Metadata.Region
+	}
+	return b.self
+}
+
+func (b *workspaceBase[B]) check() []error {
+	errs := b.tenantBase.check()
+	if b.workspace == "" {
+		errs = append(errs, ErrNoWorkspace)
+	}
+	return errs
+}
+
+func (b *workspaceBase[B]) regionalWorkspaceMetadata(kind schema.ResourceMetadataKind) *schema.RegionalWorkspaceResourceMetadata {
+	return &schema.RegionalWorkspaceResourceMetadata{
+		Name:      b.name,
+		Tenant:    b.tenant,
+		Workspace: b.workspace,
+		Region:    b.region,
+		Kind:      schema.RegionalWorkspaceResourceMetadataKind(kind),
+	}
+}
+
+// Metadata of the network resources
+type networkBase[B any] struct {
+	workspaceBase[B]
+
+	network string
+}
+
+func (b *networkBase[B]) Network(network string) B {
+	b.network = network
+	return b.self
+}
+
+// InNetwork sets the tenant, the workspace, the network and the region of the resource from a network.
+func (b *networkBase[B]) InNetwork(network *schema.Network) B {
+	if network != nil && network.Metadata != nil {
+		b.tenant = network.Metadata.Tenant
+		b.workspace = network.Metadata.Workspace
+		b.network = network.Metadata.Name
+		b.region = network.Metadata.Region
+	}
+	return b.self
+}
+
+func (b *networkBase[B]) check() []error {
+	errs := b.workspaceBase.check()
+	if b.network == "" {
+		errs = append(errs, ErrNoNetwork)
+	}
+	return errs
+}
+
+func (b *networkBase[B]) regionalNetworkMetadata(kind schema.ResourceMetadataKind) *schema.RegionalNetworkResourceMetadata {
+	return &schema.RegionalNetworkResourceMetadata{
+		Name:      b.name,
+		Tenant:    b.tenant,
+		Workspace: b.workspace,
+		Network:   b.network,
+		Region:    b.region,
+		Kind:      schema.RegionalNetworkResourceMetadataKind(kind),
+	}
+}
+
+// Returns an error of the spec field
+func invalid(field string, format string, args ...any) error {
+	return fmt.Errorf("%w: %s %s", ErrInvalidSpec, field, fmt.Sprintf(format, args...))
+}
+
+// Returns an error when the reference has no resource name
+func checkRef(field string, ref schema.Reference) error {
+	resource := strings.TrimSpace(ref.Resource)
+	if resource == "" || strings.HasSuffix(resource, "/") {
+		return invalid(field, "is required")
+	}
+	return nil
+}
+
+// Returns a copy of the value, so that the built resources don't share the pointers of the builder
+func clonePtr[T any](value *T) *T {
+	if value == nil {
+		return nil
+	}
+	clone := *value
+	return &clone
+}
+
+// Joins the errors of a build, the result is returned only when there's none
+func build[T any](result *T, errs []error) (*T, error) {
+	if err := errors.Join(errs...); err != nil {
+		return nil, err
+	}
+	return result, nil
+}
@@ -0,0 +1,67 @@
+package builders
+
+import (
+	"testing"
+
+	"github.com/eu-sovereign-cloud/go-sdk/internal/secatest"
+	"github.com/eu-sovereign-cloud/go-sdk/pkg/spec/schema"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRef(t *testing.T) {
+	instance := &schema.Instance{Metadata: secatest.NewRegionalWorkspaceResourceMetadata(secatest.Instance1Name, secatest.Tenant1Name, secatest.Workspace1Name, secatest.Region1Name)}
+	assert.Equal(t, schema.Reference{Resource: secatest.Instance1Ref}, Ref(instance))
+
+	subnet := &schema.Subnet{Metadata: secatest.NewRegionalNetworkResourceMetadata(secatest.Subnet1Name, secatest.Tenant1Name, secatest.Workspace1Name, secatest.Network1Name, secatest.Region1Name)}
+	assert.Equal(t, schema.Reference{Resource: secatest.Subnet1Ref}, Ref(subnet))
+
+	sku := &schema.NetworkSku{Metadata: &schema.SkuResourceMetadata{Name: secatest.NetworkSku1Name}}
+	assert.Equal(t, schema.Reference{Resource: secatest.NetworkSku1Ref}, Ref(sku))
+
+	assert.Equal(t, schema.Reference{Resource: secatest.RouteTable1Ref}, ResourceRef("route-tables", secatest.RouteTable1Name))
+
+	// A resource without metadata is not a valid reference
+	assert.Error(t, checkRef("ref", Ref(&schema.Nic{})))
+}
+
+func TestMetadata_InWorkspace(t *testing.T) {
+	workspace, err := NewWorkspaceBuilder().
+		Name(secatest.Workspace1Name).
+		Tenant(secatest.Tenant1Name).
+		Region(secatest.Region1Name).
+		Build()
+	assert.NoError(t, err)
+
+	gateway, err := NewInternetGatewayBuilder().
+		Name(secatest.InternetGateway1Name).
+		InWorkspace(workspace).
+		Label(secatest.LabelEnvKey, "prod").
+		Annotation("owner", "network-team").
+		Extension("billing", "shared").
+		EgressOnly(true).
+		Build()
+	assert.NoError(t, err)
+	assert.Equal(t, &schema.RegionalWorkspaceResourceMetadata{
+		Name:      secatest.InternetGateway1Name,
+		Tenant:    secatest.Tenant1Name,
+		Workspace: secatest.Workspace1Name,
+		Region:    secatest.Region1Name,
+		Kind:      schema.RegionalWorkspaceResourceMetadataKindResourceKindInternetGateway,
+	}, gateway.Metadata)
+	assert.Equal(t, schema.Labels{secatest.LabelEnvKey: "prod"}, gateway.Labels)
+	assert.Equal(t, schema.Annotations{"owner": "network-team"}, gateway.Annotations)
+	assert.Equal(t, schema.Extensions{"billing": "shared"}, gateway.Extensions)
+	assert.True(t, gateway.Spec.EgressOnly)
+}
+
+func TestMetadata_Errors(t *testing.T) {
+	_, err := NewInternetGatewayBuilder().Build()
+	assert.ErrorIs(t, err, ErrNoName)
+	assert.ErrorIs(t, err, ErrNoTenant)
+	assert.ErrorIs(t, err, ErrNoWorkspace)
+
+	_, err = NewSubnetBuilder().Name(secatest.Subnet1Name).Tenant(secatest.Tenant1Name).Workspace(secatest.Workspace1Name).Build()
+	assert.ErrorIs(t, err, ErrNoNetwork)
+	assert.NotErrorIs(t, err, ErrNoWorkspace)
+}
@@ -0,0 +1,128 @@
+package builders
+
+import (
+	"maps"
+
+	"github.com/eu-sovereign-cloud/go-sdk/pkg/spec/schema"
+)
+
+const (
+	minBlockStorageSizeGB = 1
+	maxBlockStorageSizeGB = 1000000
+)
+
+type BlockStorageBuilder struct {
+	workspaceBase[*BlockStorageBuilder]
+
+	spec schema.BlockStorageSpec
+}
+
+func NewBlockStorageBuilder() *BlockStorageBuilder {
+	b := &BlockStorageBuilder{}
+	b.self = b
+	return b
+}
+
+func (b *BlockStorageBuilder) Sku(ref schema.Reference) *BlockStorageBuilder {
+	b.spec.SkuRef = ref
+	return b
+}
+
+func (b *BlockStorageBuilder) SizeGB(size int) *BlockStorageBuilder {
+	b.spec.SizeGB = size
+	return b
+}
+
+func (b *BlockStorageBuilder) SourceImage(ref schema.Reference) *BlockStorageBuilder {
+	b.spec.SourceImageRef = &ref
+	return b
+}
+
+func (b *BlockStorageBuilder) Build() (*schema.BlockStorage, error) {
+	errs := b.check()
+	if err := checkRef("skuRef", b.spec.SkuRef); err != nil {
+		errs = append(errs, err)
+	}
+	if b.spec.SizeGB < minBlockStorageSizeGB || b.spec.SizeGB > maxBlockStorageSizeGB {
+		errs = append(errs, invalid("sizeGB", "must be between %d and %d", minBlockStorageSizeGB, maxBlockStorageSizeGB))
+	}
+	if b.spec.SourceImageRef != nil {
+		if err := checkRef("sourceImageRef", *b.spec.SourceImageRef); err != nil {
+			errs = append(errs, err)
+		}
+	}
+
+	return build(&schema.BlockStorage{
+		Metadata:    b.regionalWorkspaceMetadata(schema.ResourceMetadataKindResourceKindBlockStorage),
+		Labels:      maps.Clone(b.labels),
+		Annotations: maps.Clone(b.annotations),
+		Extensions:  maps.Clone(b.extensions),
+		Spec: schema.BlockStorageSpec{
+			SizeGB:         b.spec.SizeGB,
+			SkuRef:         b.spec.SkuRef,
+			SourceImageRef: clonePtr(b.spec.SourceImageRef),
+		},
+	}, errs)
+}
+
+type ImageBuilder struct {
+	regionalBase[*ImageBuilder]
+
+	spec schema.ImageSpec
+}
+
+func NewImageBuilder() *ImageBuilder {
+	b := &ImageBuilder{}
+	b.self = b
+	return b
+}
+
+func (b *ImageBuilder) BlockStorage(ref schema.Reference) *ImageBuilder {
+	b.spec.BlockStorageRef = ref
+	return b
+}
+
+func (b *ImageBuilder) CpuArchitecture(arch schema.ImageSpecCpuArchitecture) *ImageBuilder {
+	b.spec.CpuArchitecture = arch
+	return b
+}
+
+func (b *ImageBuilder) Boot(boot schema.ImageSpecBoot) *ImageBuilder {
+	b.spec.Boot = boot
+	return b
+}
+
+func (b *ImageBuilder) Initializer(initializer schema.ImageSpecInitializer) *ImageBuilder {
+	b.spec.Initializer = initializer
+	return b
+}
+
+func (b *ImageBuilder) Build() (*schema.Image, error) {
+	errs := b.check()
+	if err := checkRef("blockStorageRef", b.spec.BlockStorageRef); err != nil {
+		errs = append(errs, err)
+	}
+	switch b.spec.CpuArchitecture {
+	case schema.ImageSpecCpuArchitectureAmd64, schema.ImageSpecCpuArchitectureArm64:
+	default:
+		errs = append(errs, invalid("cpuArchitecture", "must be amd64 or arm64"))
+	}
+	switch b.spec.Boot {
+	case "", schema.ImageSpecBootUEFI, schema.ImageSpecBootBIOS:
+	default:
+		errs = append(errs, invalid("boot", "must be UEFI or BIOS"))
+	}
+	switch b.spec.Initializer {
+	case "", schema.ImageSpecInitializerNone, schema.ImageSpecInitializerCloudinit22:
+	default:
+		errs = append(errs, invalid("initializer", "must be none or cloudinit-22"))
+	}
+
+	return build(&schema.Image{
+		Metadata:    b.regionalMetadata(schema.ResourceMetadataKindResourceKindImage),
+		Labels:      maps.Clone(b.labels),
+		Annotations: maps.Clone(b.annotations),
+		Extensions:  maps.Clone(b.extensions),
+		Spec:        b.spec,
+	}, errs)
+}
@@ -0,0 +1,68 @@
+package builders
+
+import (
+	"testing"
+
+	"github.com/eu-sovereign-cloud/go-sdk/internal/secatest"
+	"github.com/eu-sovereign-cloud/go-sdk/pkg/spec/schema"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestBlockStorageBuilder_Build(t *testing.T) {
+	storage, err := NewBlockStorageBuilder().
+		Name(secatest.BlockStorage1Name).
+		Tenant(secatest.Tenant1Name).
+		Workspace(secatest.Workspace1Name).
+		Sku(ResourceRef("skus", secatest.StorageSku1Name)).
+		SizeGB(20).
+		SourceImage(ResourceRef("images", secatest.Image1Name)).
+		Build()
+	require.NoError(t, err)
+
+	assert.Equal(t, schema.RegionalWorkspaceResourceMetadataKindResourceKindBlockStorage, storage.Metadata.Kind)
+	assert.Equal(t, 20, storage.Spec.SizeGB)
+	assert.Equal(t, "images/"+secatest.Image1Name, storage.Spec.SourceImageRef.Resource)
+
+	_, err = NewBlockStorageBuilder().
+		Name(secatest.BlockStorage1Name).
+		Tenant(secatest.Tenant1Name).
+		Workspace(secatest.Workspace1Name).
+		Build()
+	assert.ErrorContains(t, err, "skuRef is required")
+	assert.ErrorContains(t, err, "sizeGB must be between")
+}
+
+func TestImageBuilder_Build(t *testing.T) {
+	storage := &schema.BlockStorage{Metadata: secatest.NewRegionalWorkspaceResourceMetadata(secatest.BlockStorage1Name, secatest.Tenant1Name, secatest.Workspace1Name, secatest.Region1Name)}
+
+	image, err := NewImageBuilder().
+		Name(secatest.Image1Name).
+		Tenant(secatest.Tenant1Name).
+		Region(secatest.Region1Name).
+		BlockStorage(Ref(storage)).
+		CpuArchitecture(schema.ImageSpecCpuArchitectureAmd64).
+		Boot(schema.ImageSpecBootUEFI).
+		Initializer(schema.ImageSpecInitializerCloudinit22).
+		Build()
+	require.NoError(t, err)
+
+	assert.Equal(t, &schema.RegionalResourceMetadata{
+		Name:   secatest.Image1Name,
+		Tenant: secatest.Tenant1Name,
+		Region: secatest.Region1Name,
+		Kind:   schema.RegionalResourceMetadataKindResourceKindImage,
+	}, image.Metadata)
+	assert.Equal(t, "block-storages/"+secatest.BlockStorage1Name, image.Spec.BlockStorageRef.Resource)
+
+	_, err = NewImageBuilder().
+		Name(secatest.Image1Name).
+		Tenant(secatest.Tenant1Name).
+		BlockStorage(Ref(storage)).
+		CpuArchitecture("riscv").
+		Boot("legacy").
+		Build()
+	assert.ErrorContains(t, err, "cpuArchitecture must be amd64 or arm64")
+	assert.ErrorContains(t, err, "boot must be UEFI or BIOS")
+}
@@ -0,0 +1,27 @@
+package builders
+
+import (
+	"maps"
+
+	"github.com/eu-sovereign-cloud/go-sdk/pkg/spec/schema"
+)
+
+type WorkspaceBuilder struct {
+	regionalBase[*WorkspaceBuilder]
+}
+
+func NewWorkspaceBuilder() *WorkspaceBuilder {
+	b := &WorkspaceBuilder{}
+	b.self = b
+	return b
+}
+
+func (b *WorkspaceBuilder) Build() (*schema.Workspace, error) {
+	return build(&schema.Workspace{
+		Metadata:    b.regionalMetadata(schema.ResourceMetadataKindResourceKindWorkspace),
+		Labels:      maps.Clone(b.labels),
+		Annotations: maps.Clone(b.annotations),
+		Extensions:  maps.Clone(b.extensions),
+		Spec:        schema.WorkspaceSpec{},
+	}, b.check())
+}
@@ -0,0 +1,32 @@
+package builders
+
+import (
+	"testing"
+
+	"github.com/eu-sovereign-cloud/go-sdk/internal/secatest"
+	"github.com/eu-sovereign-cloud/go-sdk/pkg/spec/schema"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestWorkspaceBuilder_Build(t *testing.T) {
+	workspace, err := NewWorkspaceBuilder().
+		Name(secatest.Workspace1Name).
+		Tenant(secatest.Tenant1Name).
+		Region(secatest.Region1Name).
+		Labels(schema.Labels{secatest.LabelEnvKey: "prod", secatest.LabelTierKey: "free"}).
+		Build()
+	require.NoError(t, err)
+
+	assert.Equal(t, &schema.RegionalResourceMetadata{
+		Name:   secatest.Workspace1Name,
+		Tenant: secatest.Tenant1Name,
+		Region: secatest.Region1Name,
+		Kind:   schema.RegionalResourceMetadataKindResourceKindWorkspace,
+	}, workspace.Metadata)
+	assert.Len(t, workspace.Labels, 2)
+
+	_, err = NewWorkspaceBuilder().Name(secatest.Workspace1Name).Build()
+	assert.ErrorIs(t, err, ErrNoTenant)
+}
@@ -0,0 +1,198 @@
+package cassette
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"os"
+	"reflect"
+	"strings"
+
+	"gopkg.in/yaml.v3"
+)
+
+// Redacted replaces the values of the redacted headers and fields.
+const Redacted = "REDACTED"
+
+const cassetteVersion = 1
+
+var (
+	ErrNoInteraction      = errors.New("no recorded interaction matches the request")
+	ErrUnsupportedVersion = errors.New("unsupported cassette version")
+)
+
+// Headers always redacted from the requests and the responses.
+var DefaultRedactHeaders = []string{"Authorization", "Proxy-Authorization", "Cookie", "Set-Cookie", "X-Api-Key"}
+
+// JSON body fields always redacted, they are matched on their whole name ignoring the case.
+var DefaultRedactFields = []string{"password", "secret", "token", "apiKey", "accessKey", "secretKey", "privateKey"}
+
+type Options struct {
+	// RedactHeaders are redacted in addition to DefaultRedactHeaders.
+	RedactHeaders []string
+
+	// RedactFields are redacted in addition to DefaultRedactFields.
+	RedactFields []string
+}
+
+// Cassette
+
+type Cassette struct {
+	Version      int           `yaml:"version"`
+	Interactions []Interaction `yaml:"interactions"`
+}
+
+type Interaction struct {
+	Request  Request  `yaml:"request"`
+	Response Response `yaml:"response"`
+}
+
+type Request struct {
+	Method  string      `yaml:"method"`
+	Path    string      `yaml:"path"`
+	Query   string      `yaml:"query,omitempty"`
+	Headers http.Header `yaml:"headers,omitempty"`
+	Body    string      `yaml:"body,omitempty"`
+}
+
+type Response struct {
+	Status  int         `yaml:"status"`
+	Headers http.Header `yaml:"headers,omitempty"`
+	Body    string      `yaml:"body,omitempty"`
+}
+
+// Load reads a cassette file.
+func Load(path string) (*Cassette, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+
+	var cassette Cassette
+	if err := yaml.Unmarshal(data, &cassette); err != nil {
+		return nil, fmt.Errorf("decoding cassette %s: %w", path, err)
+	}
+	if cassette.Version != cassetteVersion {
+		return nil, fmt.Errorf("%w: %d", ErrUnsupportedVersion, cassette.Version)
+	}
+
+	return &cassette, nil
+}
+
+// Save writes the cassette to a file.
+func (c *Cassette) Save(path string) error {
+	c.Version = cassetteVersion
+
+	var buf bytes.Buffer
+	encoder := yaml.NewEncoder(&buf)
+	encoder.SetIndent(2)
+	if err := encoder.Encode(c); err != nil {
+		return err
+	}
+	if err := encoder.Close(); err != nil {
+		return err
+	}
+
+	return os.WriteFile(path, buf.Bytes(), 0o644)
+}
+
+// Redaction
+
+type redactor struct {
+	headers []string
+	fields  map[string]bool
+}
+
+func newRedactor(options *Options) *redactor {
+	r := &redactor{headers: DefaultRedactHeaders, fields: map[string]bool{}}
+	fields := DefaultRedactFields
+	if options != nil {
+		r.headers = append(append([]string{}, DefaultRedactHeaders...), options.RedactHeaders...)
+		fields = append(append([]string{}, DefaultRedactFields...), options.RedactFields...)
+	}
+	for _, field := range fields {
+		r.fields[strings.ToLower(field)] = true
+	}
+	return r
+}
+
+func (r *redactor) redactHeaders(header http.Header) http.Header {
+	redacted := header.Clone()
+	for _, name := range r.headers {
+		if redacted.Get(name) != "" {
+			redacted.Set(name, Redacted)
+		}
+	}
+	return redacted
+}
+
+// Redacts the fields of a JSON body, the other bodies are returned as they are
+func (r *redactor) redactBody(body []byte) string {
+	var doc any
+	if len(body) == 0 || json.Unmarshal(body, &doc) != nil {
+		return string(body)
+	}
+
+	data, err := json.Marshal(r.redactValue(doc))
+	if err != nil {
+		return string(body)
+	}
+	return string(data)
+}
+
+func (r *redactor) redactValue(value any) any {
+	switch v := value.(type) {
+	case map[string]any:
+		for key, item := range v {
+			if r.fields[strings.ToLower(key)] {
+				v[key] = Redacted
+				continue
+			}
+			v[key] = r.redactValue(item)
+		}
+	case []any:
+		for i, item := range v {
+			v[i] = r.redactValue(item)
+		}
+	}
+	return value
+}
+
+// Matching
+
+func canonicalQuery(u *url.URL) string {
+	return u.Query().Encode()
+}
+
+// Compares JSON bodies by value, so the order of the fields is ignored
+func sameBody(recorded, actual string) bool {
+	if recorded == actual {
+		return true
+	}
+
+	var recordedDoc, actualDoc any
+	if json.Unmarshal([]byte(recorded), &recordedDoc) != nil || json.Unmarshal([]byte(actual), &actualDoc) != nil {
+		return false
+	}
+	return reflect.DeepEqual(recordedDoc, actualDoc)
+}
+
+// Reads the request body and restores it, so it can still be sent
+func readRequestBody(req *http.Request) ([]byte, error) {
+	if req.Body == nil || req.Body == http.NoBody {
+		return nil, nil
+	}
+
+	body, err := io.ReadAll(req.Body)
+	if err != nil {
+		return nil, err
+	}
+	_ = req.Body.Close()
+
+	req.Body = io.NopCloser(bytes.NewReader(body))
+	return body, nil
+}
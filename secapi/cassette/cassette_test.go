@@ -0,0 +1,121 @@
+package cassette
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/eu-sovereign-cloud/go-sdk/internal/secatest"
+	"github.com/eu-sovereign-cloud/go-sdk/internal/secatest/clients"
+	"github.com/eu-sovereign-cloud/go-sdk/secapi"
+	"github.com/eu-sovereign-cloud/go-sdk/secapi/fake"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRecordReplay(t *testing.T) {
+	ctx := context.Background()
+	path := filepath.Join(t.TempDir(), "cassette.yaml")
+
+	fakeServer := fake.NewServer(nil)
+	server := httptest.NewServer(fakeServer.Handler())
+	endpoints := fakeServer.Endpoints(server.URL)
+
+	wref := secapi.WorkspaceReference{Tenant: secatest.Tenant1Name, Workspace: secatest.Workspace1Name, Name: secatest.Instance1Name}
+
+	// Record
+	recorder := NewRecorder(nil, nil)
+	_, regional := clients.New(t, ctx, secapi.GlobalConfig{Endpoints: endpoints, HttpClient: recorder})
+
+	_, err := regional.ComputeV1.CreateOrUpdateInstance(ctx, secatest.NewInstance(secatest.Instance1Name, secatest.ZoneA))
+	require.NoError(t, err)
+
+	recorded, err := regional.ComputeV1.GetInstance(ctx, wref)
+	require.NoError(t, err)
+
+	require.NoError(t, recorder.Save(path))
+	server.Close()
+
+	data, err := os.ReadFile(path)
+	require.NoError(t, err)
+	assert.NotContains(t, string(data), secatest.AuthToken)
+	assert.Contains(t, string(data), Redacted)
+
+	// Replay
+	cassette, err := Load(path)
+	require.NoError(t, err)
+	require.Len(t, cassette.Interactions, 3)
+
+	replayer := NewReplayer(cassette, nil)
+	_, regional = clients.New(t, ctx, secapi.GlobalConfig{Endpoints: endpoints, HttpClient: replayer})
+
+	_, err = regional.ComputeV1.CreateOrUpdateInstance(ctx, secatest.NewInstance(secatest.Instance1Name, secatest.ZoneA))
+	require.NoError(t, err)
+
+	replayed, err := regional.ComputeV1.GetInstance(ctx, wref)
+	require.NoError(t, err)
+	assert.Equal(t, recorded, replayed)
+	assert.Equal(t, 0, replayer.Remaining())
+
+	// Every interaction is served once
+	_, err = regional.ComputeV1.GetInstance(ctx, wref)
+	assert.ErrorIs(t, err, ErrNoInteraction)
+}
+
+func TestReplayer_Matching(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Set-Cookie", "session=1")
+		w.WriteHeader(http.StatusCreated)
+		_, _ = w.Write([]byte(`{"name":"` + r.URL.Query().Get("name") + `","token":"abc"}`))
+	}))
+	defer server.Close()
+
+	recorder := NewRecorder(server.Client(), &Options{RedactFields: []string{"userData"}})
+
+	send := func(doer secapi.HttpRequestDoer, query, body string) (*http.Response, error) {
+		req, err := http.NewRequest(http.MethodPut, server.URL+"/items?"+query, strings.NewReader(body))
+		require.NoError(t, err)
+		req.Header.Set("Authorization", "Bearer "+secatest.AuthToken)
+		return doer.Do(req)
+	}
+
+	resp, err := send(recorder, "name=a&limit=1", `{"password":"p","userData":"u","size":1}`)
+	require.NoError(t, err)
+	assert.Equal(t, http.StatusCreated, resp.StatusCode)
+
+	cassette := recorder.Cassette()
+	require.Len(t, cassette.Interactions, 1)
+
+	interaction := cassette.Interactions[0]
+	assert.Equal(t, "limit=1&name=a", interaction.Request.Query)
+	assert.Equal(t, Redacted, interaction.Request.Headers.Get("Authorization"))
+	assert.JSONEq(t, `{"password":"REDACTED","userData":"REDACTED","size":1}`, interaction.Request.Body)
+	assert.Equal(t, Redacted, interaction.Response.Headers.Get("Set-Cookie"))
+	assert.JSONEq(t, `{"name":"a","token":"REDACTED"}`, interaction.Response.Body)
+
+	replayer := NewReplayer(cassette, &Options{RedactFields: []string{"userData"}})
+
+	_, err = send(replayer, "name=b&limit=1", `{"password":"p","userData":"u","size":1}`)
+	assert.ErrorIs(t, err, ErrNoInteraction)
+
+	_, err = send(replayer, "name=a&limit=1", `{"password":"p","userData":"u","size":2}`)
+	assert.ErrorIs(t, err, ErrNoInteraction)
+
+	// The query order, the field order and the redacted values are ignored
+	resp, err = send(replayer, "limit=1&name=a", `{"size":1,"userData":"other","password":"other"}`)
+	require.NoError(t, err)
+	assert.Equal(t, http.StatusCreated, resp.StatusCode)
+}
+
+func TestLoad_Errors(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "cassette.yaml")
+	require.NoError(t, os.WriteFile(path, []byte("version: 2\n"), 0o644))
+
+	_, err := Load(path)
+	assert.ErrorIs(t, err, ErrUnsupportedVersion)
+}
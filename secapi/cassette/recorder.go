@@ -0,0 +1,89 @@
+package cassette
+
+import (
+	"bytes"
+	"io"
+	"net/http"
+	"sync"
+
+	"github.com/eu-sovereign-cloud/go-sdk/secapi"
+)
+
+// Recorder performs the requests with another doer and records them, with their
+// responses, in a cassette.
+type Recorder struct {
+	doer     secapi.HttpRequestDoer
+	redactor *redactor
+
+	mu       sync.Mutex
+	cassette Cassette
+}
+
+// NewRecorder creates a recorder performing the requests with the given doer, defaults to http.DefaultClient.
+func NewRecorder(doer secapi.HttpRequestDoer, options *Options) *Recorder {
+	if doer == nil {
+		doer = http.DefaultClient
+	}
+
+	return &Recorder{
+		doer:     doer,
+		redactor: newRedactor(options),
+		cassette: Cassette{Version: cassetteVersion},
+	}
+}
+
+func (rec *Recorder) Do(req *http.Request) (*http.Response, error) {
+	reqBody, err := readRequestBody(req)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := rec.doer.Do(req)
+	if err != nil {
+		return nil, err
+	}
+
+	respBody, err := io.ReadAll(resp.Body)
+	_ = resp.Body.Close()
+	if err != nil {
+		return nil, err
+	}
+	resp.Body = io.NopCloser(bytes.NewReader(respBody))
+
+	interaction := Interaction{
+		Request: Request{
+			Method:  req.Method,
+			Path:    req.URL.Path,
+			Query:   canonicalQuery(req.URL),
+			Headers: rec.redactor.redactHeaders(req.Header),
+			Body:    rec.redactor.redactBody(reqBody),
+		},
+		Response: Response{
+			Status:  resp.StatusCode,
+			Headers: rec.redactor.redactHeaders(resp.Header),
+			Body:    rec.redactor.redactBody(respBody),
+		},
+	}
+
+	rec.mu.Lock()
+	rec.cassette.Interactions = append(rec.cassette.Interactions, interaction)
+	rec.mu.Unlock()
+
+	return resp, nil
+}
+
+// Cassette returns a copy of the recorded interactions.
+func (rec *Recorder) Cassette() *Cassette {
+	rec.mu.Lock()
+	defer rec.mu.Unlock()
+
+	return &Cassette{
+		Version:      rec.cassette.Version,
+		Interactions: append([]Interaction{}, rec.cassette.Interactions...),
+	}
+}
+
+// Save writes the recorded interactions to a cassette file.
+func (rec *Recorder) Save(path string) error {
+	return rec.Cassette().Save(path)
+}
@@ -0,0 +1,99 @@
+package cassette
+
+import (
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+	"sync"
+)
+
+// Replayer serves the responses of a cassette without performing any request.
+// Each interaction is served once, the identical requests get the responses in
+// the recorded order.
+type Replayer struct {
+	cassette *Cassette
+	redactor *redactor
+
+	mu   sync.Mutex
+	used []bool
+}
+
+// NewReplayer creates a replayer of the cassette, the options must match the ones used to record it.
+func NewReplayer(cassette *Cassette, options *Options) *Replayer {
+	return &Replayer{
+		cassette: cassette,
+		redactor: newRedactor(options),
+		used:     make([]bool, len(cassette.Interactions)),
+	}
+}
+
+func (rp *Replayer) Do(req *http.Request) (*http.Response, error) {
+	body, err := readRequestBody(req)
+	if err != nil {
+		return nil, err
+	}
+
+	// The recorded bodies are redacted, so the actual body is too before being compared
+	actual := Request{
+		Method: req.Method,
+		Path:   req.URL.Path,
+		Query:  canonicalQuery(req.URL),
+		Body:   rp.redactor.redactBody(body),
+	}
+
+	rp.mu.Lock()
+	defer rp.mu.Unlock()
+
+	for i, interaction := range rp.cassette.Interactions {
+		if rp.used[i] || !matches(interaction.Request, actual) {
+			continue
+		}
+		rp.used[i] = true
+
+		return newResponse(req, interaction.Response), nil
+	}
+
+	return nil, fmt.Errorf("%w: %s %s", ErrNoInteraction, req.Method, req.URL.RequestURI())
+}
+
+// Remaining returns the number of interactions not served yet.
+func (rp *Replayer) Remaining() int {
+	rp.mu.Lock()
+	defer rp.mu.Unlock()
+
+	remaining := 0
+	for _, used := range rp.used {
+		if !used {
+			remaining++
+		}
+	}
+	return remaining
+}
+
+func matches(recorded, actual Request) bool {
+	return recorded.Method == actual.Method &&
+		recorded.Path == actual.Path &&
+		recorded.Query == actual.Query &&
+		sameBody(recorded.Body, actual.Body)
+}
+
+func newResponse(req *http.Request, recorded Response) *http.Response {
+	header := recorded.Headers.Clone()
+	if header == nil {
+		header = http.Header{}
+	}
+	header.Del("Content-Length")
+
+	return &http.Response{
+		Status:        fmt.Sprintf("%d %s", recorded.Status, http.StatusText(recorded.Status)),
+		StatusCode:    recorded.Status,
+		Proto:         "HTTP/1.1",
+		ProtoMajor:    1,
+		ProtoMinor:    1,
+		Header:        header,
+		Body:          io.NopCloser(strings.NewReader(recorded.Body)),
+		ContentLength: int64(len(recorded.Body)),
+		Request:       req,
+	}
+}
@@ -0,0 +1,112 @@
+package secapi_test
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/eu-sovereign-cloud/go-sdk/internal/secatest"
+	mocksecapi "github.com/eu-sovereign-cloud/go-sdk/mock/secapi"
+	"github.com/eu-sovereign-cloud/go-sdk/pkg/spec/schema"
+	"github.com/eu-sovereign-cloud/go-sdk/secapi"
+	"github.com/eu-sovereign-cloud/go-sdk/secapi/fake"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewGlobalClientFromAPIs(t *testing.T) {
+	ctx := context.Background()
+
+	regionAPI := mocksecapi.NewMockRegionV1(t)
+	regionAPI.EXPECT().GetRegion(mock.Anything, secatest.Region1Name).Return(&schema.Region{
+		Metadata: &schema.GlobalResourceMetadata{Name: secatest.Region1Name},
+		Spec:     schema.RegionSpec{Providers: []schema.Provider{{Name: "seca.compute", Version: "v1", Url: "http://compute.test"}}},
+	}, nil)
+
+	global := secapi.NewGlobalClientFromAPIs(&secapi.GlobalAPIs{RegionV1: regionAPI})
+
+	_, err := global.AuthorizationV1.GetRole(ctx, secapi.TenantReference{Tenant: secatest.Tenant1Name, Name: "role-1"})
+	assert.ErrorIs(t, err, secapi.ErrProviderNotAvailable)
+
+	regional, err := global.NewRegionalClient(ctx, secatest.Region1Name)
+	require.NoError(t, err)
+	assert.IsType(t, &secapi.ComputeV1Impl{}, regional.ComputeV1)
+	assert.IsType(t, &secapi.StorageV1Unavailable{}, regional.StorageV1)
+}
+
+func TestNewRegionalClientFromAPIs(t *testing.T) {
+	ctx := context.Background()
+
+	wref := secapi.WorkspaceReference{Tenant: secatest.Tenant1Name, Workspace: secatest.Workspace1Name, Name: secatest.Instance1Name}
+
+	computeAPI := mocksecapi.NewMockComputeV1(t)
+	computeAPI.EXPECT().GetInstance(mock.Anything, wref).Return(&schema.Instance{
+		Metadata: secatest.NewRegionalWorkspaceResourceMetadata(secatest.Instance1Name, secatest.Tenant1Name, secatest.Workspace1Name, secatest.Region1Name),
+	}, nil)
+
+	regional := secapi.NewRegionalClientFromAPIs(&secapi.RegionalAPIs{ComputeV1: computeAPI})
+
+	instance, err := regional.ComputeV1.GetInstance(ctx, wref)
+	require.NoError(t, err)
+	assert.Equal(t, secatest.Instance1Name, instance.Metadata.Name)
+
+	_, err = regional.StorageV1.GetBlockStorage(ctx, wref)
+	assert.ErrorIs(t, err, secapi.ErrProviderNotAvailable)
+
+	regional = secapi.NewRegionalClientFromAPIs(nil)
+	_, err = regional.NetworkV1.GetNetwork(ctx, wref)
+	assert.ErrorIs(t, err, secapi.ErrProviderNotAvailable)
+}
+
+func TestNewGlobalClientFromWellknown(t *testing.T) {
+	ctx := context.Background()
+
+	server := httptest.NewTLSServer(fake.NewServer(nil).Handler())
+	defer server.Close()
+
+	for _, url := range []string{
+		strings.TrimPrefix(server.URL, "https://"),
+		server.URL,
+		server.URL + "/.wellknown/secapi",
+	} {
+		t.Run(url, func(t *testing.T) {
+			global, err := secapi.NewGlobalClientFromWellknown(ctx, &secapi.WellknownConfig{
+				AuthToken:  secatest.AuthToken,
+				Url:        url,
+				HttpClient: server.Client(),
+			})
+			require.NoError(t, err)
+			assert.IsType(t, &secapi.AuthorizationV1Impl{}, global.AuthorizationV1)
+
+			region, err := global.RegionV1.GetRegion(ctx, fake.DefaultRegion)
+			require.NoError(t, err)
+			assert.Equal(t, fake.DefaultRegion, region.Metadata.Name)
+		})
+	}
+}
+
+func TestNewGlobalClientFromWellknown_Errors(t *testing.T) {
+	ctx := context.Background()
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(`{"version": "v2", "endpoints": []}`))
+	}))
+	defer server.Close()
+
+	_, err := secapi.NewGlobalClientFromWellknown(ctx, &secapi.WellknownConfig{AuthToken: secatest.AuthToken, Url: server.URL})
+	assert.ErrorIs(t, err, secapi.ErrUnsupportedWellknownVersion)
+
+	_, err = secapi.NewGlobalClientFromWellknown(ctx, nil)
+	assert.Error(t, err)
+
+	_, err = secapi.NewGlobalClientFromWellknown(ctx, &secapi.WellknownConfig{AuthToken: secatest.AuthToken})
+	assert.Error(t, err)
+
+	_, err = secapi.NewGlobalClientFromWellknown(ctx, &secapi.WellknownConfig{Url: server.URL})
+	assert.Error(t, err)
+}
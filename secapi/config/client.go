@@ -0,0 +1,131 @@
+package config
+
+import (
+	"bytes"
+	"context"
+	"crypto/tls"
+	"crypto/x509"
+	"fmt"
+	"net/http"
+	"os"
+	"os/exec"
+	"strings"
+
+	"github.com/eu-sovereign-cloud/go-sdk/secapi"
+)
+
+// GlobalConfig returns the configuration of a global client, it resolves the token
+// and builds the HTTP client of the TLS settings.
+func (config *Config) GlobalConfig(ctx context.Context) (*secapi.GlobalConfig, error) {
+	if config.Endpoints.Region == "" && config.Endpoints.Wellknown == "" {
+		return nil, ErrNoEndpoint
+	}
+
+	token, err := config.ResolveToken(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	httpClient, err := config.HttpClient()
+	if err != nil {
+		return nil, err
+	}
+
+	globalConfig := &secapi.GlobalConfig{
+		AuthToken: token,
+		Endpoints: secapi.GlobalEndpoints{
+			RegionV1:        config.Endpoints.Region,
+			AuthorizationV1: config.Endpoints.Authorization,
+			WellknownV1:     config.Endpoints.Wellknown,
+		},
+		ProviderVersions: config.ProviderVersions,
+	}
+	// Keeps the interface nil to use the default client
+	if httpClient != nil {
+		globalConfig.HttpClient = httpClient
+	}
+	return globalConfig, nil
+}
+
+// NewGlobalClient creates a global client, it discovers the endpoints from the well-known
+// document when the region endpoint is not configured.
+func (config *Config) NewGlobalClient(ctx context.Context) (*secapi.GlobalClient, error) {
+	globalConfig, err := config.GlobalConfig(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	if globalConfig.Endpoints.RegionV1 == "" {
+		return secapi.NewGlobalClientFromWellknown(ctx, &secapi.WellknownConfig{
+			AuthToken:        globalConfig.AuthToken,
+			Url:              globalConfig.Endpoints.WellknownV1,
+			HttpClient:       globalConfig.HttpClient,
+			ProviderVersions: globalConfig.ProviderVersions,
+		})
+	}
+	return secapi.NewGlobalClient(globalConfig)
+}
+
+// ResolveToken returns the token of the first configured source.
+func (config *Config) ResolveToken(ctx context.Context) (string, error) {
+	switch {
+	case config.Token != "":
+		return config.Token, nil
+
+	case config.TokenFile != "":
+		data, err := os.ReadFile(expandHome(config.TokenFile))
+		if err != nil {
+			return "", fmt.Errorf("reading token file: %w", err)
+		}
+		return strings.TrimSpace(string(data)), nil
+
+	case len(config.TokenCommand) > 0:
+		var stdout, stderr bytes.Buffer
+		cmd := exec.CommandContext(ctx, config.TokenCommand[0], config.TokenCommand[1:]...)
+		cmd.Stdout = &stdout
+		cmd.Stderr = &stderr
+		if err := cmd.Run(); err != nil {
+			return "", fmt.Errorf("running token command: %w: %s", err, strings.TrimSpace(stderr.String()))
+		}
+		return strings.TrimSpace(stdout.String()), nil
+
+	default:
+		return "", ErrNoToken
+	}
+}
+
+// HttpClient returns a client of the TLS settings, it is nil without settings to use the default client.
+func (config *Config) HttpClient() (*http.Client, error) {
+	if config.TLS == (TLS{}) {
+		return nil, nil
+	}
+
+	tlsConfig := &tls.Config{
+		ServerName:         config.TLS.ServerName,
+		InsecureSkipVerify: config.TLS.InsecureSkipVerify,
+	}
+
+	if config.TLS.CAFile != "" {
+		data, err := os.ReadFile(expandHome(config.TLS.CAFile))
+		if err != nil {
+			return nil, fmt.Errorf("reading CA file: %w", err)
+		}
+		pool := x509.NewCertPool()
+		if !pool.AppendCertsFromPEM(data) {
+			return nil, fmt.Errorf("no certificate in the CA file %s", config.TLS.CAFile)
+		}
+		tlsConfig.RootCAs = pool
+	}
+
+	if config.TLS.CertFile != "" || config.TLS.KeyFile != "" {
+		cert, err := tls.LoadX509KeyPair(expandHome(config.TLS.CertFile), expandHome(config.TLS.KeyFile))
+		if err != nil {
+			return nil, fmt.Errorf("loading client certificate: %w", err)
+		}
+		tlsConfig.Certificates = []tls.Certificate{cert}
+	}
+
+	transport := http.DefaultTransport.(*http.Transport).Clone()
+	transport.TLSClientConfig = tlsConfig
+	return &http.Client{Transport: transport}, nil
+}
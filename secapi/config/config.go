@@ -0,0 +1,261 @@
+// Package config loads the configuration of the SDK from the SECA_* environment variables
+// and the profiles of a config file, so every CLI and service configures the SDK the same way.
+//
+// The config file holds named profiles:
+//
+//	currentProfile: dev
+//	profiles:
+//	  dev:
+//	    endpoints:
+//	      wellknown: https://seca.example.com
+//	    tenant: tenant-1
+//	    workspace: workspace-1
+//	    region: region-1
+//	    tokenFile: ~/.config/seca/dev.token
+//	    tls:
+//	      caFile: /etc/seca/ca.pem
+//
+// The environment variables override the values of the selected profile. SECA_TOKEN_COMMAND
+// is run by sh -c, so it's written as in a shell: quoted arguments, pipes and variables.
+package config
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+
+	"gopkg.in/yaml.v3"
+)
+
+// DefaultProfile is selected when neither the options, the environment nor the file select one.
+const DefaultProfile = "default"
+
+// Environment variables
+const (
+	EnvConfig  = "SECA_CONFIG"
+	EnvProfile = "SECA_PROFILE"
+
+	EnvRegionUrl        = "SECA_REGION_URL"
+	EnvAuthorizationUrl = "SECA_AUTHORIZATION_URL"
+	EnvWellknownUrl     = "SECA_WELLKNOWN_URL"
+
+	EnvTenant    = "SECA_TENANT"
+	EnvWorkspace = "SECA_WORKSPACE"
+	EnvRegion    = "SECA_REGION"
+
+	EnvToken        = "SECA_TOKEN"
+	EnvTokenFile    = "SECA_TOKEN_FILE"
+	EnvTokenCommand = "SECA_TOKEN_COMMAND"
+
+	EnvCAFile             = "SECA_CA_FILE"
+	EnvCertFile           = "SECA_CERT_FILE"
+	EnvKeyFile            = "SECA_KEY_FILE"
+	EnvServerName         = "SECA_TLS_SERVER_NAME"
+	EnvInsecureSkipVerify = "SECA_INSECURE_SKIP_VERIFY"
+)
+
+var (
+	ErrUnknownProfile = errors.New("unknown profile")
+	ErrNoEndpoint     = errors.New("no region or wellknown endpoint configured")
+	ErrNoToken        = errors.New("no token configured")
+)
+
+// File is the content of a config file.
+type File struct {
+	CurrentProfile string              `yaml:"currentProfile,omitempty"`
+	Profiles       map[string]*Profile `yaml:"profiles,omitempty"`
+}
+
+// Profile configures the SDK for a deployment and its default tenant, workspace and region.
+type Profile struct {
+	Endpoints Endpoints `yaml:"endpoints,omitempty"`
+
+	Tenant    string `yaml:"tenant,omitempty"`
+	Workspace string `yaml:"workspace,omitempty"`
+	Region    string `yaml:"region,omitempty"`
+
+	// Token sources, by priority: the token itself, a file holding it or a command printing it.
+	Token        string   `yaml:"token,omitempty"`
+	TokenFile    string   `yaml:"tokenFile,omitempty"`
+	TokenCommand []string `yaml:"tokenCommand,omitempty"`
+
+	TLS TLS `yaml:"tls,omitempty"`
+
+	// ProviderVersions are the preferred versions of the regional providers by name.
+	ProviderVersions map[string]string `yaml:"providerVersions,omitempty"`
+}
+
+// Endpoints are the global endpoints, the wellknown one discovers the others when they are empty.
+type Endpoints struct {
+	Region        string `yaml:"region,omitempty"`
+	Authorization string `yaml:"authorization,omitempty"`
+	Wellknown     string `yaml:"wellknown,omitempty"`
+}
+
+type TLS struct {
+	CAFile             string `yaml:"caFile,omitempty"`
+	CertFile           string `yaml:"certFile,omitempty"`
+	KeyFile            string `yaml:"keyFile,omitempty"`
+	ServerName         string `yaml:"serverName,omitempty"`
+	InsecureSkipVerify bool   `yaml:"insecureSkipVerify,omitempty"`
+}
+
+type Options struct {
+	// Path of the config file, defaults to SECA_CONFIG then DefaultPath.
+	// A missing file is an error only when its path is given.
+	Path string
+
+	// Profile to select, defaults to SECA_PROFILE then the current profile of the file.
+	Profile string
+
+	// SkipEnv ignores the environment variables.
+	SkipEnv bool
+}
+
+// Config is the loaded configuration.
+type Config struct {
+	// Name of the selected profile
+	Name string
+
+	Profile
+}
+
+// DefaultPath returns the path of the config file, ~/.config/seca/config.yaml on every platform
+// or $XDG_CONFIG_HOME/seca/config.yaml when XDG_CONFIG_HOME is set.
+func DefaultPath() (string, error) {
+	dir := os.Getenv("XDG_CONFIG_HOME")
+	if dir == "" {
+		home, err := os.UserHomeDir()
+		if err != nil {
+			return "", err
+		}
+		dir = filepath.Join(home, ".config")
+	}
+	return filepath.Join(dir, "seca", "config.yaml"), nil
+}
+
+// ReadFile reads a config file.
+func ReadFile(path string) (*File, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+
+	var file File
+	if err := yaml.Unmarshal(data, &file); err != nil {
+		return nil, fmt.Errorf("decoding config %s: %w", path, err)
+	}
+	return &file, nil
+}
+
+// Load reads the config file, selects the profile and applies the environment variables.
+func Load(options *Options) (*Config, error) {
+	if options == nil {
+		options = &Options{}
+	}
+	getenv := os.Getenv
+	if options.SkipEnv {
+		getenv = func(string) string { return "" }
+	}
+
+	file, err := loadFile(options.Path, getenv(EnvConfig))
+	if err != nil {
+		return nil, err
+	}
+
+	name := firstNonEmpty(options.Profile, getenv(EnvProfile), file.CurrentProfile)
+	config := &Config{Name: firstNonEmpty(name, DefaultProfile)}
+	if profile, found := file.Profiles[config.Name]; found && profile != nil {
+		config.Profile = *profile
+	} else if name != "" {
+		return nil, fmt.Errorf("%w: %s", ErrUnknownProfile, name)
+	}
+
+	if err := config.applyEnv(getenv); err != nil {
+		return nil, err
+	}
+	return config, nil
+}
+
+func loadFile(path, envPath string) (*File, error) {
+	explicit := firstNonEmpty(path, envPath)
+	if explicit != "" {
+		return ReadFile(expandHome(explicit))
+	}
+
+	path, err := DefaultPath()
+	if err != nil {
+		return &File{}, nil
+	}
+	file, err := ReadFile(path)
+	if errors.Is(err, os.ErrNotExist) {
+		return &File{}, nil
+	}
+	return file, err
+}
+
+func (config *Config) applyEnv(getenv func(string) string) error {
+	setString := func(value *string, name string) {
+		if env := getenv(name); env != "" {
+			*value = env
+		}
+	}
+
+	setString(&config.Endpoints.Region, EnvRegionUrl)
+	setString(&config.Endpoints.Authorization, EnvAuthorizationUrl)
+	setString(&config.Endpoints.Wellknown, EnvWellknownUrl)
+
+	setString(&config.Tenant, EnvTenant)
+	setString(&config.Workspace, EnvWorkspace)
+	setString(&config.Region, EnvRegion)
+
+	// A token source of the environment replaces every source of the profile
+	if token, file, command := getenv(EnvToken), getenv(EnvTokenFile), getenv(EnvTokenCommand); token != "" || file != "" || command != "" {
+		config.Token = token
+		config.TokenFile = file
+		config.TokenCommand = nil
+		if command != "" {
+			config.TokenCommand = []string{"sh", "-c", command}
+		}
+	}
+
+	setString(&config.TLS.CAFile, EnvCAFile)
+	setString(&config.TLS.CertFile, EnvCertFile)
+	setString(&config.TLS.KeyFile, EnvKeyFile)
+	setString(&config.TLS.ServerName, EnvServerName)
+	if env := getenv(EnvInsecureSkipVerify); env != "" {
+		insecure, err := strconv.ParseBool(env)
+		if err != nil {
+			return fmt.Errorf("invalid %s: %w", EnvInsecureSkipVerify, err)
+		}
+		config.TLS.InsecureSkipVerify = insecure
+	}
+
+	return nil
+}
+
+func firstNonEmpty(values ...string) string {
+	for _, value := range values {
+		if value != "" {
+			return value
+		}
+	}
+	return ""
+}
+
+// Expands the ~ prefix to the home directory of the user
+func expandHome(path string) string {
+	rest, found := strings.CutPrefix(path, "~/")
+	if !found {
+		return path
+	}
+
+	home, err := os.UserHomeDir()
+	if err != nil {
+		return path
+	}
+	return filepath.Join(home, rest)
+}
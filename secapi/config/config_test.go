@@ -0,0 +1,196 @@
+package config
+
+import (
+	"context"
+	"fmt"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/eu-sovereign-cloud/go-sdk/internal/secatest"
+	"github.com/eu-sovereign-cloud/go-sdk/secapi/fake"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const testConfigFile = `
+currentProfile: dev
+profiles:
+  dev:
+    endpoints:
+      region: https://dev.example.com/providers/seca.region/v1
+    tenant: tenant-1
+    workspace: workspace-1
+    region: region-1
+    token: dev-token
+  prod:
+    endpoints:
+      wellknown: https://prod.example.com
+    tenant: tenant-2
+    region: region-2
+    tokenFile: %s
+    providerVersions:
+      seca.network: v1
+`
+
+func TestLoad(t *testing.T) {
+	clearEnv(t)
+	dir := t.TempDir()
+
+	tokenFile := filepath.Join(dir, "prod.token")
+	require.NoError(t, os.WriteFile(tokenFile, []byte("prod-token\n"), 0o600))
+	path := writeConfigFile(t, dir, tokenFile)
+
+	t.Run("current profile", func(t *testing.T) {
+		config, err := Load(&Options{Path: path})
+		require.NoError(t, err)
+		assert.Equal(t, "dev", config.Name)
+		assert.Equal(t, "tenant-1", config.Tenant)
+		assert.Equal(t, "workspace-1", config.Workspace)
+
+		global, err := config.GlobalConfig(context.Background())
+		require.NoError(t, err)
+		assert.Equal(t, "dev-token", global.AuthToken)
+		assert.Equal(t, "https://dev.example.com/providers/seca.region/v1", global.Endpoints.RegionV1)
+		assert.Nil(t, global.HttpClient)
+	})
+
+	t.Run("selected profile", func(t *testing.T) {
+		config, err := Load(&Options{Path: path, Profile: "prod"})
+		require.NoError(t, err)
+		assert.Equal(t, "region-2", config.Region)
+
+		global, err := config.GlobalConfig(context.Background())
+		require.NoError(t, err)
+		assert.Equal(t, "prod-token", global.AuthToken)
+		assert.Equal(t, "https://prod.example.com", global.Endpoints.WellknownV1)
+		assert.Equal(t, map[string]string{"seca.network": "v1"}, global.ProviderVersions)
+	})
+
+	t.Run("environment", func(t *testing.T) {
+		t.Setenv(EnvConfig, path)
+		t.Setenv(EnvProfile, "prod")
+		t.Setenv(EnvTenant, "tenant-3")
+		t.Setenv(EnvToken, "env-token")
+		t.Setenv(EnvInsecureSkipVerify, "true")
+
+		config, err := Load(nil)
+		require.NoError(t, err)
+		assert.Equal(t, "prod", config.Name)
+		assert.Equal(t, "tenant-3", config.Tenant)
+		assert.Equal(t, "region-2", config.Region)
+		assert.Empty(t, config.TokenFile)
+
+		global, err := config.GlobalConfig(context.Background())
+		require.NoError(t, err)
+		assert.Equal(t, "env-token", global.AuthToken)
+		assert.NotNil(t, global.HttpClient)
+
+		config, err = Load(&Options{Path: path, SkipEnv: true})
+		require.NoError(t, err)
+		assert.Equal(t, "dev", config.Name)
+	})
+
+	t.Run("token command", func(t *testing.T) {
+		config := &Config{Profile: Profile{TokenCommand: []string{"echo", "command-token"}}}
+		token, err := config.ResolveToken(context.Background())
+		require.NoError(t, err)
+		assert.Equal(t, "command-token", token)
+	})
+
+	t.Run("environment token command", func(t *testing.T) {
+		clearEnv(t)
+		t.Setenv(EnvTokenCommand, `printf '%s' "quoted token"`)
+
+		config, err := Load(&Options{Path: path})
+		require.NoError(t, err)
+
+		token, err := config.ResolveToken(context.Background())
+		require.NoError(t, err)
+		assert.Equal(t, "quoted token", token)
+	})
+}
+
+func TestDefaultPath(t *testing.T) {
+	dir := t.TempDir()
+
+	t.Setenv("XDG_CONFIG_HOME", dir)
+	path, err := DefaultPath()
+	require.NoError(t, err)
+	assert.Equal(t, filepath.Join(dir, "seca", "config.yaml"), path)
+
+	t.Setenv("XDG_CONFIG_HOME", "")
+	t.Setenv("HOME", dir)
+	path, err = DefaultPath()
+	require.NoError(t, err)
+	assert.Equal(t, filepath.Join(dir, ".config", "seca", "config.yaml"), path)
+}
+
+func TestLoad_Errors(t *testing.T) {
+	clearEnv(t)
+	dir := t.TempDir()
+	path := writeConfigFile(t, dir, "")
+
+	_, err := Load(&Options{Path: path, Profile: "staging"})
+	assert.ErrorIs(t, err, ErrUnknownProfile)
+
+	_, err = Load(&Options{Path: filepath.Join(dir, "missing.yaml")})
+	assert.ErrorIs(t, err, os.ErrNotExist)
+
+	t.Setenv(EnvInsecureSkipVerify, "maybe")
+	_, err = Load(&Options{Path: path})
+	assert.Error(t, err)
+
+	ctx := context.Background()
+
+	_, err = (&Config{Profile: Profile{Token: "token"}}).GlobalConfig(ctx)
+	assert.ErrorIs(t, err, ErrNoEndpoint)
+
+	_, err = (&Config{Profile: Profile{Endpoints: Endpoints{Region: "https://example.com"}}}).GlobalConfig(ctx)
+	assert.ErrorIs(t, err, ErrNoToken)
+
+	_, err = (&Config{Profile: Profile{TLS: TLS{CAFile: filepath.Join(dir, "missing.pem")}}}).HttpClient()
+	assert.Error(t, err)
+}
+
+func TestConfig_NewGlobalClient(t *testing.T) {
+	clearEnv(t)
+	ctx := context.Background()
+
+	server := httptest.NewServer(fake.NewServer(nil).Handler())
+	defer server.Close()
+
+	t.Setenv(EnvWellknownUrl, server.URL)
+	t.Setenv(EnvToken, secatest.AuthToken)
+
+	path := filepath.Join(t.TempDir(), "config.yaml")
+	require.NoError(t, os.WriteFile(path, nil, 0o600))
+
+	config, err := Load(&Options{Path: path})
+	require.NoError(t, err)
+
+	global, err := config.NewGlobalClient(ctx)
+	require.NoError(t, err)
+
+	region, err := global.RegionV1.GetRegion(ctx, fake.DefaultRegion)
+	require.NoError(t, err)
+	assert.Equal(t, fake.DefaultRegion, region.Metadata.Name)
+}
+
+func writeConfigFile(t *testing.T, dir, tokenFile string) string {
+	path := filepath.Join(dir, "config.yaml")
+	require.NoError(t, os.WriteFile(path, fmt.Appendf(nil, testConfigFile, tokenFile), 0o600))
+	return path
+}
+
+// Clears the SECA_* variables of the environment running the tests
+func clearEnv(t *testing.T) {
+	for _, name := range []string{
+		EnvConfig, EnvProfile, EnvRegionUrl, EnvAuthorizationUrl, EnvWellknownUrl, EnvTenant, EnvWorkspace, EnvRegion,
+		EnvToken, EnvTokenFile, EnvTokenCommand, EnvCAFile, EnvCertFile, EnvKeyFile, EnvServerName, EnvInsecureSkipVerify,
+	} {
+		t.Setenv(name, "")
+	}
+}
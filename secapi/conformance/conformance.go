@@ -0,0 +1,246 @@
+package conformance
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"slices"
+	"time"
+
+	"github.com/eu-sovereign-cloud/go-sdk/secapi"
+)
+
+const (
+	defaultPrefix   = "conformance"
+	defaultTimeout  = 5 * time.Minute
+	defaultInterval = 2 * time.Second
+)
+
+var (
+	ErrNoTenant    = errors.New("tenant is required to run the conformance suite")
+	ErrNoWorkspace = errors.New("workspace is required to run the conformance suite")
+	ErrNoRegion    = errors.New("region is required to run the conformance suite")
+)
+
+// Names of the checks
+const (
+	CheckAvailable         = "available"
+	CheckSetup             = "setup"
+	CheckCreate            = "create"
+	CheckGet               = "get"
+	CheckList              = "list"
+	CheckTransitions       = "transitions"
+	CheckUpdate            = "update"
+	CheckIfUnmodifiedSince = "if-unmodified-since"
+	CheckLabels            = "labels"
+	CheckPagination        = "pagination"
+	CheckDelete            = "delete"
+	CheckDeleteIdempotency = "delete-idempotency"
+)
+
+type Status string
+
+const (
+	StatusPassed  Status = "pass"
+	StatusFailed  Status = "fail"
+	StatusSkipped Status = "skip"
+)
+
+type Config struct {
+	// Tenant owning the created resources.
+	Tenant string
+
+	// Workspace of the created regional resources, it must already exist.
+	Workspace string
+
+	// Region of the regional client.
+	Region string
+
+	// Prefix of the names of the created resources, defaults to "conformance".
+	Prefix string
+
+	// Timeout of each state transition, defaults to 5 minutes.
+	Timeout time.Duration
+
+	// Interval between the polls of a resource state, defaults to 2 seconds.
+	Interval time.Duration
+
+	// Providers to check, as seca.compute, defaults to every provider.
+	Providers []string
+}
+
+// Report
+
+type CheckResult struct {
+	Name     string
+	Status   Status
+	Err      error
+	Duration time.Duration
+}
+
+// ProviderReport is the outcome of the checks of a provider. The version of a regional provider is
+// the one negotiated by the regional client, it's empty when the client didn't negotiate any.
+type ProviderReport struct {
+	Provider string
+	Version  string
+	Checks   []CheckResult
+}
+
+// Passed returns whether none of the checks failed.
+func (p *ProviderReport) Passed() bool {
+	for _, check := range p.Checks {
+		if check.Status == StatusFailed {
+			return false
+		}
+	}
+	return true
+}
+
+// Check returns the result of the named check.
+func (p *ProviderReport) Check(name string) (CheckResult, bool) {
+	for _, check := range p.Checks {
+		if check.Name == name {
+			return check, true
+		}
+	}
+	return CheckResult{}, false
+}
+
+type Report struct {
+	Providers []ProviderReport
+}
+
+// Passed returns whether every provider passed.
+func (r *Report) Passed() bool {
+	for i := range r.Providers {
+		if !r.Providers[i].Passed() {
+			return false
+		}
+	}
+	return true
+}
+
+// Write writes a human readable report, one line per provider followed by its checks.
+func (r *Report) Write(w io.Writer) error {
+	for i := range r.Providers {
+		provider := &r.Providers[i]
+
+		status := StatusPassed
+		if !provider.Passed() {
+			status = StatusFailed
+		}
+		name := provider.Provider
+		if provider.Version != "" {
+			name += "/" + provider.Version
+		}
+		if _, err := fmt.Fprintf(w, "%s %s\n", status, name); err != nil {
+			return err
+		}
+
+		for _, check := range provider.Checks {
+			line := fmt.Sprintf("  %s %s (%s)", check.Status, check.Name, check.Duration.Round(time.Millisecond))
+			if check.Err != nil {
+				line += ": " + check.Err.Error()
+			}
+			if _, err := fmt.Fprintln(w, line); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+// Suite
+
+// Suite checks the behavior of the providers of a region against the SECA specification.
+// It creates, updates and deletes resources, so it must run in a dedicated workspace.
+type Suite struct {
+	global   *secapi.GlobalClient
+	regional *secapi.RegionalClient
+	config   Config
+}
+
+// NewSuite creates a suite checking the providers of the given clients.
+func NewSuite(global *secapi.GlobalClient, regional *secapi.RegionalClient, config *Config) (*Suite, error) {
+	if config == nil {
+		return nil, ErrNoTenant
+	}
+
+	s := &Suite{global: global, regional: regional, config: *config}
+	if s.config.Tenant == "" {
+		return nil, ErrNoTenant
+	}
+	if s.config.Workspace == "" {
+		return nil, ErrNoWorkspace
+	}
+	if s.config.Region == "" {
+		return nil, ErrNoRegion
+	}
+	if s.config.Prefix == "" {
+		s.config.Prefix = defaultPrefix
+	}
+	if s.config.Timeout == 0 {
+		s.config.Timeout = defaultTimeout
+	}
+	if s.config.Interval == 0 {
+		s.config.Interval = defaultInterval
+	}
+
+	return s, nil
+}
+
+// Run runs the checks of every selected provider.
+func (s *Suite) Run(ctx context.Context) *Report {
+	report := &Report{}
+	for _, run := range s.providers() {
+		if len(s.config.Providers) > 0 && !slices.Contains(s.config.Providers, run.provider) {
+			continue
+		}
+		report.Providers = append(report.Providers, run.fn(ctx))
+	}
+	return report
+}
+
+// Runner of the checks of a provider
+
+type checker struct {
+	report  ProviderReport
+	aborted bool
+}
+
+func newChecker(provider, version string) *checker {
+	return &checker{report: ProviderReport{Provider: provider, Version: version}}
+}
+
+// Runs a check, it is skipped when a previous required check failed
+func (c *checker) run(name string, fn func() error) bool {
+	if c.aborted {
+		c.skip(name, nil)
+		return false
+	}
+
+	start := time.Now()
+	err := fn()
+
+	result := CheckResult{Name: name, Status: StatusPassed, Duration: time.Since(start)}
+	if err != nil {
+		result.Status = StatusFailed
+		result.Err = err
+	}
+	c.report.Checks = append(c.report.Checks, result)
+	return err == nil
+}
+
+// Runs a check the next ones depend on
+func (c *checker) require(name string, fn func() error) bool {
+	if !c.run(name, fn) {
+		c.aborted = true
+		return false
+	}
+	return true
+}
+
+func (c *checker) skip(name string, err error) {
+	c.report.Checks = append(c.report.Checks, CheckResult{Name: name, Status: StatusSkipped, Err: err})
+}
@@ -0,0 +1,160 @@
+package conformance
+
+import (
+	"bytes"
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/eu-sovereign-cloud/go-sdk/internal/secatest"
+	"github.com/eu-sovereign-cloud/go-sdk/internal/secatest/clients"
+	"github.com/eu-sovereign-cloud/go-sdk/pkg/constants"
+	"github.com/eu-sovereign-cloud/go-sdk/pkg/spec/schema"
+	"github.com/eu-sovereign-cloud/go-sdk/secapi"
+	"github.com/eu-sovereign-cloud/go-sdk/secapi/fake"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSuite_Run(t *testing.T) {
+	ctx := context.Background()
+
+	suite := newTestSuite(t, ctx, fake.Schedule{Creating: 10 * time.Millisecond, Deleting: 10 * time.Millisecond}, nil)
+
+	report := suite.Run(ctx)
+
+	var out bytes.Buffer
+	require.NoError(t, report.Write(&out))
+	require.True(t, report.Passed(), out.String())
+
+	require.Len(t, report.Providers, 6)
+	assert.Equal(t, constants.RegionProviderName, report.Providers[0].Provider)
+
+	compute := report.Providers[5]
+	assert.Equal(t, constants.ComputeProviderName, compute.Provider)
+	for _, name := range []string{CheckSetup, CheckCreate, CheckTransitions, CheckIfUnmodifiedSince, CheckPagination, CheckDeleteIdempotency} {
+		check, ok := compute.Check(name)
+		require.True(t, ok, name)
+		assert.Equal(t, StatusPassed, check.Status, name)
+	}
+
+	assert.Equal(t, suite.regional.ProviderVersion(constants.ComputeProviderName), compute.Version)
+	assert.Contains(t, out.String(), "pass seca.compute/v1\n")
+	assert.Contains(t, out.String(), "  pass labels (")
+}
+
+func TestSuite_Failures(t *testing.T) {
+	ctx := context.Background()
+
+	faults := fake.NewFaultInjector(
+		fake.Fault{Operation: "DeleteSecurityGroup", Status: http.StatusInternalServerError},
+		fake.Fault{Operation: "CreateOrUpdateWorkspace", Name: "conformance-workspace-1", Status: http.StatusConflict},
+	)
+	suite := newTestSuite(t, ctx, fake.Schedule{}, faults.Middleware())
+	suite.config.Providers = []string{constants.WorkspaceProviderName, constants.NetworkProviderName}
+
+	report := suite.Run(ctx)
+	assert.False(t, report.Passed())
+	require.Len(t, report.Providers, 2)
+
+	// A failed required check skips the next ones
+	ws := report.Providers[0]
+	check, _ := ws.Check(CheckCreate)
+	assert.Equal(t, StatusFailed, check.Status)
+	assert.ErrorIs(t, check.Err, secapi.ErrConflictingRequest)
+
+	check, _ = ws.Check(CheckGet)
+	assert.Equal(t, StatusSkipped, check.Status)
+
+	network := report.Providers[1]
+	check, _ = network.Check(CheckUpdate)
+	assert.Equal(t, StatusPassed, check.Status)
+
+	check, _ = network.Check(CheckDelete)
+	assert.Equal(t, StatusFailed, check.Status)
+	assert.ErrorIs(t, check.Err, secapi.ErrInternalError)
+
+	check, _ = network.Check(CheckDeleteIdempotency)
+	assert.Equal(t, StatusSkipped, check.Status)
+}
+
+func TestSuite_Versions(t *testing.T) {
+	ctx := context.Background()
+
+	// The clients built from APIs don't negotiate any version
+	suite, err := NewSuite(secapi.NewGlobalClientFromAPIs(&secapi.GlobalAPIs{}), secapi.NewRegionalClientFromAPIs(&secapi.RegionalAPIs{}), &Config{
+		Tenant:    secatest.Tenant1Name,
+		Workspace: secatest.Workspace1Name,
+		Region:    fake.DefaultRegion,
+	})
+	require.NoError(t, err)
+
+	report := suite.Run(ctx)
+	require.Len(t, report.Providers, 6)
+	for _, provider := range report.Providers {
+		switch provider.Provider {
+		case constants.RegionProviderName, constants.AuthorizationProviderName:
+			assert.Equal(t, constants.ApiVersion1, provider.Version)
+		default:
+			assert.Empty(t, provider.Version, provider.Provider)
+		}
+	}
+
+	var out bytes.Buffer
+	require.NoError(t, report.Write(&out))
+	assert.Contains(t, out.String(), " seca.compute\n")
+}
+
+func TestNewSuite_Errors(t *testing.T) {
+	_, err := NewSuite(nil, nil, nil)
+	assert.ErrorIs(t, err, ErrNoTenant)
+
+	_, err = NewSuite(nil, nil, &Config{Tenant: secatest.Tenant1Name})
+	assert.ErrorIs(t, err, ErrNoWorkspace)
+
+	_, err = NewSuite(nil, nil, &Config{Tenant: secatest.Tenant1Name, Workspace: secatest.Workspace1Name})
+	assert.ErrorIs(t, err, ErrNoRegion)
+
+	suite, err := NewSuite(nil, nil, &Config{Tenant: secatest.Tenant1Name, Workspace: secatest.Workspace1Name, Region: fake.DefaultRegion})
+	require.NoError(t, err)
+	assert.Equal(t, defaultPrefix, suite.config.Prefix)
+	assert.Equal(t, defaultTimeout, suite.config.Timeout)
+	assert.Equal(t, defaultInterval, suite.config.Interval)
+}
+
+func newTestSuite(t *testing.T, ctx context.Context, schedule fake.Schedule, middleware fake.Middleware) *Suite {
+	config := &fake.Config{Schedule: schedule}
+	if middleware != nil {
+		config.Middlewares = []fake.Middleware{middleware}
+	}
+
+	fakeServer := fake.NewServer(config)
+	require.NoError(t, fakeServer.Seed(fake.DefaultRegion,
+		&schema.InstanceSku{
+			Metadata: secatest.NewSkuResourceMetadata(secatest.InstanceSku1Name, secatest.Tenant1Name),
+			Spec:     &schema.InstanceSkuSpec{Ram: secatest.InstanceSku1RAM, VCPU: secatest.InstanceSku1VCPU},
+		},
+		&schema.StorageSku{
+			Metadata: secatest.NewSkuResourceMetadata("storage-sku-1", secatest.Tenant1Name),
+			Spec:     &schema.StorageSkuSpec{Iops: 100, MinVolumeSize: 1, Type: schema.StorageSkuTypeLocalDurable},
+		},
+	))
+
+	server := httptest.NewServer(fakeServer.Handler())
+	t.Cleanup(server.Close)
+
+	global, regional := clients.New(t, ctx, secapi.GlobalConfig{Endpoints: fakeServer.Endpoints(server.URL)})
+
+	suite, err := NewSuite(global, regional, &Config{
+		Tenant:    secatest.Tenant1Name,
+		Workspace: secatest.Workspace1Name,
+		Region:    fake.DefaultRegion,
+		Timeout:   5 * time.Second,
+		Interval:  5 * time.Millisecond,
+	})
+	require.NoError(t, err)
+	return suite
+}
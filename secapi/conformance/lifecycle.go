@@ -0,0 +1,319 @@
+package conformance
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"reflect"
+	"sort"
+	"time"
+
+	"github.com/eu-sovereign-cloud/go-sdk/pkg/spec/schema"
+	"github.com/eu-sovereign-cloud/go-sdk/pkg/types"
+	"github.com/eu-sovereign-cloud/go-sdk/secapi"
+	"github.com/eu-sovereign-cloud/go-sdk/secapi/builders"
+)
+
+const (
+	suiteLabel = "conformance-suite"
+	roleLabel  = "conformance-role"
+	revLabel   = "conformance-revision"
+)
+
+// Operations of a provider on the resource kind used to check it
+type subject[T types.ResourceType] struct {
+	provider string
+	version  string
+	kind     string
+
+	build  func(name string, labels schema.Labels) *T
+	put    func(ctx context.Context, resource *T, ifUnmodifiedSince *int) (*T, error)
+	get    func(ctx context.Context, name string) (*T, error)
+	list   func(ctx context.Context, options *secapi.ListOptions) (*secapi.Iterator[T], error)
+	delete func(ctx context.Context, resource *T) error
+
+	// Creates the resources the checked one depends on, and deletes them
+	setup    func(ctx context.Context) error
+	teardown func(ctx context.Context)
+}
+
+// Checks the lifecycle of a resource kind, from its creation to its deletion
+func runLifecycle[T types.ResourceType](ctx context.Context, s *Suite, sub subject[T]) ProviderReport {
+	c := newChecker(sub.provider, sub.version)
+
+	if sub.setup != nil {
+		c.require(CheckSetup, func() error { return sub.setup(ctx) })
+	}
+	if sub.teardown != nil {
+		defer sub.teardown(ctx)
+	}
+
+	primaryName := fmt.Sprintf("%s-%s-1", s.config.Prefix, sub.kind)
+	secondaryName := fmt.Sprintf("%s-%s-2", s.config.Prefix, sub.kind)
+	labels := func(role string, revision int) schema.Labels {
+		return schema.Labels{suiteLabel: s.config.Prefix, roleLabel: role, revLabel: fmt.Sprint(revision)}
+	}
+
+	var primary *T
+	c.require(CheckCreate, func() error {
+		created, err := sub.put(ctx, sub.build(primaryName, labels("primary", 1)), nil)
+		if err != nil {
+			return err
+		}
+		if name, _, _ := inspect(created); name != primaryName {
+			return fmt.Errorf("created resource is named %q instead of %q", name, primaryName)
+		}
+		primary = created
+		return nil
+	})
+	defer func() {
+		if primary != nil {
+			_ = sub.delete(ctx, primary)
+		}
+	}()
+
+	c.run(CheckGet, func() error {
+		fetched, err := sub.get(ctx, primaryName)
+		if err != nil {
+			return err
+		}
+		if name, _, _ := inspect(fetched); name != primaryName {
+			return fmt.Errorf("fetched resource is named %q instead of %q", name, primaryName)
+		}
+		return nil
+	})
+
+	c.require(CheckTransitions, func() error {
+		_, err := waitActive(ctx, s, sub, primaryName)
+		return err
+	})
+
+	c.run(CheckUpdate, func() error {
+		current, err := sub.get(ctx, primaryName)
+		if err != nil {
+			return err
+		}
+		_, before, _ := inspect(current)
+
+		updated, err := sub.put(ctx, sub.build(primaryName, labels("primary", 2)), nil)
+		if err != nil {
+			return err
+		}
+		if _, after, _ := inspect(updated); after <= before {
+			return fmt.Errorf("resource version %d was not increased from %d", after, before)
+		}
+
+		_, err = waitActive(ctx, s, sub, primaryName)
+		return err
+	})
+
+	c.run(CheckIfUnmodifiedSince, func() error {
+		current, err := sub.get(ctx, primaryName)
+		if err != nil {
+			return err
+		}
+		_, version, _ := inspect(current)
+
+		stale := int(version) - 1
+		_, err = sub.put(ctx, sub.build(primaryName, labels("primary", 3)), &stale)
+		if !errors.Is(err, secapi.ErrRequestPreconditionFailed) {
+			return fmt.Errorf("update of version %d at version %d returned %v instead of a failed precondition", stale, version, err)
+		}
+
+		expected := int(version)
+		if _, err = sub.put(ctx, sub.build(primaryName, labels("primary", 3)), &expected); err != nil {
+			return fmt.Errorf("update at the current version %d: %w", version, err)
+		}
+
+		_, err = waitActive(ctx, s, sub, primaryName)
+		return err
+	})
+
+	var secondary *T
+	c.run(CheckLabels, func() error {
+		created, err := sub.put(ctx, sub.build(secondaryName, labels("secondary", 1)), nil)
+		if err != nil {
+			return err
+		}
+		secondary = created
+
+		names, _, err := listNames(ctx, sub, secapi.NewListOptions().WithLabels(
+			builders.NewLabelsBuilder().Equals(suiteLabel, s.config.Prefix).Equals(roleLabel, "primary")))
+		if err != nil {
+			return err
+		}
+		if !names[primaryName] || names[secondaryName] {
+			return fmt.Errorf("label filter returned %v instead of only %s", keys(names), primaryName)
+		}
+		return nil
+	})
+	defer func() {
+		if secondary != nil {
+			_ = sub.delete(ctx, secondary)
+		}
+	}()
+
+	c.run(CheckPagination, func() error {
+		if secondary == nil {
+			return errors.New("the second resource required to check the pagination was not created")
+		}
+
+		names, paged, err := listNames(ctx, sub, secapi.NewListOptions().WithLimit(1).WithLabels(
+			builders.NewLabelsBuilder().Equals(suiteLabel, s.config.Prefix)))
+		if err != nil {
+			return err
+		}
+		if !names[primaryName] || !names[secondaryName] {
+			return fmt.Errorf("paginated list returned %v instead of %s and %s", keys(names), primaryName, secondaryName)
+		}
+		if !paged {
+			return errors.New("paginated list with a limit of 1 returned no skip token")
+		}
+		return nil
+	})
+
+	c.require(CheckDelete, func() error {
+		if err := sub.delete(ctx, primary); err != nil {
+			return err
+		}
+		return waitDeleted(ctx, s, sub, primaryName)
+	})
+
+	c.run(CheckDeleteIdempotency, func() error {
+		// Deleting a missing resource succeeds, the providers return 202 or 404
+		return sub.delete(ctx, primary)
+	})
+
+	return c.report
+}
+
+// Waits for the resource to be active, it fails as soon as it is in the error state
+func waitActive[T types.ResourceType](ctx context.Context, s *Suite, sub subject[T], name string) (*T, error) {
+	ctx, cancel := context.WithTimeout(ctx, s.config.Timeout)
+	defer cancel()
+
+	for {
+		resource, err := sub.get(ctx, name)
+		if err != nil {
+			return nil, err
+		}
+
+		_, _, state := inspect(resource)
+		switch state {
+		case schema.ResourceStateActive:
+			return resource, nil
+		case schema.ResourceStateError:
+			return nil, fmt.Errorf("resource %s is in the error state", name)
+		case schema.ResourceStateDeleting:
+			return nil, fmt.Errorf("resource %s is unexpectedly deleting", name)
+		}
+
+		if err := sleep(ctx, s.config.Interval); err != nil {
+			return nil, fmt.Errorf("resource %s is still %q: %w", name, state, err)
+		}
+	}
+}
+
+// Waits for the resource to be not found
+func waitDeleted[T types.ResourceType](ctx context.Context, s *Suite, sub subject[T], name string) error {
+	ctx, cancel := context.WithTimeout(ctx, s.config.Timeout)
+	defer cancel()
+
+	for {
+		resource, err := sub.get(ctx, name)
+		if errors.Is(err, secapi.ErrResourceNotFound) {
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+
+		_, _, state := inspect(resource)
+		if state != schema.ResourceStateDeleting {
+			return fmt.Errorf("resource %s is %q instead of deleting", name, state)
+		}
+
+		if err := sleep(ctx, s.config.Interval); err != nil {
+			return fmt.Errorf("resource %s is still deleting: %w", name, err)
+		}
+	}
+}
+
+// Lists the names of the resources, and whether the first page has a skip token
+func listNames[T types.ResourceType](ctx context.Context, sub subject[T], options *secapi.ListOptions) (map[string]bool, bool, error) {
+	iter, err := sub.list(ctx, options)
+	if err != nil {
+		return nil, false, err
+	}
+
+	names := map[string]bool{}
+	paged := false
+	for {
+		item, err := iter.Next(ctx)
+		if errors.Is(err, io.EOF) {
+			return names, paged, nil
+		}
+		if err != nil {
+			return nil, false, err
+		}
+		if len(names) == 0 {
+			paged = iter.Metadata().SkipToken != nil
+		}
+
+		name, _, _ := inspect(item)
+		if names[name] {
+			return nil, false, fmt.Errorf("resource %s is listed twice", name)
+		}
+		names[name] = true
+	}
+}
+
+// Reads the name, the resource version and the state of a schema resource
+func inspect(resource any) (string, int64, schema.ResourceState) {
+	value := reflect.Indirect(reflect.ValueOf(resource))
+	if value.Kind() != reflect.Struct {
+		return "", 0, ""
+	}
+
+	var name string
+	var version int64
+	if metadata := reflect.Indirect(value.FieldByName("Metadata")); metadata.IsValid() {
+		if f := metadata.FieldByName("Name"); f.IsValid() && f.Kind() == reflect.String {
+			name = f.String()
+		}
+		if f := metadata.FieldByName("ResourceVersion"); f.IsValid() && f.CanInt() {
+			version = f.Int()
+		}
+	}
+
+	var state schema.ResourceState
+	if status := reflect.Indirect(value.FieldByName("Status")); status.IsValid() {
+		if f := status.FieldByName("State"); f.IsValid() && f.Kind() == reflect.String {
+			state = schema.ResourceState(f.String())
+		}
+	}
+
+	return name, version, state
+}
+
+func sleep(ctx context.Context, d time.Duration) error {
+	timer := time.NewTimer(d)
+	defer timer.Stop()
+
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	case <-timer.C:
+		return nil
+	}
+}
+
+func keys(set map[string]bool) []string {
+	items := make([]string, 0, len(set))
+	for item := range set {
+		items = append(items, item)
+	}
+	sort.Strings(items)
+	return items
+}
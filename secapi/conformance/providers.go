@@ -0,0 +1,383 @@
+package conformance
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/eu-sovereign-cloud/go-sdk/pkg/constants"
+	authorization "github.com/eu-sovereign-cloud/go-sdk/pkg/spec/foundation.authorization.v1"
+	compute "github.com/eu-sovereign-cloud/go-sdk/pkg/spec/foundation.compute.v1"
+	network "github.com/eu-sovereign-cloud/go-sdk/pkg/spec/foundation.network.v1"
+	storage "github.com/eu-sovereign-cloud/go-sdk/pkg/spec/foundation.storage.v1"
+	workspace "github.com/eu-sovereign-cloud/go-sdk/pkg/spec/foundation.workspace.v1"
+	"github.com/eu-sovereign-cloud/go-sdk/pkg/spec/schema"
+	"github.com/eu-sovereign-cloud/go-sdk/secapi"
+)
+
+const bootVolumeSizeGB = 10
+
+var ErrNoSku = errors.New("no sku available")
+
+type providerRun struct {
+	provider string
+	fn       func(ctx context.Context) ProviderReport
+}
+
+// Checks of each provider, in the order they are run
+func (s *Suite) providers() []providerRun {
+	return []providerRun{
+		{constants.RegionProviderName, s.checkRegion},
+		{constants.AuthorizationProviderName, s.checkAuthorization},
+		{constants.WorkspaceProviderName, s.checkWorkspace},
+		{constants.NetworkProviderName, s.checkNetwork},
+		{constants.StorageProviderName, s.checkStorage},
+		{constants.ComputeProviderName, s.checkCompute},
+	}
+}
+
+// Returns the report of a provider not available in the region
+func unavailable(provider, version string) ProviderReport {
+	c := newChecker(provider, version)
+	c.skip(CheckAvailable, secapi.ErrProviderNotAvailable)
+	return c.report
+}
+
+// Region, the regions are read only. The global providers are reached through their v1 endpoints.
+
+func (s *Suite) checkRegion(ctx context.Context) ProviderReport {
+	if _, ok := s.global.RegionV1.(*secapi.RegionV1Unavailable); ok {
+		return unavailable(constants.RegionProviderName, constants.ApiVersion1)
+	}
+
+	c := newChecker(constants.RegionProviderName, constants.ApiVersion1)
+
+	c.run(CheckList, func() error {
+		iter, err := s.global.RegionV1.ListRegions(ctx)
+		if err != nil {
+			return err
+		}
+		regions, err := iter.All(ctx)
+		if err != nil {
+			return err
+		}
+		for _, region := range regions {
+			if region.Metadata != nil && region.Metadata.Name == s.config.Region {
+				return nil
+			}
+		}
+		return fmt.Errorf("region %s is not listed", s.config.Region)
+	})
+
+	c.run(CheckGet, func() error {
+		region, err := s.global.RegionV1.GetRegion(ctx, s.config.Region)
+		if err != nil {
+			return err
+		}
+		if len(region.Spec.Providers) == 0 {
+			return fmt.Errorf("region %s has no provider", s.config.Region)
+		}
+		return nil
+	})
+
+	c.run(CheckPagination, func() error {
+		iter, err := s.global.RegionV1.ListRegionsWithOptions(ctx, secapi.NewListOptions().WithLimit(1))
+		if err != nil {
+			return err
+		}
+		regions, err := iter.All(ctx)
+		if err != nil {
+			return err
+		}
+
+		seen := map[string]bool{}
+		for _, region := range regions {
+			name, _, _ := inspect(region)
+			if seen[name] {
+				return fmt.Errorf("region %s is listed twice", name)
+			}
+			seen[name] = true
+		}
+		return nil
+	})
+
+	return c.report
+}
+
+// Authorization
+
+func (s *Suite) checkAuthorization(ctx context.Context) ProviderReport {
+	if _, ok := s.global.AuthorizationV1.(*secapi.AuthorizationV1Unavailable); ok {
+		return unavailable(constants.AuthorizationProviderName, constants.ApiVersion1)
+	}
+
+	api := s.global.AuthorizationV1
+	tpath := secapi.TenantPath{Tenant: secapi.TenantID(s.config.Tenant)}
+
+	return runLifecycle(ctx, s, subject[schema.Role]{
+		provider: constants.AuthorizationProviderName,
+		version:  constants.ApiVersion1,
+		kind:     "role",
+		build: func(name string, labels schema.Labels) *schema.Role {
+			return &schema.Role{
+				Metadata: &schema.GlobalTenantResourceMetadata{Name: name, Tenant: s.config.Tenant},
+				Labels:   labels,
+				Spec: schema.RoleSpec{Permissions: []schema.Permission{
+					{Provider: constants.ComputeProviderName, Resources: []string{"instances/*"}, Verb: []string{"get"}},
+				}},
+			}
+		},
+		put: func(ctx context.Context, role *schema.Role, ifUnmodifiedSince *int) (*schema.Role, error) {
+			return api.CreateOrUpdateRoleWithParams(ctx, role, &authorization.CreateOrUpdateRoleParams{IfUnmodifiedSince: ifUnmodifiedSince})
+		},
+		get: func(ctx context.Context, name string) (*schema.Role, error) {
+			return api.GetRole(ctx, secapi.TenantReference{Tenant: tpath.Tenant, Name: name})
+		},
+		list: func(ctx context.Context, options *secapi.ListOptions) (*secapi.Iterator[schema.Role], error) {
+			return api.ListRolesWithOptions(ctx, tpath, options)
+		},
+		delete: func(ctx context.Context, role *schema.Role) error {
+			return api.DeleteRole(ctx, role)
+		},
+	})
+}
+
+// Workspace
+
+func (s *Suite) checkWorkspace(ctx context.Context) ProviderReport {
+	if _, ok := s.regional.WorkspaceV1.(*secapi.WorkspaceV1Unavailable); ok {
+		return unavailable(constants.WorkspaceProviderName, s.regional.ProviderVersion(constants.WorkspaceProviderName))
+	}
+
+	api := s.regional.WorkspaceV1
+	tpath := secapi.TenantPath{Tenant: secapi.TenantID(s.config.Tenant)}
+
+	return runLifecycle(ctx, s, subject[schema.Workspace]{
+		provider: constants.WorkspaceProviderName,
+		version:  s.regional.ProviderVersion(constants.WorkspaceProviderName),
+		kind:     "workspace",
+		build: func(name string, labels schema.Labels) *schema.Workspace {
+			return &schema.Workspace{
+				Metadata: &schema.RegionalResourceMetadata{Name: name, Tenant: s.config.Tenant, Region: s.config.Region},
+				Labels:   labels,
+			}
+		},
+		put: func(ctx context.Context, ws *schema.Workspace, ifUnmodifiedSince *int) (*schema.Workspace, error) {
+			return api.CreateOrUpdateWorkspaceWithParams(ctx, ws, &workspace.CreateOrUpdateWorkspaceParams{IfUnmodifiedSince: ifUnmodifiedSince})
+		},
+		get: func(ctx context.Context, name string) (*schema.Workspace, error) {
+			return api.GetWorkspace(ctx, secapi.TenantReference{Tenant: tpath.Tenant, Name: name})
+		},
+		list: func(ctx context.Context, options *secapi.ListOptions) (*secapi.Iterator[schema.Workspace], error) {
+			return api.ListWorkspacesWithOptions(ctx, tpath, options)
+		},
+		delete: func(ctx context.Context, ws *schema.Workspace) error {
+			return api.DeleteWorkspace(ctx, ws)
+		},
+	})
+}
+
+// Network
+
+func (s *Suite) checkNetwork(ctx context.Context) ProviderReport {
+	if _, ok := s.regional.NetworkV1.(*secapi.NetworkV1Unavailable); ok {
+		return unavailable(constants.NetworkProviderName, s.regional.ProviderVersion(constants.NetworkProviderName))
+	}
+
+	api := s.regional.NetworkV1
+	wpath := s.workspacePath()
+
+	return runLifecycle(ctx, s, subject[schema.SecurityGroup]{
+		provider: constants.NetworkProviderName,
+		version:  s.regional.ProviderVersion(constants.NetworkProviderName),
+		kind:     "security-group",
+		build: func(name string, labels schema.Labels) *schema.SecurityGroup {
+			return &schema.SecurityGroup{
+				Metadata: s.workspaceMetadata(name),
+				Labels:   labels,
+				Spec: schema.SecurityGroupSpec{Rules: []schema.SecurityGroupRuleSpec{
+					{Direction: schema.SecurityGroupRuleDirectionIngress},
+				}},
+			}
+		},
+		put: func(ctx context.Context, group *schema.SecurityGroup, ifUnmodifiedSince *int) (*schema.SecurityGroup, error) {
+			return api.CreateOrUpdateSecurityGroupWithParams(ctx, group, &network.CreateOrUpdateSecurityGroupParams{IfUnmodifiedSince: ifUnmodifiedSince})
+		},
+		get: func(ctx context.Context, name string) (*schema.SecurityGroup, error) {
+			return api.GetSecurityGroup(ctx, s.workspaceReference(name))
+		},
+		list: func(ctx context.Context, options *secapi.ListOptions) (*secapi.Iterator[schema.SecurityGroup], error) {
+			return api.ListSecurityGroupsWithOptions(ctx, wpath, options)
+		},
+		delete: func(ctx context.Context, group *schema.SecurityGroup) error {
+			return api.DeleteSecurityGroup(ctx, group)
+		},
+	})
+}
+
+// Storage
+
+func (s *Suite) checkStorage(ctx context.Context) ProviderReport {
+	if _, ok := s.regional.StorageV1.(*secapi.StorageV1Unavailable); ok {
+		return unavailable(constants.StorageProviderName, s.regional.ProviderVersion(constants.StorageProviderName))
+	}
+
+	var skuRef string
+	sub := s.blockStorageSubject(func() string { return skuRef })
+	sub.setup = func(ctx context.Context) error {
+		var err error
+		skuRef, err = s.firstStorageSku(ctx)
+		return err
+	}
+
+	return runLifecycle(ctx, s, sub)
+}
+
+func (s *Suite) blockStorageSubject(skuRef func() string) subject[schema.BlockStorage] {
+	api := s.regional.StorageV1
+	wpath := s.workspacePath()
+
+	return subject[schema.BlockStorage]{
+		provider: constants.StorageProviderName,
+		version:  s.regional.ProviderVersion(constants.StorageProviderName),
+		kind:     "block-storage",
+		build: func(name string, labels schema.Labels) *schema.BlockStorage {
+			return &schema.BlockStorage{
+				Metadata: s.workspaceMetadata(name),
+				Labels:   labels,
+				Spec:     schema.BlockStorageSpec{SizeGB: bootVolumeSizeGB, SkuRef: schema.Reference{Resource: skuRef()}},
+			}
+		},
+		put: func(ctx context.Context, block *schema.BlockStorage, ifUnmodifiedSince *int) (*schema.BlockStorage, error) {
+			return api.CreateOrUpdateBlockStorageWithParams(ctx, block, &storage.CreateOrUpdateBlockStorageParams{IfUnmodifiedSince: ifUnmodifiedSince})
+		},
+		get: func(ctx context.Context, name string) (*schema.BlockStorage, error) {
+			return api.GetBlockStorage(ctx, s.workspaceReference(name))
+		},
+		list: func(ctx context.Context, options *secapi.ListOptions) (*secapi.Iterator[schema.BlockStorage], error) {
+			return api.ListBlockStoragesWithOptions(ctx, wpath, options)
+		},
+		delete: func(ctx context.Context, block *schema.BlockStorage) error {
+			return api.DeleteBlockStorage(ctx, block)
+		},
+	}
+}
+
+// Compute, the instances boot from a block storage created by the setup
+
+func (s *Suite) checkCompute(ctx context.Context) ProviderReport {
+	if _, ok := s.regional.ComputeV1.(*secapi.ComputeV1Unavailable); ok {
+		return unavailable(constants.ComputeProviderName, s.regional.ProviderVersion(constants.ComputeProviderName))
+	}
+
+	api := s.regional.ComputeV1
+	wpath := s.workspacePath()
+
+	var skuRef, storageSkuRef, zone string
+	var bootVolume *schema.BlockStorage
+	storageSub := s.blockStorageSubject(func() string { return storageSkuRef })
+
+	return runLifecycle(ctx, s, subject[schema.Instance]{
+		provider: constants.ComputeProviderName,
+		version:  s.regional.ProviderVersion(constants.ComputeProviderName),
+		kind:     "instance",
+		build: func(name string, labels schema.Labels) *schema.Instance {
+			return &schema.Instance{
+				Metadata: s.workspaceMetadata(name),
+				Labels:   labels,
+				Spec: schema.InstanceSpec{
+					SkuRef:     schema.Reference{Resource: skuRef},
+					Zone:       zone,
+					BootVolume: schema.VolumeReference{DeviceRef: schema.Reference{Resource: "block-storages/" + bootVolume.Metadata.Name}},
+				},
+			}
+		},
+		put: func(ctx context.Context, inst *schema.Instance, ifUnmodifiedSince *int) (*schema.Instance, error) {
+			return api.CreateOrUpdateInstanceWithParams(ctx, inst, &compute.CreateOrUpdateInstanceParams{IfUnmodifiedSince: ifUnmodifiedSince})
+		},
+		get: func(ctx context.Context, name string) (*schema.Instance, error) {
+			return api.GetInstance(ctx, s.workspaceReference(name))
+		},
+		list: func(ctx context.Context, options *secapi.ListOptions) (*secapi.Iterator[schema.Instance], error) {
+			return api.ListInstancesWithOptions(ctx, wpath, options)
+		},
+		delete: func(ctx context.Context, inst *schema.Instance) error {
+			return api.DeleteInstance(ctx, inst)
+		},
+		setup: func(ctx context.Context) error {
+			var err error
+			if skuRef, err = s.firstInstanceSku(ctx); err != nil {
+				return err
+			}
+			if storageSkuRef, err = s.firstStorageSku(ctx); err != nil {
+				return err
+			}
+			if zone, err = s.firstZone(ctx); err != nil {
+				return err
+			}
+
+			name := fmt.Sprintf("%s-boot-volume", s.config.Prefix)
+			if bootVolume, err = storageSub.put(ctx, storageSub.build(name, nil), nil); err != nil {
+				return err
+			}
+			_, err = waitActive(ctx, s, storageSub, name)
+			return err
+		},
+		teardown: func(ctx context.Context) {
+			if bootVolume != nil {
+				_ = storageSub.delete(ctx, bootVolume)
+			}
+		},
+	})
+}
+
+// Helpers
+
+func (s *Suite) workspacePath() secapi.WorkspacePath {
+	return secapi.WorkspacePath{Tenant: secapi.TenantID(s.config.Tenant), Workspace: secapi.WorkspaceID(s.config.Workspace)}
+}
+
+func (s *Suite) workspaceReference(name string) secapi.WorkspaceReference {
+	return secapi.WorkspaceReference{Tenant: secapi.TenantID(s.config.Tenant), Workspace: secapi.WorkspaceID(s.config.Workspace), Name: name}
+}
+
+func (s *Suite) workspaceMetadata(name string) *schema.RegionalWorkspaceResourceMetadata {
+	return &schema.RegionalWorkspaceResourceMetadata{Name: name, Tenant: s.config.Tenant, Workspace: s.config.Workspace, Region: s.config.Region}
+}
+
+func (s *Suite) firstInstanceSku(ctx context.Context) (string, error) {
+	iter, err := s.regional.ComputeV1.ListSkus(ctx, secapi.TenantPath{Tenant: secapi.TenantID(s.config.Tenant)})
+	if err != nil {
+		return "", err
+	}
+	return firstSkuRef(ctx, iter)
+}
+
+func (s *Suite) firstStorageSku(ctx context.Context) (string, error) {
+	iter, err := s.regional.StorageV1.ListSkus(ctx, secapi.TenantPath{Tenant: secapi.TenantID(s.config.Tenant)})
+	if err != nil {
+		return "", err
+	}
+	return firstSkuRef(ctx, iter)
+}
+
+func firstSkuRef[T schema.InstanceSku | schema.StorageSku](ctx context.Context, iter *secapi.Iterator[T]) (string, error) {
+	sku, err := iter.Next(ctx)
+	if err != nil {
+		return "", fmt.Errorf("%w: %w", ErrNoSku, err)
+	}
+
+	name, _, _ := inspect(sku)
+	return "skus/" + name, nil
+}
+
+func (s *Suite) firstZone(ctx context.Context) (string, error) {
+	region, err := s.global.RegionV1.GetRegion(ctx, s.config.Region)
+	if err != nil {
+		return "", err
+	}
+	if len(region.Spec.AvailableZones) == 0 {
+		return "", fmt.Errorf("region %s has no available zone", s.config.Region)
+	}
+	return region.Spec.AvailableZones[0], nil
+}
@@ -0,0 +1,268 @@
+package drift
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"reflect"
+	"sync"
+	"time"
+
+	"github.com/eu-sovereign-cloud/go-sdk/secapi"
+	"github.com/eu-sovereign-cloud/go-sdk/secapi/plan"
+)
+
+var ErrInvalidInterval = errors.New("the detection interval must be positive")
+
+// Status is the outcome of the drift detection of a resource.
+type Status string
+
+const (
+	// StatusInSync means the live resource matches the desired one
+	StatusInSync Status = "in-sync"
+	// StatusDrifted means the live resource differs from the desired one
+	StatusDrifted Status = "drifted"
+	// StatusMissing means the live resource doesn't exist anymore
+	StatusMissing Status = "missing"
+	// StatusError means the live resource couldn't be fetched
+	StatusError Status = "error"
+)
+
+// Result is the drift detection result of a single resource.
+type Result struct {
+	ID     plan.ResourceID  `json:"id"`
+	Status Status           `json:"status"`
+	Diffs  []plan.FieldDiff `json:"diffs,omitempty"`
+	Err    error            `json:"-"`
+
+	// Revision of the live resource the result was computed for
+	ResourceVersion int64     `json:"resourceVersion,omitempty"`
+	LastModifiedAt  time.Time `json:"lastModifiedAt,omitempty"`
+
+	// Skipped is set when the live resource didn't change since the previous check,
+	// the result of the previous check is reported without comparing the resources again.
+	Skipped   bool      `json:"skipped"`
+	CheckedAt time.Time `json:"checkedAt"`
+}
+
+// Callback receives the result of every checked resource.
+type Callback func(Result)
+
+type Config struct {
+	// Interval between two detections when running on a schedule.
+	Interval time.Duration
+
+	// Callback receives the results, it is called sequentially.
+	Callback Callback
+}
+
+// Detector
+
+type Detector struct {
+	planner  *plan.Planner
+	interval time.Duration
+	callback Callback
+
+	// Serializes the passes, so the callback is called sequentially
+	detecting sync.Mutex
+
+	mu         sync.Mutex
+	desired    []any
+	ids        []plan.ResourceID
+	last       map[plan.ResourceID]Result
+	generation int
+}
+
+type revision struct {
+	resourceVersion int64
+	lastModifiedAt  time.Time
+}
+
+// NewDetector creates a drift detector that fetches the live resources through the given clients.
+func NewDetector(global *secapi.GlobalClient, regional *secapi.RegionalClient, config *Config) (*Detector, error) {
+	if config == nil {
+		config = &Config{}
+	}
+	if config.Interval < 0 {
+		return nil, ErrInvalidInterval
+	}
+
+	return &Detector{
+		planner:  plan.NewPlanner(global, regional),
+		interval: config.Interval,
+		callback: config.Callback,
+		last:     map[plan.ResourceID]Result{},
+	}, nil
+}
+
+// SetDesired replaces the stored desired resources, as decoded from manifests.
+// The cached results of the resources are discarded.
+func (d *Detector) SetDesired(desired []any) error {
+	ids := make([]plan.ResourceID, 0, len(desired))
+	seen := map[plan.ResourceID]bool{}
+	for _, resource := range desired {
+		id, err := plan.Identify(resource)
+		if err != nil {
+			return err
+		}
+		if seen[id] {
+			return fmt.Errorf("%w: %s", plan.ErrDuplicatedResource, id)
+		}
+		seen[id] = true
+		ids = append(ids, id)
+	}
+
+	d.mu.Lock()
+	defer d.mu.Unlock()
+
+	d.desired = desired
+	d.ids = ids
+	d.last = map[plan.ResourceID]Result{}
+	d.generation++
+	return nil
+}
+
+// Detect checks every desired resource once against its live version and returns the results,
+// which are also sent to the callback. Resources whose resource version and last modification
+// time didn't change since the previous check are not compared again. The desired resources are
+// read when the pass starts, a pass running while they are replaced doesn't record its results.
+func (d *Detector) Detect(ctx context.Context) []Result {
+	d.detecting.Lock()
+	defer d.detecting.Unlock()
+
+	d.mu.Lock()
+	desired, ids, generation := d.desired, d.ids, d.generation
+	d.mu.Unlock()
+
+	results := make([]Result, 0, len(desired))
+	for i, resource := range desired {
+		if ctx.Err() != nil {
+			break
+		}
+
+		result := d.detect(ctx, ids[i], resource, generation)
+		if result.Status != StatusError {
+			d.record(result, generation)
+		}
+
+		results = append(results, result)
+		if d.callback != nil {
+			d.callback(result)
+		}
+	}
+
+	return results
+}
+
+// Run detects the drift on the configured interval until the context is done.
+func (d *Detector) Run(ctx context.Context) error {
+	if d.interval <= 0 {
+		return ErrInvalidInterval
+	}
+
+	ticker := time.NewTicker(d.interval)
+	defer ticker.Stop()
+
+	for {
+		d.Detect(ctx)
+
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-ticker.C:
+		}
+	}
+}
+
+// Records the result of a check, unless the desired resources were replaced since the pass started
+func (d *Detector) record(result Result, generation int) {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+
+	if d.generation == generation {
+		d.last[result.ID] = result
+	}
+}
+
+// Returns the result of the previous check of a resource
+func (d *Detector) previous(id plan.ResourceID, generation int) (Result, bool) {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+
+	if d.generation != generation {
+		return Result{}, false
+	}
+	last, found := d.last[id]
+	return last, found
+}
+
+func (d *Detector) detect(ctx context.Context, id plan.ResourceID, desired any, generation int) Result {
+	result := Result{ID: id, CheckedAt: time.Now()}
+
+	live, err := d.planner.Fetch(ctx, desired)
+	if err != nil {
+		result.Status = StatusError
+		result.Err = err
+		return result
+	}
+	if live == nil {
+		result.Status = StatusMissing
+		return result
+	}
+
+	rev := revisionOf(live)
+	result.ResourceVersion = rev.resourceVersion
+	result.LastModifiedAt = rev.lastModifiedAt
+
+	if last, found := d.previous(id, generation); found && last.Status != StatusMissing && unchanged(last, rev) {
+		last.Skipped = true
+		last.CheckedAt = result.CheckedAt
+		return last
+	}
+
+	diffs, err := plan.Compare(desired, live)
+	if err != nil {
+		result.Status = StatusError
+		result.Err = err
+		return result
+	}
+
+	result.Diffs = diffs
+	if len(diffs) > 0 {
+		result.Status = StatusDrifted
+	} else {
+		result.Status = StatusInSync
+	}
+	return result
+}
+
+func unchanged(last Result, rev revision) bool {
+	// Without any revision the resource can't be known as unchanged
+	if rev.resourceVersion == 0 && rev.lastModifiedAt.IsZero() {
+		return false
+	}
+
+	return last.ResourceVersion == rev.resourceVersion && last.LastModifiedAt.Equal(rev.lastModifiedAt)
+}
+
+// Every resource metadata holds the resource version and the last modification time
+func revisionOf(resource any) revision {
+	value := reflect.Indirect(reflect.ValueOf(resource))
+	if value.Kind() != reflect.Struct {
+		return revision{}
+	}
+
+	metadata := reflect.Indirect(value.FieldByName("Metadata"))
+	if metadata.Kind() != reflect.Struct {
+		return revision{}
+	}
+
+	var rev revision
+	if field := metadata.FieldByName("ResourceVersion"); field.IsValid() && field.CanInt() {
+		rev.resourceVersion = field.Int()
+	}
+	if field := metadata.FieldByName("LastModifiedAt"); field.IsValid() {
+		rev.lastModifiedAt, _ = field.Interface().(time.Time)
+	}
+	return rev
+}
@@ -0,0 +1,172 @@
+package drift
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/eu-sovereign-cloud/go-sdk/internal/secatest"
+	"github.com/eu-sovereign-cloud/go-sdk/internal/secatest/clients"
+	mockcompute "github.com/eu-sovereign-cloud/go-sdk/mock/spec/foundation.compute.v1"
+	mocknetwork "github.com/eu-sovereign-cloud/go-sdk/mock/spec/foundation.network.v1"
+	"github.com/eu-sovereign-cloud/go-sdk/pkg/spec/schema"
+	"github.com/eu-sovereign-cloud/go-sdk/secapi"
+	"github.com/eu-sovereign-cloud/go-sdk/secapi/plan"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestDetector_Detect(t *testing.T) {
+	ctx := context.Background()
+	sm := http.NewServeMux()
+
+	secatest.ConfigureRegionV1Handler(t, sm)
+
+	live := secatest.NewInstance(secatest.Instance1Name, "b")
+	live.Metadata.ResourceVersion = 2
+	live.Metadata.LastModifiedAt = time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)
+	live.Labels = schema.Labels{secatest.LabelEnvKey: secatest.LabelEnvValue}
+	live.Status = secatest.NewInstanceStatus(schema.ResourceStateActive)
+
+	csim := mockcompute.NewMockServerInterface(t)
+	secatest.MockGetInstanceV1(csim, live, 2)
+	secatest.ConfigureComputeHandler(csim, sm)
+
+	nsim := mocknetwork.NewMockServerInterface(t)
+	secatest.MockNotFoundNetworkV1(nsim, nil, 2)
+	secatest.ConfigureNetworkHandler(nsim, sm)
+
+	server := httptest.NewServer(sm)
+	defer server.Close()
+
+	var received []Result
+	detector := newTestDetector(t, ctx, server, &Config{Callback: func(result Result) {
+		received = append(received, result)
+	}})
+
+	require.NoError(t, detector.SetDesired([]any{
+		secatest.NewInstance(secatest.Instance1Name, secatest.ZoneA),
+		&schema.Network{
+			Metadata: secatest.NewRegionalWorkspaceResourceMetadata(secatest.Network1Name, secatest.Tenant1Name, secatest.Workspace1Name, secatest.Region1Name),
+			Spec:     schema.NetworkSpec{Cidr: schema.Cidr{Ipv4: secatest.CidrIpv4}, SkuRef: schema.Reference{Resource: secatest.NetworkSku1Ref}},
+		},
+	}))
+
+	results := detector.Detect(ctx)
+	require.Len(t, results, 2)
+	assert.Equal(t, results, received)
+
+	assert.Equal(t, StatusDrifted, results[0].Status)
+	assert.False(t, results[0].Skipped)
+	assert.Equal(t, int64(2), results[0].ResourceVersion)
+	assert.Equal(t, []plan.FieldDiff{
+		{Path: "labels.env", Operation: plan.DiffOperationAdded, To: secatest.LabelEnvValue},
+		{Path: "spec.zone", Operation: plan.DiffOperationModified, From: secatest.ZoneA, To: "b"},
+	}, results[0].Diffs)
+
+	assert.Equal(t, StatusMissing, results[1].Status)
+
+	// The unchanged instance isn't compared again
+	results = detector.Detect(ctx)
+	require.Len(t, results, 2)
+	assert.Equal(t, StatusDrifted, results[0].Status)
+	assert.True(t, results[0].Skipped)
+	assert.Len(t, results[0].Diffs, 2)
+	assert.Equal(t, StatusMissing, results[1].Status)
+	assert.False(t, results[1].Skipped)
+}
+
+func TestDetector_Run(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	sm := http.NewServeMux()
+	secatest.ConfigureRegionV1Handler(t, sm)
+
+	live := secatest.NewInstance(secatest.Instance1Name, secatest.ZoneA)
+	live.Metadata.ResourceVersion = 1
+
+	csim := mockcompute.NewMockServerInterface(t)
+	secatest.MockGetInstanceV1(csim, live, 2)
+	secatest.ConfigureComputeHandler(csim, sm)
+
+	server := httptest.NewServer(sm)
+	defer server.Close()
+
+	var received []Result
+	detector := newTestDetector(t, ctx, server, &Config{
+		Interval: time.Millisecond,
+		Callback: func(result Result) {
+			received = append(received, result)
+			if len(received) == 2 {
+				cancel()
+			}
+		},
+	})
+	require.NoError(t, detector.SetDesired([]any{secatest.NewInstance(secatest.Instance1Name, secatest.ZoneA)}))
+
+	err := detector.Run(ctx)
+	assert.ErrorIs(t, err, context.Canceled)
+
+	require.Len(t, received, 2)
+	assert.Equal(t, StatusInSync, received[0].Status)
+	assert.False(t, received[0].Skipped)
+	assert.Equal(t, StatusInSync, received[1].Status)
+	assert.True(t, received[1].Skipped)
+}
+
+func TestDetector_CallbackSetsDesired(t *testing.T) {
+	ctx := context.Background()
+	sm := http.NewServeMux()
+
+	secatest.ConfigureRegionV1Handler(t, sm)
+
+	live := secatest.NewInstance(secatest.Instance1Name, secatest.ZoneA)
+	live.Metadata.ResourceVersion = 1
+
+	csim := mockcompute.NewMockServerInterface(t)
+	secatest.MockGetInstanceV1(csim, live, 2)
+	secatest.ConfigureComputeHandler(csim, sm)
+
+	server := httptest.NewServer(sm)
+	defer server.Close()
+
+	var detector *Detector
+	detector = newTestDetector(t, ctx, server, &Config{Callback: func(result Result) {
+		// Replacing the desired resources from the callback doesn't deadlock
+		assert.NoError(t, detector.SetDesired([]any{secatest.NewInstance(secatest.Instance1Name, secatest.ZoneA)}))
+	}})
+	require.NoError(t, detector.SetDesired([]any{secatest.NewInstance(secatest.Instance1Name, secatest.ZoneA)}))
+
+	results := detector.Detect(ctx)
+	require.Len(t, results, 1)
+	assert.Equal(t, StatusInSync, results[0].Status)
+
+	// The result of the pass is dropped with the replaced desired resources
+	results = detector.Detect(ctx)
+	require.Len(t, results, 1)
+	assert.False(t, results[0].Skipped)
+}
+
+func TestDetector_Errors(t *testing.T) {
+	_, err := NewDetector(nil, nil, &Config{Interval: -time.Second})
+	assert.ErrorIs(t, err, ErrInvalidInterval)
+
+	detector, err := NewDetector(nil, nil, nil)
+	require.NoError(t, err)
+	assert.ErrorIs(t, detector.Run(context.Background()), ErrInvalidInterval)
+
+	instance := secatest.NewInstance(secatest.Instance1Name, secatest.ZoneA)
+	assert.ErrorIs(t, detector.SetDesired([]any{instance, instance}), plan.ErrDuplicatedResource)
+}
+
+func newTestDetector(t *testing.T, ctx context.Context, server *httptest.Server, config *Config) *Detector {
+	global, regional := clients.New(t, ctx, secapi.GlobalConfig{Endpoints: clients.Endpoints(server.URL)})
+
+	detector, err := NewDetector(global, regional, config)
+	require.NoError(t, err)
+	return detector
+}
@@ -7,6 +7,8 @@ import (
 var (
 	ErrProviderNotAvailable = errors.New("provider not available in the region")
 
+	ErrUnsupportedWellknownVersion = errors.New("unsupported wellknown version")
+
 	ErrNoMetadata          = errors.New("metadata is empty")
 	ErrNoMetadataTenant    = errors.New("metadata tenant is empty")
 	ErrNoMetadataWorkspace = errors.New("metadata workspace is empty")
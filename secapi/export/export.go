@@ -0,0 +1,370 @@
+package export
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"os"
+	"path/filepath"
+	"reflect"
+	"slices"
+
+	"github.com/eu-sovereign-cloud/go-sdk/pkg/spec/schema"
+	"github.com/eu-sovereign-cloud/go-sdk/pkg/types"
+	"github.com/eu-sovereign-cloud/go-sdk/secapi"
+	"github.com/eu-sovereign-cloud/go-sdk/secapi/manifest"
+)
+
+var ErrNoWorkspace = errors.New("the workspace resource is required to relocate the manifests")
+
+// Options
+
+type ExportOptions struct {
+	// Format of the written manifests, defaults to YAML.
+	Format manifest.Format
+
+	// Workspace and Region, when set, replace the source ones in the manifests
+	// so they can be applied to another workspace or region.
+	Workspace string
+	Region    string
+}
+
+// Exporter
+
+type Exporter struct {
+	global   *secapi.GlobalClient
+	regional *secapi.RegionalClient
+}
+
+// NewExporter creates an exporter that lists the resources through the given clients.
+func NewExporter(global *secapi.GlobalClient, regional *secapi.RegionalClient) *Exporter {
+	return &Exporter{global: global, regional: regional}
+}
+
+// Export returns every resource of a workspace in creation order: the workspace itself, the tenant
+// images, the workspace storage, network and compute resources, the subnets and route tables of each
+// network and the role assignments whose scopes mention the workspace.
+func (e *Exporter) Export(ctx context.Context, wpath secapi.WorkspacePath) ([]any, error) {
+	tpath := secapi.TenantPath{Tenant: wpath.Tenant}
+
+	var resources []any
+
+	// Workspace
+	workspace, err := e.regional.WorkspaceV1.GetWorkspace(ctx, secapi.TenantReference{Tenant: wpath.Tenant, Name: string(wpath.Workspace)})
+	if err != nil {
+		return nil, err
+	}
+	resources = append(resources, workspace)
+
+	// Storage
+	images, err := list(ctx, e.regional.StorageV1.ListImages, tpath)
+	if err != nil {
+		return nil, err
+	}
+	resources = append(resources, images...)
+
+	blockStorages, err := list(ctx, e.regional.StorageV1.ListBlockStorages, wpath)
+	if err != nil {
+		return nil, err
+	}
+	resources = append(resources, blockStorages...)
+
+	// Network
+	networks, err := e.regional.NetworkV1.ListNetworks(ctx, wpath)
+	if err != nil {
+		return nil, err
+	}
+	networkItems, err := networks.All(ctx)
+	if err != nil {
+		return nil, err
+	}
+	for _, network := range networkItems {
+		resources = append(resources, network)
+	}
+
+	for _, network := range networkItems {
+		npath := secapi.NetworkPath{Tenant: wpath.Tenant, Workspace: wpath.Workspace, Network: secapi.NetworkID(network.Metadata.Name)}
+
+		subnets, err := list(ctx, e.regional.NetworkV1.ListSubnets, npath)
+		if err != nil {
+			return nil, err
+		}
+		resources = append(resources, subnets...)
+
+		routeTables, err := list(ctx, e.regional.NetworkV1.ListRouteTables, npath)
+		if err != nil {
+			return nil, err
+		}
+		resources = append(resources, routeTables...)
+	}
+
+	internetGateways, err := list(ctx, e.regional.NetworkV1.ListInternetGateways, wpath)
+	if err != nil {
+		return nil, err
+	}
+	resources = append(resources, internetGateways...)
+
+	securityGroupRules, err := list(ctx, e.regional.NetworkV1.ListSecurityGroupRules, wpath)
+	if err != nil {
+		return nil, err
+	}
+	resources = append(resources, securityGroupRules...)
+
+	securityGroups, err := list(ctx, e.regional.NetworkV1.ListSecurityGroups, wpath)
+	if err != nil {
+		return nil, err
+	}
+	resources = append(resources, securityGroups...)
+
+	publicIps, err := list(ctx, e.regional.NetworkV1.ListPublicIps, wpath)
+	if err != nil {
+		return nil, err
+	}
+	resources = append(resources, publicIps...)
+
+	nics, err := list(ctx, e.regional.NetworkV1.ListNics, wpath)
+	if err != nil {
+		return nil, err
+	}
+	resources = append(resources, nics...)
+
+	// Compute
+	instances, err := list(ctx, e.regional.ComputeV1.ListInstances, wpath)
+	if err != nil {
+		return nil, err
+	}
+	resources = append(resources, instances...)
+
+	// Authorization
+	assignments, err := e.global.AuthorizationV1.ListRoleAssignments(ctx, tpath)
+	if err != nil {
+		return nil, err
+	}
+	assignmentItems, err := assignments.All(ctx)
+	if err != nil {
+		return nil, err
+	}
+	for _, assignment := range assignmentItems {
+		if scopesWorkspace(assignment, string(wpath.Workspace)) {
+			resources = append(resources, assignment)
+		}
+	}
+
+	return resources, nil
+}
+
+// ExportToDir exports a workspace and writes one manifest per resource into the directory,
+// as <kind>/<name> or <kind>/<network>/<name> for the network scoped resources.
+// It returns the paths of the written files.
+func (e *Exporter) ExportToDir(ctx context.Context, wpath secapi.WorkspacePath, dir string, options *ExportOptions) ([]string, error) {
+	resources, err := e.Export(ctx, wpath)
+	if err != nil {
+		return nil, err
+	}
+
+	return WriteDir(dir, resources, options)
+}
+
+// WriteDir writes one manifest per resource into the directory, relocating the resources
+// when the options ask for it, the source workspace and region are the ones of the exported
+// workspace resource. It returns the paths of the written files.
+func WriteDir(dir string, resources []any, options *ExportOptions) ([]string, error) {
+	if options == nil {
+		options = &ExportOptions{}
+	}
+
+	var sourceWorkspace, sourceRegion string
+	for _, resource := range resources {
+		if workspace, ok := resource.(*schema.Workspace); ok && workspace.Metadata != nil {
+			sourceWorkspace = workspace.Metadata.Name
+			sourceRegion = workspace.Metadata.Region
+		}
+	}
+	if (options.Workspace != "" || options.Region != "") && sourceWorkspace == "" {
+		return nil, ErrNoWorkspace
+	}
+
+	format := options.Format
+	if format == "" {
+		format = manifest.FormatYAML
+	}
+
+	relocation := relocation{
+		fromWorkspace: sourceWorkspace, toWorkspace: options.Workspace,
+		fromRegion: sourceRegion, toRegion: options.Region,
+	}
+
+	var paths []string
+	for _, resource := range resources {
+		if relocation.active() {
+			relocated, err := relocation.resource(resource)
+			if err != nil {
+				return nil, err
+			}
+			resource = relocated
+		}
+
+		document, err := manifest.Strip(resource)
+		if err != nil {
+			return nil, err
+		}
+		if metadata, ok := document["metadata"].(map[string]any); ok && relocation.active() {
+			if _, ok := resource.(*schema.Workspace); ok {
+				relocation.workspace(metadata, "name")
+			}
+			relocation.workspace(metadata, "workspace")
+			relocation.region(metadata, "region")
+		}
+
+		path := filepath.Join(dir, documentPath(document)+"."+string(format))
+		if err := os.MkdirAll(filepath.Dir(path), 0o750); err != nil {
+			return nil, err
+		}
+
+		var buf bytes.Buffer
+		if err := manifest.EncodeDocuments(&buf, format, document); err != nil {
+			return nil, err
+		}
+		if err := os.WriteFile(path, buf.Bytes(), 0o600); err != nil {
+			return nil, err
+		}
+
+		paths = append(paths, path)
+	}
+
+	return paths, nil
+}
+
+func list[P secapi.PathType, T types.ResourceType](ctx context.Context, fn func(context.Context, P) (*secapi.Iterator[T], error), path P) ([]any, error) {
+	iter, err := fn(ctx, path)
+	if err != nil {
+		return nil, err
+	}
+
+	items, err := iter.All(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	resources := make([]any, 0, len(items))
+	for _, item := range items {
+		resources = append(resources, item)
+	}
+	return resources, nil
+}
+
+func scopesWorkspace(assignment *schema.RoleAssignment, workspace string) bool {
+	for _, scope := range assignment.Spec.Scopes {
+		if slices.Contains(scope.Workspaces, workspace) {
+			return true
+		}
+	}
+	return false
+}
+
+func documentPath(document map[string]any) string {
+	metadata, _ := document["metadata"].(map[string]any)
+	kind, _ := metadata["kind"].(string)
+	name, _ := metadata["name"].(string)
+
+	if network, ok := metadata["network"].(string); ok {
+		return filepath.Join(kind, network, name)
+	}
+	return filepath.Join(kind, name)
+}
+
+// Source and target workspace and region of the manifests, an empty target keeps the source
+type relocation struct {
+	fromWorkspace, toWorkspace string
+	fromRegion, toRegion       string
+}
+
+func (r relocation) active() bool {
+	return (r.toWorkspace != "" && r.fromWorkspace != "") || (r.toRegion != "" && r.fromRegion != "")
+}
+
+func (r relocation) workspace(fields map[string]any, key string) {
+	if r.toWorkspace != "" && fields[key] == r.fromWorkspace {
+		fields[key] = r.toWorkspace
+	}
+}
+
+func (r relocation) region(fields map[string]any, key string) {
+	if r.toRegion != "" && r.fromRegion != "" && fields[key] == r.fromRegion {
+		fields[key] = r.toRegion
+	}
+}
+
+// Returns a copy of the resource whose references and role assignment scopes are relocated.
+// The labels, annotations and extensions are user data and are left as they are.
+func (r relocation) resource(resource any) (any, error) {
+	value := reflect.ValueOf(resource)
+	if value.Kind() != reflect.Pointer || value.IsNil() {
+		return resource, nil
+	}
+
+	data, err := json.Marshal(resource)
+	if err != nil {
+		return nil, err
+	}
+	relocated := reflect.New(value.Elem().Type())
+	if err := json.Unmarshal(data, relocated.Interface()); err != nil {
+		return nil, err
+	}
+
+	r.walk(relocated.Elem())
+	return relocated.Interface(), nil
+}
+
+var (
+	referenceType = reflect.TypeFor[schema.Reference]()
+	scopeType     = reflect.TypeFor[schema.RoleAssignmentScope]()
+)
+
+// Relocates the references and the scopes found in the structs, the pointers and the slices
+func (r relocation) walk(value reflect.Value) {
+	switch value.Kind() {
+	case reflect.Pointer:
+		if !value.IsNil() {
+			r.walk(value.Elem())
+		}
+	case reflect.Slice, reflect.Array:
+		for i := range value.Len() {
+			r.walk(value.Index(i))
+		}
+	case reflect.Struct:
+		switch value.Type() {
+		case referenceType:
+			ref := value.Addr().Interface().(*schema.Reference)
+			if r.toWorkspace != "" && ref.Workspace == r.fromWorkspace && ref.Workspace != "" {
+				ref.Workspace = r.toWorkspace
+			}
+			if r.toRegion != "" && ref.Region == r.fromRegion && ref.Region != "" {
+				ref.Region = r.toRegion
+			}
+		case scopeType:
+			scope := value.Addr().Interface().(*schema.RoleAssignmentScope)
+			if r.toWorkspace != "" {
+				replaceAll(scope.Workspaces, r.fromWorkspace, r.toWorkspace)
+			}
+			if r.toRegion != "" && r.fromRegion != "" {
+				replaceAll(scope.Regions, r.fromRegion, r.toRegion)
+			}
+		default:
+			for i := range value.NumField() {
+				if value.Type().Field(i).IsExported() {
+					r.walk(value.Field(i))
+				}
+			}
+		}
+	}
+}
+
+func replaceAll(values []string, from, to string) {
+	for i := range values {
+		if values[i] == from {
+			values[i] = to
+		}
+	}
+}
@@ -0,0 +1,191 @@
+package export
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"path/filepath"
+	"testing"
+
+	"github.com/eu-sovereign-cloud/go-sdk/internal/secatest"
+	"github.com/eu-sovereign-cloud/go-sdk/internal/secatest/clients"
+	mockauthorization "github.com/eu-sovereign-cloud/go-sdk/mock/spec/foundation.authorization.v1"
+	mockcompute "github.com/eu-sovereign-cloud/go-sdk/mock/spec/foundation.compute.v1"
+	mocknetwork "github.com/eu-sovereign-cloud/go-sdk/mock/spec/foundation.network.v1"
+	mockstorage "github.com/eu-sovereign-cloud/go-sdk/mock/spec/foundation.storage.v1"
+	mockworkspace "github.com/eu-sovereign-cloud/go-sdk/mock/spec/foundation.workspace.v1"
+	"github.com/eu-sovereign-cloud/go-sdk/pkg/spec/schema"
+	"github.com/eu-sovereign-cloud/go-sdk/secapi"
+	"github.com/eu-sovereign-cloud/go-sdk/secapi/manifest"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestExporter_ExportToDir(t *testing.T) {
+	ctx := context.Background()
+	sm := http.NewServeMux()
+
+	secatest.ConfigureRegionV1Handler(t, sm)
+
+	wsim := mockworkspace.NewMockServerInterface(t)
+	secatest.MockGetWorkspaceV1(wsim, &schema.Workspace{
+		Metadata: secatest.NewRegionalResourceMetadata(secatest.Workspace1Name, secatest.Tenant1Name, secatest.Region1Name),
+		Status:   &schema.WorkspaceStatus{State: schema.ResourceStateActive},
+	}, 1)
+	secatest.ConfigureWorkspaceHandler(wsim, sm)
+
+	ssim := mockstorage.NewMockServerInterface(t)
+	secatest.MockListImagesV1(ssim, []schema.Image{
+		{
+			Metadata: secatest.NewRegionalResourceMetadata(secatest.Image1Name, secatest.Tenant1Name, secatest.Region1Name),
+			Spec: schema.ImageSpec{
+				BlockStorageRef: schema.Reference{Resource: secatest.BlockStorage1Ref, Workspace: secatest.Workspace1Name},
+				CpuArchitecture: schema.ImageSpecCpuArchitectureAmd64,
+			},
+		},
+	})
+	secatest.MockListBlockStoragesV1(ssim, []schema.BlockStorage{
+		{
+			Metadata: secatest.NewRegionalWorkspaceResourceMetadata(secatest.BlockStorage1Name, secatest.Tenant1Name, secatest.Workspace1Name, secatest.Region1Name),
+			Spec:     schema.BlockStorageSpec{SizeGB: secatest.BlockStorage1SizeGB, SkuRef: schema.Reference{Resource: secatest.StorageSku1Ref}},
+		},
+	})
+	secatest.ConfigureStorageHandler(ssim, sm)
+
+	nsim := mocknetwork.NewMockServerInterface(t)
+	secatest.MockListNetworksV1(nsim, []schema.Network{
+		{
+			Metadata: secatest.NewRegionalWorkspaceResourceMetadata(secatest.Network1Name, secatest.Tenant1Name, secatest.Workspace1Name, secatest.Region1Name),
+			Spec:     schema.NetworkSpec{Cidr: schema.Cidr{Ipv4: secatest.CidrIpv4}, SkuRef: schema.Reference{Resource: secatest.NetworkSku1Ref}},
+		},
+	})
+	secatest.MockListSubnetsV1(nsim, []schema.Subnet{
+		{
+			Metadata: secatest.NewRegionalNetworkResourceMetadata(secatest.Subnet1Name, secatest.Tenant1Name, secatest.Workspace1Name, secatest.Network1Name, secatest.Region1Name),
+			Spec:     schema.SubnetSpec{Cidr: schema.Cidr{Ipv4: secatest.CidrIpv4}, Zone: secatest.ZoneA},
+		},
+	})
+	secatest.MockListRouteTablesV1(nsim, []schema.RouteTable{})
+	secatest.MockListInternetGatewaysV1(nsim, []schema.InternetGateway{})
+	secatest.MockListSecurityGroupRulesV1(nsim, []schema.SecurityGroupRule{})
+	secatest.MockListSecurityGroupsV1(nsim, []schema.SecurityGroup{})
+	secatest.MockListPublicIpsV1(nsim, []schema.PublicIp{})
+	secatest.MockListNicsV1(nsim, []schema.Nic{})
+	secatest.ConfigureNetworkHandler(nsim, sm)
+
+	csim := mockcompute.NewMockServerInterface(t)
+	secatest.MockListInstancesV1(csim, []schema.Instance{
+		{
+			Metadata: secatest.NewRegionalWorkspaceResourceMetadata(secatest.Instance1Name, secatest.Tenant1Name, secatest.Workspace1Name, secatest.Region1Name),
+			Spec:     schema.InstanceSpec{SkuRef: schema.Reference{Resource: secatest.InstanceSku1Ref}, Zone: secatest.ZoneA},
+			Status:   secatest.NewInstanceStatus(schema.ResourceStateActive),
+		},
+	})
+	secatest.ConfigureComputeHandler(csim, sm)
+
+	asim := mockauthorization.NewMockServerInterface(t)
+	secatest.MockListRoleAssignmentsV1(asim, []schema.RoleAssignment{
+		buildRoleAssignment(secatest.RoleAssignment1Name, secatest.Workspace1Name),
+		buildRoleAssignment("role-assignment-2", "workspace-2"),
+	})
+	secatest.ConfigureAuthorizationHandler(asim, sm)
+
+	server := httptest.NewServer(sm)
+	defer server.Close()
+
+	exporter := NewExporter(clients.New(t, ctx, secapi.GlobalConfig{Endpoints: clients.Endpoints(server.URL)}))
+
+	dir := t.TempDir()
+	paths, err := exporter.ExportToDir(ctx, secapi.WorkspacePath{Tenant: secatest.Tenant1Name, Workspace: secatest.Workspace1Name}, dir, &ExportOptions{
+		Workspace: "workspace-2",
+		Region:    secatest.Region2Name,
+	})
+	require.NoError(t, err)
+
+	assert.Equal(t, []string{
+		filepath.Join(dir, "workspace", "workspace-2.yaml"),
+		filepath.Join(dir, "image", secatest.Image1Name+".yaml"),
+		filepath.Join(dir, "block-storage", secatest.BlockStorage1Name+".yaml"),
+		filepath.Join(dir, "network", secatest.Network1Name+".yaml"),
+		filepath.Join(dir, "subnet", secatest.Network1Name, secatest.Subnet1Name+".yaml"),
+		filepath.Join(dir, "instance", secatest.Instance1Name+".yaml"),
+		filepath.Join(dir, "role-assignment", secatest.RoleAssignment1Name+".yaml"),
+	}, paths)
+
+	resources, err := manifest.DecodeFile(paths[1])
+	require.NoError(t, err)
+	image := resources[0].(*schema.Image)
+	assert.Equal(t, secatest.Region2Name, image.Metadata.Region)
+	assert.Equal(t, "workspace-2", image.Spec.BlockStorageRef.Workspace)
+
+	resources, err = manifest.DecodeFile(paths[5])
+	require.NoError(t, err)
+	instance := resources[0].(*schema.Instance)
+	assert.Equal(t, "workspace-2", instance.Metadata.Workspace)
+	assert.Equal(t, secatest.Region2Name, instance.Metadata.Region)
+	assert.Zero(t, instance.Metadata.ResourceVersion)
+	assert.Nil(t, instance.Status)
+
+	resources, err = manifest.DecodeFile(paths[6])
+	require.NoError(t, err)
+	assignment := resources[0].(*schema.RoleAssignment)
+	assert.Equal(t, []string{"workspace-2"}, assignment.Spec.Scopes[0].Workspaces)
+	assert.Equal(t, []string{secatest.Region2Name}, assignment.Spec.Scopes[0].Regions)
+}
+
+func TestWriteDir_RequiresWorkspace(t *testing.T) {
+	instance := &schema.Instance{
+		Metadata: secatest.NewRegionalWorkspaceResourceMetadata(secatest.Instance1Name, secatest.Tenant1Name, secatest.Workspace1Name, secatest.Region1Name),
+	}
+
+	_, err := WriteDir(t.TempDir(), []any{instance}, &ExportOptions{Workspace: "workspace-2"})
+	assert.ErrorIs(t, err, ErrNoWorkspace)
+
+	paths, err := WriteDir(t.TempDir(), []any{instance}, &ExportOptions{Format: manifest.FormatJSON})
+	require.NoError(t, err)
+	assert.Equal(t, filepath.Join("instance", secatest.Instance1Name+".json"), filepath.Join(filepath.Base(filepath.Dir(paths[0])), filepath.Base(paths[0])))
+}
+
+func TestWriteDir_RelocatesOnlyLocations(t *testing.T) {
+	workspace := &schema.Workspace{
+		Metadata: secatest.NewRegionalResourceMetadata(secatest.Workspace1Name, secatest.Tenant1Name, secatest.Region1Name),
+	}
+	instance := &schema.Instance{
+		Metadata:    secatest.NewRegionalWorkspaceResourceMetadata(secatest.Workspace1Name, secatest.Tenant1Name, secatest.Workspace1Name, secatest.Region1Name),
+		Labels:      schema.Labels{"workspace": secatest.Workspace1Name, "region": secatest.Region1Name},
+		Annotations: schema.Annotations{"workspaces": secatest.Workspace1Name},
+		Spec: schema.InstanceSpec{
+			SkuRef:     schema.Reference{Resource: secatest.InstanceSku1Ref, Region: secatest.Region1Name},
+			BootVolume: schema.VolumeReference{DeviceRef: schema.Reference{Resource: secatest.BlockStorage1Ref, Workspace: secatest.Workspace1Name}},
+			Zone:       secatest.ZoneA,
+		},
+	}
+
+	paths, err := WriteDir(t.TempDir(), []any{workspace, instance}, &ExportOptions{Workspace: "workspace-2", Region: secatest.Region2Name})
+	require.NoError(t, err)
+
+	resources, err := manifest.DecodeFile(paths[1])
+	require.NoError(t, err)
+	relocated := resources[0].(*schema.Instance)
+	assert.Equal(t, secatest.Workspace1Name, relocated.Metadata.Name)
+	assert.Equal(t, "workspace-2", relocated.Metadata.Workspace)
+	assert.Equal(t, secatest.Region2Name, relocated.Metadata.Region)
+	assert.Equal(t, secatest.Region2Name, relocated.Spec.SkuRef.Region)
+	assert.Equal(t, "workspace-2", relocated.Spec.BootVolume.DeviceRef.Workspace)
+	assert.Equal(t, schema.Labels{"workspace": secatest.Workspace1Name, "region": secatest.Region1Name}, relocated.Labels)
+	assert.Equal(t, schema.Annotations{"workspaces": secatest.Workspace1Name}, relocated.Annotations)
+
+	assert.Equal(t, secatest.Workspace1Name, instance.Metadata.Workspace, "the exported resource is left as it is")
+}
+
+func buildRoleAssignment(name, workspace string) schema.RoleAssignment {
+	return schema.RoleAssignment{
+		Metadata: secatest.NewGlobalTenantResourceMetadata(name, secatest.Tenant1Name),
+		Spec: schema.RoleAssignmentSpec{
+			Roles:  []string{secatest.Role1Name},
+			Subs:   []string{"user-1"},
+			Scopes: []schema.RoleAssignmentScope{{Workspaces: []string{workspace}, Regions: []string{secatest.Region1Name}}},
+		},
+	}
+}
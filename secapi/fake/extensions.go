@@ -0,0 +1,126 @@
+package fake
+
+import (
+	"net/http"
+
+	activitylog "github.com/eu-sovereign-cloud/go-sdk/pkg/spec/extensions.activitylog.v1beta1"
+	kubernetes "github.com/eu-sovereign-cloud/go-sdk/pkg/spec/extensions.kubernetes.v1beta1"
+	loadbalancer "github.com/eu-sovereign-cloud/go-sdk/pkg/spec/extensions.loadbalancer.v1beta1"
+	natgateway "github.com/eu-sovereign-cloud/go-sdk/pkg/spec/extensions.natgateway.v1beta1"
+	objectstorage "github.com/eu-sovereign-cloud/go-sdk/pkg/spec/extensions.objectstorage.v1beta1"
+	"github.com/eu-sovereign-cloud/go-sdk/pkg/spec/schema"
+)
+
+// Activity log, the logs are read only and can only be added with Seed
+
+type activityLogServer struct {
+	regional
+}
+
+func (as *activityLogServer) ListActivityLogs(w http.ResponseWriter, r *http.Request, tenant string, workspace string, params activitylog.ListActivityLogsParams) {
+	as.s.list(w, r, as.workspaceKey(activityLogType, tenant, workspace, ""))
+}
+
+// Kubernetes
+
+type kubernetesServer struct {
+	regional
+}
+
+func (ks *kubernetesServer) ListClusters(w http.ResponseWriter, r *http.Request, tenant string, workspace string, params kubernetes.ListClustersParams) {
+	ks.s.list(w, r, ks.workspaceKey(clusterType, tenant, workspace, ""))
+}
+
+func (ks *kubernetesServer) DeleteCluster(w http.ResponseWriter, r *http.Request, tenant string, workspace string, name string, params kubernetes.DeleteClusterParams) {
+	ks.s.delete(w, r, ks.workspaceKey(clusterType, tenant, workspace, name))
+}
+
+func (ks *kubernetesServer) GetCluster(w http.ResponseWriter, r *http.Request, tenant string, workspace string, name string) {
+	ks.s.get(w, r, ks.workspaceKey(clusterType, tenant, workspace, name))
+}
+
+func (ks *kubernetesServer) CreateOrUpdateCluster(w http.ResponseWriter, r *http.Request, tenant string, workspace string, name string, params kubernetes.CreateOrUpdateClusterParams) {
+	ks.s.put(w, r, ks.workspaceKey(clusterType, tenant, workspace, name), &schema.KubernetesCluster{})
+}
+
+func (ks *kubernetesServer) ListNodePools(w http.ResponseWriter, r *http.Request, tenant string, workspace string, cluster string, params kubernetes.ListNodePoolsParams) {
+	ks.s.list(w, r, ks.nestedKey(nodePoolType, tenant, workspace, cluster, ""))
+}
+
+func (ks *kubernetesServer) DeleteNodePool(w http.ResponseWriter, r *http.Request, tenant string, workspace string, cluster string, name string, params kubernetes.DeleteNodePoolParams) {
+	ks.s.delete(w, r, ks.nestedKey(nodePoolType, tenant, workspace, cluster, name))
+}
+
+func (ks *kubernetesServer) GetNodePool(w http.ResponseWriter, r *http.Request, tenant string, workspace string, cluster string, name string) {
+	ks.s.get(w, r, ks.nestedKey(nodePoolType, tenant, workspace, cluster, name))
+}
+
+func (ks *kubernetesServer) CreateOrUpdateNodePool(w http.ResponseWriter, r *http.Request, tenant string, workspace string, cluster string, name string, params kubernetes.CreateOrUpdateNodePoolParams) {
+	ks.s.put(w, r, ks.nestedKey(nodePoolType, tenant, workspace, cluster, name), &schema.KubernetesNodePool{})
+}
+
+// Load balancer
+
+type loadBalancerServer struct {
+	regional
+}
+
+func (ls *loadBalancerServer) ListNetworkLoadBalancers(w http.ResponseWriter, r *http.Request, tenant string, workspace string, params loadbalancer.ListNetworkLoadBalancersParams) {
+	ls.s.list(w, r, ls.workspaceKey(networkLoadBalancerType, tenant, workspace, ""))
+}
+
+func (ls *loadBalancerServer) DeleteNetworkLoadBalancer(w http.ResponseWriter, r *http.Request, tenant string, workspace string, name string, params loadbalancer.DeleteNetworkLoadBalancerParams) {
+	ls.s.delete(w, r, ls.workspaceKey(networkLoadBalancerType, tenant, workspace, name))
+}
+
+func (ls *loadBalancerServer) GetNetworkLoadBalancer(w http.ResponseWriter, r *http.Request, tenant string, workspace string, name string) {
+	ls.s.get(w, r, ls.workspaceKey(networkLoadBalancerType, tenant, workspace, name))
+}
+
+func (ls *loadBalancerServer) CreateOrUpdateNetworkLoadBalancer(w http.ResponseWriter, r *http.Request, tenant string, workspace string, name string, params loadbalancer.CreateOrUpdateNetworkLoadBalancerParams) {
+	ls.s.put(w, r, ls.workspaceKey(networkLoadBalancerType, tenant, workspace, name), &schema.NetworkLoadBalancer{})
+}
+
+// NAT gateway
+
+type natGatewayServer struct {
+	regional
+}
+
+func (ns *natGatewayServer) ListInternetNatGatewayInstances(w http.ResponseWriter, r *http.Request, tenant string, workspace string, params natgateway.ListInternetNatGatewayInstancesParams) {
+	ns.s.list(w, r, ns.workspaceKey(natGatewayType, tenant, workspace, ""))
+}
+
+func (ns *natGatewayServer) DeleteInternetNatGatewayInstance(w http.ResponseWriter, r *http.Request, tenant string, workspace string, name string, params natgateway.DeleteInternetNatGatewayInstanceParams) {
+	ns.s.delete(w, r, ns.workspaceKey(natGatewayType, tenant, workspace, name))
+}
+
+func (ns *natGatewayServer) GetInternetNatGatewayInstance(w http.ResponseWriter, r *http.Request, tenant string, workspace string, name string) {
+	ns.s.get(w, r, ns.workspaceKey(natGatewayType, tenant, workspace, name))
+}
+
+func (ns *natGatewayServer) CreateOrUpdateInternetNatGatewayInstance(w http.ResponseWriter, r *http.Request, tenant string, workspace string, name string, params natgateway.CreateOrUpdateInternetNatGatewayInstanceParams) {
+	ns.s.put(w, r, ns.workspaceKey(natGatewayType, tenant, workspace, name), &schema.InternetNatGatewayInstance{})
+}
+
+// Object storage
+
+type objectStorageServer struct {
+	regional
+}
+
+func (os *objectStorageServer) ListAccounts(w http.ResponseWriter, r *http.Request, tenant string, workspace string, params objectstorage.ListAccountsParams) {
+	os.s.list(w, r, os.workspaceKey(objectStorageAccountType, tenant, workspace, ""))
+}
+
+func (os *objectStorageServer) DeleteAccount(w http.ResponseWriter, r *http.Request, tenant string, workspace string, name string, params objectstorage.DeleteAccountParams) {
+	os.s.delete(w, r, os.workspaceKey(objectStorageAccountType, tenant, workspace, name))
+}
+
+func (os *objectStorageServer) GetAccount(w http.ResponseWriter, r *http.Request, tenant string, workspace string, name string) {
+	os.s.get(w, r, os.workspaceKey(objectStorageAccountType, tenant, workspace, name))
+}
+
+func (os *objectStorageServer) CreateOrUpdateAccount(w http.ResponseWriter, r *http.Request, tenant string, workspace string, name string, params objectstorage.CreateOrUpdateAccountParams) {
+	os.s.put(w, r, os.workspaceKey(objectStorageAccountType, tenant, workspace, name), &schema.ObjectStorageAccount{})
+}
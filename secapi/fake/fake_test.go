@@ -0,0 +1,226 @@
+package fake
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/eu-sovereign-cloud/go-sdk/internal/secatest"
+	"github.com/eu-sovereign-cloud/go-sdk/internal/secatest/clients"
+	compute "github.com/eu-sovereign-cloud/go-sdk/pkg/spec/foundation.compute.v1"
+	"github.com/eu-sovereign-cloud/go-sdk/pkg/spec/schema"
+	"github.com/eu-sovereign-cloud/go-sdk/secapi"
+	"github.com/eu-sovereign-cloud/go-sdk/secapi/builders"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestServer_InstanceLifecycle(t *testing.T) {
+	ctx := context.Background()
+	clock := newTestClock()
+
+	fake := NewServer(&Config{
+		Schedule: Schedule{Creating: time.Minute, Updating: time.Minute, Deleting: time.Minute, PowerChange: time.Minute},
+		Now:      clock.Now,
+	})
+	require.NoError(t, fake.Seed(DefaultRegion, &schema.InstanceSku{
+		Metadata: secatest.NewSkuResourceMetadata(secatest.InstanceSku1Name, "tenant-2"),
+		Spec:     &schema.InstanceSkuSpec{Ram: secatest.InstanceSku1RAM, VCPU: secatest.InstanceSku1VCPU},
+	}))
+
+	server := httptest.NewServer(fake.Handler())
+	defer server.Close()
+
+	_, regional := clients.New(t, ctx, secapi.GlobalConfig{Endpoints: fake.Endpoints(server.URL)})
+
+	sku, err := regional.ComputeV1.GetSku(ctx, secapi.TenantReference{Tenant: secatest.Tenant1Name, Name: secatest.InstanceSku1Name})
+	require.NoError(t, err)
+	assert.Equal(t, secatest.InstanceSku1VCPU, sku.Spec.VCPU)
+	assert.Equal(t, secatest.Tenant1Name, sku.Metadata.Tenant)
+
+	// Create
+	created, err := regional.ComputeV1.CreateOrUpdateInstance(ctx, secatest.NewInstance(secatest.Instance1Name, secatest.ZoneA))
+	require.NoError(t, err)
+	assert.Equal(t, int64(1), created.Metadata.ResourceVersion)
+	assert.Equal(t, DefaultRegion, created.Metadata.Region)
+	assert.Equal(t, schema.ResourceStateCreating, created.Status.State)
+
+	wref := secapi.WorkspaceReference{Tenant: secatest.Tenant1Name, Workspace: secatest.Workspace1Name, Name: secatest.Instance1Name}
+
+	clock.Advance(time.Minute)
+	instance, err := regional.ComputeV1.GetInstance(ctx, wref)
+	require.NoError(t, err)
+	assert.Equal(t, schema.ResourceStateActive, instance.Status.State)
+	assert.Equal(t, schema.InstanceStatusPowerStateOn, instance.Status.PowerState)
+
+	// Power
+	require.NoError(t, regional.ComputeV1.StopInstance(ctx, instance))
+	clock.Advance(time.Minute)
+	instance, err = regional.ComputeV1.GetInstance(ctx, wref)
+	require.NoError(t, err)
+	assert.Equal(t, schema.InstanceStatusPowerStateOff, instance.Status.PowerState)
+	assert.Equal(t, clock.Now(), instance.Status.PowerStateSince.UTC())
+
+	// Update
+	updated, err := regional.ComputeV1.CreateOrUpdateInstance(ctx, secatest.NewInstance(secatest.Instance1Name, "b"))
+	require.NoError(t, err)
+	assert.Equal(t, int64(2), updated.Metadata.ResourceVersion)
+	assert.Equal(t, schema.ResourceStateUpdating, updated.Status.State)
+	assert.Equal(t, "b", updated.Spec.Zone)
+
+	stale := 1
+	_, err = regional.ComputeV1.CreateOrUpdateInstanceWithParams(ctx, secatest.NewInstance(secatest.Instance1Name, "c"),
+		&compute.CreateOrUpdateInstanceParams{IfUnmodifiedSince: &stale})
+	assert.ErrorIs(t, err, secapi.ErrRequestPreconditionFailed)
+
+	// An action needs an active resource
+	err = regional.ComputeV1.StartInstance(ctx, updated)
+	assert.ErrorIs(t, err, secapi.ErrConflictingRequest)
+
+	// Delete
+	clock.Advance(time.Minute)
+	require.NoError(t, regional.ComputeV1.DeleteInstance(ctx, updated))
+
+	instance, err = regional.ComputeV1.GetInstance(ctx, wref)
+	require.NoError(t, err)
+	assert.Equal(t, schema.ResourceStateDeleting, instance.Status.State)
+
+	clock.Advance(time.Minute)
+	_, err = regional.ComputeV1.GetInstance(ctx, wref)
+	assert.ErrorIs(t, err, secapi.ErrResourceNotFound)
+
+	// The deleted resources are only listed on demand
+	listURL := server.URL + regionalProviderPath(DefaultRegion, "seca.compute/v1") + "/v1/tenants/tenant-1/workspaces/workspace-1/instances"
+	assert.Empty(t, listRaw(t, listURL, "application/json"))
+	assert.Len(t, listRaw(t, listURL, "application/json; deleted=only"), 1)
+	assert.Len(t, listRaw(t, listURL, "application/json; deleted=true"), 1)
+}
+
+func TestServer_ListNetworks(t *testing.T) {
+	ctx := context.Background()
+
+	fake := NewServer(nil)
+	server := httptest.NewServer(fake.Handler())
+	defer server.Close()
+
+	_, regional := clients.New(t, ctx, secapi.GlobalConfig{Endpoints: fake.Endpoints(server.URL)})
+
+	for _, item := range []struct {
+		name string
+		env  string
+	}{{"network-1", "prod"}, {"network-2", "dev"}, {"network-3", "prod"}, {"network-4", "prod"}} {
+		_, err := regional.NetworkV1.CreateOrUpdateNetwork(ctx, &schema.Network{
+			Metadata: secatest.NewRegionalWorkspaceResourceMetadata(item.name, secatest.Tenant1Name, secatest.Workspace1Name, DefaultRegion),
+			Labels:   schema.Labels{secatest.LabelEnvKey: item.env},
+			Spec:     schema.NetworkSpec{Cidr: schema.Cidr{Ipv4: secatest.CidrIpv4}, SkuRef: schema.Reference{Resource: secatest.NetworkSku1Ref}},
+		})
+		require.NoError(t, err)
+	}
+
+	wpath := secapi.WorkspacePath{Tenant: secatest.Tenant1Name, Workspace: secatest.Workspace1Name}
+
+	// Paging
+	iter, err := regional.NetworkV1.ListNetworksWithOptions(ctx, wpath, secapi.NewListOptions().WithLimit(3))
+	require.NoError(t, err)
+
+	networks, err := iter.All(ctx)
+	require.NoError(t, err)
+	require.Len(t, networks, 4)
+	assert.Equal(t, "network-1", networks[0].Metadata.Name)
+	assert.Equal(t, "network-4", networks[3].Metadata.Name)
+
+	// Labels
+	iter, err = regional.NetworkV1.ListNetworksWithOptions(ctx, wpath,
+		secapi.NewListOptions().WithLabels(builders.NewLabelsBuilder().Equals(secatest.LabelEnvKey, "prod")))
+	require.NoError(t, err)
+
+	networks, err = iter.All(ctx)
+	require.NoError(t, err)
+	require.Len(t, networks, 3)
+	assert.Equal(t, "network-3", networks[1].Metadata.Name)
+}
+
+func TestServer_Middlewares(t *testing.T) {
+	ctx := context.Background()
+
+	var operations []string
+	var mu sync.Mutex
+	fake := NewServer(&Config{
+		Middlewares: []Middleware{func(next http.Handler) http.Handler {
+			return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				mu.Lock()
+				operations = append(operations, OperationID(r))
+				mu.Unlock()
+
+				next.ServeHTTP(w, r)
+			})
+		}},
+	})
+	server := httptest.NewServer(fake.Handler())
+	defer server.Close()
+
+	_, regional := clients.New(t, ctx, secapi.GlobalConfig{Endpoints: fake.Endpoints(server.URL)})
+
+	_, err := regional.WorkspaceV1.GetWorkspace(ctx, secapi.TenantReference{Tenant: secatest.Tenant1Name, Name: secatest.Workspace1Name})
+	assert.ErrorIs(t, err, secapi.ErrResourceNotFound)
+
+	mu.Lock()
+	defer mu.Unlock()
+	assert.Equal(t, []string{"GetRegion", "GetWorkspace"}, operations)
+}
+
+func TestServer_Seed(t *testing.T) {
+	fake := NewServer(nil)
+
+	err := fake.Seed(secatest.Region2Name, &schema.Workspace{})
+	assert.ErrorIs(t, err, ErrUnknownRegion)
+
+	err = fake.Seed(DefaultRegion, schema.Workspace{})
+	assert.ErrorIs(t, err, ErrUnsupportedResource)
+
+	err = fake.Seed(DefaultRegion, &schema.Workspace{})
+	assert.ErrorIs(t, err, secapi.ErrNoMetadata)
+}
+
+type testClock struct {
+	mu  sync.Mutex
+	now time.Time
+}
+
+func newTestClock() *testClock {
+	return &testClock{now: time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)}
+}
+
+func (c *testClock) Now() time.Time {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return c.now
+}
+
+func (c *testClock) Advance(d time.Duration) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.now = c.now.Add(d)
+}
+
+func listRaw(t *testing.T, url, accept string) []any {
+	req, err := http.NewRequest(http.MethodGet, url, nil)
+	require.NoError(t, err)
+	req.Header.Set("Accept", accept)
+
+	resp, err := http.DefaultClient.Do(req)
+	require.NoError(t, err)
+	defer resp.Body.Close()
+	require.Equal(t, http.StatusOK, resp.StatusCode)
+
+	var body struct {
+		Items []any `json:"items"`
+	}
+	require.NoError(t, json.NewDecoder(resp.Body).Decode(&body))
+	return body.Items
+}
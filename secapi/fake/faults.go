@@ -0,0 +1,253 @@
+package fake
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/eu-sovereign-cloud/go-sdk/pkg/spec/schema"
+)
+
+// Error types of the responses without a matching schema.ErrorType
+const (
+	errorTypeTooManyRequests    schema.ErrorType = "http://secapi.cloud/errors/too-many-requests"
+	errorTypeServiceUnavailable schema.ErrorType = "http://secapi.cloud/errors/service-unavailable"
+)
+
+// Fault describes a misbehavior of the providers, it is applied to the requests
+// matching both its operation and its resource name.
+type Fault struct {
+	// Operation ID of the matched requests, as GetInstance, empty matches every operation.
+	Operation string
+
+	// Name of the matched resources, it may contain * wildcards, empty matches every resource.
+	Name string
+
+	// Latency added before handling the request.
+	Latency time.Duration
+
+	// Status of the error returned instead of handling the request, as 429, 500 or 503.
+	Status int
+
+	// RetryAfter is returned in the Retry-After header of the error responses.
+	RetryAfter time.Duration
+
+	// DropConnection closes the connection without any response.
+	DropConnection bool
+
+	// StaleVersion rejects the request with a failed precondition, as if the resource was modified
+	// concurrently, the same way the store rejects a stale If-Unmodified-Since version.
+	StaleVersion bool
+
+	// StuckInError reports the resources in the error state, whatever their actual state.
+	StuckInError bool
+
+	// Times the fault is applied, zero applies it forever.
+	Times int
+}
+
+// FaultInjector applies faults to the requests, the faults are checked in order
+// and only the first matching one is applied.
+type FaultInjector struct {
+	mu     sync.Mutex
+	faults []*Fault
+}
+
+// NewFaultInjector creates an injector applying the given faults.
+func NewFaultInjector(faults ...Fault) *FaultInjector {
+	fi := &FaultInjector{}
+	for _, fault := range faults {
+		fi.Add(fault)
+	}
+	return fi
+}
+
+// Add appends a fault, it is checked after the existing ones.
+func (fi *FaultInjector) Add(fault Fault) {
+	fi.mu.Lock()
+	defer fi.mu.Unlock()
+
+	fi.faults = append(fi.faults, &fault)
+}
+
+// Reset removes every fault.
+func (fi *FaultInjector) Reset() {
+	fi.mu.Lock()
+	defer fi.mu.Unlock()
+
+	fi.faults = nil
+}
+
+// Middleware returns the middleware applying the faults, to be added to Config.Middlewares.
+// The faults selecting an operation only match the requests served by a Server.
+func (fi *FaultInjector) Middleware() Middleware {
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			fault, found := fi.match(r)
+			if !found {
+				next.ServeHTTP(w, r)
+				return
+			}
+
+			fi.apply(fault, next, w, r)
+		})
+	}
+}
+
+// Returns a copy of the first matching fault, consuming one of its times
+func (fi *FaultInjector) match(r *http.Request) (Fault, bool) {
+	fi.mu.Lock()
+	defer fi.mu.Unlock()
+
+	operation := OperationID(r)
+	name := r.PathValue("name")
+
+	for i, fault := range fi.faults {
+		if fault.Operation != "" && fault.Operation != operation {
+			continue
+		}
+		if fault.Name != "" && !matchValue(fault.Name, name) {
+			// The listed resources are matched by name on the response
+			if !fault.StuckInError || name != "" {
+				continue
+			}
+		}
+
+		matched := *fault
+		if fault.Times > 0 {
+			fault.Times--
+			if fault.Times == 0 {
+				fi.faults = append(fi.faults[:i], fi.faults[i+1:]...)
+			}
+		}
+		return matched, true
+	}
+	return Fault{}, false
+}
+
+func (fi *FaultInjector) apply(fault Fault, next http.Handler, w http.ResponseWriter, r *http.Request) {
+	if fault.Latency > 0 {
+		timer := time.NewTimer(fault.Latency)
+		defer timer.Stop()
+
+		select {
+		case <-timer.C:
+		case <-r.Context().Done():
+			return
+		}
+	}
+
+	if fault.DropConnection {
+		// Aborts the handler, the server closes the connection without logging
+		panic(http.ErrAbortHandler)
+	}
+
+	if fault.RetryAfter > 0 {
+		w.Header().Set("Retry-After", strconv.Itoa(int(fault.RetryAfter.Seconds())))
+	}
+
+	switch {
+	case fault.Status != 0:
+		writeError(w, r, fault.Status, faultErrorType(fault.Status), http.StatusText(fault.Status), "Injected fault")
+	case fault.StaleVersion:
+		writeError(w, r, http.StatusPreconditionFailed, schema.ErrorTypePreconditionFailed, "Resource was modified",
+			fmt.Sprintf("%s was modified concurrently", r.URL.Path))
+	case fault.StuckInError:
+		serveInErrorState(fault.Name, next, w, r)
+	default:
+		next.ServeHTTP(w, r)
+	}
+}
+
+func faultErrorType(status int) schema.ErrorType {
+	switch status {
+	case http.StatusTooManyRequests:
+		return errorTypeTooManyRequests
+	case http.StatusServiceUnavailable:
+		return errorTypeServiceUnavailable
+	case http.StatusConflict:
+		return schema.ErrorTypeResourceConflict
+	case http.StatusPreconditionFailed:
+		return schema.ErrorTypePreconditionFailed
+	case http.StatusNotFound:
+		return schema.ErrorTypeResourceNotFound
+	default:
+		return schema.ErrorTypeInternalServerError
+	}
+}
+
+// Serves the request, then moves the returned resources matching the name to the error state
+func serveInErrorState(name string, next http.Handler, w http.ResponseWriter, r *http.Request) {
+	rec := &recorder{header: http.Header{}, status: http.StatusOK}
+	next.ServeHTTP(rec, r)
+
+	body := rec.body.Bytes()
+	if rec.status == http.StatusOK || rec.status == http.StatusCreated {
+		var doc map[string]any
+		if err := json.Unmarshal(body, &doc); err == nil {
+			if items, ok := doc["items"].([]any); ok {
+				for _, item := range items {
+					setErrorState(item, name)
+				}
+			} else {
+				setErrorState(doc, name)
+			}
+
+			if data, err := json.Marshal(doc); err == nil {
+				body = data
+			}
+		}
+	}
+
+	for field, values := range rec.header {
+		w.Header()[field] = values
+	}
+	w.Header().Del("Content-Length")
+	w.WriteHeader(rec.status)
+	_, _ = w.Write(body)
+}
+
+func setErrorState(value any, name string) {
+	doc, ok := value.(map[string]any)
+	if !ok {
+		return
+	}
+
+	if name != "" {
+		metadata, _ := doc["metadata"].(map[string]any)
+		resourceName, _ := metadata["name"].(string)
+		if !matchValue(name, resourceName) {
+			return
+		}
+	}
+
+	status, ok := doc["status"].(map[string]any)
+	if !ok {
+		status = map[string]any{}
+		doc["status"] = status
+	}
+	status["state"] = string(schema.ResourceStateError)
+}
+
+// Records a response to be rewritten
+type recorder struct {
+	header http.Header
+	status int
+	body   bytes.Buffer
+}
+
+func (rec *recorder) Header() http.Header {
+	return rec.header
+}
+
+func (rec *recorder) WriteHeader(status int) {
+	rec.status = status
+}
+
+func (rec *recorder) Write(data []byte) (int, error) {
+	return rec.body.Write(data)
+}
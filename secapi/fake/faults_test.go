@@ -0,0 +1,117 @@
+package fake
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/eu-sovereign-cloud/go-sdk/internal/secatest"
+	"github.com/eu-sovereign-cloud/go-sdk/internal/secatest/clients"
+	"github.com/eu-sovereign-cloud/go-sdk/pkg/spec/schema"
+	"github.com/eu-sovereign-cloud/go-sdk/secapi"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestFaultInjector(t *testing.T) {
+	ctx := context.Background()
+
+	faults := NewFaultInjector()
+	fake := NewServer(&Config{Middlewares: []Middleware{faults.Middleware()}})
+	server := httptest.NewServer(fake.Handler())
+	defer server.Close()
+
+	_, regional := clients.New(t, ctx, secapi.GlobalConfig{Endpoints: fake.Endpoints(server.URL)})
+
+	for _, name := range []string{"instance-1", "instance-2"} {
+		_, err := regional.ComputeV1.CreateOrUpdateInstance(ctx, secatest.NewInstance(name, secatest.ZoneA))
+		require.NoError(t, err)
+	}
+
+	wref1 := secapi.WorkspaceReference{Tenant: secatest.Tenant1Name, Workspace: secatest.Workspace1Name, Name: "instance-1"}
+	wref2 := secapi.WorkspaceReference{Tenant: secatest.Tenant1Name, Workspace: secatest.Workspace1Name, Name: "instance-2"}
+
+	t.Run("status", func(t *testing.T) {
+		faults.Reset()
+		faults.Add(Fault{Operation: "GetInstance", Name: "instance-1", Status: http.StatusServiceUnavailable, Times: 1})
+
+		_, err := regional.ComputeV1.GetInstance(ctx, wref2)
+		require.NoError(t, err)
+
+		_, err = regional.ComputeV1.GetInstance(ctx, wref1)
+		assert.ErrorIs(t, err, secapi.ErrUnknowError)
+
+		// The fault is consumed
+		_, err = regional.ComputeV1.GetInstance(ctx, wref1)
+		require.NoError(t, err)
+	})
+
+	t.Run("error body", func(t *testing.T) {
+		faults.Reset()
+		faults.Add(Fault{Status: http.StatusTooManyRequests, RetryAfter: 2 * time.Second})
+
+		resp, err := http.Get(server.URL + providerPath("seca.region/v1") + "/v1/regions")
+		require.NoError(t, err)
+		defer resp.Body.Close()
+
+		assert.Equal(t, http.StatusTooManyRequests, resp.StatusCode)
+		assert.Equal(t, "2", resp.Header.Get("Retry-After"))
+
+		var body schema.Error
+		require.NoError(t, json.NewDecoder(resp.Body).Decode(&body))
+		assert.Equal(t, float32(http.StatusTooManyRequests), body.Status)
+		assert.Equal(t, string(errorTypeTooManyRequests), body.Type)
+	})
+
+	t.Run("latency", func(t *testing.T) {
+		faults.Reset()
+		faults.Add(Fault{Operation: "GetInstance", Latency: 50 * time.Millisecond})
+
+		start := time.Now()
+		_, err := regional.ComputeV1.GetInstance(ctx, wref1)
+		require.NoError(t, err)
+		assert.GreaterOrEqual(t, time.Since(start), 50*time.Millisecond)
+	})
+
+	t.Run("dropped connection", func(t *testing.T) {
+		faults.Reset()
+		faults.Add(Fault{Operation: "GetInstance", DropConnection: true})
+
+		_, err := regional.ComputeV1.GetInstance(ctx, wref1)
+		assert.Error(t, err)
+	})
+
+	t.Run("stale version", func(t *testing.T) {
+		faults.Reset()
+		faults.Add(Fault{Operation: "CreateOrUpdateInstance", StaleVersion: true})
+
+		_, err := regional.ComputeV1.CreateOrUpdateInstance(ctx, secatest.NewInstance("instance-1", "b"))
+		assert.ErrorIs(t, err, secapi.ErrRequestPreconditionFailed)
+	})
+
+	t.Run("stuck in error", func(t *testing.T) {
+		faults.Reset()
+		faults.Add(Fault{Name: "instance-1", StuckInError: true})
+
+		instance, err := regional.ComputeV1.GetInstance(ctx, wref1)
+		require.NoError(t, err)
+		assert.Equal(t, schema.ResourceStateError, instance.Status.State)
+
+		instance, err = regional.ComputeV1.GetInstance(ctx, wref2)
+		require.NoError(t, err)
+		assert.Equal(t, schema.ResourceStateActive, instance.Status.State)
+
+		iter, err := regional.ComputeV1.ListInstances(ctx, secapi.WorkspacePath{Tenant: secatest.Tenant1Name, Workspace: secatest.Workspace1Name})
+		require.NoError(t, err)
+
+		instances, err := iter.All(ctx)
+		require.NoError(t, err)
+		require.Len(t, instances, 2)
+		assert.Equal(t, schema.ResourceStateError, instances[0].Status.State)
+		assert.Equal(t, schema.ResourceStateActive, instances[1].Status.State)
+	})
+}
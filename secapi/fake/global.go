@@ -0,0 +1,84 @@
+package fake
+
+import (
+	"net/http"
+
+	"github.com/eu-sovereign-cloud/go-sdk/pkg/constants"
+	authorization "github.com/eu-sovereign-cloud/go-sdk/pkg/spec/foundation.authorization.v1"
+	region "github.com/eu-sovereign-cloud/go-sdk/pkg/spec/foundation.region.v1"
+	"github.com/eu-sovereign-cloud/go-sdk/pkg/spec/schema"
+)
+
+// Wellknown
+
+type wellknownServer struct {
+	s *Server
+}
+
+func (ws *wellknownServer) GetWellknown(w http.ResponseWriter, r *http.Request) {
+	scheme := "http"
+	if r.TLS != nil {
+		scheme = "https"
+	}
+	base := scheme + "://" + r.Host
+
+	writeJSON(w, http.StatusOK, schema.Wellknown{
+		Version: constants.ApiVersion1,
+		Endpoints: []schema.WellknownEndpoint{
+			{Provider: constants.RegionProviderV1Name, Url: base + providerPath(constants.RegionProviderV1Name)},
+			{Provider: constants.AuthorizationProviderV1Name, Url: base + providerPath(constants.AuthorizationProviderV1Name)},
+		},
+	})
+}
+
+// Region
+
+type regionServer struct {
+	s *Server
+}
+
+func (rs *regionServer) ListRegions(w http.ResponseWriter, r *http.Request, params region.ListRegionsParams) {
+	rs.s.list(w, r, key{typ: regionType})
+}
+
+func (rs *regionServer) GetRegion(w http.ResponseWriter, r *http.Request, name string) {
+	rs.s.get(w, r, key{typ: regionType, name: name})
+}
+
+// Authorization
+
+type authorizationServer struct {
+	s *Server
+}
+
+func (as *authorizationServer) ListRoleAssignments(w http.ResponseWriter, r *http.Request, tenant string, params authorization.ListRoleAssignmentsParams) {
+	as.s.list(w, r, key{typ: roleAssignmentType, tenant: tenant})
+}
+
+func (as *authorizationServer) DeleteRoleAssignment(w http.ResponseWriter, r *http.Request, tenant string, name string, params authorization.DeleteRoleAssignmentParams) {
+	as.s.delete(w, r, key{typ: roleAssignmentType, tenant: tenant, name: name})
+}
+
+func (as *authorizationServer) GetRoleAssignment(w http.ResponseWriter, r *http.Request, tenant string, name string) {
+	as.s.get(w, r, key{typ: roleAssignmentType, tenant: tenant, name: name})
+}
+
+func (as *authorizationServer) CreateOrUpdateRoleAssignment(w http.ResponseWriter, r *http.Request, tenant string, name string, params authorization.CreateOrUpdateRoleAssignmentParams) {
+	as.s.put(w, r, key{typ: roleAssignmentType, tenant: tenant, name: name}, &schema.RoleAssignment{})
+}
+
+func (as *authorizationServer) ListRoles(w http.ResponseWriter, r *http.Request, tenant string, params authorization.ListRolesParams) {
+	as.s.list(w, r, key{typ: roleType, tenant: tenant})
+}
+
+func (as *authorizationServer) DeleteRole(w http.ResponseWriter, r *http.Request, tenant string, name string, params authorization.DeleteRoleParams) {
+	as.s.delete(w, r, key{typ: roleType, tenant: tenant, name: name})
+}
+
+func (as *authorizationServer) GetRole(w http.ResponseWriter, r *http.Request, tenant string, name string) {
+	as.s.get(w, r, key{typ: roleType, tenant: tenant, name: name})
+}
+
+func (as *authorizationServer) CreateOrUpdateRole(w http.ResponseWriter, r *http.Request, tenant string, name string, params authorization.CreateOrUpdateRoleParams) {
+	as.s.put(w, r, key{typ: roleType, tenant: tenant, name: name}, &schema.Role{})
+}
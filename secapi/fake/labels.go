@@ -0,0 +1,128 @@
+package fake
+
+import (
+	"fmt"
+	"path"
+	"strconv"
+	"strings"
+)
+
+// Operators of the label selector, the longest ones first as they are matched in order
+var selectorOperators = []string{"!=", ">=", "<=", "=", ">", "<"}
+
+type requirement struct {
+	key      string
+	operator string
+	value    string
+}
+
+type selector []requirement
+
+// Parses a label selector as built by the builders.LabelsBuilder, as env=prod,tier!=web,size>=3.
+// Equality keys and values may contain * wildcards.
+func parseSelector(value string) (selector, error) {
+	if value == "" {
+		return nil, nil
+	}
+
+	var sel selector
+	for _, item := range strings.Split(value, ",") {
+		item = strings.TrimSpace(item)
+		if item == "" {
+			continue
+		}
+
+		req, err := parseRequirement(item)
+		if err != nil {
+			return nil, err
+		}
+		sel = append(sel, req)
+	}
+	return sel, nil
+}
+
+func parseRequirement(item string) (requirement, error) {
+	for _, operator := range selectorOperators {
+		before, after, found := strings.Cut(item, operator)
+		if !found {
+			continue
+		}
+		if before == "" {
+			return requirement{}, fmt.Errorf("missing key in label requirement %q", item)
+		}
+
+		req := requirement{key: before, operator: operator, value: after}
+		if isNumericOperator(operator) {
+			if _, err := strconv.Atoi(after); err != nil {
+				return requirement{}, fmt.Errorf("non numeric value in label requirement %q", item)
+			}
+		}
+		return req, nil
+	}
+
+	return requirement{}, fmt.Errorf("missing operator in label requirement %q", item)
+}
+
+func isNumericOperator(operator string) bool {
+	return operator != "=" && operator != "!="
+}
+
+func (sel selector) matches(labels map[string]any) bool {
+	for _, req := range sel {
+		if !req.matches(labels) {
+			return false
+		}
+	}
+	return true
+}
+
+func (req requirement) matches(labels map[string]any) bool {
+	switch req.operator {
+	case "=":
+		return req.matchesAny(labels)
+	case "!=":
+		return !req.matchesAny(labels)
+	}
+
+	value, found := labels[req.key].(string)
+
+	if !found {
+		return false
+	}
+	actual, err := strconv.Atoi(value)
+	if err != nil {
+		return false
+	}
+	expected, _ := strconv.Atoi(req.value)
+
+	switch req.operator {
+	case ">":
+		return actual > expected
+	case "<":
+		return actual < expected
+	case ">=":
+		return actual >= expected
+	default:
+		return actual <= expected
+	}
+}
+
+// Whether a label matches both the key and the value of the requirement
+func (req requirement) matchesAny(labels map[string]any) bool {
+	for key, item := range labels {
+		value, ok := item.(string)
+		if ok && matchValue(req.key, key) && matchValue(req.value, value) {
+			return true
+		}
+	}
+	return false
+}
+
+func matchValue(pattern, value string) bool {
+	if !strings.Contains(pattern, "*") {
+		return pattern == value
+	}
+
+	matched, err := path.Match(pattern, value)
+	return err == nil && matched
+}
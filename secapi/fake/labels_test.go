@@ -0,0 +1,45 @@
+package fake
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSelector(t *testing.T) {
+	labels := map[string]any{"env": "production", "tier": "web", "size": "3", "billing:team": "platform"}
+
+	for _, test := range []struct {
+		selector string
+		matches  bool
+	}{
+		{"", true},
+		{"env=production", true},
+		{"env=staging", false},
+		{"env=prod*", true},
+		{"*env*=*duct*", true},
+		{"env!=staging", true},
+		{"tier!=web", false},
+		{"missing!=web", true},
+		{"size>2", true},
+		{"size<3", false},
+		{"size>=3,size<=3", true},
+		{"env=production,tier=db", false},
+		{"billing:team=platform", true},
+		{"missing>1", false},
+	} {
+		t.Run(test.selector, func(t *testing.T) {
+			sel, err := parseSelector(test.selector)
+			require.NoError(t, err)
+			assert.Equal(t, test.matches, sel.matches(labels))
+		})
+	}
+}
+
+func TestSelector_Errors(t *testing.T) {
+	for _, value := range []string{"env", "=prod", "size>big"} {
+		_, err := parseSelector(value)
+		assert.Error(t, err, value)
+	}
+}
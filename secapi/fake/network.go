@@ -0,0 +1,148 @@
+package fake
+
+import (
+	"net/http"
+
+	network "github.com/eu-sovereign-cloud/go-sdk/pkg/spec/foundation.network.v1"
+	"github.com/eu-sovereign-cloud/go-sdk/pkg/spec/schema"
+)
+
+type networkServer struct {
+	regional
+}
+
+func (ns *networkServer) ListSkus(w http.ResponseWriter, r *http.Request, tenant string, params network.ListSkusParams) {
+	ns.s.list(w, r, ns.tenantKey(networkSkuType, tenant, ""))
+}
+
+func (ns *networkServer) GetSku(w http.ResponseWriter, r *http.Request, tenant string, name string) {
+	ns.s.get(w, r, ns.tenantKey(networkSkuType, tenant, name))
+}
+
+func (ns *networkServer) ListInternetGateways(w http.ResponseWriter, r *http.Request, tenant string, workspace string, params network.ListInternetGatewaysParams) {
+	ns.s.list(w, r, ns.workspaceKey(internetGatewayType, tenant, workspace, ""))
+}
+
+func (ns *networkServer) DeleteInternetGateway(w http.ResponseWriter, r *http.Request, tenant string, workspace string, name string, params network.DeleteInternetGatewayParams) {
+	ns.s.delete(w, r, ns.workspaceKey(internetGatewayType, tenant, workspace, name))
+}
+
+func (ns *networkServer) GetInternetGateway(w http.ResponseWriter, r *http.Request, tenant string, workspace string, name string) {
+	ns.s.get(w, r, ns.workspaceKey(internetGatewayType, tenant, workspace, name))
+}
+
+func (ns *networkServer) CreateOrUpdateInternetGateway(w http.ResponseWriter, r *http.Request, tenant string, workspace string, name string, params network.CreateOrUpdateInternetGatewayParams) {
+	ns.s.put(w, r, ns.workspaceKey(internetGatewayType, tenant, workspace, name), &schema.InternetGateway{})
+}
+
+func (ns *networkServer) ListNetworks(w http.ResponseWriter, r *http.Request, tenant string, workspace string, params network.ListNetworksParams) {
+	ns.s.list(w, r, ns.workspaceKey(networkType, tenant, workspace, ""))
+}
+
+func (ns *networkServer) DeleteNetwork(w http.ResponseWriter, r *http.Request, tenant string, workspace string, name string, params network.DeleteNetworkParams) {
+	ns.s.delete(w, r, ns.workspaceKey(networkType, tenant, workspace, name))
+}
+
+func (ns *networkServer) GetNetwork(w http.ResponseWriter, r *http.Request, tenant string, workspace string, name string) {
+	ns.s.get(w, r, ns.workspaceKey(networkType, tenant, workspace, name))
+}
+
+func (ns *networkServer) CreateOrUpdateNetwork(w http.ResponseWriter, r *http.Request, tenant string, workspace string, name string, params network.CreateOrUpdateNetworkParams) {
+	ns.s.put(w, r, ns.workspaceKey(networkType, tenant, workspace, name), &schema.Network{})
+}
+
+func (ns *networkServer) ListNics(w http.ResponseWriter, r *http.Request, tenant string, workspace string, params network.ListNicsParams) {
+	ns.s.list(w, r, ns.workspaceKey(nicType, tenant, workspace, ""))
+}
+
+func (ns *networkServer) DeleteNic(w http.ResponseWriter, r *http.Request, tenant string, workspace string, name string, params network.DeleteNicParams) {
+	ns.s.delete(w, r, ns.workspaceKey(nicType, tenant, workspace, name))
+}
+
+func (ns *networkServer) GetNic(w http.ResponseWriter, r *http.Request, tenant string, workspace string, name string) {
+	ns.s.get(w, r, ns.workspaceKey(nicType, tenant, workspace, name))
+}
+
+func (ns *networkServer) CreateOrUpdateNic(w http.ResponseWriter, r *http.Request, tenant string, workspace string, name string, params network.CreateOrUpdateNicParams) {
+	ns.s.put(w, r, ns.workspaceKey(nicType, tenant, workspace, name), &schema.Nic{})
+}
+
+func (ns *networkServer) ListPublicIps(w http.ResponseWriter, r *http.Request, tenant string, workspace string, params network.ListPublicIpsParams) {
+	ns.s.list(w, r, ns.workspaceKey(publicIpType, tenant, workspace, ""))
+}
+
+func (ns *networkServer) DeletePublicIp(w http.ResponseWriter, r *http.Request, tenant string, workspace string, name string, params network.DeletePublicIpParams) {
+	ns.s.delete(w, r, ns.workspaceKey(publicIpType, tenant, workspace, name))
+}
+
+func (ns *networkServer) GetPublicIp(w http.ResponseWriter, r *http.Request, tenant string, workspace string, name string) {
+	ns.s.get(w, r, ns.workspaceKey(publicIpType, tenant, workspace, name))
+}
+
+func (ns *networkServer) CreateOrUpdatePublicIp(w http.ResponseWriter, r *http.Request, tenant string, workspace string, name string, params network.CreateOrUpdatePublicIpParams) {
+	ns.s.put(w, r, ns.workspaceKey(publicIpType, tenant, workspace, name), &schema.PublicIp{})
+}
+
+func (ns *networkServer) ListSecurityGroupRules(w http.ResponseWriter, r *http.Request, tenant string, workspace string, params network.ListSecurityGroupRulesParams) {
+	ns.s.list(w, r, ns.workspaceKey(securityGroupRuleType, tenant, workspace, ""))
+}
+
+func (ns *networkServer) DeleteSecurityGroupRule(w http.ResponseWriter, r *http.Request, tenant string, workspace string, name string, params network.DeleteSecurityGroupRuleParams) {
+	ns.s.delete(w, r, ns.workspaceKey(securityGroupRuleType, tenant, workspace, name))
+}
+
+func (ns *networkServer) GetSecurityGroupRule(w http.ResponseWriter, r *http.Request, tenant string, workspace string, name string) {
+	ns.s.get(w, r, ns.workspaceKey(securityGroupRuleType, tenant, workspace, name))
+}
+
+func (ns *networkServer) CreateOrUpdateSecurityGroupRule(w http.ResponseWriter, r *http.Request, tenant string, workspace string, name string, params network.CreateOrUpdateSecurityGroupRuleParams) {
+	ns.s.put(w, r, ns.workspaceKey(securityGroupRuleType, tenant, workspace, name), &schema.SecurityGroupRule{})
+}
+
+func (ns *networkServer) ListSecurityGroups(w http.ResponseWriter, r *http.Request, tenant string, workspace string, params network.ListSecurityGroupsParams) {
+	ns.s.list(w, r, ns.workspaceKey(securityGroupType, tenant, workspace, ""))
+}
+
+func (ns *networkServer) DeleteSecurityGroup(w http.ResponseWriter, r *http.Request, tenant string, workspace string, name string, params network.DeleteSecurityGroupParams) {
+	ns.s.delete(w, r, ns.workspaceKey(securityGroupType, tenant, workspace, name))
+}
+
+func (ns *networkServer) GetSecurityGroup(w http.ResponseWriter, r *http.Request, tenant string, workspace string, name string) {
+	ns.s.get(w, r, ns.workspaceKey(securityGroupType, tenant, workspace, name))
+}
+
+func (ns *networkServer) CreateOrUpdateSecurityGroup(w http.ResponseWriter, r *http.Request, tenant string, workspace string, name string, params network.CreateOrUpdateSecurityGroupParams) {
+	ns.s.put(w, r, ns.workspaceKey(securityGroupType, tenant, workspace, name), &schema.SecurityGroup{})
+}
+
+func (ns *networkServer) ListRouteTables(w http.ResponseWriter, r *http.Request, tenant string, workspace string, network string, params network.ListRouteTablesParams) {
+	ns.s.list(w, r, ns.nestedKey(routeTableType, tenant, workspace, network, ""))
+}
+
+func (ns *networkServer) DeleteRouteTable(w http.ResponseWriter, r *http.Request, tenant string, workspace string, network string, name string, params network.DeleteRouteTableParams) {
+	ns.s.delete(w, r, ns.nestedKey(routeTableType, tenant, workspace, network, name))
+}
+
+func (ns *networkServer) GetRouteTable(w http.ResponseWriter, r *http.Request, tenant string, workspace string, network string, name string) {
+	ns.s.get(w, r, ns.nestedKey(routeTableType, tenant, workspace, network, name))
+}
+
+func (ns *networkServer) CreateOrUpdateRouteTable(w http.ResponseWriter, r *http.Request, tenant string, workspace string, network string, name string, params network.CreateOrUpdateRouteTableParams) {
+	ns.s.put(w, r, ns.nestedKey(routeTableType, tenant, workspace, network, name), &schema.RouteTable{})
+}
+
+func (ns *networkServer) ListSubnets(w http.ResponseWriter, r *http.Request, tenant string, workspace string, network string, params network.ListSubnetsParams) {
+	ns.s.list(w, r, ns.nestedKey(subnetType, tenant, workspace, network, ""))
+}
+
+func (ns *networkServer) DeleteSubnet(w http.ResponseWriter, r *http.Request, tenant string, workspace string, network string, name string, params network.DeleteSubnetParams) {
+	ns.s.delete(w, r, ns.nestedKey(subnetType, tenant, workspace, network, name))
+}
+
+func (ns *networkServer) GetSubnet(w http.ResponseWriter, r *http.Request, tenant string, workspace string, network string, name string) {
+	ns.s.get(w, r, ns.nestedKey(subnetType, tenant, workspace, network, name))
+}
+
+func (ns *networkServer) CreateOrUpdateSubnet(w http.ResponseWriter, r *http.Request, tenant string, workspace string, network string, name string, params network.CreateOrUpdateSubnetParams) {
+	ns.s.put(w, r, ns.nestedKey(subnetType, tenant, workspace, network, name), &schema.Subnet{})
+}
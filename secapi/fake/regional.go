@@ -0,0 +1,226 @@
+package fake
+
+import (
+	"net/http"
+	"time"
+
+	"github.com/eu-sovereign-cloud/go-sdk/pkg/constants"
+	activitylog "github.com/eu-sovereign-cloud/go-sdk/pkg/spec/extensions.activitylog.v1beta1"
+	kubernetes "github.com/eu-sovereign-cloud/go-sdk/pkg/spec/extensions.kubernetes.v1beta1"
+	loadbalancer "github.com/eu-sovereign-cloud/go-sdk/pkg/spec/extensions.loadbalancer.v1beta1"
+	natgateway "github.com/eu-sovereign-cloud/go-sdk/pkg/spec/extensions.natgateway.v1beta1"
+	objectstorage "github.com/eu-sovereign-cloud/go-sdk/pkg/spec/extensions.objectstorage.v1beta1"
+	compute "github.com/eu-sovereign-cloud/go-sdk/pkg/spec/foundation.compute.v1"
+	network "github.com/eu-sovereign-cloud/go-sdk/pkg/spec/foundation.network.v1"
+	storage "github.com/eu-sovereign-cloud/go-sdk/pkg/spec/foundation.storage.v1"
+	workspace "github.com/eu-sovereign-cloud/go-sdk/pkg/spec/foundation.workspace.v1"
+	"github.com/eu-sovereign-cloud/go-sdk/pkg/spec/schema"
+)
+
+func (s *Server) registerRegion(name string) {
+	rg := regional{s: s, region: name}
+	baseURL := func(provider, version string) string {
+		return regionalProviderPath(name, provider+"/"+version)
+	}
+
+	workspace.HandlerWithOptions(&workspaceServer{rg}, workspace.StdHTTPServerOptions{
+		BaseURL:          baseURL(constants.WorkspaceProviderName, constants.ApiVersion1),
+		BaseRouter:       router{s.mux},
+		Middlewares:      middlewares[workspace.MiddlewareFunc](s.config.Middlewares),
+		ErrorHandlerFunc: s.errorHandler,
+	})
+
+	compute.HandlerWithOptions(&computeServer{rg}, compute.StdHTTPServerOptions{
+		BaseURL:          baseURL(constants.ComputeProviderName, constants.ApiVersion1),
+		BaseRouter:       router{s.mux},
+		Middlewares:      middlewares[compute.MiddlewareFunc](s.config.Middlewares),
+		ErrorHandlerFunc: s.errorHandler,
+	})
+
+	storage.HandlerWithOptions(&storageServer{rg}, storage.StdHTTPServerOptions{
+		BaseURL:          baseURL(constants.StorageProviderName, constants.ApiVersion1),
+		BaseRouter:       router{s.mux},
+		Middlewares:      middlewares[storage.MiddlewareFunc](s.config.Middlewares),
+		ErrorHandlerFunc: s.errorHandler,
+	})
+
+	network.HandlerWithOptions(&networkServer{rg}, network.StdHTTPServerOptions{
+		BaseURL:          baseURL(constants.NetworkProviderName, constants.ApiVersion1),
+		BaseRouter:       router{s.mux},
+		Middlewares:      middlewares[network.MiddlewareFunc](s.config.Middlewares),
+		ErrorHandlerFunc: s.errorHandler,
+	})
+
+	activitylog.HandlerWithOptions(&activityLogServer{rg}, activitylog.StdHTTPServerOptions{
+		BaseURL:          baseURL(activityLogProviderName, apiVersion1Beta1),
+		BaseRouter:       router{s.mux},
+		Middlewares:      middlewares[activitylog.MiddlewareFunc](s.config.Middlewares),
+		ErrorHandlerFunc: s.errorHandler,
+	})
+
+	kubernetes.HandlerWithOptions(&kubernetesServer{rg}, kubernetes.StdHTTPServerOptions{
+		BaseURL:          baseURL(kubernetesProviderName, apiVersion1Beta1),
+		BaseRouter:       router{s.mux},
+		Middlewares:      middlewares[kubernetes.MiddlewareFunc](s.config.Middlewares),
+		ErrorHandlerFunc: s.errorHandler,
+	})
+
+	loadbalancer.HandlerWithOptions(&loadBalancerServer{rg}, loadbalancer.StdHTTPServerOptions{
+		BaseURL:          baseURL(loadBalancerProviderName, apiVersion1Beta1),
+		BaseRouter:       router{s.mux},
+		Middlewares:      middlewares[loadbalancer.MiddlewareFunc](s.config.Middlewares),
+		ErrorHandlerFunc: s.errorHandler,
+	})
+
+	natgateway.HandlerWithOptions(&natGatewayServer{rg}, natgateway.StdHTTPServerOptions{
+		BaseURL:          baseURL(natGatewayProviderName, apiVersion1Beta1),
+		BaseRouter:       router{s.mux},
+		Middlewares:      middlewares[natgateway.MiddlewareFunc](s.config.Middlewares),
+		ErrorHandlerFunc: s.errorHandler,
+	})
+
+	objectstorage.HandlerWithOptions(&objectStorageServer{rg}, objectstorage.StdHTTPServerOptions{
+		BaseURL:          baseURL(objectStorageProviderName, apiVersion1Beta1),
+		BaseRouter:       router{s.mux},
+		Middlewares:      middlewares[objectstorage.MiddlewareFunc](s.config.Middlewares),
+		ErrorHandlerFunc: s.errorHandler,
+	})
+}
+
+// Base of the regional providers, it builds the keys of the resources in its region
+type regional struct {
+	s      *Server
+	region string
+}
+
+func (rg regional) tenantKey(typ *resourceType, tenant, name string) key {
+	if typ.shared {
+		tenant = ""
+	}
+	return key{typ: typ, region: rg.region, tenant: tenant, name: name}
+}
+
+func (rg regional) workspaceKey(typ *resourceType, tenant, workspace, name string) key {
+	return key{typ: typ, region: rg.region, tenant: tenant, workspace: workspace, name: name}
+}
+
+func (rg regional) nestedKey(typ *resourceType, tenant, workspace, parent, name string) key {
+	return key{typ: typ, region: rg.region, tenant: tenant, workspace: workspace, parent: parent, name: name}
+}
+
+// Workspace
+
+type workspaceServer struct {
+	regional
+}
+
+func (ws *workspaceServer) ListWorkspaces(w http.ResponseWriter, r *http.Request, tenant string, params workspace.ListWorkspacesParams) {
+	ws.s.list(w, r, ws.tenantKey(workspaceType, tenant, ""))
+}
+
+func (ws *workspaceServer) DeleteWorkspace(w http.ResponseWriter, r *http.Request, tenant string, name string, params workspace.DeleteWorkspaceParams) {
+	ws.s.delete(w, r, ws.tenantKey(workspaceType, tenant, name))
+}
+
+func (ws *workspaceServer) GetWorkspace(w http.ResponseWriter, r *http.Request, tenant string, name string) {
+	ws.s.get(w, r, ws.tenantKey(workspaceType, tenant, name))
+}
+
+func (ws *workspaceServer) CreateOrUpdateWorkspace(w http.ResponseWriter, r *http.Request, tenant string, name string, params workspace.CreateOrUpdateWorkspaceParams) {
+	ws.s.put(w, r, ws.tenantKey(workspaceType, tenant, name), &schema.Workspace{})
+}
+
+// Compute
+
+type computeServer struct {
+	regional
+}
+
+func (cs *computeServer) ListSkus(w http.ResponseWriter, r *http.Request, tenant string, params compute.ListSkusParams) {
+	cs.s.list(w, r, cs.tenantKey(instanceSkuType, tenant, ""))
+}
+
+func (cs *computeServer) GetSku(w http.ResponseWriter, r *http.Request, tenant string, name string) {
+	cs.s.get(w, r, cs.tenantKey(instanceSkuType, tenant, name))
+}
+
+func (cs *computeServer) ListInstances(w http.ResponseWriter, r *http.Request, tenant string, workspace string, params compute.ListInstancesParams) {
+	cs.s.list(w, r, cs.workspaceKey(instanceType, tenant, workspace, ""))
+}
+
+func (cs *computeServer) DeleteInstance(w http.ResponseWriter, r *http.Request, tenant string, workspace string, name string, params compute.DeleteInstanceParams) {
+	cs.s.delete(w, r, cs.workspaceKey(instanceType, tenant, workspace, name))
+}
+
+func (cs *computeServer) GetInstance(w http.ResponseWriter, r *http.Request, tenant string, workspace string, name string) {
+	cs.s.get(w, r, cs.workspaceKey(instanceType, tenant, workspace, name))
+}
+
+func (cs *computeServer) CreateOrUpdateInstance(w http.ResponseWriter, r *http.Request, tenant string, workspace string, name string, params compute.CreateOrUpdateInstanceParams) {
+	cs.s.put(w, r, cs.workspaceKey(instanceType, tenant, workspace, name), &schema.Instance{})
+}
+
+func (cs *computeServer) RestartInstance(w http.ResponseWriter, r *http.Request, tenant string, workspace string, name string, params compute.RestartInstanceParams) {
+	cs.powerAction(w, r, cs.workspaceKey(instanceType, tenant, workspace, name), schema.InstanceStatusPowerStateOn)
+}
+
+func (cs *computeServer) StartInstance(w http.ResponseWriter, r *http.Request, tenant string, workspace string, name string, params compute.StartInstanceParams) {
+	cs.powerAction(w, r, cs.workspaceKey(instanceType, tenant, workspace, name), schema.InstanceStatusPowerStateOn)
+}
+
+func (cs *computeServer) StopInstance(w http.ResponseWriter, r *http.Request, tenant string, workspace string, name string, params compute.StopInstanceParams) {
+	cs.powerAction(w, r, cs.workspaceKey(instanceType, tenant, workspace, name), schema.InstanceStatusPowerStateOff)
+}
+
+// Moves the instance to the power state once the power change delay has elapsed
+func (cs *computeServer) powerAction(w http.ResponseWriter, r *http.Request, k key, state schema.InstanceStatusPowerState) {
+	cs.s.action(w, r, k, cs.s.config.Schedule.PowerChange, func(e *entry, at time.Time) {
+		setPowerState(e.status, state, at)
+	})
+}
+
+// Storage
+
+type storageServer struct {
+	regional
+}
+
+func (ss *storageServer) ListImages(w http.ResponseWriter, r *http.Request, tenant string, params storage.ListImagesParams) {
+	ss.s.list(w, r, ss.tenantKey(imageType, tenant, ""))
+}
+
+func (ss *storageServer) DeleteImage(w http.ResponseWriter, r *http.Request, tenant string, name string, params storage.DeleteImageParams) {
+	ss.s.delete(w, r, ss.tenantKey(imageType, tenant, name))
+}
+
+func (ss *storageServer) GetImage(w http.ResponseWriter, r *http.Request, tenant string, name string) {
+	ss.s.get(w, r, ss.tenantKey(imageType, tenant, name))
+}
+
+func (ss *storageServer) CreateOrUpdateImage(w http.ResponseWriter, r *http.Request, tenant string, name string, params storage.CreateOrUpdateImageParams) {
+	ss.s.put(w, r, ss.tenantKey(imageType, tenant, name), &schema.Image{})
+}
+
+func (ss *storageServer) ListSkus(w http.ResponseWriter, r *http.Request, tenant string, params storage.ListSkusParams) {
+	ss.s.list(w, r, ss.tenantKey(storageSkuType, tenant, ""))
+}
+
+func (ss *storageServer) GetSku(w http.ResponseWriter, r *http.Request, tenant string, name string) {
+	ss.s.get(w, r, ss.tenantKey(storageSkuType, tenant, name))
+}
+
+func (ss *storageServer) ListBlockStorages(w http.ResponseWriter, r *http.Request, tenant string, workspace string, params storage.ListBlockStoragesParams) {
+	ss.s.list(w, r, ss.workspaceKey(blockStorageType, tenant, workspace, ""))
+}
+
+func (ss *storageServer) DeleteBlockStorage(w http.ResponseWriter, r *http.Request, tenant string, workspace string, name string, params storage.DeleteBlockStorageParams) {
+	ss.s.delete(w, r, ss.workspaceKey(blockStorageType, tenant, workspace, name))
+}
+
+func (ss *storageServer) GetBlockStorage(w http.ResponseWriter, r *http.Request, tenant string, workspace string, name string) {
+	ss.s.get(w, r, ss.workspaceKey(blockStorageType, tenant, workspace, name))
+}
+
+func (ss *storageServer) CreateOrUpdateBlockStorage(w http.ResponseWriter, r *http.Request, tenant string, workspace string, name string, params storage.CreateOrUpdateBlockStorageParams) {
+	ss.s.put(w, r, ss.workspaceKey(blockStorageType, tenant, workspace, name), &schema.BlockStorage{})
+}
@@ -0,0 +1,47 @@
+package fake
+
+import (
+	"context"
+	"net/http"
+	"reflect"
+	"runtime"
+	"strings"
+)
+
+type operationIDKey struct{}
+
+// OperationID returns the ID of the operation handling the request, as GetInstance,
+// it is available to the middlewares.
+func OperationID(r *http.Request) string {
+	id, _ := r.Context().Value(operationIDKey{}).(string)
+	return id
+}
+
+// Router registering the generated handlers on a mux, it stores the operation
+// ID of each handler in the request context
+type router struct {
+	*http.ServeMux
+}
+
+func (rt router) HandleFunc(pattern string, handler func(http.ResponseWriter, *http.Request)) {
+	id := operationName(handler)
+
+	rt.ServeMux.HandleFunc(pattern, func(w http.ResponseWriter, r *http.Request) {
+		handler(w, r.WithContext(context.WithValue(r.Context(), operationIDKey{}, id)))
+	})
+}
+
+// The generated handlers are methods of the wrapper named after the operation ID,
+// as (*ServerInterfaceWrapper).GetInstance-fm
+func operationName(handler func(http.ResponseWriter, *http.Request)) string {
+	fn := runtime.FuncForPC(reflect.ValueOf(handler).Pointer())
+	if fn == nil {
+		return ""
+	}
+
+	name := fn.Name()
+	if i := strings.LastIndex(name, "."); i >= 0 {
+		name = name[i+1:]
+	}
+	return strings.TrimSuffix(name, "-fm")
+}
@@ -0,0 +1,267 @@
+package fake
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"reflect"
+	"sync"
+	"time"
+
+	"github.com/eu-sovereign-cloud/go-sdk/pkg/constants"
+	wellknown "github.com/eu-sovereign-cloud/go-sdk/pkg/spec/extensions.wellknown.v1"
+	authorization "github.com/eu-sovereign-cloud/go-sdk/pkg/spec/foundation.authorization.v1"
+	region "github.com/eu-sovereign-cloud/go-sdk/pkg/spec/foundation.region.v1"
+	"github.com/eu-sovereign-cloud/go-sdk/pkg/spec/schema"
+	"github.com/eu-sovereign-cloud/go-sdk/secapi"
+)
+
+const (
+	DefaultRegion = "region-1"
+
+	providersPrefix = "/providers/"
+)
+
+var DefaultZones = []string{"a", "b", "c"}
+
+var (
+	ErrUnknownRegion       = errors.New("unknown region")
+	ErrUnsupportedResource = errors.New("unsupported resource type")
+)
+
+// Middleware wraps the handler of every operation, the operation ID can be
+// read from the request with OperationID.
+type Middleware func(http.Handler) http.Handler
+
+// Schedule configures how long the resources stay in each transitional state,
+// a zero duration moves the resource to its next state immediately.
+type Schedule struct {
+	// Creating is the time spent in the creating state before being active.
+	Creating time.Duration
+	// Updating is the time spent in the updating state before being active.
+	Updating time.Duration
+	// Deleting is the time spent in the deleting state before being deleted.
+	Deleting time.Duration
+	// PowerChange is the time taken by an instance to start or stop.
+	PowerChange time.Duration
+}
+
+type Config struct {
+	// Regions served by the fake, each one exposes every regional provider.
+	// Defaults to DefaultRegion.
+	Regions []string
+
+	// Zones available in each region, defaults to DefaultZones.
+	Zones []string
+
+	Schedule Schedule
+
+	// Middlewares applied to every operation, the first one is the outermost.
+	Middlewares []Middleware
+
+	// Now returns the current time, defaults to time.Now.
+	Now func() time.Time
+}
+
+// Server
+
+// Server is a stateful in-memory implementation of the SECA providers.
+type Server struct {
+	config Config
+	mux    *http.ServeMux
+
+	mu      sync.Mutex
+	entries map[key]*entry
+}
+
+// NewServer creates a fake server, the providers are served by the handler returned by Handler.
+func NewServer(config *Config) *Server {
+	s := &Server{
+		mux:     http.NewServeMux(),
+		entries: map[key]*entry{},
+	}
+	if config != nil {
+		s.config = *config
+	}
+	if len(s.config.Regions) == 0 {
+		s.config.Regions = []string{DefaultRegion}
+	}
+	if len(s.config.Zones) == 0 {
+		s.config.Zones = DefaultZones
+	}
+	if s.config.Now == nil {
+		s.config.Now = time.Now
+	}
+
+	s.registerGlobal()
+	for _, name := range s.config.Regions {
+		s.registerRegion(name)
+		s.seedRegion(name)
+	}
+
+	return s
+}
+
+// Handler returns the HTTP handler serving every provider.
+func (s *Server) Handler() http.Handler {
+	return s.mux
+}
+
+// Endpoints returns the global endpoints of the fake served at the given URL.
+func (s *Server) Endpoints(url string) secapi.GlobalEndpoints {
+	return secapi.GlobalEndpoints{
+		RegionV1:        url + providerPath(constants.RegionProviderV1Name),
+		AuthorizationV1: url + providerPath(constants.AuthorizationProviderV1Name),
+		WellknownV1:     url,
+	}
+}
+
+// Seed stores resources as they are, without state transitions, as the read only SKUs.
+// The regional resources are stored in the given region, the SKUs are visible to every tenant.
+func (s *Server) Seed(regionName string, resources ...any) error {
+	if !s.hasRegion(regionName) {
+		return fmt.Errorf("%w: %s", ErrUnknownRegion, regionName)
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	for _, resource := range resources {
+		k, err := seedKey(regionName, resource)
+		if err != nil {
+			return err
+		}
+
+		body, status, err := split(resource)
+		if err != nil {
+			return err
+		}
+
+		now := s.now()
+		s.entries[k] = &entry{key: k, body: body, status: status, resourceVersion: 1, createdAt: now, lastModifiedAt: now}
+	}
+
+	return nil
+}
+
+func (s *Server) now() time.Time {
+	return s.config.Now()
+}
+
+func (s *Server) hasRegion(name string) bool {
+	for _, r := range s.config.Regions {
+		if r == name {
+			return true
+		}
+	}
+	return false
+}
+
+func (s *Server) errorHandler(w http.ResponseWriter, r *http.Request, err error) {
+	writeError(w, r, http.StatusBadRequest, schema.ErrorTypeInvalidRequest, "Invalid request", err.Error())
+}
+
+// Registration
+
+func (s *Server) registerGlobal() {
+	wellknown.HandlerWithOptions(&wellknownServer{s}, wellknown.StdHTTPServerOptions{
+		BaseRouter:       router{s.mux},
+		Middlewares:      middlewares[wellknown.MiddlewareFunc](s.config.Middlewares),
+		ErrorHandlerFunc: s.errorHandler,
+	})
+
+	region.HandlerWithOptions(&regionServer{s}, region.StdHTTPServerOptions{
+		BaseURL:          providerPath(constants.RegionProviderV1Name),
+		BaseRouter:       router{s.mux},
+		Middlewares:      middlewares[region.MiddlewareFunc](s.config.Middlewares),
+		ErrorHandlerFunc: s.errorHandler,
+	})
+
+	authorization.HandlerWithOptions(&authorizationServer{s}, authorization.StdHTTPServerOptions{
+		BaseURL:          providerPath(constants.AuthorizationProviderV1Name),
+		BaseRouter:       router{s.mux},
+		Middlewares:      middlewares[authorization.MiddlewareFunc](s.config.Middlewares),
+		ErrorHandlerFunc: s.errorHandler,
+	})
+}
+
+func (s *Server) seedRegion(name string) {
+	var providers []any
+	for _, provider := range regionalProviders {
+		providers = append(providers, map[string]any{
+			"name":    provider.name,
+			"version": provider.version,
+			"url":     regionalProviderPath(name, provider.name+"/"+provider.version),
+		})
+	}
+
+	zones := make([]any, 0, len(s.config.Zones))
+	for _, zone := range s.config.Zones {
+		zones = append(zones, zone)
+	}
+
+	now := s.now()
+	k := key{typ: regionType, name: name}
+	s.entries[k] = &entry{
+		key:             k,
+		body:            map[string]any{"spec": map[string]any{"availableZones": zones, "providers": providers}},
+		resourceVersion: 1,
+		createdAt:       now,
+		lastModifiedAt:  now,
+	}
+}
+
+func providerPath(provider string) string {
+	return providersPrefix + provider
+}
+
+func regionalProviderPath(regionName, provider string) string {
+	return "/regions/" + regionName + providersPrefix + provider
+}
+
+// Converts the middlewares to the type of a generated package
+func middlewares[M ~func(http.Handler) http.Handler](fns []Middleware) []M {
+	converted := make([]M, 0, len(fns))
+	// The generated wrappers apply the middlewares in order, each one wrapping
+	// the previous, so they are reversed for the first one to be the outermost
+	for i := len(fns) - 1; i >= 0; i-- {
+		converted = append(converted, M(fns[i]))
+	}
+	return converted
+}
+
+// Returns the key of a resource from its metadata
+func seedKey(regionName string, resource any) (key, error) {
+	typ, found := seedTypes[reflect.TypeOf(resource)]
+	if !found {
+		return key{}, fmt.Errorf("%w: %T", ErrUnsupportedResource, resource)
+	}
+
+	metadata := reflect.Indirect(reflect.Indirect(reflect.ValueOf(resource)).FieldByName("Metadata"))
+	if !metadata.IsValid() {
+		return key{}, secapi.ErrNoMetadata
+	}
+
+	field := func(name string) string {
+		if f := metadata.FieldByName(name); f.IsValid() && f.Kind() == reflect.String {
+			return f.String()
+		}
+		return ""
+	}
+
+	k := key{typ: typ, tenant: field("Tenant"), workspace: field("Workspace"), name: field("Name")}
+	if typ.parent == networkCollection {
+		k.parent = field("Network")
+	}
+	if !typ.global {
+		k.region = regionName
+	}
+	if typ.shared {
+		k.tenant = ""
+	}
+	if k.name == "" {
+		return key{}, secapi.ErrNoMetadataName
+	}
+
+	return k, nil
+}
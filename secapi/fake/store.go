@@ -0,0 +1,474 @@
+package fake
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/eu-sovereign-cloud/go-sdk/pkg/spec/schema"
+)
+
+const ifUnmodifiedSinceHeader = "If-Unmodified-Since"
+
+// Resource types
+
+type resourceType struct {
+	kind       string
+	provider   string
+	version    string
+	collection string
+
+	// Collection of the parent resource for the nested ones, as networks for subnets
+	parent string
+
+	// Global resources are shared by all the regions
+	global bool
+
+	// Shared resources are visible to all the tenants, as the SKUs
+	shared bool
+
+	// Called when the resource becomes active
+	activate func(status map[string]any, now time.Time)
+
+	// Called before the resource is written in a response
+	render func(doc map[string]any, r *http.Request)
+}
+
+type key struct {
+	typ       *resourceType
+	region    string
+	tenant    string
+	workspace string
+	parent    string
+	name      string
+}
+
+func (k key) scope() key {
+	k.name = ""
+	return k
+}
+
+func (k key) resource() string {
+	if k.typ.parent != "" {
+		return k.typ.parent + "/" + k.parent + "/" + k.typ.collection + "/" + k.name
+	}
+	return k.typ.collection + "/" + k.name
+}
+
+func (k key) ref() string {
+	segments := []string{k.typ.provider, k.typ.version}
+	if k.tenant != "" {
+		segments = append(segments, "tenants", k.tenant)
+	}
+	if k.workspace != "" {
+		segments = append(segments, "workspaces", k.workspace)
+	}
+	return strings.Join(append(segments, k.resource()), "/")
+}
+
+// Entries
+
+type transition struct {
+	at    time.Time
+	apply func(e *entry, at time.Time)
+}
+
+type entry struct {
+	key key
+
+	// Client owned fields, as labels, annotations and spec
+	body   map[string]any
+	status map[string]any
+
+	resourceVersion int64
+	createdAt       time.Time
+	lastModifiedAt  time.Time
+	deletedAt       *time.Time
+
+	transitions []transition
+}
+
+func (e *entry) deleted() bool {
+	return e.deletedAt != nil
+}
+
+func (e *entry) state() schema.ResourceState {
+	if e.status == nil {
+		return ""
+	}
+	state, _ := e.status["state"].(string)
+	return schema.ResourceState(state)
+}
+
+func (e *entry) setState(state schema.ResourceState) {
+	if e.status == nil {
+		e.status = map[string]any{}
+	}
+	e.status["state"] = string(state)
+}
+
+// Applies the transitions due at the given time
+func (e *entry) advance(now time.Time) {
+	var pending []transition
+	for _, t := range e.transitions {
+		if t.at.After(now) {
+			pending = append(pending, t)
+			continue
+		}
+		t.apply(e, t.at)
+	}
+	e.transitions = pending
+}
+
+func (e *entry) render(r *http.Request) map[string]any {
+	doc := map[string]any{}
+	for field, value := range deepCopy(e.body).(map[string]any) {
+		doc[field] = value
+	}
+
+	metadata := map[string]any{
+		"name":            e.key.name,
+		"kind":            e.key.typ.kind,
+		"apiVersion":      e.key.typ.version,
+		"provider":        e.key.typ.provider,
+		"resource":        e.key.resource(),
+		"ref":             e.key.ref(),
+		"verb":            strings.ToLower(r.Method),
+		"createdAt":       e.createdAt,
+		"lastModifiedAt":  e.lastModifiedAt,
+		"resourceVersion": e.resourceVersion,
+	}
+	if e.key.tenant != "" {
+		metadata["tenant"] = e.key.tenant
+	} else if tenant := r.PathValue("tenant"); tenant != "" {
+		// The shared resources belong to the tenant they are requested from
+		metadata["tenant"] = tenant
+	}
+	if e.key.workspace != "" {
+		metadata["workspace"] = e.key.workspace
+	}
+	if e.key.region != "" {
+		metadata["region"] = e.key.region
+	}
+	if e.key.typ.parent == networkCollection {
+		metadata["network"] = e.key.parent
+	}
+	if e.deletedAt != nil {
+		metadata["deletedAt"] = *e.deletedAt
+	}
+	doc["metadata"] = metadata
+
+	if e.status != nil {
+		doc["status"] = deepCopy(e.status)
+	}
+
+	if e.key.typ.render != nil {
+		e.key.typ.render(doc, r)
+	}
+	return doc
+}
+
+// Operations, they must be called with the server lock held
+
+func (s *Server) getEntry(k key) (*entry, bool) {
+	e, found := s.entries[k]
+	if !found || e.deleted() {
+		return nil, false
+	}
+	return e, true
+}
+
+func (s *Server) get(w http.ResponseWriter, r *http.Request, k key) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.advance()
+
+	e, found := s.getEntry(k)
+	if !found {
+		writeNotFound(w, r, k)
+		return
+	}
+
+	writeJSON(w, http.StatusOK, e.render(r))
+}
+
+func (s *Server) list(w http.ResponseWriter, r *http.Request, scope key) {
+	query := r.URL.Query()
+
+	selector, err := parseSelector(query.Get("labels"))
+	if err != nil {
+		writeError(w, r, http.StatusBadRequest, schema.ErrorTypeInvalidRequest, "Invalid label selector", err.Error())
+		return
+	}
+
+	limit := 0
+	if value := query.Get("limit"); value != "" {
+		if limit, err = strconv.Atoi(value); err != nil || limit < 0 {
+			writeError(w, r, http.StatusBadRequest, schema.ErrorTypeInvalidRequest, "Invalid limit", value)
+			return
+		}
+	}
+
+	after := ""
+	if token := query.Get("skipToken"); token != "" {
+		decoded, err := base64.RawURLEncoding.DecodeString(token)
+		if err != nil {
+			writeError(w, r, http.StatusBadRequest, schema.ErrorTypeInvalidRequest, "Invalid skip token", token)
+			return
+		}
+		after = string(decoded)
+	}
+
+	accept := r.Header.Get("Accept")
+	includeDeleted := strings.Contains(accept, "deleted=true")
+	onlyDeleted := strings.Contains(accept, "deleted=only")
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.advance()
+
+	var entries []*entry
+	for k, e := range s.entries {
+		if k.scope() != scope || k.name <= after && after != "" {
+			continue
+		}
+		if onlyDeleted && !e.deleted() || !onlyDeleted && !includeDeleted && e.deleted() {
+			continue
+		}
+		labels, _ := e.body["labels"].(map[string]any)
+		if !selector.matches(labels) {
+			continue
+		}
+		entries = append(entries, e)
+	}
+	sort.Slice(entries, func(i, j int) bool { return entries[i].key.name < entries[j].key.name })
+
+	metadata := map[string]any{
+		"provider": scope.typ.provider,
+		"resource": scope.typ.collection,
+		"verb":     "list",
+	}
+	if limit > 0 && len(entries) > limit {
+		entries = entries[:limit]
+		metadata["skipToken"] = base64.RawURLEncoding.EncodeToString([]byte(entries[limit-1].key.name))
+	}
+
+	items := make([]map[string]any, 0, len(entries))
+	for _, e := range entries {
+		items = append(items, e.render(r))
+	}
+
+	writeJSON(w, http.StatusOK, map[string]any{"items": items, "metadata": metadata})
+}
+
+// Creates or updates a resource, the body is decoded into the given schema type to be validated
+func (s *Server) put(w http.ResponseWriter, r *http.Request, k key, resource any) {
+	if err := json.NewDecoder(r.Body).Decode(resource); err != nil {
+		writeError(w, r, http.StatusBadRequest, schema.ErrorTypeInvalidRequest, "Invalid request body", err.Error())
+		return
+	}
+
+	// The status is owned by the server
+	body, _, err := split(resource)
+	if err != nil {
+		writeError(w, r, http.StatusBadRequest, schema.ErrorTypeInvalidRequest, "Invalid request body", err.Error())
+		return
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.advance()
+
+	now := s.now()
+	e, found := s.getEntry(k)
+	if !s.checkPrecondition(w, r, k, e) {
+		return
+	}
+
+	if !found {
+		e = &entry{key: k, body: body, resourceVersion: 1, createdAt: now, lastModifiedAt: now}
+		s.entries[k] = e
+		s.schedule(e, schema.ResourceStateCreating, s.config.Schedule.Creating, activate)
+
+		writeJSON(w, http.StatusCreated, e.render(r))
+		return
+	}
+
+	if e.state() == schema.ResourceStateDeleting {
+		writeError(w, r, http.StatusConflict, schema.ErrorTypeResourceConflict, "Resource is being deleted", k.ref())
+		return
+	}
+
+	e.body = body
+	e.resourceVersion++
+	e.lastModifiedAt = now
+	s.schedule(e, schema.ResourceStateUpdating, s.config.Schedule.Updating, activate)
+
+	writeJSON(w, http.StatusOK, e.render(r))
+}
+
+func (s *Server) delete(w http.ResponseWriter, r *http.Request, k key) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.advance()
+
+	e, found := s.getEntry(k)
+	if !found {
+		writeNotFound(w, r, k)
+		return
+	}
+	if !s.checkPrecondition(w, r, k, e) {
+		return
+	}
+
+	if e.state() != schema.ResourceStateDeleting {
+		s.schedule(e, schema.ResourceStateDeleting, s.config.Schedule.Deleting, func(e *entry, at time.Time) {
+			e.deletedAt = &at
+		})
+	}
+
+	w.WriteHeader(http.StatusAccepted)
+}
+
+// Runs an action on an active resource, as starting an instance
+func (s *Server) action(w http.ResponseWriter, r *http.Request, k key, delay time.Duration, apply func(e *entry, at time.Time)) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.advance()
+
+	e, found := s.getEntry(k)
+	if !found {
+		writeNotFound(w, r, k)
+		return
+	}
+	if !s.checkPrecondition(w, r, k, e) {
+		return
+	}
+	if e.state() != schema.ResourceStateActive {
+		writeError(w, r, http.StatusConflict, schema.ErrorTypeResourceConflict, "Resource is not active", k.ref())
+		return
+	}
+
+	at := s.now().Add(delay)
+	e.transitions = append(e.transitions, transition{at: at, apply: apply})
+	e.advance(s.now())
+
+	w.WriteHeader(http.StatusAccepted)
+}
+
+// Checks the If-Unmodified-Since header against the resource version
+func (s *Server) checkPrecondition(w http.ResponseWriter, r *http.Request, k key, e *entry) bool {
+	value := r.Header.Get(ifUnmodifiedSinceHeader)
+	if value == "" {
+		return true
+	}
+
+	version, err := strconv.ParseInt(value, 10, 64)
+	if err != nil {
+		writeError(w, r, http.StatusBadRequest, schema.ErrorTypeInvalidRequest, "Invalid if-unmodified-since header", value)
+		return false
+	}
+
+	if e == nil || e.resourceVersion != version {
+		writeError(w, r, http.StatusPreconditionFailed, schema.ErrorTypePreconditionFailed, "Resource was modified",
+			fmt.Sprintf("%s is not at version %d", k.ref(), version))
+		return false
+	}
+	return true
+}
+
+// Moves the resource to the given state, then applies the final transition after the delay
+func (s *Server) schedule(e *entry, state schema.ResourceState, delay time.Duration, apply func(e *entry, at time.Time)) {
+	now := s.now()
+
+	e.setState(state)
+	e.transitions = []transition{{at: now.Add(delay), apply: apply}}
+	e.advance(now)
+}
+
+func (s *Server) advance() {
+	now := s.now()
+	for _, e := range s.entries {
+		if len(e.transitions) > 0 {
+			e.advance(now)
+		}
+	}
+}
+
+func activate(e *entry, at time.Time) {
+	e.setState(schema.ResourceStateActive)
+	if e.key.typ.activate != nil {
+		e.key.typ.activate(e.status, at)
+	}
+}
+
+// Splits a schema resource into its client owned fields and its status
+func split(resource any) (map[string]any, map[string]any, error) {
+	data, err := json.Marshal(resource)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var doc map[string]any
+	if err := json.Unmarshal(data, &doc); err != nil {
+		return nil, nil, err
+	}
+	if doc == nil {
+		doc = map[string]any{}
+	}
+
+	status, _ := doc["status"].(map[string]any)
+	delete(doc, "status")
+	delete(doc, "metadata")
+
+	return doc, status, nil
+}
+
+func deepCopy(value any) any {
+	switch v := value.(type) {
+	case map[string]any:
+		m := make(map[string]any, len(v))
+		for key, item := range v {
+			m[key] = deepCopy(item)
+		}
+		return m
+	case []any:
+		s := make([]any, len(v))
+		for i, item := range v {
+			s[i] = deepCopy(item)
+		}
+		return s
+	default:
+		return v
+	}
+}
+
+// Responses
+
+func writeJSON(w http.ResponseWriter, status int, body any) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	_ = json.NewEncoder(w).Encode(body)
+}
+
+func writeError(w http.ResponseWriter, r *http.Request, status int, errorType schema.ErrorType, title, detail string) {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(status)
+	_ = json.NewEncoder(w).Encode(schema.Error{
+		Type:     string(errorType),
+		Title:    title,
+		Detail:   detail,
+		Status:   float32(status),
+		Instance: r.URL.Path,
+		Sources:  []schema.ErrorSource{},
+	})
+}
+
+func writeNotFound(w http.ResponseWriter, r *http.Request, k key) {
+	writeError(w, r, http.StatusNotFound, schema.ErrorTypeResourceNotFound, "Resource not found", k.ref())
+}
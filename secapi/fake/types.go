@@ -0,0 +1,280 @@
+package fake
+
+import (
+	"net/http"
+	"reflect"
+	"time"
+
+	"github.com/eu-sovereign-cloud/go-sdk/pkg/constants"
+	"github.com/eu-sovereign-cloud/go-sdk/pkg/spec/schema"
+)
+
+const (
+	apiVersion1Beta1 = "v1beta1"
+
+	activityLogProviderName   = "seca.activitylog"
+	kubernetesProviderName    = "seca.kubernetes"
+	loadBalancerProviderName  = "seca.loadbalancer"
+	natGatewayProviderName    = "seca.natgateway"
+	objectStorageProviderName = "seca.objectstorage"
+
+	networkCollection = "networks"
+	clusterCollection = "clusters"
+)
+
+type regionalProvider struct {
+	name    string
+	version string
+}
+
+// Providers exposed by each region
+var regionalProviders = []regionalProvider{
+	{constants.WorkspaceProviderName, constants.ApiVersion1},
+	{constants.ComputeProviderName, constants.ApiVersion1},
+	{constants.StorageProviderName, constants.ApiVersion1},
+	{constants.NetworkProviderName, constants.ApiVersion1},
+	{activityLogProviderName, apiVersion1Beta1},
+	{kubernetesProviderName, apiVersion1Beta1},
+	{loadBalancerProviderName, apiVersion1Beta1},
+	{natGatewayProviderName, apiVersion1Beta1},
+	{objectStorageProviderName, apiVersion1Beta1},
+}
+
+// Region
+
+var regionType = &resourceType{
+	kind:       string(schema.ResourceMetadataKindResourceKindRegion),
+	provider:   constants.RegionProviderName,
+	version:    constants.ApiVersion1,
+	collection: "regions",
+	global:     true,
+	render:     renderRegion,
+}
+
+// Authorization
+
+var (
+	roleType = &resourceType{
+		kind:       string(schema.ResourceMetadataKindResourceKindRole),
+		provider:   constants.AuthorizationProviderName,
+		version:    constants.ApiVersion1,
+		collection: "roles",
+		global:     true,
+	}
+	roleAssignmentType = &resourceType{
+		kind:       string(schema.ResourceMetadataKindResourceKindRoleAssignment),
+		provider:   constants.AuthorizationProviderName,
+		version:    constants.ApiVersion1,
+		collection: "role-assignments",
+		global:     true,
+	}
+)
+
+// Workspace
+
+var workspaceType = &resourceType{
+	kind:       string(schema.ResourceMetadataKindResourceKindWorkspace),
+	provider:   constants.WorkspaceProviderName,
+	version:    constants.ApiVersion1,
+	collection: "workspaces",
+}
+
+// Compute
+
+var (
+	instanceSkuType = &resourceType{
+		kind:       string(schema.ResourceMetadataKindResourceKindInstanceSku),
+		provider:   constants.ComputeProviderName,
+		version:    constants.ApiVersion1,
+		collection: "skus",
+		shared:     true,
+	}
+	instanceType = &resourceType{
+		kind:       string(schema.ResourceMetadataKindResourceKindInstance),
+		provider:   constants.ComputeProviderName,
+		version:    constants.ApiVersion1,
+		collection: "instances",
+		activate:   powerOn,
+	}
+)
+
+// Storage
+
+var (
+	storageSkuType = &resourceType{
+		kind:       string(schema.ResourceMetadataKindResourceKindStorageSku),
+		provider:   constants.StorageProviderName,
+		version:    constants.ApiVersion1,
+		collection: "skus",
+		shared:     true,
+	}
+	imageType = &resourceType{
+		kind:       string(schema.ResourceMetadataKindResourceKindImage),
+		provider:   constants.StorageProviderName,
+		version:    constants.ApiVersion1,
+		collection: "images",
+	}
+	blockStorageType = &resourceType{
+		kind:       string(schema.ResourceMetadataKindResourceKindBlockStorage),
+		provider:   constants.StorageProviderName,
+		version:    constants.ApiVersion1,
+		collection: "block-storages",
+	}
+)
+
+// Network
+
+var (
+	networkSkuType = &resourceType{
+		kind:       string(schema.ResourceMetadataKindResourceKindNetworkSku),
+		provider:   constants.NetworkProviderName,
+		version:    constants.ApiVersion1,
+		collection: "skus",
+		shared:     true,
+	}
+	networkType = &resourceType{
+		kind:       string(schema.ResourceMetadataKindResourceKindNetwork),
+		provider:   constants.NetworkProviderName,
+		version:    constants.ApiVersion1,
+		collection: networkCollection,
+	}
+	subnetType = &resourceType{
+		kind:       string(schema.ResourceMetadataKindResourceKindSubnet),
+		provider:   constants.NetworkProviderName,
+		version:    constants.ApiVersion1,
+		collection: "subnets",
+		parent:     networkCollection,
+	}
+	routeTableType = &resourceType{
+		kind:       string(schema.ResourceMetadataKindResourceKindRoutingTable),
+		provider:   constants.NetworkProviderName,
+		version:    constants.ApiVersion1,
+		collection: "route-tables",
+		parent:     networkCollection,
+	}
+	internetGatewayType = &resourceType{
+		kind:       string(schema.ResourceMetadataKindResourceKindInternetGateway),
+		provider:   constants.NetworkProviderName,
+		version:    constants.ApiVersion1,
+		collection: "internet-gateways",
+	}
+	securityGroupRuleType = &resourceType{
+		kind:       string(schema.ResourceMetadataKindResourceKindSecurityGroupRule),
+		provider:   constants.NetworkProviderName,
+		version:    constants.ApiVersion1,
+		collection: "security-group-rules",
+	}
+	securityGroupType = &resourceType{
+		kind:       string(schema.ResourceMetadataKindResourceKindSecurityGroup),
+		provider:   constants.NetworkProviderName,
+		version:    constants.ApiVersion1,
+		collection: "security-groups",
+	}
+	nicType = &resourceType{
+		kind:       string(schema.ResourceMetadataKindResourceKindNic),
+		provider:   constants.NetworkProviderName,
+		version:    constants.ApiVersion1,
+		collection: "nics",
+	}
+	publicIpType = &resourceType{
+		kind:       string(schema.ResourceMetadataKindResourceKindPublicIP),
+		provider:   constants.NetworkProviderName,
+		version:    constants.ApiVersion1,
+		collection: "public-ips",
+	}
+)
+
+// Extensions
+
+var (
+	activityLogType = &resourceType{
+		kind:       string(schema.ResourceMetadataKindResourceKindActivityLog),
+		provider:   activityLogProviderName,
+		version:    apiVersion1Beta1,
+		collection: "activity-logs",
+	}
+	clusterType = &resourceType{
+		kind:       "kubernetes-cluster",
+		provider:   kubernetesProviderName,
+		version:    apiVersion1Beta1,
+		collection: clusterCollection,
+	}
+	nodePoolType = &resourceType{
+		kind:       "kubernetes-node-pool",
+		provider:   kubernetesProviderName,
+		version:    apiVersion1Beta1,
+		collection: "node-pools",
+		parent:     clusterCollection,
+	}
+	networkLoadBalancerType = &resourceType{
+		kind:       string(schema.ResourceMetadataKindResourceKindNetworkLoadBalancer),
+		provider:   loadBalancerProviderName,
+		version:    apiVersion1Beta1,
+		collection: "network-load-balancers",
+	}
+	natGatewayType = &resourceType{
+		kind:       "internet-nat-gateway-instance",
+		provider:   natGatewayProviderName,
+		version:    apiVersion1Beta1,
+		collection: "internet-nat-gateway-instances",
+	}
+	objectStorageAccountType = &resourceType{
+		kind:       string(schema.ResourceMetadataKindResourceKindObjectStorageAccount),
+		provider:   objectStorageProviderName,
+		version:    apiVersion1Beta1,
+		collection: "accounts",
+	}
+)
+
+// Types of the resources accepted by Seed
+var seedTypes = map[reflect.Type]*resourceType{
+	reflect.TypeOf(&schema.Role{}):                       roleType,
+	reflect.TypeOf(&schema.RoleAssignment{}):             roleAssignmentType,
+	reflect.TypeOf(&schema.Workspace{}):                  workspaceType,
+	reflect.TypeOf(&schema.InstanceSku{}):                instanceSkuType,
+	reflect.TypeOf(&schema.Instance{}):                   instanceType,
+	reflect.TypeOf(&schema.StorageSku{}):                 storageSkuType,
+	reflect.TypeOf(&schema.Image{}):                      imageType,
+	reflect.TypeOf(&schema.BlockStorage{}):               blockStorageType,
+	reflect.TypeOf(&schema.NetworkSku{}):                 networkSkuType,
+	reflect.TypeOf(&schema.Network{}):                    networkType,
+	reflect.TypeOf(&schema.Subnet{}):                     subnetType,
+	reflect.TypeOf(&schema.RouteTable{}):                 routeTableType,
+	reflect.TypeOf(&schema.InternetGateway{}):            internetGatewayType,
+	reflect.TypeOf(&schema.SecurityGroupRule{}):          securityGroupRuleType,
+	reflect.TypeOf(&schema.SecurityGroup{}):              securityGroupType,
+	reflect.TypeOf(&schema.Nic{}):                        nicType,
+	reflect.TypeOf(&schema.PublicIp{}):                   publicIpType,
+	reflect.TypeOf(&schema.ActivityLog{}):                activityLogType,
+	reflect.TypeOf(&schema.KubernetesCluster{}):          clusterType,
+	reflect.TypeOf(&schema.NetworkLoadBalancer{}):        networkLoadBalancerType,
+	reflect.TypeOf(&schema.InternetNatGatewayInstance{}): natGatewayType,
+	reflect.TypeOf(&schema.ObjectStorageAccount{}):       objectStorageAccountType,
+}
+
+// The provider URLs are stored relative to the server, as it doesn't know its own address
+func renderRegion(doc map[string]any, r *http.Request) {
+	scheme := "http"
+	if r.TLS != nil {
+		scheme = "https"
+	}
+
+	spec, _ := doc["spec"].(map[string]any)
+	providers, _ := spec["providers"].([]any)
+	for _, item := range providers {
+		if provider, ok := item.(map[string]any); ok {
+			if url, ok := provider["url"].(string); ok {
+				provider["url"] = scheme + "://" + r.Host + url
+			}
+		}
+	}
+}
+
+func powerOn(status map[string]any, now time.Time) {
+	setPowerState(status, schema.InstanceStatusPowerStateOn, now)
+}
+
+func setPowerState(status map[string]any, state schema.InstanceStatusPowerState, now time.Time) {
+	status["powerState"] = string(state)
+	status["powerStateSince"] = now
+}
@@ -3,11 +3,29 @@ package secapi
 import (
 	"context"
 	"fmt"
+	"net/url"
+	"slices"
+	"strings"
+
+	"github.com/eu-sovereign-cloud/go-sdk/pkg/constants"
 )
 
+// WellknownVersions are the versions of the well-known document supported by the SDK.
+var WellknownVersions = []string{constants.ApiVersion1}
+
+const wellknownPath = "/.wellknown/secapi"
+
 type GlobalConfig struct {
 	AuthToken string
 	Endpoints GlobalEndpoints
+
+	// HttpClient performs the requests of the global and regional clients, defaults to http.Client.
+	HttpClient HttpRequestDoer
+
+	// ProviderVersions are the preferred versions of the regional providers by name, as seca.network.
+	// A preferred version is selected when the region offers it and it is compatible with the API,
+	// otherwise the highest compatible version is.
+	ProviderVersions map[string]string
 }
 
 type GlobalEndpoints struct {
@@ -18,7 +36,9 @@ type GlobalEndpoints struct {
 }
 
 type GlobalClient struct {
-	authToken string
+	authToken        string
+	httpClient       HttpRequestDoer
+	providerVersions map[string]string
 
 	RegionV1        RegionV1
 	AuthorizationV1 AuthorizationV1
@@ -35,7 +55,9 @@ func NewGlobalClient(config *GlobalConfig) (*GlobalClient, error) {
 	}
 
 	client := &GlobalClient{
-		authToken: config.AuthToken,
+		authToken:        config.AuthToken,
+		httpClient:       config.HttpClient,
+		providerVersions: config.ProviderVersions,
 	}
 
 	// Initializes regionsV1 API client
@@ -68,6 +90,122 @@ func NewGlobalClient(config *GlobalConfig) (*GlobalClient, error) {
 	return client, nil
 }
 
+type WellknownConfig struct {
+	AuthToken string
+
+	// Url is the base domain of the deployment, as example.com, or the URL of its well-known document.
+	// The scheme defaults to https.
+	Url string
+
+	// HttpClient performs the requests of the global and regional clients, defaults to http.Client.
+	HttpClient HttpRequestDoer
+
+	// ProviderVersions are the preferred versions of the regional providers, as in GlobalConfig.
+	ProviderVersions map[string]string
+}
+
+// NewGlobalClientFromWellknown creates a global client from the well-known document of a deployment,
+// every global provider endpoint it lists is filled in. The providers missing from the document are unavailable.
+func NewGlobalClientFromWellknown(ctx context.Context, config *WellknownConfig) (*GlobalClient, error) {
+	if config == nil {
+		return nil, fmt.Errorf("WellknownConfig is required to create a global client")
+	}
+	if config.Url == "" {
+		return nil, fmt.Errorf("Url is required to create a global client")
+	}
+
+	base, err := wellknownBaseUrl(config.Url)
+	if err != nil {
+		return nil, err
+	}
+
+	client, err := NewGlobalClient(&GlobalConfig{
+		AuthToken:  config.AuthToken,
+		Endpoints:  GlobalEndpoints{WellknownV1: base.String()},
+		HttpClient: config.HttpClient,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	wellknown, err := client.WellknownV1.GetWellknown(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if !slices.Contains(WellknownVersions, wellknown.Version) {
+		return nil, fmt.Errorf("%w: %s", ErrUnsupportedWellknownVersion, wellknown.Version)
+	}
+
+	endpoints := GlobalEndpoints{WellknownV1: base.String()}
+	for _, endpoint := range wellknown.Endpoints {
+		// The relative URLs are resolved against the base URL of the document
+		ref, err := url.Parse(endpoint.Url)
+		if err != nil {
+			return nil, fmt.Errorf("invalid url of the provider %s: %w", endpoint.Provider, err)
+		}
+		providerUrl := base.ResolveReference(ref).String()
+
+		switch endpoint.Provider {
+		case constants.RegionProviderV1Name:
+			endpoints.RegionV1 = providerUrl
+		case constants.AuthorizationProviderV1Name:
+			endpoints.AuthorizationV1 = providerUrl
+		}
+	}
+
+	return NewGlobalClient(&GlobalConfig{
+		AuthToken:        config.AuthToken,
+		Endpoints:        endpoints,
+		HttpClient:       config.HttpClient,
+		ProviderVersions: config.ProviderVersions,
+	})
+}
+
+// Returns the server URL of the well-known document, from a base domain or the URL of the document
+func wellknownBaseUrl(rawUrl string) (*url.URL, error) {
+	if !strings.Contains(rawUrl, "://") {
+		rawUrl = "https://" + rawUrl
+	}
+
+	base, err := url.Parse(rawUrl)
+	if err != nil {
+		return nil, fmt.Errorf("invalid well-known url: %w", err)
+	}
+	if base.Host == "" {
+		return nil, fmt.Errorf("invalid well-known url: no host in %s", rawUrl)
+	}
+
+	base.Path = strings.TrimSuffix(strings.TrimSuffix(base.Path, "/"), wellknownPath)
+	base.RawPath = ""
+	base.RawQuery = ""
+	base.Fragment = ""
+	return base, nil
+}
+
+// GlobalAPIs are the implementations of the global APIs, as mocks or fakes.
+type GlobalAPIs struct {
+	RegionV1        RegionV1
+	AuthorizationV1 AuthorizationV1
+
+	WellknownV1 WellknownV1
+}
+
+// NewGlobalClientFromAPIs creates a global client from the given implementations,
+// it performs no request itself. The missing APIs are unavailable.
+func NewGlobalClientFromAPIs(apis *GlobalAPIs) *GlobalClient {
+	if apis == nil {
+		apis = &GlobalAPIs{}
+	}
+
+	client := &GlobalClient{}
+
+	setGlobalAPI(apis.RegionV1, newRegionV1Unavailable, client.setRegionV1)
+	setGlobalAPI(apis.AuthorizationV1, newAuthorizationV1Unavailable, client.setAuthorizationV1)
+	setGlobalAPI(apis.WellknownV1, newWellknownV1Unavailable, client.setWellknownV1)
+
+	return client
+}
+
 func (client *GlobalClient) NewRegionalClient(ctx context.Context, name string) (*RegionalClient, error) {
 	region, err := client.RegionV1.GetRegion(ctx, name)
 	if err != nil {
@@ -77,7 +215,7 @@ func (client *GlobalClient) NewRegionalClient(ctx context.Context, name string)
 		return nil, fmt.Errorf("region %s not found in the regions provider", name)
 	}
 
-	return newRegionalClient(client.authToken, region)
+	return newRegionalClient(client.authToken, client.httpClient, client.providerVersions, region)
 }
 
 func initGlobalAPI[T any](client *GlobalClient, endpoint string, newFunc func(client *GlobalClient, url string) (T, error), setFunc func(T)) error {
@@ -95,6 +233,16 @@ func setUnavailableGlobalAPI[T any](newFunc func() T, setFunc func(T)) {
 	setFunc(api)
 }
 
+func setGlobalAPI[T comparable](api T, newUnavailableFunc func() T, setFunc func(T)) {
+	var none T
+	if api == none {
+		setUnavailableGlobalAPI(newUnavailableFunc, setFunc)
+		return
+	}
+
+	setFunc(api)
+}
+
 func (client *GlobalClient) setRegionV1(region RegionV1) {
 	client.RegionV1 = region
 }
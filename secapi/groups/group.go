@@ -0,0 +1,432 @@
+// Package groups manages the instance groups: the instances created from a template, spread across
+// the zones of a region with anti-affinity. Each instance has its own boot block storage, created
+// from a source image, and its own NIC in a subnet of its zone. The instances of a group are found
+// by the group label, scaling reconciles them to the desired count.
+package groups
+
+import (
+	"cmp"
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/eu-sovereign-cloud/go-sdk/pkg/spec/schema"
+	"github.com/eu-sovereign-cloud/go-sdk/secapi"
+	"github.com/eu-sovereign-cloud/go-sdk/secapi/builders"
+	"github.com/eu-sovereign-cloud/go-sdk/secapi/ipam"
+)
+
+// GroupLabel is the label identifying the group of the instances, their boot volumes and their NICs.
+const GroupLabel = "instance-group"
+
+// DefaultObserver is the wait for the boot volumes and the deleted instances used for the zero
+// fields of Manager.Observer.
+var DefaultObserver = secapi.ResourceObserverConfig{
+	Delay:       time.Second,
+	Interval:    2 * time.Second,
+	MaxAttempts: 60,
+}
+
+var (
+	ErrNoZones      = errors.New("instance group has no zones")
+	ErrNoZoneSubnet = errors.New("no subnet in the zone")
+	ErrInvalidCount = errors.New("invalid instance count")
+
+	ErrVolumeNotReady = errors.New("boot volume did not become active")
+)
+
+// Template describes the instances of a group.
+type Template struct {
+	Sku           schema.Reference
+	Image         schema.Reference
+	StorageSku    schema.Reference
+	BootSizeGB    int
+	SecurityGroup *schema.Reference
+	SshKeys       []string
+	UserData      string
+	Labels        schema.Labels
+}
+
+// Group is an instance group of a workspace, its instances are attached to the subnets of a network.
+// The group name is also the anti-affinity group of its instances.
+type Group struct {
+	Name      string
+	Tenant    string
+	Workspace string
+	Region    string
+	Network   string
+	Zones     []schema.Zone
+	Template  Template
+}
+
+// ZonesOf returns the zones available in a region.
+func ZonesOf(region *schema.Region) []schema.Zone {
+	if region == nil {
+		return nil
+	}
+	return slices.Clone(region.Spec.AvailableZones)
+}
+
+// Result reports the names of the instances created and deleted by a scaling.
+type Result struct {
+	Created []string
+	Deleted []string
+}
+
+// Manager creates and deletes the instances of the groups. The boot volume of an instance is
+// observed with the configuration until it's active, the deletion of an instance until it's done
+// before its boot volume and its NIC are deleted. The zero fields of Observer are taken from
+// DefaultObserver.
+type Manager struct {
+	compute secapi.ComputeV1
+	storage secapi.StorageV1
+	network secapi.NetworkV1
+
+	Observer secapi.ResourceObserverConfig
+}
+
+func NewManager(compute secapi.ComputeV1, storage secapi.StorageV1, network secapi.NetworkV1) *Manager {
+	return &Manager{compute: compute, storage: storage, network: network}
+}
+
+// Instances returns the instances of the group, by name.
+func (m *Manager) Instances(ctx context.Context, group *Group) ([]*schema.Instance, error) {
+	selector := builders.NewLabelsBuilder().Equals(GroupLabel, group.Name)
+
+	iter, err := m.compute.ListInstancesWithOptions(ctx, group.workspacePath(), secapi.NewListOptions().WithLabels(selector))
+	if err != nil {
+		return nil, err
+	}
+	instances, err := iter.All(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	instances = slices.DeleteFunc(instances, func(inst *schema.Instance) bool {
+		return inst.Metadata == nil || !selector.Matches(inst.Labels)
+	})
+	slices.SortFunc(instances, func(a, b *schema.Instance) int {
+		return cmp.Or(cmp.Compare(group.indexOf(a), group.indexOf(b)), strings.Compare(a.Metadata.Name, b.Metadata.Name))
+	})
+	return instances, nil
+}
+
+// Scale reconciles the group to the desired count of instances. The new instances are placed in
+// the zones with the fewest instances, the instances removed are taken from the zones with the
+// most, the instances outside of the group zones first.
+func (m *Manager) Scale(ctx context.Context, group *Group, count int) (*Result, error) {
+	if count < 0 {
+		return nil, fmt.Errorf("%w: %d", ErrInvalidCount, count)
+	}
+	if len(group.Zones) == 0 {
+		return nil, ErrNoZones
+	}
+
+	instances, err := m.Instances(ctx, group)
+	if err != nil {
+		return nil, err
+	}
+
+	result := &Result{}
+	switch {
+	case len(instances) < count:
+		err = m.scaleUp(ctx, group, instances, count-len(instances), result)
+	case len(instances) > count:
+		err = m.scaleDown(ctx, group, instances, len(instances)-count, result)
+	}
+	return result, err
+}
+
+func (m *Manager) scaleUp(ctx context.Context, group *Group, instances []*schema.Instance, missing int, result *Result) error {
+	subnets, err := m.loadSubnets(ctx, group)
+	if err != nil {
+		return err
+	}
+
+	zones := group.zoneCounts(instances)
+	used := make(map[int]bool, len(instances))
+	for _, inst := range instances {
+		used[group.indexOf(inst)] = true
+	}
+
+	index := 0
+	for range missing {
+		for used[index] {
+			index++
+		}
+		used[index] = true
+
+		zone := zones.least(group.Zones)
+		name := group.Name + "-" + strconv.Itoa(index)
+		if err := m.create(ctx, group, name, zone, subnets[zone]); err != nil {
+			return fmt.Errorf("instance %s: %w", name, err)
+		}
+		zones[zone]++
+		result.Created = append(result.Created, name)
+	}
+	return nil
+}
+
+func (m *Manager) scaleDown(ctx context.Context, group *Group, instances []*schema.Instance, extra int, result *Result) error {
+	zones := group.zoneCounts(instances)
+
+	for range extra {
+		zone := zones.most(group.Zones)
+
+		// The last instance of the zone, by index
+		var victim *schema.Instance
+		for _, inst := range instances {
+			if inst.Spec.Zone == zone {
+				victim = inst
+			}
+		}
+
+		if err := m.delete(ctx, victim); err != nil {
+			return fmt.Errorf("instance %s: %w", victim.Metadata.Name, err)
+		}
+		zones[zone]--
+		instances = slices.DeleteFunc(instances, func(inst *schema.Instance) bool { return inst == victim })
+		result.Deleted = append(result.Deleted, victim.Metadata.Name)
+	}
+	return nil
+}
+
+// Creates the boot volume, waits for it to be active, then creates the NIC and the instance.
+// Nothing is cleaned up on failure: the volume and the NIC are named after the instance, so the
+// next Scale creating it updates and reuses them.
+func (m *Manager) create(ctx context.Context, group *Group, name string, zone schema.Zone, subnet *zoneSubnet) error {
+	labels := group.labels()
+
+	volume, err := builders.NewBlockStorageBuilder().
+		Name(name + "-boot").Tenant(group.Tenant).Workspace(group.Workspace).Region(group.Region).Labels(labels).
+		Sku(group.Template.StorageSku).SizeGB(group.Template.BootSizeGB).SourceImage(group.Template.Image).
+		Build()
+	if err != nil {
+		return err
+	}
+	if _, err = m.storage.CreateOrUpdateBlockStorage(ctx, volume); err != nil {
+		return err
+	}
+
+	wref := secapi.WorkspaceReference{Tenant: secapi.TenantID(group.Tenant), Workspace: secapi.WorkspaceID(group.Workspace), Name: volume.Metadata.Name}
+	observer := m.observer()
+	ready, err := m.storage.GetBlockStorageUntilState(ctx, wref, secapi.ResourceObserverUntilValueConfig[schema.ResourceState]{
+		ExpectedValues: []schema.ResourceState{schema.ResourceStateActive, schema.ResourceStateError},
+		Delay:          observer.Delay,
+		Interval:       observer.Interval,
+		MaxAttempts:    observer.MaxAttempts,
+	})
+	if err != nil {
+		return err
+	}
+	if ready.Status == nil || ready.Status.State != schema.ResourceStateActive {
+		return fmt.Errorf("%w: %s", ErrVolumeNotReady, volume.Metadata.Name)
+	}
+
+	addresses, err := subnet.planner.Allocate(name + "-nic")
+	if err != nil {
+		return err
+	}
+	nicBuilder := builders.NewNicBuilder().
+		Name(name + "-nic").Tenant(group.Tenant).Workspace(group.Workspace).Region(group.Region).Labels(labels).
+		Subnet(builders.Ref(subnet.subnet)).Address(addresses...)
+	if group.Template.SecurityGroup != nil {
+		nicBuilder.SecurityGroup(*group.Template.SecurityGroup)
+	}
+	nic, err := nicBuilder.Build()
+	if err != nil {
+		return err
+	}
+	if _, err = m.network.CreateOrUpdateNic(ctx, nic); err != nil {
+		return err
+	}
+
+	instBuilder := builders.NewInstanceBuilder().
+		Name(name).Tenant(group.Tenant).Workspace(group.Workspace).Region(group.Region).Labels(labels).
+		Sku(group.Template.Sku).Zone(zone).AntiAffinityGroup(group.Name).
+		BootVolume(builders.Ref(volume)).Nic(builders.Ref(nic)).
+		SshKey(group.Template.SshKeys...).UserData(group.Template.UserData)
+	if group.Template.SecurityGroup != nil {
+		instBuilder.SecurityGroup(*group.Template.SecurityGroup)
+	}
+	inst, err := instBuilder.Build()
+	if err != nil {
+		return err
+	}
+	_, err = m.compute.CreateOrUpdateInstance(ctx, inst)
+	return err
+}
+
+// Deletes the instance then, once it's deleted, its boot volume and its primary NIC
+func (m *Manager) delete(ctx context.Context, inst *schema.Instance) error {
+	if err := m.compute.DeleteInstance(ctx, inst); err != nil {
+		return err
+	}
+
+	wref := secapi.WorkspaceReference{Tenant: secapi.TenantID(inst.Metadata.Tenant), Workspace: secapi.WorkspaceID(inst.Metadata.Workspace), Name: inst.Metadata.Name}
+	if err := m.compute.WatchInstanceUntilDeleted(ctx, wref, m.observer()); err != nil {
+		return err
+	}
+
+	metadata := func(ref schema.Reference) *schema.RegionalWorkspaceResourceMetadata {
+		return &schema.RegionalWorkspaceResourceMetadata{
+			Name:      refName(ref),
+			Tenant:    inst.Metadata.Tenant,
+			Workspace: inst.Metadata.Workspace,
+			Region:    inst.Metadata.Region,
+		}
+	}
+
+	if ref := inst.Spec.BootVolume.DeviceRef; ref.Resource != "" {
+		if err := m.storage.DeleteBlockStorage(ctx, &schema.BlockStorage{Metadata: metadata(ref)}); err != nil && !errors.Is(err, secapi.ErrResourceNotFound) {
+			return err
+		}
+	}
+	if ref := inst.Spec.PrimaryNicRef; ref != nil {
+		if err := m.network.DeleteNic(ctx, &schema.Nic{Metadata: metadata(*ref)}); err != nil && !errors.Is(err, secapi.ErrResourceNotFound) {
+			return err
+		}
+	}
+	return nil
+}
+
+// Returns the observer configuration with its zero fields taken from DefaultObserver
+func (m *Manager) observer() secapi.ResourceObserverConfig {
+	observer := m.Observer
+	if observer.Delay <= 0 {
+		observer.Delay = DefaultObserver.Delay
+	}
+	if observer.Interval <= 0 {
+		observer.Interval = DefaultObserver.Interval
+	}
+	if observer.MaxAttempts <= 0 {
+		observer.MaxAttempts = DefaultObserver.MaxAttempts
+	}
+	return observer
+}
+
+// Subnet of a zone and the planner of its addresses
+type zoneSubnet struct {
+	subnet  *schema.Subnet
+	planner *ipam.AddressPlanner
+}
+
+// Returns the first subnet, by name, of each zone of the group
+func (m *Manager) loadSubnets(ctx context.Context, group *Group) (map[schema.Zone]*zoneSubnet, error) {
+	subIter, err := m.network.ListSubnets(ctx, secapi.NetworkPath{Tenant: secapi.TenantID(group.Tenant), Workspace: secapi.WorkspaceID(group.Workspace), Network: secapi.NetworkID(group.Network)})
+	if err != nil {
+		return nil, err
+	}
+	subnets, err := subIter.All(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	nicIter, err := m.network.ListNics(ctx, group.workspacePath())
+	if err != nil {
+		return nil, err
+	}
+	nics, err := nicIter.All(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	slices.SortFunc(subnets, func(a, b *schema.Subnet) int {
+		return strings.Compare(a.Metadata.Name, b.Metadata.Name)
+	})
+
+	zoneSubnets := make(map[schema.Zone]*zoneSubnet, len(group.Zones))
+	for _, zone := range group.Zones {
+		i := slices.IndexFunc(subnets, func(sub *schema.Subnet) bool { return sub.Spec.Zone == zone })
+		if i < 0 {
+			return nil, fmt.Errorf("%w: %s", ErrNoZoneSubnet, zone)
+		}
+		planner, err := ipam.NewAddressPlanner(subnets[i], nics)
+		if err != nil {
+			return nil, err
+		}
+		zoneSubnets[zone] = &zoneSubnet{subnet: subnets[i], planner: planner}
+	}
+	return zoneSubnets, nil
+}
+
+func (g *Group) workspacePath() secapi.WorkspacePath {
+	return secapi.WorkspacePath{Tenant: secapi.TenantID(g.Tenant), Workspace: secapi.WorkspaceID(g.Workspace)}
+}
+
+func (g *Group) labels() schema.Labels {
+	labels := make(schema.Labels, len(g.Template.Labels)+1)
+	for key, value := range g.Template.Labels {
+		labels[key] = value
+	}
+	labels[GroupLabel] = g.Name
+	return labels
+}
+
+// Returns the index of an instance named after the group, -1 for any other name
+func (g *Group) indexOf(inst *schema.Instance) int {
+	suffix, found := strings.CutPrefix(inst.Metadata.Name, g.Name+"-")
+	if !found {
+		return -1
+	}
+	index, err := strconv.Atoi(suffix)
+	if err != nil || index < 0 {
+		return -1
+	}
+	return index
+}
+
+func (g *Group) zoneCounts(instances []*schema.Instance) zoneCounts {
+	counts := make(zoneCounts, len(g.Zones))
+	for _, zone := range g.Zones {
+		counts[zone] = 0
+	}
+	for _, inst := range instances {
+		counts[inst.Spec.Zone]++
+	}
+	return counts
+}
+
+// Instances count by zone
+type zoneCounts map[schema.Zone]int
+
+// Returns the group zone with the fewest instances, the first one between equal counts
+func (c zoneCounts) least(zones []schema.Zone) schema.Zone {
+	least := zones[0]
+	for _, zone := range zones[1:] {
+		if c[zone] < c[least] {
+			least = zone
+		}
+	}
+	return least
+}
+
+// Returns a zone outside of the group zones having instances, otherwise the group zone
+// with the most instances, the last one between equal counts
+func (c zoneCounts) most(zones []schema.Zone) schema.Zone {
+	var outside []schema.Zone
+	for zone, count := range c {
+		if count > 0 && !slices.Contains(zones, zone) {
+			outside = append(outside, zone)
+		}
+	}
+	if len(outside) > 0 {
+		return slices.Min(outside)
+	}
+
+	most := zones[0]
+	for _, zone := range zones[1:] {
+		if c[zone] >= c[most] {
+			most = zone
+		}
+	}
+	return most
+}
+
+func refName(ref schema.Reference) string {
+	return ref.Resource[strings.LastIndex(ref.Resource, "/")+1:]
+}
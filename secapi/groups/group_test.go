@@ -0,0 +1,283 @@
+package groups
+
+import (
+	"context"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/eu-sovereign-cloud/go-sdk/internal/secatest"
+	"github.com/eu-sovereign-cloud/go-sdk/internal/secatest/clients"
+	mocksecapi "github.com/eu-sovereign-cloud/go-sdk/mock/secapi"
+	"github.com/eu-sovereign-cloud/go-sdk/pkg/spec/schema"
+	"github.com/eu-sovereign-cloud/go-sdk/secapi"
+	"github.com/eu-sovereign-cloud/go-sdk/secapi/fake"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+const groupName = "web"
+
+func TestManager_ScaleUp(t *testing.T) {
+	ctx := context.Background()
+	group := buildGroup()
+
+	compute := mocksecapi.NewMockComputeV1(t)
+	storage := mocksecapi.NewMockStorageV1(t)
+	network := mocksecapi.NewMockNetworkV1(t)
+
+	expectInstances(compute, buildInstance(groupName+"-0", secatest.ZoneA))
+	network.EXPECT().ListSubnets(mock.Anything, secapi.NetworkPath{Tenant: secatest.Tenant1Name, Workspace: secatest.Workspace1Name, Network: secatest.Network1Name}).
+		Return(secapi.NewIterator(secatest.Page(
+			buildSubnet("subnet-b", secatest.ZoneB, "10.0.2.0/24"),
+			buildSubnet("subnet-a", secatest.ZoneA, "10.0.1.0/24"),
+		)), nil)
+	network.EXPECT().ListNics(mock.Anything, secapi.WorkspacePath{Tenant: secatest.Tenant1Name, Workspace: secatest.Workspace1Name}).
+		Return(secapi.NewIterator(secatest.Page(buildNic(groupName+"-0-nic", "subnet-a", "10.0.1.2"))), nil)
+
+	var volumes []*schema.BlockStorage
+	storage.EXPECT().CreateOrUpdateBlockStorage(mock.Anything, mock.Anything).
+		RunAndReturn(func(ctx context.Context, block *schema.BlockStorage) (*schema.BlockStorage, error) {
+			volumes = append(volumes, block)
+			return block, nil
+		}).Twice()
+	var waited []string
+	storage.EXPECT().GetBlockStorageUntilState(mock.Anything, mock.Anything, mock.Anything).
+		RunAndReturn(func(ctx context.Context, wref secapi.WorkspaceReference, config secapi.ResourceObserverUntilValueConfig[schema.ResourceState]) (*schema.BlockStorage, error) {
+			waited = append(waited, wref.Name)
+			return &schema.BlockStorage{Status: &schema.BlockStorageStatus{State: schema.ResourceStateActive}}, nil
+		}).Twice()
+
+	var nics []*schema.Nic
+	network.EXPECT().CreateOrUpdateNic(mock.Anything, mock.Anything).
+		RunAndReturn(func(ctx context.Context, nic *schema.Nic) (*schema.Nic, error) {
+			nics = append(nics, nic)
+			return nic, nil
+		}).Twice()
+
+	var instances []*schema.Instance
+	compute.EXPECT().CreateOrUpdateInstance(mock.Anything, mock.Anything).
+		RunAndReturn(func(ctx context.Context, inst *schema.Instance) (*schema.Instance, error) {
+			instances = append(instances, inst)
+			return inst, nil
+		}).Twice()
+
+	result, err := NewManager(compute, storage, network).Scale(ctx, group, 3)
+	require.NoError(t, err)
+	assert.Equal(t, []string{groupName + "-1", groupName + "-2"}, result.Created)
+	assert.Empty(t, result.Deleted)
+
+	require.Len(t, volumes, 2)
+	assert.Equal(t, groupName+"-1-boot", volumes[0].Metadata.Name)
+	assert.Equal(t, 20, volumes[0].Spec.SizeGB)
+	assert.Equal(t, "images/"+secatest.Image1Name, volumes[0].Spec.SourceImageRef.Resource)
+	assert.Equal(t, groupName, volumes[0].Labels[GroupLabel])
+	assert.Equal(t, []string{groupName + "-1-boot", groupName + "-2-boot"}, waited)
+
+	require.Len(t, nics, 2)
+	assert.Equal(t, "subnets/subnet-b", nics[0].Spec.SubnetRef.Resource)
+	assert.Equal(t, []schema.NicIp{"10.0.2.2"}, nics[0].Spec.Addresses)
+	assert.Equal(t, "subnets/subnet-a", nics[1].Spec.SubnetRef.Resource)
+	assert.Equal(t, []schema.NicIp{"10.0.1.3"}, nics[1].Spec.Addresses)
+
+	require.Len(t, instances, 2)
+	assert.Equal(t, secatest.ZoneB, instances[0].Spec.Zone)
+	assert.Equal(t, secatest.ZoneA, instances[1].Spec.Zone)
+	assert.Equal(t, groupName, instances[0].Spec.AntiAffinityGroup)
+	assert.Equal(t, "block-storages/"+groupName+"-1-boot", instances[0].Spec.BootVolume.DeviceRef.Resource)
+	assert.Equal(t, "nics/"+groupName+"-1-nic", instances[0].Spec.PrimaryNicRef.Resource)
+	assert.Equal(t, secatest.InstanceSku1Ref, instances[0].Spec.SkuRef.Resource)
+	assert.Equal(t, secatest.LabelEnvValue, instances[0].Labels[secatest.LabelEnvKey])
+}
+
+func TestManager_ScaleDown(t *testing.T) {
+	ctx := context.Background()
+	group := buildGroup()
+
+	compute := mocksecapi.NewMockComputeV1(t)
+	storage := mocksecapi.NewMockStorageV1(t)
+	network := mocksecapi.NewMockNetworkV1(t)
+
+	expectInstances(compute,
+		buildInstance(groupName+"-0", secatest.ZoneA),
+		buildInstance(groupName+"-1", secatest.ZoneB),
+		buildInstance(groupName+"-2", secatest.ZoneA),
+		buildInstance(groupName+"-3", "c"),
+		buildInstance("other", secatest.ZoneA),
+	)
+
+	var deleted []string
+	compute.EXPECT().DeleteInstance(mock.Anything, mock.Anything).
+		RunAndReturn(func(ctx context.Context, inst *schema.Instance) error {
+			deleted = append(deleted, inst.Metadata.Name)
+			return nil
+		}).Twice()
+	compute.EXPECT().WatchInstanceUntilDeleted(mock.Anything, mock.Anything, mock.Anything).Return(nil).Twice()
+	storage.EXPECT().DeleteBlockStorage(mock.Anything, mock.MatchedBy(func(block *schema.BlockStorage) bool {
+		return block.Metadata.Name == groupName+"-3-boot" || block.Metadata.Name == groupName+"-2-boot"
+	})).Return(nil).Twice()
+	network.EXPECT().DeleteNic(mock.Anything, mock.MatchedBy(func(nic *schema.Nic) bool {
+		return nic.Metadata.Name == groupName+"-3-nic" || nic.Metadata.Name == groupName+"-2-nic"
+	})).Return(secapi.ErrResourceNotFound).Twice()
+
+	result, err := NewManager(compute, storage, network).Scale(ctx, group, 2)
+	require.NoError(t, err)
+	assert.Equal(t, []string{groupName + "-3", groupName + "-2"}, result.Deleted)
+	assert.Equal(t, result.Deleted, deleted)
+	assert.Empty(t, result.Created)
+}
+
+func TestManager_ScaleErrors(t *testing.T) {
+	ctx := context.Background()
+	manager := NewManager(mocksecapi.NewMockComputeV1(t), mocksecapi.NewMockStorageV1(t), mocksecapi.NewMockNetworkV1(t))
+
+	_, err := manager.Scale(ctx, buildGroup(), -1)
+	assert.ErrorIs(t, err, ErrInvalidCount)
+
+	group := buildGroup()
+	group.Zones = nil
+	_, err = manager.Scale(ctx, group, 1)
+	assert.ErrorIs(t, err, ErrNoZones)
+}
+
+func TestManager_ScaleNoZoneSubnet(t *testing.T) {
+	compute := mocksecapi.NewMockComputeV1(t)
+	network := mocksecapi.NewMockNetworkV1(t)
+
+	expectInstances(compute)
+	network.EXPECT().ListSubnets(mock.Anything, mock.Anything).Return(secapi.NewIterator(secatest.Page(buildSubnet("subnet-a", secatest.ZoneA, "10.0.1.0/24"))), nil)
+	network.EXPECT().ListNics(mock.Anything, mock.Anything).Return(secapi.NewIterator(secatest.Page[schema.Nic]()), nil)
+
+	_, err := NewManager(compute, mocksecapi.NewMockStorageV1(t), network).Scale(context.Background(), buildGroup(), 1)
+	assert.ErrorIs(t, err, ErrNoZoneSubnet)
+}
+
+func TestManager_ScaleVolumeNotReady(t *testing.T) {
+	compute := mocksecapi.NewMockComputeV1(t)
+	storage := mocksecapi.NewMockStorageV1(t)
+	network := mocksecapi.NewMockNetworkV1(t)
+
+	expectInstances(compute)
+	network.EXPECT().ListSubnets(mock.Anything, mock.Anything).Return(secapi.NewIterator(secatest.Page(buildSubnet("subnet-a", secatest.ZoneA, "10.0.1.0/24"))), nil)
+	network.EXPECT().ListNics(mock.Anything, mock.Anything).Return(secapi.NewIterator(secatest.Page[schema.Nic]()), nil)
+	storage.EXPECT().CreateOrUpdateBlockStorage(mock.Anything, mock.Anything).Return(&schema.BlockStorage{}, nil).Once()
+	storage.EXPECT().GetBlockStorageUntilState(mock.Anything, mock.Anything, mock.Anything).
+		Return(&schema.BlockStorage{Status: &schema.BlockStorageStatus{State: schema.ResourceStateError}}, nil).Once()
+
+	group := buildGroup()
+	group.Zones = []schema.Zone{secatest.ZoneA}
+	_, err := NewManager(compute, storage, network).Scale(context.Background(), group, 1)
+	assert.ErrorIs(t, err, ErrVolumeNotReady)
+}
+
+func TestManager_ScaleFake(t *testing.T) {
+	ctx := context.Background()
+
+	// The zero observer of the manager waits as the default one, faster for the test
+	defaultObserver := DefaultObserver
+	DefaultObserver = secapi.ResourceObserverConfig{Delay: time.Millisecond, Interval: 5 * time.Millisecond, MaxAttempts: 100}
+	t.Cleanup(func() { DefaultObserver = defaultObserver })
+
+	fakeServer := fake.NewServer(&fake.Config{Schedule: fake.Schedule{Creating: 20 * time.Millisecond, Deleting: 20 * time.Millisecond}})
+	server := httptest.NewServer(fakeServer.Handler())
+	defer server.Close()
+
+	_, regional := clients.New(t, ctx, secapi.GlobalConfig{Endpoints: fakeServer.Endpoints(server.URL)})
+	for _, subnet := range []*schema.Subnet{
+		buildSubnet("subnet-a", secatest.ZoneA, "10.0.1.0/24"),
+		buildSubnet("subnet-b", secatest.ZoneB, "10.0.2.0/24"),
+	} {
+		_, err := regional.NetworkV1.CreateOrUpdateSubnet(ctx, subnet)
+		require.NoError(t, err)
+	}
+
+	manager := NewManager(regional.ComputeV1, regional.StorageV1, regional.NetworkV1)
+	group := buildGroup()
+
+	result, err := manager.Scale(ctx, group, 2)
+	require.NoError(t, err)
+	assert.Equal(t, []string{groupName + "-0", groupName + "-1"}, result.Created)
+
+	wref := secapi.WorkspaceReference{Tenant: secatest.Tenant1Name, Workspace: secatest.Workspace1Name, Name: groupName + "-0-boot"}
+	volume, err := regional.StorageV1.GetBlockStorage(ctx, wref)
+	require.NoError(t, err)
+	assert.Equal(t, schema.ResourceStateActive, volume.Status.State)
+
+	// The boot volume and the NIC are deleted once the instance is
+	result, err = manager.Scale(ctx, group, 1)
+	require.NoError(t, err)
+	assert.Equal(t, []string{groupName + "-1"}, result.Deleted)
+
+	_, err = regional.ComputeV1.GetInstance(ctx, secapi.WorkspaceReference{Tenant: secatest.Tenant1Name, Workspace: secatest.Workspace1Name, Name: groupName + "-1"})
+	assert.ErrorIs(t, err, secapi.ErrResourceNotFound)
+	wref.Name = groupName + "-1-boot"
+	require.NoError(t, regional.StorageV1.WatchBlockStorageUntilDeleted(ctx, wref, DefaultObserver))
+	wref.Name = groupName + "-1-nic"
+	require.NoError(t, regional.NetworkV1.WatchNicUntilDeleted(ctx, wref, DefaultObserver))
+
+	instances, err := manager.Instances(ctx, group)
+	require.NoError(t, err)
+	require.Len(t, instances, 1)
+	assert.Equal(t, groupName+"-0", instances[0].Metadata.Name)
+}
+
+func TestZonesOf(t *testing.T) {
+	region := &schema.Region{Spec: schema.RegionSpec{AvailableZones: []schema.Zone{secatest.ZoneA, secatest.ZoneB}}}
+	assert.Equal(t, []schema.Zone{secatest.ZoneA, secatest.ZoneB}, ZonesOf(region))
+	assert.Nil(t, ZonesOf(nil))
+}
+
+func buildGroup() *Group {
+	return &Group{
+		Name:      groupName,
+		Tenant:    secatest.Tenant1Name,
+		Workspace: secatest.Workspace1Name,
+		Region:    secatest.Region1Name,
+		Network:   secatest.Network1Name,
+		Zones:     []schema.Zone{secatest.ZoneA, secatest.ZoneB},
+		Template: Template{
+			Sku:        schema.Reference{Resource: secatest.InstanceSku1Ref},
+			Image:      schema.Reference{Resource: "images/" + secatest.Image1Name},
+			StorageSku: schema.Reference{Resource: "skus/" + secatest.StorageSku1Name},
+			BootSizeGB: 20,
+			Labels:     schema.Labels{secatest.LabelEnvKey: secatest.LabelEnvValue},
+		},
+	}
+}
+
+func expectInstances(compute *mocksecapi.MockComputeV1, instances ...*schema.Instance) {
+	compute.EXPECT().ListInstancesWithOptions(mock.Anything, secapi.WorkspacePath{Tenant: secatest.Tenant1Name, Workspace: secatest.Workspace1Name}, mock.Anything).
+		Return(secapi.NewIterator(secatest.Page(instances...)), nil)
+}
+
+func buildInstance(name string, zone schema.Zone) *schema.Instance {
+	labels := schema.Labels{GroupLabel: groupName}
+	if name == "other" {
+		labels = nil
+	}
+	return &schema.Instance{
+		Metadata: secatest.NewRegionalWorkspaceResourceMetadata(name, secatest.Tenant1Name, secatest.Workspace1Name, secatest.Region1Name),
+		Labels:   labels,
+		Spec: schema.InstanceSpec{
+			Zone:          zone,
+			BootVolume:    schema.VolumeReference{DeviceRef: schema.Reference{Resource: "block-storages/" + name + "-boot"}},
+			PrimaryNicRef: &schema.Reference{Resource: "nics/" + name + "-nic"},
+		},
+	}
+}
+
+func buildSubnet(name string, zone schema.Zone, cidr string) *schema.Subnet {
+	return &schema.Subnet{
+		Metadata: secatest.NewRegionalNetworkResourceMetadata(name, secatest.Tenant1Name, secatest.Workspace1Name, secatest.Network1Name, secatest.Region1Name),
+		Spec:     schema.SubnetSpec{Zone: zone, Cidr: schema.Cidr{Ipv4: cidr}},
+	}
+}
+
+func buildNic(name, subnet string, addresses ...schema.NicIp) *schema.Nic {
+	return &schema.Nic{
+		Metadata: secatest.NewRegionalWorkspaceResourceMetadata(name, secatest.Tenant1Name, secatest.Workspace1Name, secatest.Region1Name),
+		Spec:     schema.NicSpec{Addresses: addresses, SubnetRef: schema.Reference{Resource: "subnets/" + subnet}},
+	}
+}
@@ -4,6 +4,11 @@ import (
 	"net/http"
 )
 
+// HttpRequestDoer performs HTTP requests, as the http.Client, it is accepted by all the generated clients.
+type HttpRequestDoer interface {
+	Do(req *http.Request) (*http.Response, error)
+}
+
 func checkStatusCode(code int, alloweds ...int) bool {
 	for _, allowed := range alloweds {
 		if code == allowed {
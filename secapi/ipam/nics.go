@@ -0,0 +1,192 @@
+package ipam
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/netip"
+	"strings"
+
+	"github.com/eu-sovereign-cloud/go-sdk/pkg/spec/schema"
+	"github.com/eu-sovereign-cloud/go-sdk/secapi"
+)
+
+var (
+	ErrNoFreeAddress = errors.New("no free address in the subnet")
+	ErrNoSubnetCidr  = errors.New("subnet has no cidr")
+)
+
+// AddressPlanner allocates the addresses of the NICs of a subnet, avoiding the addresses
+// of the NICs attached to it and the reserved ones: the network and the gateway addresses,
+// the first two of each range, and the broadcast address, the last of an IPv4 range.
+// The allocations are kept by the planner, so the next ones never collide with them.
+type AddressPlanner struct {
+	subnet   *schema.Subnet
+	prefixes []netip.Prefix
+	used     map[netip.Addr]string
+}
+
+// NewAddressPlanner creates a planner of the subnet, the NICs not attached to it are skipped. The CIDR
+// blocks of the subnet are read from its status or, when empty, its spec, the addresses of the NICs from
+// their status or, when empty, their spec.
+func NewAddressPlanner(sub *schema.Subnet, nics []*schema.Nic) (*AddressPlanner, error) {
+	if sub == nil || sub.Metadata == nil {
+		return nil, fmt.Errorf("subnet is required to plan its addresses")
+	}
+
+	cidr := sub.Spec.Cidr
+	if sub.Status != nil && sub.Status.Cidr != nil && *sub.Status.Cidr != (schema.Cidr{}) {
+		cidr = *sub.Status.Cidr
+	}
+	prefixes, err := parseCidr(cidr)
+	if err != nil {
+		return nil, fmt.Errorf("subnet %s: %w", sub.Metadata.Name, err)
+	}
+	if len(prefixes) == 0 {
+		return nil, fmt.Errorf("%w: %s", ErrNoSubnetCidr, sub.Metadata.Name)
+	}
+
+	p := &AddressPlanner{subnet: sub, prefixes: prefixes, used: map[netip.Addr]string{}}
+	for _, nic := range nics {
+		if !attached(nic, sub) {
+			continue
+		}
+
+		addresses := nic.Spec.Addresses
+		if nic.Status != nil && len(nic.Status.Addresses) > 0 {
+			addresses = nic.Status.Addresses
+		}
+		for _, address := range addresses {
+			addr, err := netip.ParseAddr(address)
+			if err != nil {
+				return nil, fmt.Errorf("nic %s: %w", nicName(nic), err)
+			}
+			p.used[addr] = nicName(nic)
+		}
+	}
+
+	return p, nil
+}
+
+// LoadAddressPlanner creates a planner of a subnet and the NICs of its workspace listed from the provider.
+func LoadAddressPlanner(ctx context.Context, api secapi.NetworkV1, nref secapi.NetworkReference) (*AddressPlanner, error) {
+	sub, err := api.GetSubnet(ctx, nref)
+	if err != nil {
+		return nil, err
+	}
+
+	iter, err := api.ListNics(ctx, secapi.WorkspacePath{Tenant: nref.Tenant, Workspace: nref.Workspace})
+	if err != nil {
+		return nil, err
+	}
+	nics, err := iter.All(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return NewAddressPlanner(sub, nics)
+}
+
+// Allocate returns a free address of each requested family, of each family of the subnet when none
+// is requested, and keeps them allocated to the NIC of the given name.
+func (p *AddressPlanner) Allocate(name string, families ...Family) ([]schema.NicIp, error) {
+	if len(families) == 0 {
+		for _, prefix := range p.prefixes {
+			families = append(families, familyOf(prefix))
+		}
+	}
+
+	// Each address is used as soon as it's found, so that a repeated family gets another one, and
+	// all of them are released when one of the families has no free address.
+	var addresses []netip.Addr
+	for _, family := range families {
+		addr, err := p.free(family)
+		if err != nil {
+			for _, addr := range addresses {
+				delete(p.used, addr)
+			}
+			return nil, err
+		}
+		p.used[addr] = name
+		addresses = append(addresses, addr)
+	}
+
+	allocated := make([]schema.NicIp, 0, len(addresses))
+	for _, addr := range addresses {
+		allocated = append(allocated, addr.String())
+	}
+	return allocated, nil
+}
+
+// Nic returns a NIC of the given name attached to the subnet, in its workspace, with
+// free addresses allocated as by Allocate. The NIC is ready to be created.
+func (p *AddressPlanner) Nic(name string, families ...Family) (*schema.Nic, error) {
+	addresses, err := p.Allocate(name, families...)
+	if err != nil {
+		return nil, err
+	}
+
+	return &schema.Nic{
+		Metadata: &schema.RegionalWorkspaceResourceMetadata{
+			Name:      name,
+			Tenant:    p.subnet.Metadata.Tenant,
+			Workspace: p.subnet.Metadata.Workspace,
+			Region:    p.subnet.Metadata.Region,
+		},
+		Spec: schema.NicSpec{
+			Addresses: addresses,
+			SubnetRef: schema.Reference{Resource: "subnets/" + p.subnet.Metadata.Name},
+		},
+	}, nil
+}
+
+// Used returns the name of the NIC using an address, false when the address is free.
+func (p *AddressPlanner) Used(addr netip.Addr) (string, bool) {
+	name, found := p.used[addr]
+	return name, found
+}
+
+// Returns the first free address of the family, skipping the reserved ones
+func (p *AddressPlanner) free(family Family) (netip.Addr, error) {
+	for _, prefix := range p.prefixes {
+		if familyOf(prefix) != family {
+			continue
+		}
+
+		// Network and gateway addresses
+		addr := prefix.Addr().Next().Next()
+		last := lastAddr(prefix)
+		if family == IPv4 {
+			// Broadcast address
+			last = last.Prev()
+		}
+
+		for ; addr.IsValid() && addr.Compare(last) <= 0; addr = addr.Next() {
+			if _, found := p.used[addr]; !found {
+				return addr, nil
+			}
+		}
+		return netip.Addr{}, fmt.Errorf("%w: %s", ErrNoFreeAddress, prefix)
+	}
+
+	return netip.Addr{}, fmt.Errorf("%w: %s", ErrNoFamily, family)
+}
+
+// Reports whether the NIC references the subnet, by its name and its network when given
+func attached(nic *schema.Nic, sub *schema.Subnet) bool {
+	parts := strings.Split(strings.Trim(nic.Spec.SubnetRef.Resource, "/"), "/")
+	if len(parts) < 2 || parts[len(parts)-2] != "subnets" || parts[len(parts)-1] != sub.Metadata.Name {
+		return false
+	}
+	if len(parts) >= 4 && parts[len(parts)-4] == "networks" {
+		return parts[len(parts)-3] == sub.Metadata.Network
+	}
+	return true
+}
+
+func nicName(nic *schema.Nic) string {
+	if nic.Metadata == nil {
+		return ""
+	}
+	return nic.Metadata.Name
+}
@@ -0,0 +1,130 @@
+package ipam
+
+import (
+	"context"
+	"net/netip"
+	"testing"
+
+	"github.com/eu-sovereign-cloud/go-sdk/internal/secatest"
+	mocksecapi "github.com/eu-sovereign-cloud/go-sdk/mock/secapi"
+	"github.com/eu-sovereign-cloud/go-sdk/pkg/spec/schema"
+	"github.com/eu-sovereign-cloud/go-sdk/secapi"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestAddressPlanner_Allocate(t *testing.T) {
+	sub := buildSubnet(secatest.Subnet1Name, schema.Cidr{})
+	sub.Status = &schema.SubnetStatus{Cidr: &schema.Cidr{Ipv4: "10.0.1.0/24", Ipv6: "fd00:0:0:1::/64"}}
+
+	planner, err := NewAddressPlanner(sub, []*schema.Nic{
+		buildNic("nic-1", secatest.Subnet1Ref, []string{"10.0.1.2"}, []string{"10.0.1.2", "fd00:0:0:1::2"}),
+		buildNic("nic-2", "subnets/subnet-2", nil, []string{"10.0.1.3"}),
+		buildNic("nic-3", secatest.Subnet1Ref, []string{"10.0.1.3"}, nil),
+		buildNic("nic-4", "networks/"+secatest.Network1Name+"/"+secatest.Subnet1Ref, []string{"10.0.1.5"}, nil),
+		buildNic("nic-5", "networks/network-2/"+secatest.Subnet1Ref, []string{"10.0.1.4"}, nil),
+	})
+	require.NoError(t, err)
+
+	name, used := planner.Used(netip.MustParseAddr("fd00:0:0:1::2"))
+	assert.True(t, used)
+	assert.Equal(t, "nic-1", name)
+
+	addresses, err := planner.Allocate("nic-6")
+	require.NoError(t, err)
+	assert.Equal(t, []schema.NicIp{"10.0.1.4", "fd00:0:0:1::3"}, addresses)
+
+	addresses, err = planner.Allocate("nic-7", IPv4)
+	require.NoError(t, err)
+	assert.Equal(t, []schema.NicIp{"10.0.1.6"}, addresses)
+
+	nic, err := planner.Nic("nic-8", IPv6)
+	require.NoError(t, err)
+	assert.Equal(t, "nic-8", nic.Metadata.Name)
+	assert.Equal(t, secatest.Tenant1Name, nic.Metadata.Tenant)
+	assert.Equal(t, secatest.Workspace1Name, nic.Metadata.Workspace)
+	assert.Equal(t, []schema.NicIp{"fd00:0:0:1::4"}, nic.Spec.Addresses)
+	assert.Equal(t, secatest.Subnet1Ref, nic.Spec.SubnetRef.Resource)
+}
+
+func TestAddressPlanner_AllocateFull(t *testing.T) {
+	planner, err := NewAddressPlanner(buildSubnet(secatest.Subnet1Name, schema.Cidr{Ipv4: "10.0.1.0/29"}), nil)
+	require.NoError(t, err)
+
+	for _, expected := range []string{"10.0.1.2", "10.0.1.3", "10.0.1.4", "10.0.1.5", "10.0.1.6"} {
+		addresses, err := planner.Allocate("nic")
+		require.NoError(t, err)
+		assert.Equal(t, []schema.NicIp{expected}, addresses)
+	}
+
+	_, err = planner.Allocate("nic")
+	assert.ErrorIs(t, err, ErrNoFreeAddress)
+
+	_, err = planner.Allocate("nic", IPv6)
+	assert.ErrorIs(t, err, ErrNoFamily)
+}
+
+func TestAddressPlanner_AllocateRepeatedFamily(t *testing.T) {
+	planner, err := NewAddressPlanner(buildSubnet(secatest.Subnet1Name, schema.Cidr{Ipv4: "10.0.1.0/29"}), nil)
+	require.NoError(t, err)
+
+	addresses, err := planner.Allocate("nic-1", IPv4, IPv4)
+	require.NoError(t, err)
+	assert.Equal(t, []schema.NicIp{"10.0.1.2", "10.0.1.3"}, addresses)
+
+	// The addresses found before a family without free ones are released
+	_, err = planner.Allocate("nic-2", IPv4, IPv4, IPv4, IPv4)
+	assert.ErrorIs(t, err, ErrNoFreeAddress)
+
+	_, used := planner.Used(netip.MustParseAddr("10.0.1.4"))
+	assert.False(t, used)
+
+	addresses, err = planner.Allocate("nic-3", IPv4)
+	require.NoError(t, err)
+	assert.Equal(t, []schema.NicIp{"10.0.1.4"}, addresses)
+}
+
+func TestAddressPlanner_Errors(t *testing.T) {
+	_, err := NewAddressPlanner(nil, nil)
+	assert.Error(t, err)
+
+	_, err = NewAddressPlanner(buildSubnet(secatest.Subnet1Name, schema.Cidr{}), nil)
+	assert.ErrorIs(t, err, ErrNoSubnetCidr)
+
+	_, err = NewAddressPlanner(buildSubnet(secatest.Subnet1Name, schema.Cidr{Ipv4: "10.0.1.0/24"}), []*schema.Nic{
+		buildNic("nic-1", secatest.Subnet1Ref, []string{"10.0.1"}, nil),
+	})
+	assert.Error(t, err)
+}
+
+func TestLoadAddressPlanner(t *testing.T) {
+	ctx := context.Background()
+	nref := secapi.NetworkReference{Tenant: secatest.Tenant1Name, Workspace: secatest.Workspace1Name, Network: secatest.Network1Name, Name: secatest.Subnet1Name}
+
+	api := mocksecapi.NewMockNetworkV1(t)
+	api.EXPECT().GetSubnet(mock.Anything, nref).Return(buildSubnet(secatest.Subnet1Name, schema.Cidr{Ipv4: "10.0.1.0/24"}), nil)
+	api.EXPECT().ListNics(mock.Anything, secapi.WorkspacePath{Tenant: secatest.Tenant1Name, Workspace: secatest.Workspace1Name}).
+		Return(secapi.NewIterator(func(ctx context.Context, skipToken *string) ([]schema.Nic, *schema.ResponseMetadata, error) {
+			return []schema.Nic{*buildNic("nic-1", secatest.Subnet1Ref, nil, []string{"10.0.1.2"})}, &schema.ResponseMetadata{}, nil
+		}), nil)
+
+	planner, err := LoadAddressPlanner(ctx, api, nref)
+	require.NoError(t, err)
+
+	addresses, err := planner.Allocate("nic-2")
+	require.NoError(t, err)
+	assert.Equal(t, []schema.NicIp{"10.0.1.3"}, addresses)
+}
+
+func buildNic(name string, subnetRef string, spec []string, status []string) *schema.Nic {
+	nic := &schema.Nic{
+		Metadata: secatest.NewRegionalWorkspaceResourceMetadata(name, secatest.Tenant1Name, secatest.Workspace1Name, secatest.Region1Name),
+		Spec:     schema.NicSpec{Addresses: spec, SubnetRef: schema.Reference{Resource: subnetRef}},
+	}
+	if status != nil {
+		nic.Status = &schema.NicStatus{Addresses: status}
+	}
+	return nic
+}
@@ -0,0 +1,59 @@
+package ipam
+
+import (
+	"math/big"
+	"net/netip"
+)
+
+// Returns the address as an integer
+func toInt(addr netip.Addr) *big.Int {
+	return new(big.Int).SetBytes(addr.AsSlice())
+}
+
+// Returns the address of the integer in the family of the reference address, false when out of the family
+func fromInt(value *big.Int, ref netip.Addr) (netip.Addr, bool) {
+	if value.Sign() < 0 || value.BitLen() > ref.BitLen() {
+		return netip.Addr{}, false
+	}
+
+	if ref.Is4() {
+		var four [4]byte
+		value.FillBytes(four[:])
+		return netip.AddrFrom4(four), true
+	}
+
+	var sixteen [16]byte
+	value.FillBytes(sixteen[:])
+	return netip.AddrFrom16(sixteen), true
+}
+
+// Returns the last address of the prefix
+func lastAddr(prefix netip.Prefix) netip.Addr {
+	last, _ := fromInt(new(big.Int).Sub(new(big.Int).Add(toInt(prefix.Addr()), prefixSize(prefix)), big.NewInt(1)), prefix.Addr())
+	return last
+}
+
+// Returns the first address after the prefix, false when the prefix ends the address space
+func nextAddr(prefix netip.Prefix) (netip.Addr, bool) {
+	next := lastAddr(prefix).Next()
+	return next, next.IsValid()
+}
+
+// Rounds the address up to the start of a prefix of the given length, false when out of the address space
+func alignUp(addr netip.Addr, bits int) (netip.Addr, bool) {
+	prefix := netip.PrefixFrom(addr, bits).Masked()
+	if prefix.Addr() == addr {
+		return addr, true
+	}
+	return nextAddr(prefix)
+}
+
+// Reports whether the outer prefix contains the whole inner prefix
+func containsPrefix(outer, inner netip.Prefix) bool {
+	return outer.Bits() <= inner.Bits() && outer.Contains(inner.Addr())
+}
+
+// Returns the number of addresses of the prefix
+func prefixSize(prefix netip.Prefix) *big.Int {
+	return new(big.Int).Lsh(big.NewInt(1), uint(prefix.Addr().BitLen()-prefix.Bits()))
+}
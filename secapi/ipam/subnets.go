@@ -0,0 +1,306 @@
+// Package ipam plans the addresses of the networks: the CIDR blocks of their subnets and the addresses of their NICs.
+package ipam
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/netip"
+	"slices"
+
+	"github.com/eu-sovereign-cloud/go-sdk/pkg/spec/schema"
+	"github.com/eu-sovereign-cloud/go-sdk/secapi"
+)
+
+var (
+	ErrInvalidCidr = errors.New("invalid cidr")
+	ErrNoFamily    = errors.New("address family not in the network")
+	ErrOutOfRange  = errors.New("cidr out of the network ranges")
+	ErrOverlap     = errors.New("cidr overlaps another subnet")
+	ErrNoSpace     = errors.New("no free prefix in the network ranges")
+)
+
+// Family is the IP version of a prefix.
+type Family int
+
+const (
+	IPv4 Family = 4
+	IPv6 Family = 6
+)
+
+// SubnetSize is the length of the prefixes to allocate, zero skips the family.
+type SubnetSize struct {
+	IPv4 int
+	IPv6 int
+}
+
+// Subnet allocated or found in the network
+type subnet struct {
+	name     string
+	prefixes []netip.Prefix
+}
+
+// SubnetPlanner allocates the CIDR blocks of the subnets of a network, the primary and the
+// additional CIDR blocks of the network are its ranges. The allocations are kept by the
+// planner, so the next ones never overlap them.
+type SubnetPlanner struct {
+	ranges  map[Family][]netip.Prefix
+	subnets []*subnet
+}
+
+// NewSubnetPlanner creates a planner of the network and its existing subnets,
+// the subnets are read from their spec or, when empty, their status.
+func NewSubnetPlanner(network *schema.Network, subnets []*schema.Subnet) (*SubnetPlanner, error) {
+	if network == nil {
+		return nil, fmt.Errorf("network is required to plan its subnets")
+	}
+
+	p := &SubnetPlanner{ranges: map[Family][]netip.Prefix{}}
+	for _, cidr := range append([]schema.Cidr{network.Spec.Cidr}, network.Spec.AdditionalCidrs...) {
+		prefixes, err := parseCidr(cidr)
+		if err != nil {
+			return nil, fmt.Errorf("network: %w", err)
+		}
+		for _, prefix := range prefixes {
+			p.ranges[familyOf(prefix)] = append(p.ranges[familyOf(prefix)], prefix)
+		}
+	}
+
+	for _, sub := range subnets {
+		prefixes, err := parseCidr(subnetCidr(sub))
+		if err != nil {
+			return nil, fmt.Errorf("subnet %s: %w", subnetName(sub), err)
+		}
+		p.subnets = append(p.subnets, &subnet{name: subnetName(sub), prefixes: prefixes})
+	}
+
+	return p, nil
+}
+
+// LoadSubnetPlanner creates a planner of a network and the subnets listed from the provider.
+func LoadSubnetPlanner(ctx context.Context, api secapi.NetworkV1, wref secapi.WorkspaceReference) (*SubnetPlanner, error) {
+	network, err := api.GetNetwork(ctx, wref)
+	if err != nil {
+		return nil, err
+	}
+
+	iter, err := api.ListSubnets(ctx, secapi.NetworkPath{Tenant: wref.Tenant, Workspace: wref.Workspace, Network: secapi.NetworkID(wref.Name)})
+	if err != nil {
+		return nil, err
+	}
+	subnets, err := iter.All(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return NewSubnetPlanner(network, subnets)
+}
+
+// Allocate returns the first free prefixes of the requested size in the ranges of the network,
+// and keeps them allocated to the subnet of the given name.
+func (p *SubnetPlanner) Allocate(name string, size SubnetSize) (schema.Cidr, error) {
+	if size.IPv4 == 0 && size.IPv6 == 0 {
+		return schema.Cidr{}, fmt.Errorf("%w: no prefix length requested", ErrInvalidCidr)
+	}
+
+	var cidr schema.Cidr
+	var prefixes []netip.Prefix
+	for _, request := range []struct {
+		family Family
+		bits   int
+		cidr   *string
+	}{
+		{IPv4, size.IPv4, &cidr.Ipv4},
+		{IPv6, size.IPv6, &cidr.Ipv6},
+	} {
+		if request.bits == 0 {
+			continue
+		}
+
+		prefix, err := p.free(request.family, request.bits)
+		if err != nil {
+			return schema.Cidr{}, err
+		}
+		*request.cidr = prefix.String()
+		prefixes = append(prefixes, prefix)
+	}
+
+	p.subnets = append(p.subnets, &subnet{name: name, prefixes: prefixes})
+	return cidr, nil
+}
+
+// AllocateZones allocates a subnet of the requested size in each zone, the subnets are named after the prefix and the zone.
+func (p *SubnetPlanner) AllocateZones(prefix string, zones []schema.Zone, size SubnetSize) (map[schema.Zone]schema.Cidr, error) {
+	allocated := make(map[schema.Zone]schema.Cidr, len(zones))
+	for _, zone := range zones {
+		cidr, err := p.Allocate(prefix+"-"+zone, size)
+		if err != nil {
+			return nil, fmt.Errorf("zone %s: %w", zone, err)
+		}
+		allocated[zone] = cidr
+	}
+	return allocated, nil
+}
+
+// Validate checks the CIDR block of a subnet fits in the ranges of the network and overlaps
+// no other subnet, a subnet of the same name is replaced by the checked one.
+func (p *SubnetPlanner) Validate(sub *schema.Subnet) error {
+	if sub == nil {
+		return fmt.Errorf("subnet is required to be validated")
+	}
+
+	prefixes, err := parseCidr(sub.Spec.Cidr)
+	if err != nil {
+		return err
+	}
+	if len(prefixes) == 0 {
+		return fmt.Errorf("%w: subnet %s has no cidr", ErrInvalidCidr, subnetName(sub))
+	}
+
+	return p.check(subnetName(sub), prefixes)
+}
+
+// Check validates the existing subnets, against the network ranges and between themselves.
+func (p *SubnetPlanner) Check() error {
+	var errs []error
+	for i, sub := range p.subnets {
+		// Each overlap is reported once, by the last subnet of the pair
+		others := &SubnetPlanner{ranges: p.ranges, subnets: p.subnets[:i]}
+		if err := others.check(sub.name, sub.prefixes); err != nil {
+			errs = append(errs, fmt.Errorf("subnet %s: %w", sub.name, err))
+		}
+	}
+	return errors.Join(errs...)
+}
+
+func (p *SubnetPlanner) check(name string, prefixes []netip.Prefix) error {
+	var errs []error
+	for _, prefix := range prefixes {
+		ranges, found := p.ranges[familyOf(prefix)]
+		if !found {
+			errs = append(errs, fmt.Errorf("%w: %s", ErrNoFamily, prefix))
+			continue
+		}
+
+		if !slices.ContainsFunc(ranges, func(r netip.Prefix) bool { return containsPrefix(r, prefix) }) {
+			errs = append(errs, fmt.Errorf("%w: %s", ErrOutOfRange, prefix))
+		}
+
+		for _, other := range p.subnets {
+			if other.name == name {
+				continue
+			}
+			for _, used := range other.prefixes {
+				if used.Overlaps(prefix) {
+					errs = append(errs, fmt.Errorf("%w %s: %s overlaps %s", ErrOverlap, other.name, prefix, used))
+				}
+			}
+		}
+	}
+	return errors.Join(errs...)
+}
+
+// Returns the first free prefix of the given length in the ranges of the family
+func (p *SubnetPlanner) free(family Family, bits int) (netip.Prefix, error) {
+	ranges, found := p.ranges[family]
+	if !found {
+		return netip.Prefix{}, fmt.Errorf("%w: %s", ErrNoFamily, family)
+	}
+
+	for _, r := range ranges {
+		if bits < r.Bits() || bits > r.Addr().BitLen() {
+			continue
+		}
+
+		candidate := r.Addr()
+		for {
+			prefix := netip.PrefixFrom(candidate, bits)
+			if !containsPrefix(r, prefix) {
+				break
+			}
+
+			used := p.overlapping(prefix)
+			if !used.IsValid() {
+				return prefix, nil
+			}
+
+			// Skips the used prefix, then aligns on the requested length
+			next, ok := nextAddr(used)
+			if !ok {
+				break
+			}
+			if candidate, ok = alignUp(next, bits); !ok {
+				break
+			}
+		}
+	}
+
+	return netip.Prefix{}, fmt.Errorf("%w: /%d %s", ErrNoSpace, bits, family)
+}
+
+// Returns the allocated prefix overlapping the given one, the largest if several do
+func (p *SubnetPlanner) overlapping(prefix netip.Prefix) netip.Prefix {
+	var found netip.Prefix
+	for _, sub := range p.subnets {
+		for _, used := range sub.prefixes {
+			if used.Overlaps(prefix) && (!found.IsValid() || lastAddr(used).Compare(lastAddr(found)) > 0) {
+				found = used
+			}
+		}
+	}
+	return found
+}
+
+func (f Family) String() string {
+	return fmt.Sprintf("IPv%d", int(f))
+}
+
+func familyOf(prefix netip.Prefix) Family {
+	if prefix.Addr().Is4() {
+		return IPv4
+	}
+	return IPv6
+}
+
+// Parses the IPv4 and IPv6 blocks of a CIDR, the empty ones are skipped
+func parseCidr(cidr schema.Cidr) ([]netip.Prefix, error) {
+	var prefixes []netip.Prefix
+	for _, block := range []struct {
+		family Family
+		value  string
+	}{
+		{IPv4, cidr.Ipv4},
+		{IPv6, cidr.Ipv6},
+	} {
+		if block.value == "" {
+			continue
+		}
+
+		prefix, err := netip.ParsePrefix(block.value)
+		if err != nil {
+			return nil, fmt.Errorf("%w: %w", ErrInvalidCidr, err)
+		}
+		if familyOf(prefix) != block.family || prefix.Addr().Is4In6() {
+			return nil, fmt.Errorf("%w: %s is not an %s block", ErrInvalidCidr, block.value, block.family)
+		}
+		if prefix != prefix.Masked() {
+			return nil, fmt.Errorf("%w: %s has host bits set, expected %s", ErrInvalidCidr, block.value, prefix.Masked())
+		}
+		prefixes = append(prefixes, prefix)
+	}
+	return prefixes, nil
+}
+
+func subnetCidr(sub *schema.Subnet) schema.Cidr {
+	if sub.Spec.Cidr == (schema.Cidr{}) && sub.Status != nil && sub.Status.Cidr != nil {
+		return *sub.Status.Cidr
+	}
+	return sub.Spec.Cidr
+}
+
+func subnetName(sub *schema.Subnet) string {
+	if sub.Metadata == nil {
+		return ""
+	}
+	return sub.Metadata.Name
+}
@@ -0,0 +1,174 @@
+package ipam
+
+import (
+	"context"
+	"testing"
+
+	"github.com/eu-sovereign-cloud/go-sdk/internal/secatest"
+	mocksecapi "github.com/eu-sovereign-cloud/go-sdk/mock/secapi"
+	"github.com/eu-sovereign-cloud/go-sdk/pkg/spec/schema"
+	"github.com/eu-sovereign-cloud/go-sdk/secapi"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSubnetPlanner_Allocate(t *testing.T) {
+	planner, err := NewSubnetPlanner(buildNetwork(), []*schema.Subnet{
+		buildSubnet("subnet-1", schema.Cidr{Ipv4: "10.0.0.0/24", Ipv6: "fd00::/64"}),
+		buildSubnet("subnet-2", schema.Cidr{Ipv4: "10.0.2.0/23"}),
+	})
+	require.NoError(t, err)
+	require.NoError(t, planner.Check())
+
+	cidr, err := planner.Allocate("subnet-3", SubnetSize{IPv4: 24, IPv6: 64})
+	require.NoError(t, err)
+	assert.Equal(t, schema.Cidr{Ipv4: "10.0.1.0/24", Ipv6: "fd00:0:0:1::/64"}, cidr)
+
+	cidr, err = planner.Allocate("subnet-4", SubnetSize{IPv4: 23})
+	require.NoError(t, err)
+	assert.Equal(t, schema.Cidr{Ipv4: "10.0.4.0/23"}, cidr)
+
+	zones, err := planner.AllocateZones("subnet", []schema.Zone{secatest.ZoneA, secatest.ZoneB}, SubnetSize{IPv4: 24, IPv6: 64})
+	require.NoError(t, err)
+	assert.Equal(t, schema.Cidr{Ipv4: "10.0.6.0/24", Ipv6: "fd00:0:0:2::/64"}, zones[secatest.ZoneA])
+	assert.Equal(t, schema.Cidr{Ipv4: "10.0.7.0/24", Ipv6: "fd00:0:0:3::/64"}, zones[secatest.ZoneB])
+
+	// Larger than the primary range, only the additional range fits
+	cidr, err = planner.Allocate("subnet-5", SubnetSize{IPv6: 40})
+	require.NoError(t, err)
+	assert.Equal(t, schema.Cidr{Ipv6: "fd01::/40"}, cidr)
+
+	require.NoError(t, planner.Check())
+}
+
+func TestSubnetPlanner_AllocateFull(t *testing.T) {
+	network := buildNetwork()
+	network.Spec.Cidr = schema.Cidr{Ipv4: "10.0.0.0/30"}
+	network.Spec.AdditionalCidrs = nil
+
+	planner, err := NewSubnetPlanner(network, nil)
+	require.NoError(t, err)
+
+	for _, expected := range []string{"10.0.0.0/31", "10.0.0.2/31"} {
+		cidr, err := planner.Allocate("subnet", SubnetSize{IPv4: 31})
+		require.NoError(t, err)
+		assert.Equal(t, expected, cidr.Ipv4)
+	}
+
+	_, err = planner.Allocate("subnet", SubnetSize{IPv4: 32})
+	assert.ErrorIs(t, err, ErrNoSpace)
+
+	_, err = planner.Allocate("subnet", SubnetSize{IPv4: 8})
+	assert.ErrorIs(t, err, ErrNoSpace)
+
+	_, err = planner.Allocate("subnet", SubnetSize{IPv6: 64})
+	assert.ErrorIs(t, err, ErrNoFamily)
+
+	_, err = planner.Allocate("subnet", SubnetSize{})
+	assert.ErrorIs(t, err, ErrInvalidCidr)
+}
+
+func TestSubnetPlanner_Validate(t *testing.T) {
+	planner, err := NewSubnetPlanner(buildNetwork(), []*schema.Subnet{
+		buildSubnet("subnet-1", schema.Cidr{Ipv4: "10.0.0.0/24", Ipv6: "fd00::/64"}),
+	})
+	require.NoError(t, err)
+
+	tests := []struct {
+		name string
+		cidr schema.Cidr
+		err  error
+	}{
+		{name: "subnet-2", cidr: schema.Cidr{Ipv4: "10.0.1.0/24", Ipv6: "fd00:0:0:1::/64"}},
+		{name: "subnet-2", cidr: schema.Cidr{Ipv4: "10.1.0.128/25"}},
+		{name: "subnet-1", cidr: schema.Cidr{Ipv4: "10.0.0.0/23"}},
+		{name: "subnet-2", cidr: schema.Cidr{Ipv4: "10.0.0.128/25"}, err: ErrOverlap},
+		{name: "subnet-2", cidr: schema.Cidr{Ipv6: "fd00::/48"}, err: ErrOverlap},
+		{name: "subnet-2", cidr: schema.Cidr{Ipv4: "10.2.0.0/24"}, err: ErrOutOfRange},
+		{name: "subnet-2", cidr: schema.Cidr{Ipv4: "10.0.0.0/15"}, err: ErrOutOfRange},
+		{name: "subnet-2", cidr: schema.Cidr{Ipv4: "10.0.1.1/24"}, err: ErrInvalidCidr},
+		{name: "subnet-2", cidr: schema.Cidr{Ipv4: "fd00::/64"}, err: ErrInvalidCidr},
+		{name: "subnet-2", cidr: schema.Cidr{}, err: ErrInvalidCidr},
+	}
+	for _, tt := range tests {
+		err := planner.Validate(buildSubnet(tt.name, tt.cidr))
+		if tt.err == nil {
+			assert.NoError(t, err, tt.cidr)
+		} else {
+			assert.ErrorIs(t, err, tt.err, tt.cidr)
+		}
+	}
+
+	network := buildNetwork()
+	network.Spec.Cidr.Ipv6 = ""
+	network.Spec.AdditionalCidrs = nil
+	planner, err = NewSubnetPlanner(network, nil)
+	require.NoError(t, err)
+	assert.ErrorIs(t, planner.Validate(buildSubnet("subnet-1", schema.Cidr{Ipv6: "fd00::/64"})), ErrNoFamily)
+}
+
+func TestSubnetPlanner_Check(t *testing.T) {
+	planner, err := NewSubnetPlanner(buildNetwork(), []*schema.Subnet{
+		buildSubnet("subnet-1", schema.Cidr{Ipv4: "10.0.0.0/24"}),
+		buildSubnet("subnet-2", schema.Cidr{Ipv4: "10.0.0.0/25"}),
+		buildSubnet("subnet-3", schema.Cidr{Ipv4: "192.168.0.0/24"}),
+	})
+	require.NoError(t, err)
+
+	err = planner.Check()
+	assert.ErrorIs(t, err, ErrOverlap)
+	assert.ErrorIs(t, err, ErrOutOfRange)
+	assert.ErrorContains(t, err, "subnet subnet-2")
+	assert.ErrorContains(t, err, "subnet subnet-3")
+
+	// The status is read when the spec is empty
+	allocated := buildSubnet("subnet-1", schema.Cidr{})
+	allocated.Status = &schema.SubnetStatus{Cidr: &schema.Cidr{Ipv4: "10.0.0.0/24"}}
+	planner, err = NewSubnetPlanner(buildNetwork(), []*schema.Subnet{allocated})
+	require.NoError(t, err)
+
+	cidr, err := planner.Allocate("subnet-2", SubnetSize{IPv4: 24})
+	require.NoError(t, err)
+	assert.Equal(t, "10.0.1.0/24", cidr.Ipv4)
+
+	_, err = NewSubnetPlanner(buildNetwork(), []*schema.Subnet{buildSubnet("subnet-1", schema.Cidr{Ipv4: "invalid"})})
+	assert.ErrorIs(t, err, ErrInvalidCidr)
+}
+
+func TestLoadSubnetPlanner(t *testing.T) {
+	ctx := context.Background()
+	wref := secapi.WorkspaceReference{Tenant: secatest.Tenant1Name, Workspace: secatest.Workspace1Name, Name: secatest.Network1Name}
+
+	api := mocksecapi.NewMockNetworkV1(t)
+	api.EXPECT().GetNetwork(mock.Anything, wref).Return(buildNetwork(), nil)
+	api.EXPECT().ListSubnets(mock.Anything, secapi.NetworkPath{Tenant: secatest.Tenant1Name, Workspace: secatest.Workspace1Name, Network: secatest.Network1Name}).
+		Return(secapi.NewIterator(func(ctx context.Context, skipToken *string) ([]schema.Subnet, *schema.ResponseMetadata, error) {
+			return []schema.Subnet{*buildSubnet("subnet-1", schema.Cidr{Ipv4: "10.0.0.0/24"})}, &schema.ResponseMetadata{}, nil
+		}), nil)
+
+	planner, err := LoadSubnetPlanner(ctx, api, wref)
+	require.NoError(t, err)
+
+	cidr, err := planner.Allocate("subnet-2", SubnetSize{IPv4: 24})
+	require.NoError(t, err)
+	assert.Equal(t, "10.0.1.0/24", cidr.Ipv4)
+}
+
+func buildNetwork() *schema.Network {
+	return &schema.Network{
+		Metadata: secatest.NewRegionalWorkspaceResourceMetadata(secatest.Network1Name, secatest.Tenant1Name, secatest.Workspace1Name, secatest.Region1Name),
+		Spec: schema.NetworkSpec{
+			Cidr:            schema.Cidr{Ipv4: "10.0.0.0/16", Ipv6: "fd00::/48"},
+			AdditionalCidrs: []schema.Cidr{{Ipv4: "10.1.0.0/24", Ipv6: "fd01::/32"}},
+		},
+	}
+}
+
+func buildSubnet(name string, cidr schema.Cidr) *schema.Subnet {
+	return &schema.Subnet{
+		Metadata: secatest.NewRegionalNetworkResourceMetadata(name, secatest.Tenant1Name, secatest.Workspace1Name, secatest.Network1Name, secatest.Region1Name),
+		Spec:     schema.SubnetSpec{Cidr: cidr, Zone: secatest.ZoneA},
+	}
+}
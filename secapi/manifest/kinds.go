@@ -0,0 +1,130 @@
+package manifest
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/eu-sovereign-cloud/go-sdk/pkg/spec/schema"
+)
+
+var (
+	ErrUnknownKind         = errors.New("unknown resource kind")
+	ErrNoKind              = errors.New("metadata kind is empty")
+	ErrUnsupportedResource = errors.New("unsupported resource type")
+)
+
+// Maps each resource kind to a constructor of its schema type
+var kinds = map[schema.ResourceMetadataKind]func() any{
+	// Region
+	schema.ResourceMetadataKindResourceKindRegion: func() any { return &schema.Region{} },
+
+	// Authorization
+	schema.ResourceMetadataKindResourceKindRole:           func() any { return &schema.Role{} },
+	schema.ResourceMetadataKindResourceKindRoleAssignment: func() any { return &schema.RoleAssignment{} },
+
+	// Workspace
+	schema.ResourceMetadataKindResourceKindWorkspace: func() any { return &schema.Workspace{} },
+
+	// Storage
+	schema.ResourceMetadataKindResourceKindStorageSku:   func() any { return &schema.StorageSku{} },
+	schema.ResourceMetadataKindResourceKindBlockStorage: func() any { return &schema.BlockStorage{} },
+	schema.ResourceMetadataKindResourceKindImage:        func() any { return &schema.Image{} },
+
+	// Compute
+	schema.ResourceMetadataKindResourceKindInstanceSku: func() any { return &schema.InstanceSku{} },
+	schema.ResourceMetadataKindResourceKindInstance:    func() any { return &schema.Instance{} },
+
+	// Network
+	schema.ResourceMetadataKindResourceKindNetworkSku:        func() any { return &schema.NetworkSku{} },
+	schema.ResourceMetadataKindResourceKindNetwork:           func() any { return &schema.Network{} },
+	schema.ResourceMetadataKindResourceKindSubnet:            func() any { return &schema.Subnet{} },
+	schema.ResourceMetadataKindResourceKindRoutingTable:      func() any { return &schema.RouteTable{} },
+	schema.ResourceMetadataKindResourceKindInternetGateway:   func() any { return &schema.InternetGateway{} },
+	schema.ResourceMetadataKindResourceKindSecurityGroupRule: func() any { return &schema.SecurityGroupRule{} },
+	schema.ResourceMetadataKindResourceKindSecurityGroup:     func() any { return &schema.SecurityGroup{} },
+	schema.ResourceMetadataKindResourceKindNic:               func() any { return &schema.Nic{} },
+	schema.ResourceMetadataKindResourceKindPublicIP:          func() any { return &schema.PublicIp{} },
+
+	// Extensions
+	schema.ResourceMetadataKindResourceKindActivityLog:          func() any { return &schema.ActivityLog{} },
+	schema.ResourceMetadataKindResourceKindNetworkLoadBalancer:  func() any { return &schema.NetworkLoadBalancer{} },
+	schema.ResourceMetadataKindResourceKindObjectStorageAccount: func() any { return &schema.ObjectStorageAccount{} },
+}
+
+// New returns a pointer to an empty schema resource of the given kind.
+func New(kind schema.ResourceMetadataKind) (any, error) {
+	if kind == "" {
+		return nil, ErrNoKind
+	}
+
+	ctor, found := kinds[kind]
+	if !found {
+		return nil, fmt.Errorf("%w: %s", ErrUnknownKind, kind)
+	}
+
+	return ctor(), nil
+}
+
+// KindOf returns the kind of a schema resource, the resource must be a pointer as *schema.Instance.
+func KindOf(resource any) (schema.ResourceMetadataKind, error) {
+	switch resource.(type) {
+	// Region
+	case *schema.Region:
+		return schema.ResourceMetadataKindResourceKindRegion, nil
+
+	// Authorization
+	case *schema.Role:
+		return schema.ResourceMetadataKindResourceKindRole, nil
+	case *schema.RoleAssignment:
+		return schema.ResourceMetadataKindResourceKindRoleAssignment, nil
+
+	// Workspace
+	case *schema.Workspace:
+		return schema.ResourceMetadataKindResourceKindWorkspace, nil
+
+	// Storage
+	case *schema.StorageSku:
+		return schema.ResourceMetadataKindResourceKindStorageSku, nil
+	case *schema.BlockStorage:
+		return schema.ResourceMetadataKindResourceKindBlockStorage, nil
+	case *schema.Image:
+		return schema.ResourceMetadataKindResourceKindImage, nil
+
+	// Compute
+	case *schema.InstanceSku:
+		return schema.ResourceMetadataKindResourceKindInstanceSku, nil
+	case *schema.Instance:
+		return schema.ResourceMetadataKindResourceKindInstance, nil
+
+	// Network
+	case *schema.NetworkSku:
+		return schema.ResourceMetadataKindResourceKindNetworkSku, nil
+	case *schema.Network:
+		return schema.ResourceMetadataKindResourceKindNetwork, nil
+	case *schema.Subnet:
+		return schema.ResourceMetadataKindResourceKindSubnet, nil
+	case *schema.RouteTable:
+		return schema.ResourceMetadataKindResourceKindRoutingTable, nil
+	case *schema.InternetGateway:
+		return schema.ResourceMetadataKindResourceKindInternetGateway, nil
+	case *schema.SecurityGroupRule:
+		return schema.ResourceMetadataKindResourceKindSecurityGroupRule, nil
+	case *schema.SecurityGroup:
+		return schema.ResourceMetadataKindResourceKindSecurityGroup, nil
+	case *schema.Nic:
+		return schema.ResourceMetadataKindResourceKindNic, nil
+	case *schema.PublicIp:
+		return schema.ResourceMetadataKindResourceKindPublicIP, nil
+
+	// Extensions
+	case *schema.ActivityLog:
+		return schema.ResourceMetadataKindResourceKindActivityLog, nil
+	case *schema.NetworkLoadBalancer:
+		return schema.ResourceMetadataKindResourceKindNetworkLoadBalancer, nil
+	case *schema.ObjectStorageAccount:
+		return schema.ResourceMetadataKindResourceKindObjectStorageAccount, nil
+
+	default:
+		return "", fmt.Errorf("%w: %T", ErrUnsupportedResource, resource)
+	}
+}
@@ -0,0 +1,373 @@
+package manifest
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/eu-sovereign-cloud/go-sdk/pkg/spec/schema"
+
+	"gopkg.in/yaml.v3"
+)
+
+// Format is the encoding of a manifest.
+type Format string
+
+const (
+	FormatYAML Format = "yaml"
+	FormatJSON Format = "json"
+)
+
+const (
+	metadataField = "metadata"
+	kindField     = "kind"
+	statusField   = "status"
+)
+
+// ServerOwnedMetadataFields are assigned by the provider, they are never part of a manifest nor
+// compared by the plans.
+var ServerOwnedMetadataFields = []string{
+	"resourceVersion",
+	"createdAt",
+	"lastModifiedAt",
+	"deletedAt",
+	"ref",
+	"provider",
+	"resource",
+	"verb",
+}
+
+// Top level fields kept even when empty, as they are required by the API
+var requiredFields = []string{metadataField, "spec"}
+
+// FormatFromPath returns the format matching the file extension, defaulting to YAML.
+func FormatFromPath(path string) Format {
+	if strings.EqualFold(filepath.Ext(path), ".json") {
+		return FormatJSON
+	}
+	return FormatYAML
+}
+
+// Decoding
+
+// Decode reads every document of a multi-document YAML or JSON stream and returns
+// the matching schema resources, dispatching on the metadata kind of each document.
+func Decode(r io.Reader) ([]any, error) {
+	br := bufio.NewReader(r)
+
+	documents, err := readDocuments(br)
+	if err != nil {
+		return nil, err
+	}
+
+	var resources []any
+	for i, document := range documents {
+		resource, err := decodeDocument(document)
+		if err != nil {
+			return nil, fmt.Errorf("document %d: %w", i, err)
+		}
+		resources = append(resources, resource)
+	}
+
+	return resources, nil
+}
+
+// DecodeFile reads the resources of a manifest file.
+func DecodeFile(path string) ([]any, error) {
+	file, err := os.Open(filepath.Clean(path))
+	if err != nil {
+		return nil, err
+	}
+	defer file.Close()
+
+	resources, err := Decode(file)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", path, err)
+	}
+	return resources, nil
+}
+
+func readDocuments(br *bufio.Reader) ([]any, error) {
+	if isJSON(br) {
+		return readJSONDocuments(br)
+	}
+	return readYAMLDocuments(br)
+}
+
+func isJSON(br *bufio.Reader) bool {
+	for {
+		b, err := br.ReadByte()
+		if err != nil {
+			return false
+		}
+		if b == ' ' || b == '\t' || b == '\r' || b == '\n' {
+			continue
+		}
+
+		_ = br.UnreadByte()
+		return b == '{' || b == '['
+	}
+}
+
+func readJSONDocuments(r io.Reader) ([]any, error) {
+	decoder := json.NewDecoder(r)
+	decoder.UseNumber()
+
+	var documents []any
+	for {
+		var document any
+		err := decoder.Decode(&document)
+		if errors.Is(err, io.EOF) {
+			break
+		}
+		if err != nil {
+			return nil, err
+		}
+
+		// A JSON array holds one document per item
+		if items, ok := document.([]any); ok {
+			documents = append(documents, items...)
+		} else {
+			documents = append(documents, document)
+		}
+	}
+
+	return documents, nil
+}
+
+func readYAMLDocuments(r io.Reader) ([]any, error) {
+	decoder := yaml.NewDecoder(r)
+
+	var documents []any
+	for {
+		var document any
+		err := decoder.Decode(&document)
+		if errors.Is(err, io.EOF) {
+			break
+		}
+		if err != nil {
+			return nil, err
+		}
+
+		// Skip empty documents, as the ones after a trailing separator
+		if document == nil {
+			continue
+		}
+		documents = append(documents, document)
+	}
+
+	return documents, nil
+}
+
+func decodeDocument(document any) (any, error) {
+	fields, ok := stringKeys(document).(map[string]any)
+	if !ok {
+		return nil, fmt.Errorf("expected an object, found %T", document)
+	}
+
+	metadata, _ := fields[metadataField].(map[string]any)
+	kind, _ := metadata[kindField].(string)
+
+	resource, err := New(schema.ResourceMetadataKind(kind))
+	if err != nil {
+		return nil, err
+	}
+
+	data, err := json.Marshal(fields)
+	if err != nil {
+		return nil, err
+	}
+
+	decoder := json.NewDecoder(bytes.NewReader(data))
+	decoder.DisallowUnknownFields()
+	if err := decoder.Decode(resource); err != nil {
+		return nil, fmt.Errorf("%s: %w", kind, err)
+	}
+
+	return resource, nil
+}
+
+// YAML maps with non string keys can't be encoded to JSON, so their keys are converted
+func stringKeys(value any) any {
+	switch v := value.(type) {
+	case map[string]any:
+		for key, item := range v {
+			v[key] = stringKeys(item)
+		}
+		return v
+	case map[any]any:
+		m := make(map[string]any, len(v))
+		for key, item := range v {
+			m[fmt.Sprint(key)] = stringKeys(item)
+		}
+		return m
+	case []any:
+		for i, item := range v {
+			v[i] = stringKeys(item)
+		}
+		return v
+	default:
+		return v
+	}
+}
+
+// Encoding
+
+// Encode writes the resources in their minimal form, as a multi-document YAML stream
+// or as JSON, a single object for one resource and an array otherwise.
+func Encode(w io.Writer, format Format, resources ...any) error {
+	documents := make([]map[string]any, 0, len(resources))
+	for _, resource := range resources {
+		document, err := Strip(resource)
+		if err != nil {
+			return err
+		}
+		documents = append(documents, document)
+	}
+
+	return EncodeDocuments(w, format, documents...)
+}
+
+// EncodeDocuments writes documents already in their generic form, as returned by Strip.
+func EncodeDocuments(w io.Writer, format Format, documents ...map[string]any) error {
+	switch format {
+	case FormatJSON:
+		encoder := json.NewEncoder(w)
+		encoder.SetIndent("", "  ")
+		if len(documents) == 1 {
+			return encoder.Encode(documents[0])
+		}
+		return encoder.Encode(documents)
+
+	case FormatYAML:
+		encoder := yaml.NewEncoder(w)
+		encoder.SetIndent(2)
+		for _, document := range documents {
+			if err := encoder.Encode(document); err != nil {
+				return err
+			}
+		}
+		return encoder.Close()
+
+	default:
+		return fmt.Errorf("unknown manifest format %q", format)
+	}
+}
+
+// EncodeFile writes the resources to a file, using the format matching its extension.
+func EncodeFile(path string, resources ...any) error {
+	var buf bytes.Buffer
+	if err := Encode(&buf, FormatFromPath(path), resources...); err != nil {
+		return err
+	}
+
+	return os.WriteFile(path, buf.Bytes(), 0o600)
+}
+
+// Strip returns the generic form of a resource as written to a manifest: the status, the
+// server owned metadata fields and the empty values are removed, and the kind is set.
+func Strip(resource any) (map[string]any, error) {
+	kind, err := KindOf(resource)
+	if err != nil {
+		return nil, err
+	}
+
+	data, err := json.Marshal(resource)
+	if err != nil {
+		return nil, err
+	}
+
+	decoder := json.NewDecoder(bytes.NewReader(data))
+	decoder.UseNumber()
+
+	var fields map[string]any
+	if err := decoder.Decode(&fields); err != nil {
+		return nil, err
+	}
+	if fields == nil {
+		return nil, fmt.Errorf("resource %T is empty", resource)
+	}
+
+	delete(fields, statusField)
+
+	metadata, _ := fields[metadataField].(map[string]any)
+	if metadata == nil {
+		metadata = map[string]any{}
+	}
+	for _, field := range ServerOwnedMetadataFields {
+		delete(metadata, field)
+	}
+	metadata[kindField] = string(kind)
+	fields[metadataField] = metadata
+
+	for key, value := range fields {
+		pruned := prune(value)
+		if pruned == nil && !isRequired(key) {
+			delete(fields, key)
+			continue
+		}
+		if pruned == nil {
+			pruned = map[string]any{}
+		}
+		fields[key] = pruned
+	}
+
+	return fields, nil
+}
+
+func isRequired(field string) bool {
+	for _, required := range requiredFields {
+		if field == required {
+			return true
+		}
+	}
+	return false
+}
+
+// Removes the empty values and converts the numbers to their Go type, returns nil when empty
+func prune(value any) any {
+	switch v := value.(type) {
+	case map[string]any:
+		for key, item := range v {
+			if pruned := prune(item); pruned != nil {
+				v[key] = pruned
+			} else {
+				delete(v, key)
+			}
+		}
+		if len(v) == 0 {
+			return nil
+		}
+		return v
+	case []any:
+		if len(v) == 0 {
+			return nil
+		}
+		// Items are kept even when empty, as their position is meaningful
+		for i, item := range v {
+			if pruned := prune(item); pruned != nil {
+				v[i] = pruned
+			}
+		}
+		return v
+	case string:
+		if v == "" {
+			return nil
+		}
+		return v
+	case json.Number:
+		if i, err := v.Int64(); err == nil {
+			return i
+		}
+		f, _ := v.Float64()
+		return f
+	default:
+		return v
+	}
+}
@@ -0,0 +1,193 @@
+package manifest
+
+import (
+	"bytes"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/eu-sovereign-cloud/go-sdk/internal/secatest"
+	"github.com/eu-sovereign-cloud/go-sdk/pkg/spec/schema"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const multiDocumentYAML = `
+metadata:
+  kind: network
+  name: network-1
+  tenant: tenant-1
+  workspace: workspace-1
+spec:
+  cidr:
+    ipv4: 10.0.0.0/16
+  skuRef:
+    resource: skus/sku-1
+---
+metadata:
+  kind: instance
+  name: instance-1
+  tenant: tenant-1
+  workspace: workspace-1
+labels:
+  env: test
+spec:
+  skuRef:
+    resource: skus/sku-1
+  zone: a
+  bootVolume:
+    deviceRef:
+      resource: block-storages/storage-1
+---
+`
+
+func TestDecode_MultiDocumentYAML(t *testing.T) {
+	resources, err := Decode(strings.NewReader(multiDocumentYAML))
+	require.NoError(t, err)
+	require.Len(t, resources, 2)
+
+	network, ok := resources[0].(*schema.Network)
+	require.True(t, ok)
+	assert.Equal(t, secatest.Network1Name, network.Metadata.Name)
+	assert.Equal(t, "10.0.0.0/16", network.Spec.Cidr.Ipv4)
+
+	instance, ok := resources[1].(*schema.Instance)
+	require.True(t, ok)
+	assert.Equal(t, secatest.Instance1Name, instance.Metadata.Name)
+	assert.Equal(t, secatest.Workspace1Name, instance.Metadata.Workspace)
+	assert.Equal(t, secatest.LabelEnvValue, instance.Labels[secatest.LabelEnvKey])
+	assert.Equal(t, secatest.ZoneA, instance.Spec.Zone)
+}
+
+func TestDecode_JSON(t *testing.T) {
+	data := `[
+		{"metadata": {"kind": "role", "name": "role-1", "tenant": "tenant-1"}, "spec": {"permissions": [{"provider": "seca.compute", "resources": ["instances/*"], "verb": ["get"]}]}},
+		{"metadata": {"kind": "workspace", "name": "workspace-1", "tenant": "tenant-1"}, "spec": {}}
+	]
+	{"metadata": {"kind": "image", "name": "image-1", "tenant": "tenant-1"}, "spec": {"blockStorageRef": {"resource": "block-storages/storage-1"}, "cpuArchitecture": "amd64"}}`
+
+	resources, err := Decode(strings.NewReader(data))
+	require.NoError(t, err)
+	require.Len(t, resources, 3)
+
+	role, ok := resources[0].(*schema.Role)
+	require.True(t, ok)
+	assert.Equal(t, secatest.Role1PermissionProvider, role.Spec.Permissions[0].Provider)
+
+	_, ok = resources[1].(*schema.Workspace)
+	assert.True(t, ok)
+
+	image, ok := resources[2].(*schema.Image)
+	require.True(t, ok)
+	assert.Equal(t, schema.ImageSpecCpuArchitectureAmd64, image.Spec.CpuArchitecture)
+}
+
+func TestDecode_Errors(t *testing.T) {
+	_, err := Decode(strings.NewReader("metadata:\n  name: instance-1\n"))
+	assert.ErrorIs(t, err, ErrNoKind)
+
+	_, err = Decode(strings.NewReader("metadata:\n  kind: unknown\n"))
+	assert.ErrorIs(t, err, ErrUnknownKind)
+
+	_, err = Decode(strings.NewReader("metadata:\n  kind: instance\nspec:\n  zonee: a\n"))
+	assert.ErrorContains(t, err, "zonee")
+
+	_, err = Decode(strings.NewReader("- a\n- b\n"))
+	assert.Error(t, err)
+}
+
+func TestEncode_StripsServerOwnedFields(t *testing.T) {
+	instance := &schema.Instance{
+		Metadata: secatest.NewRegionalWorkspaceResourceMetadata(secatest.Instance1Name, secatest.Tenant1Name, secatest.Workspace1Name, secatest.Region1Name),
+		Spec: schema.InstanceSpec{
+			SkuRef: schema.Reference{Resource: secatest.InstanceSku1Ref},
+			Zone:   secatest.ZoneA,
+		},
+		Status: secatest.NewInstanceStatus(schema.ResourceStateActive),
+	}
+	instance.Metadata.ResourceVersion = 5
+	instance.Metadata.CreatedAt = time.Now()
+	instance.Metadata.LastModifiedAt = time.Now()
+
+	var buf bytes.Buffer
+	require.NoError(t, Encode(&buf, FormatYAML, instance))
+
+	expected := `metadata:
+  kind: instance
+  name: instance-1
+  region: region-1
+  tenant: tenant-1
+  workspace: workspace-1
+spec:
+  skuRef:
+    resource: skus/sku-1
+  zone: a
+`
+	assert.Equal(t, expected, buf.String())
+}
+
+func TestEncode_RoundTrip(t *testing.T) {
+	network := &schema.Network{
+		Metadata: secatest.NewRegionalWorkspaceResourceMetadata(secatest.Network1Name, secatest.Tenant1Name, secatest.Workspace1Name, secatest.Region1Name),
+		Spec: schema.NetworkSpec{
+			Cidr:   schema.Cidr{Ipv4: secatest.CidrIpv4},
+			SkuRef: schema.Reference{Resource: secatest.NetworkSku1Ref},
+		},
+	}
+	rule := &schema.SecurityGroupRule{
+		Metadata: secatest.NewRegionalWorkspaceResourceMetadata(secatest.SecurityGroupRule1Name, secatest.Tenant1Name, secatest.Workspace1Name, secatest.Region1Name),
+		Spec: schema.SecurityGroupRuleSpec{
+			Direction: schema.SecurityGroupRuleDirectionIngress,
+			Protocol:  schema.SecurityGroupRuleProtocolTCP,
+			Ports:     &schema.Ports{From: secatest.SecurityGroup1PortFrom, To: secatest.SecurityGroup1PortTo},
+		},
+	}
+
+	for _, format := range []Format{FormatYAML, FormatJSON} {
+		var buf bytes.Buffer
+		require.NoError(t, Encode(&buf, format, network, rule))
+
+		resources, err := Decode(&buf)
+		require.NoError(t, err)
+		require.Len(t, resources, 2)
+
+		decodedNetwork := resources[0].(*schema.Network)
+		assert.Equal(t, network.Spec, decodedNetwork.Spec)
+		assert.Equal(t, schema.RegionalWorkspaceResourceMetadataKindResourceKindNetwork, decodedNetwork.Metadata.Kind)
+
+		decodedRule := resources[1].(*schema.SecurityGroupRule)
+		assert.Equal(t, rule.Spec, decodedRule.Spec)
+	}
+}
+
+func TestEncodeFile(t *testing.T) {
+	workspace := &schema.Workspace{
+		Metadata: secatest.NewRegionalResourceMetadata(secatest.Workspace1Name, secatest.Tenant1Name, secatest.Region1Name),
+	}
+
+	for _, name := range []string{"workspace.yaml", "workspace.json"} {
+		path := filepath.Join(t.TempDir(), name)
+		require.NoError(t, EncodeFile(path, workspace))
+
+		resources, err := DecodeFile(path)
+		require.NoError(t, err)
+		require.Len(t, resources, 1)
+		assert.Equal(t, secatest.Workspace1Name, resources[0].(*schema.Workspace).Metadata.Name)
+	}
+}
+
+func TestKinds(t *testing.T) {
+	for kind := range kinds {
+		resource, err := New(kind)
+		require.NoError(t, err)
+
+		resourceKind, err := KindOf(resource)
+		require.NoError(t, err)
+		assert.Equal(t, kind, resourceKind)
+	}
+
+	_, err := KindOf(schema.Instance{})
+	assert.ErrorIs(t, err, ErrUnsupportedResource)
+}
@@ -0,0 +1,169 @@
+package plan
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"slices"
+	"sort"
+	"strings"
+
+	"github.com/eu-sovereign-cloud/go-sdk/secapi/manifest"
+)
+
+// DiffOperation describes how a field changes between two versions of a resource.
+type DiffOperation string
+
+const (
+	DiffOperationAdded    DiffOperation = "added"
+	DiffOperationRemoved  DiffOperation = "removed"
+	DiffOperationModified DiffOperation = "modified"
+)
+
+// FieldDiff is a single field level difference, addressed by its JSON path.
+type FieldDiff struct {
+	Path      string        `json:"path"`
+	Operation DiffOperation `json:"op"`
+	From      any           `json:"from,omitempty"`
+	To        any           `json:"to,omitempty"`
+}
+
+// Server owned fields, they are assigned by the provider and never part of a desired state: the
+// server owned fields of the manifests, and the apiVersion and the kind the provider fills in.
+var serverOwnedMetadataFields = append(slices.Clone(manifest.ServerOwnedMetadataFields), "apiVersion", "kind")
+
+const statusField = "status"
+
+// Compare returns the field level differences needed to go from one resource to another.
+// Server owned fields (status, resource version and timestamps) are ignored. Any of the
+// resources can be nil, in which case every field of the other one is reported.
+func Compare(from, to any) ([]FieldDiff, error) {
+	fromFields, err := flattenResource(from)
+	if err != nil {
+		return nil, err
+	}
+
+	toFields, err := flattenResource(to)
+	if err != nil {
+		return nil, err
+	}
+
+	paths := make([]string, 0, len(fromFields)+len(toFields))
+	for path := range fromFields {
+		paths = append(paths, path)
+	}
+	for path := range toFields {
+		if _, found := fromFields[path]; !found {
+			paths = append(paths, path)
+		}
+	}
+	sort.Strings(paths)
+
+	var diffs []FieldDiff
+	for _, path := range paths {
+		fromValue, inFrom := fromFields[path]
+		toValue, inTo := toFields[path]
+
+		switch {
+		case inFrom && !inTo:
+			diffs = append(diffs, FieldDiff{Path: path, Operation: DiffOperationRemoved, From: fromValue})
+		case !inFrom && inTo:
+			diffs = append(diffs, FieldDiff{Path: path, Operation: DiffOperationAdded, To: toValue})
+		case !reflect.DeepEqual(fromValue, toValue):
+			diffs = append(diffs, FieldDiff{Path: path, Operation: DiffOperationModified, From: fromValue, To: toValue})
+		}
+	}
+
+	return diffs, nil
+}
+
+// Normalize converts a resource into its generic JSON form with the server owned fields removed.
+func Normalize(resource any) (map[string]any, error) {
+	if isNil(resource) {
+		return map[string]any{}, nil
+	}
+
+	data, err := json.Marshal(resource)
+	if err != nil {
+		return nil, err
+	}
+
+	decoder := json.NewDecoder(bytes.NewReader(data))
+	decoder.UseNumber()
+
+	var fields map[string]any
+	if err := decoder.Decode(&fields); err != nil {
+		return nil, err
+	}
+	if fields == nil {
+		return nil, fmt.Errorf("resource %T is not an object", resource)
+	}
+
+	delete(fields, statusField)
+	if metadata, ok := fields["metadata"].(map[string]any); ok {
+		for _, field := range serverOwnedMetadataFields {
+			delete(metadata, field)
+		}
+	}
+
+	return fields, nil
+}
+
+func flattenResource(resource any) (map[string]any, error) {
+	fields, err := Normalize(resource)
+	if err != nil {
+		return nil, err
+	}
+
+	flat := map[string]any{}
+	flatten("", fields, flat)
+	return flat, nil
+}
+
+func flatten(prefix string, value any, flat map[string]any) {
+	switch v := value.(type) {
+	case map[string]any:
+		for key, item := range v {
+			flatten(joinPath(prefix, key), item, flat)
+		}
+	case []any:
+		for i, item := range v {
+			flatten(fmt.Sprintf("%s[%d]", prefix, i), item, flat)
+		}
+	case nil:
+		// Absent and null values are the same for the API
+	case string:
+		if v != "" {
+			flat[prefix] = v
+		}
+	default:
+		flat[prefix] = v
+	}
+}
+
+func joinPath(prefix, key string) string {
+	// Keys as labels may contain dots, so they are quoted to keep the path readable
+	if strings.ContainsAny(key, ".[]\" ") {
+		return fmt.Sprintf("%s[%q]", prefix, key)
+	}
+
+	if prefix == "" {
+		return key
+	}
+	return prefix + "." + key
+}
+
+func isNil(value any) bool {
+	if value == nil {
+		return true
+	}
+
+	rv := reflect.ValueOf(value)
+	switch rv.Kind() {
+	case reflect.Pointer, reflect.Map, reflect.Slice, reflect.Interface:
+		return rv.IsNil()
+	default:
+		return false
+	}
+}
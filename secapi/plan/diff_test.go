@@ -0,0 +1,128 @@
+package plan
+
+import (
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/eu-sovereign-cloud/go-sdk/internal/secatest"
+	"github.com/eu-sovereign-cloud/go-sdk/pkg/spec/schema"
+	"github.com/eu-sovereign-cloud/go-sdk/secapi/manifest"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCompare_IgnoresServerOwnedFields(t *testing.T) {
+	desired := secatest.NewInstance(secatest.Instance1Name, secatest.ZoneA)
+
+	live := secatest.NewInstance(secatest.Instance1Name, secatest.ZoneA)
+	live.Metadata.ResourceVersion = 7
+	live.Metadata.CreatedAt = time.Now()
+	live.Metadata.LastModifiedAt = time.Now()
+	live.Metadata.Kind = schema.RegionalWorkspaceResourceMetadataKindResourceKindInstance
+	live.Status = secatest.NewInstanceStatus(schema.ResourceStateActive)
+
+	diffs, err := Compare(live, desired)
+	assert.NoError(t, err)
+	assert.Empty(t, diffs)
+
+	assert.Subset(t, serverOwnedMetadataFields, manifest.ServerOwnedMetadataFields)
+}
+
+func TestCompare_Operations(t *testing.T) {
+	from := secatest.NewInstance(secatest.Instance1Name, secatest.ZoneA)
+	from.Labels = schema.Labels{secatest.LabelEnvKey: secatest.LabelEnvValue}
+
+	to := secatest.NewInstance(secatest.Instance1Name, "b")
+	to.Spec.AntiAffinityGroup = "group-1"
+
+	diffs, err := Compare(from, to)
+	assert.NoError(t, err)
+
+	assert.Equal(t, []FieldDiff{
+		{Path: "labels.env", Operation: DiffOperationRemoved, From: secatest.LabelEnvValue},
+		{Path: "spec.antiAffinityGroup", Operation: DiffOperationAdded, To: "group-1"},
+		{Path: "spec.zone", Operation: DiffOperationModified, From: secatest.ZoneA, To: "b"},
+	}, diffs)
+}
+
+func TestCompare_NilResource(t *testing.T) {
+	to := secatest.NewInstance(secatest.Instance1Name, secatest.ZoneA)
+
+	diffs, err := Compare(nil, to)
+	assert.NoError(t, err)
+	assert.NotEmpty(t, diffs)
+
+	for _, diff := range diffs {
+		assert.Equal(t, DiffOperationAdded, diff.Operation)
+	}
+}
+
+func TestCompare_QuotesDottedKeys(t *testing.T) {
+	from := secatest.NewInstance(secatest.Instance1Name, secatest.ZoneA)
+
+	to := secatest.NewInstance(secatest.Instance1Name, secatest.ZoneA)
+	to.Labels = schema.Labels{"app.kubernetes.io/name": "web"}
+
+	diffs, err := Compare(from, to)
+	assert.NoError(t, err)
+	assert.Len(t, diffs, 1)
+	assert.Equal(t, `labels["app.kubernetes.io/name"]`, diffs[0].Path)
+}
+
+func TestCompare_ArrayItems(t *testing.T) {
+	from := secatest.NewInstance(secatest.Instance1Name, secatest.ZoneA)
+	from.Spec.SshKeys = []string{"key-1", "key-2"}
+
+	to := secatest.NewInstance(secatest.Instance1Name, secatest.ZoneA)
+	to.Spec.SshKeys = []string{"key-1", "key-3"}
+
+	diffs, err := Compare(from, to)
+	assert.NoError(t, err)
+	assert.Equal(t, []FieldDiff{
+		{Path: "spec.sshKeys[1]", Operation: DiffOperationModified, From: "key-2", To: "key-3"},
+	}, diffs)
+}
+
+func TestPlan_String(t *testing.T) {
+	plan := &Plan{
+		Changes: []Change{
+			{
+				ID:     ResourceID{Kind: schema.ResourceMetadataKindResourceKindInstance, Tenant: secatest.Tenant1Name, Workspace: secatest.Workspace1Name, Name: secatest.Instance1Name},
+				Action: ActionUpdate,
+				Diffs:  []FieldDiff{{Path: "spec.zone", Operation: DiffOperationModified, From: secatest.ZoneA, To: "b"}},
+			},
+			{
+				ID:     ResourceID{Kind: schema.ResourceMetadataKindResourceKindNetwork, Tenant: secatest.Tenant1Name, Workspace: secatest.Workspace1Name, Name: secatest.Network1Name},
+				Action: ActionCreate,
+			},
+		},
+	}
+
+	expected := "~ instance tenant-1/workspace-1/instance-1\n" +
+		"    ~ spec.zone: \"a\" => \"b\"\n" +
+		"+ network tenant-1/workspace-1/network-1\n" +
+		"Plan: 1 to create, 1 to update, 0 to delete, 0 unchanged.\n"
+	assert.Equal(t, expected, plan.String())
+	assert.True(t, plan.HasChanges())
+}
+
+func TestPlan_JSON(t *testing.T) {
+	plan := &Plan{
+		Changes: []Change{
+			{
+				ID:     ResourceID{Kind: schema.ResourceMetadataKindResourceKindInstance, Tenant: secatest.Tenant1Name, Workspace: secatest.Workspace1Name, Name: secatest.Instance1Name},
+				Action: ActionNoop,
+			},
+		},
+	}
+
+	data, err := plan.JSON()
+	assert.NoError(t, err)
+
+	var decoded Plan
+	assert.NoError(t, json.Unmarshal(data, &decoded))
+	assert.Equal(t, plan.Changes[0].ID, decoded.Changes[0].ID)
+	assert.Equal(t, ActionNoop, decoded.Changes[0].Action)
+	assert.False(t, decoded.HasChanges())
+}
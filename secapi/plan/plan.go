@@ -0,0 +1,143 @@
+package plan
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"github.com/eu-sovereign-cloud/go-sdk/pkg/spec/schema"
+)
+
+// Action is the operation required to move a live resource to its desired state.
+type Action string
+
+const (
+	ActionCreate Action = "create"
+	ActionUpdate Action = "update"
+	ActionDelete Action = "delete"
+	ActionNoop   Action = "no-op"
+)
+
+func (a Action) symbol() string {
+	switch a {
+	case ActionCreate:
+		return "+"
+	case ActionUpdate:
+		return "~"
+	case ActionDelete:
+		return "-"
+	default:
+		return "="
+	}
+}
+
+// ResourceID identifies a resource by its kind and its scope.
+type ResourceID struct {
+	Kind      schema.ResourceMetadataKind `json:"kind"`
+	Tenant    string                      `json:"tenant"`
+	Workspace string                      `json:"workspace,omitempty"`
+	Network   string                      `json:"network,omitempty"`
+	Name      string                      `json:"name"`
+}
+
+func (id ResourceID) String() string {
+	parts := []string{id.Tenant}
+	if id.Workspace != "" {
+		parts = append(parts, id.Workspace)
+	}
+	if id.Network != "" {
+		parts = append(parts, id.Network)
+	}
+	parts = append(parts, id.Name)
+
+	return string(id.Kind) + " " + strings.Join(parts, "/")
+}
+
+// Change is the planned action for a single resource.
+type Change struct {
+	ID     ResourceID  `json:"id"`
+	Action Action      `json:"action"`
+	Diffs  []FieldDiff `json:"diffs,omitempty"`
+
+	// Desired and Live hold the compared resources, any of them is nil when it does not exist
+	Desired any `json:"-"`
+	Live    any `json:"-"`
+}
+
+// Plan is the ordered list of changes between the desired and the live resources.
+type Plan struct {
+	Changes []Change `json:"changes"`
+}
+
+// Summary counts the planned changes per action.
+type Summary struct {
+	Create int `json:"create"`
+	Update int `json:"update"`
+	Delete int `json:"delete"`
+	Noop   int `json:"noop"`
+}
+
+// Summary returns the number of planned changes per action.
+func (p *Plan) Summary() Summary {
+	var summary Summary
+	for _, change := range p.Changes {
+		switch change.Action {
+		case ActionCreate:
+			summary.Create++
+		case ActionUpdate:
+			summary.Update++
+		case ActionDelete:
+			summary.Delete++
+		case ActionNoop:
+			summary.Noop++
+		}
+	}
+	return summary
+}
+
+// HasChanges reports if applying the plan would modify any resource.
+func (p *Plan) HasChanges() bool {
+	summary := p.Summary()
+	return summary.Create+summary.Update+summary.Delete > 0
+}
+
+// JSON returns the plan encoded as indented JSON.
+func (p *Plan) JSON() ([]byte, error) {
+	return json.MarshalIndent(p, "", "  ")
+}
+
+// String returns the plan in a human readable form.
+func (p *Plan) String() string {
+	var sb strings.Builder
+
+	for _, change := range p.Changes {
+		fmt.Fprintf(&sb, "%s %s\n", change.Action.symbol(), change.ID)
+
+		if change.Action != ActionUpdate {
+			continue
+		}
+		for _, diff := range change.Diffs {
+			switch diff.Operation {
+			case DiffOperationAdded:
+				fmt.Fprintf(&sb, "    + %s: %s\n", diff.Path, formatValue(diff.To))
+			case DiffOperationRemoved:
+				fmt.Fprintf(&sb, "    - %s: %s\n", diff.Path, formatValue(diff.From))
+			case DiffOperationModified:
+				fmt.Fprintf(&sb, "    ~ %s: %s => %s\n", diff.Path, formatValue(diff.From), formatValue(diff.To))
+			}
+		}
+	}
+
+	summary := p.Summary()
+	fmt.Fprintf(&sb, "Plan: %d to create, %d to update, %d to delete, %d unchanged.\n",
+		summary.Create, summary.Update, summary.Delete, summary.Noop)
+
+	return sb.String()
+}
+
+func formatValue(value any) string {
+	if s, ok := value.(string); ok {
+		return fmt.Sprintf("%q", s)
+	}
+	return fmt.Sprint(value)
+}
@@ -0,0 +1,307 @@
+package plan
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/eu-sovereign-cloud/go-sdk/pkg/spec/schema"
+	"github.com/eu-sovereign-cloud/go-sdk/secapi"
+)
+
+var (
+	ErrUnsupportedResource = errors.New("unsupported resource type")
+	ErrDuplicatedResource  = errors.New("duplicated resource")
+)
+
+// Options
+
+type PlanOptions struct {
+	// Previous holds the resources of the last applied desired state,
+	// the ones missing from the new desired state are planned for deletion.
+	Previous []any
+}
+
+// Planner
+
+type Planner struct {
+	global   *secapi.GlobalClient
+	regional *secapi.RegionalClient
+}
+
+// NewPlanner creates a planner that fetches the live resources through the given clients.
+func NewPlanner(global *secapi.GlobalClient, regional *secapi.RegionalClient) *Planner {
+	return &Planner{global: global, regional: regional}
+}
+
+// Plan compares the desired resources with the live ones.
+func (p *Planner) Plan(ctx context.Context, desired []any) (*Plan, error) {
+	return p.PlanWithOptions(ctx, desired, nil)
+}
+
+// PlanWithOptions compares the desired resources with the live ones, the desired resources
+// must be pointers to schema resources, as *schema.Instance.
+func (p *Planner) PlanWithOptions(ctx context.Context, desired []any, options *PlanOptions) (*Plan, error) {
+	plan := &Plan{}
+	seen := map[ResourceID]bool{}
+
+	for _, resource := range desired {
+		id, err := Identify(resource)
+		if err != nil {
+			return nil, err
+		}
+		if seen[id] {
+			return nil, fmt.Errorf("%w: %s", ErrDuplicatedResource, id)
+		}
+		seen[id] = true
+
+		live, err := p.Fetch(ctx, resource)
+		if err != nil {
+			return nil, err
+		}
+
+		change, err := newChange(id, resource, live)
+		if err != nil {
+			return nil, err
+		}
+		plan.Changes = append(plan.Changes, *change)
+	}
+
+	if options == nil {
+		return plan, nil
+	}
+
+	for _, resource := range options.Previous {
+		id, err := Identify(resource)
+		if err != nil {
+			return nil, err
+		}
+		if seen[id] {
+			continue
+		}
+		seen[id] = true
+
+		live, err := p.Fetch(ctx, resource)
+		if err != nil {
+			return nil, err
+		}
+		if live == nil {
+			// Already deleted
+			continue
+		}
+
+		change, err := newChange(id, nil, live)
+		if err != nil {
+			return nil, err
+		}
+		plan.Changes = append(plan.Changes, *change)
+	}
+
+	return plan, nil
+}
+
+func newChange(id ResourceID, desired, live any) (*Change, error) {
+	diffs, err := Compare(live, desired)
+	if err != nil {
+		return nil, err
+	}
+
+	change := &Change{ID: id, Diffs: diffs, Desired: desired, Live: live}
+	switch {
+	case live == nil:
+		change.Action = ActionCreate
+	case desired == nil:
+		change.Action = ActionDelete
+	case len(diffs) > 0:
+		change.Action = ActionUpdate
+	default:
+		change.Action = ActionNoop
+	}
+
+	return change, nil
+}
+
+// Fetch returns the live version of a resource through the matching Get method,
+// or nil if the resource does not exist.
+func (p *Planner) Fetch(ctx context.Context, resource any) (any, error) {
+	if _, err := Identify(resource); err != nil {
+		return nil, err
+	}
+
+	switch r := resource.(type) {
+	// Authorization
+	case *schema.Role:
+		return liveResource(p.global.AuthorizationV1.GetRole(ctx, tenantReference(r.Metadata.Tenant, r.Metadata.Name)))
+	case *schema.RoleAssignment:
+		return liveResource(p.global.AuthorizationV1.GetRoleAssignment(ctx, tenantReference(r.Metadata.Tenant, r.Metadata.Name)))
+
+	// Workspace
+	case *schema.Workspace:
+		return liveResource(p.regional.WorkspaceV1.GetWorkspace(ctx, tenantReference(r.Metadata.Tenant, r.Metadata.Name)))
+
+	// Storage
+	case *schema.BlockStorage:
+		return liveResource(p.regional.StorageV1.GetBlockStorage(ctx, workspaceReference(r.Metadata)))
+	case *schema.Image:
+		return liveResource(p.regional.StorageV1.GetImage(ctx, tenantReference(r.Metadata.Tenant, r.Metadata.Name)))
+
+	// Compute
+	case *schema.Instance:
+		return liveResource(p.regional.ComputeV1.GetInstance(ctx, workspaceReference(r.Metadata)))
+
+	// Network
+	case *schema.Network:
+		return liveResource(p.regional.NetworkV1.GetNetwork(ctx, workspaceReference(r.Metadata)))
+	case *schema.Subnet:
+		return liveResource(p.regional.NetworkV1.GetSubnet(ctx, networkReference(r.Metadata)))
+	case *schema.RouteTable:
+		return liveResource(p.regional.NetworkV1.GetRouteTable(ctx, networkReference(r.Metadata)))
+	case *schema.InternetGateway:
+		return liveResource(p.regional.NetworkV1.GetInternetGateway(ctx, workspaceReference(r.Metadata)))
+	case *schema.SecurityGroupRule:
+		return liveResource(p.regional.NetworkV1.GetSecurityGroupRule(ctx, workspaceReference(r.Metadata)))
+	case *schema.SecurityGroup:
+		return liveResource(p.regional.NetworkV1.GetSecurityGroup(ctx, workspaceReference(r.Metadata)))
+	case *schema.Nic:
+		return liveResource(p.regional.NetworkV1.GetNic(ctx, workspaceReference(r.Metadata)))
+	case *schema.PublicIp:
+		return liveResource(p.regional.NetworkV1.GetPublicIp(ctx, workspaceReference(r.Metadata)))
+
+	default:
+		return nil, fmt.Errorf("%w: %T", ErrUnsupportedResource, resource)
+	}
+}
+
+func liveResource[T any](live *T, err error) (any, error) {
+	if errors.Is(err, secapi.ErrResourceNotFound) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	// Avoid returning a typed nil inside the interface
+	if live == nil {
+		return nil, nil
+	}
+	return live, nil
+}
+
+func tenantReference(tenant, name string) secapi.TenantReference {
+	return secapi.TenantReference{Tenant: secapi.TenantID(tenant), Name: name}
+}
+
+func workspaceReference(metadata *schema.RegionalWorkspaceResourceMetadata) secapi.WorkspaceReference {
+	return secapi.WorkspaceReference{
+		Tenant:    secapi.TenantID(metadata.Tenant),
+		Workspace: secapi.WorkspaceID(metadata.Workspace),
+		Name:      metadata.Name,
+	}
+}
+
+func networkReference(metadata *schema.RegionalNetworkResourceMetadata) secapi.NetworkReference {
+	return secapi.NetworkReference{
+		Tenant:    secapi.TenantID(metadata.Tenant),
+		Workspace: secapi.WorkspaceID(metadata.Workspace),
+		Network:   secapi.NetworkID(metadata.Network),
+		Name:      metadata.Name,
+	}
+}
+
+// Identify returns the identity of a resource from its metadata.
+func Identify(resource any) (ResourceID, error) {
+	switch r := resource.(type) {
+	// Authorization
+	case *schema.Role:
+		return globalTenantID(schema.ResourceMetadataKindResourceKindRole, r.Metadata)
+	case *schema.RoleAssignment:
+		return globalTenantID(schema.ResourceMetadataKindResourceKindRoleAssignment, r.Metadata)
+
+	// Workspace
+	case *schema.Workspace:
+		return regionalID(schema.ResourceMetadataKindResourceKindWorkspace, r.Metadata)
+
+	// Storage
+	case *schema.BlockStorage:
+		return workspaceID(schema.ResourceMetadataKindResourceKindBlockStorage, r.Metadata)
+	case *schema.Image:
+		return regionalID(schema.ResourceMetadataKindResourceKindImage, r.Metadata)
+
+	// Compute
+	case *schema.Instance:
+		return workspaceID(schema.ResourceMetadataKindResourceKindInstance, r.Metadata)
+
+	// Network
+	case *schema.Network:
+		return workspaceID(schema.ResourceMetadataKindResourceKindNetwork, r.Metadata)
+	case *schema.Subnet:
+		return networkID(schema.ResourceMetadataKindResourceKindSubnet, r.Metadata)
+	case *schema.RouteTable:
+		return networkID(schema.ResourceMetadataKindResourceKindRoutingTable, r.Metadata)
+	case *schema.InternetGateway:
+		return workspaceID(schema.ResourceMetadataKindResourceKindInternetGateway, r.Metadata)
+	case *schema.SecurityGroupRule:
+		return workspaceID(schema.ResourceMetadataKindResourceKindSecurityGroupRule, r.Metadata)
+	case *schema.SecurityGroup:
+		return workspaceID(schema.ResourceMetadataKindResourceKindSecurityGroup, r.Metadata)
+	case *schema.Nic:
+		return workspaceID(schema.ResourceMetadataKindResourceKindNic, r.Metadata)
+	case *schema.PublicIp:
+		return workspaceID(schema.ResourceMetadataKindResourceKindPublicIP, r.Metadata)
+
+	default:
+		return ResourceID{}, fmt.Errorf("%w: %T", ErrUnsupportedResource, resource)
+	}
+}
+
+func globalTenantID(kind schema.ResourceMetadataKind, metadata *schema.GlobalTenantResourceMetadata) (ResourceID, error) {
+	if metadata == nil {
+		return ResourceID{}, secapi.ErrNoMetadata
+	}
+
+	return validateID(ResourceID{Kind: kind, Tenant: metadata.Tenant, Name: metadata.Name})
+}
+
+func regionalID(kind schema.ResourceMetadataKind, metadata *schema.RegionalResourceMetadata) (ResourceID, error) {
+	if metadata == nil {
+		return ResourceID{}, secapi.ErrNoMetadata
+	}
+
+	return validateID(ResourceID{Kind: kind, Tenant: metadata.Tenant, Name: metadata.Name})
+}
+
+func workspaceID(kind schema.ResourceMetadataKind, metadata *schema.RegionalWorkspaceResourceMetadata) (ResourceID, error) {
+	if metadata == nil {
+		return ResourceID{}, secapi.ErrNoMetadata
+	}
+	if metadata.Workspace == "" {
+		return ResourceID{}, secapi.ErrNoMetadataWorkspace
+	}
+
+	return validateID(ResourceID{Kind: kind, Tenant: metadata.Tenant, Workspace: metadata.Workspace, Name: metadata.Name})
+}
+
+func networkID(kind schema.ResourceMetadataKind, metadata *schema.RegionalNetworkResourceMetadata) (ResourceID, error) {
+	if metadata == nil {
+		return ResourceID{}, secapi.ErrNoMetadata
+	}
+	if metadata.Workspace == "" {
+		return ResourceID{}, secapi.ErrNoMetadataWorkspace
+	}
+	if metadata.Network == "" {
+		return ResourceID{}, secapi.ErrNoMetadataNetwork
+	}
+
+	return validateID(ResourceID{Kind: kind, Tenant: metadata.Tenant, Workspace: metadata.Workspace, Network: metadata.Network, Name: metadata.Name})
+}
+
+func validateID(id ResourceID) (ResourceID, error) {
+	if id.Tenant == "" {
+		return ResourceID{}, secapi.ErrNoMetadataTenant
+	}
+	if id.Name == "" {
+		return ResourceID{}, secapi.ErrNoMetadataName
+	}
+
+	return id, nil
+}
@@ -0,0 +1,113 @@
+package plan
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/eu-sovereign-cloud/go-sdk/internal/secatest"
+	"github.com/eu-sovereign-cloud/go-sdk/internal/secatest/clients"
+	mockcompute "github.com/eu-sovereign-cloud/go-sdk/mock/spec/foundation.compute.v1"
+	mocknetwork "github.com/eu-sovereign-cloud/go-sdk/mock/spec/foundation.network.v1"
+	mockstorage "github.com/eu-sovereign-cloud/go-sdk/mock/spec/foundation.storage.v1"
+	"github.com/eu-sovereign-cloud/go-sdk/pkg/spec/schema"
+	"github.com/eu-sovereign-cloud/go-sdk/secapi"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestPlanner_Plan(t *testing.T) {
+	ctx := context.Background()
+	sm := http.NewServeMux()
+
+	secatest.ConfigureRegionV1Handler(t, sm)
+
+	live := secatest.NewInstance(secatest.Instance1Name, secatest.ZoneA)
+	live.Metadata.ResourceVersion = 3
+	live.Status = secatest.NewInstanceStatus(schema.ResourceStateActive)
+
+	csim := mockcompute.NewMockServerInterface(t)
+	secatest.MockGetInstanceV1(csim, live, 1)
+	secatest.ConfigureComputeHandler(csim, sm)
+
+	nsim := mocknetwork.NewMockServerInterface(t)
+	secatest.MockNotFoundNetworkV1(nsim, nil, 1)
+	secatest.ConfigureNetworkHandler(nsim, sm)
+
+	server := httptest.NewServer(sm)
+	defer server.Close()
+
+	planner := NewPlanner(clients.New(t, ctx, secapi.GlobalConfig{Endpoints: clients.Endpoints(server.URL)}))
+
+	desired := []any{
+		secatest.NewInstance(secatest.Instance1Name, "b"),
+		&schema.Network{
+			Metadata: secatest.NewRegionalWorkspaceResourceMetadata(secatest.Network1Name, secatest.Tenant1Name, secatest.Workspace1Name, secatest.Region1Name),
+			Spec:     schema.NetworkSpec{Cidr: schema.Cidr{Ipv4: secatest.CidrIpv4}, SkuRef: schema.Reference{Resource: secatest.NetworkSku1Ref}},
+		},
+	}
+
+	plan, err := planner.Plan(ctx, desired)
+	assert.NoError(t, err)
+	assert.Len(t, plan.Changes, 2)
+
+	assert.Equal(t, ActionUpdate, plan.Changes[0].Action)
+	assert.Equal(t, schema.ResourceMetadataKindResourceKindInstance, plan.Changes[0].ID.Kind)
+	assert.Equal(t, []FieldDiff{{Path: "spec.zone", Operation: DiffOperationModified, From: secatest.ZoneA, To: "b"}}, plan.Changes[0].Diffs)
+
+	assert.Equal(t, ActionCreate, plan.Changes[1].Action)
+	assert.Nil(t, plan.Changes[1].Live)
+
+	assert.Equal(t, Summary{Create: 1, Update: 1}, plan.Summary())
+}
+
+func TestPlanner_PlanWithPrevious(t *testing.T) {
+	ctx := context.Background()
+	sm := http.NewServeMux()
+
+	secatest.ConfigureRegionV1Handler(t, sm)
+
+	block := &schema.BlockStorage{
+		Metadata: secatest.NewRegionalWorkspaceResourceMetadata(secatest.BlockStorage1Name, secatest.Tenant1Name, secatest.Workspace1Name, secatest.Region1Name),
+		Spec:     schema.BlockStorageSpec{SizeGB: secatest.BlockStorage1SizeGB, SkuRef: schema.Reference{Resource: secatest.StorageSku1Ref}},
+	}
+
+	ssim := mockstorage.NewMockServerInterface(t)
+	secatest.MockGetBlockStorageV1(ssim, block, 1)
+	secatest.ConfigureStorageHandler(ssim, sm)
+
+	csim := mockcompute.NewMockServerInterface(t)
+	secatest.MockGetInstanceV1(csim, secatest.NewInstance(secatest.Instance1Name, secatest.ZoneA), 1)
+	secatest.ConfigureComputeHandler(csim, sm)
+
+	server := httptest.NewServer(sm)
+	defer server.Close()
+
+	planner := NewPlanner(clients.New(t, ctx, secapi.GlobalConfig{Endpoints: clients.Endpoints(server.URL)}))
+
+	instance := secatest.NewInstance(secatest.Instance1Name, secatest.ZoneA)
+	plan, err := planner.PlanWithOptions(ctx, []any{instance}, &PlanOptions{Previous: []any{instance, block}})
+	assert.NoError(t, err)
+	assert.Len(t, plan.Changes, 2)
+
+	assert.Equal(t, ActionNoop, plan.Changes[0].Action)
+	assert.Equal(t, ActionDelete, plan.Changes[1].Action)
+	assert.Equal(t, schema.ResourceMetadataKindResourceKindBlockStorage, plan.Changes[1].ID.Kind)
+}
+
+func TestPlanner_PlanErrors(t *testing.T) {
+	ctx := context.Background()
+	planner := NewPlanner(nil, nil)
+
+	_, err := planner.Plan(ctx, []any{&schema.Region{}})
+	assert.ErrorIs(t, err, ErrUnsupportedResource)
+
+	_, err = planner.Plan(ctx, []any{&schema.Instance{}})
+	assert.ErrorIs(t, err, secapi.ErrNoMetadata)
+
+	instance := secatest.NewInstance(secatest.Instance1Name, secatest.ZoneA)
+	instance.Metadata.Workspace = ""
+	_, err = planner.Plan(ctx, []any{instance})
+	assert.ErrorIs(t, err, secapi.ErrNoMetadataWorkspace)
+}
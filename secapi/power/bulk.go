@@ -0,0 +1,286 @@
+// Package power runs the power actions of many instances at once: the instances selected by their
+// labels are started, stopped or restarted with a bounded concurrency, the transient failures are
+// retried and the power state of each instance is waited for before it's reported.
+package power
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"net"
+	"slices"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/cenkalti/backoff/v4"
+
+	"github.com/eu-sovereign-cloud/go-sdk/pkg/spec/schema"
+	"github.com/eu-sovereign-cloud/go-sdk/secapi"
+	"github.com/eu-sovereign-cloud/go-sdk/secapi/builders"
+)
+
+const (
+	DefaultConcurrency   = 10
+	DefaultRetries       = 3
+	DefaultRetryInterval = time.Second
+)
+
+// DefaultObserver is the wait for the power state used for the zero fields of Options.Observer.
+var DefaultObserver = secapi.ResourceObserverConfig{
+	Delay:       time.Second,
+	Interval:    2 * time.Second,
+	MaxAttempts: 60,
+}
+
+var ErrUnknownAction = errors.New("unknown power action")
+
+// Action is a power action of the instances.
+type Action string
+
+const (
+	ActionStart   Action = "start"
+	ActionStop    Action = "stop"
+	ActionRestart Action = "restart"
+)
+
+// Returns the power state an instance reaches with the action
+func (a Action) powerState() (schema.InstanceStatusPowerState, error) {
+	switch a {
+	case ActionStart, ActionRestart:
+		return schema.InstanceStatusPowerStateOn, nil
+	case ActionStop:
+		return schema.InstanceStatusPowerStateOff, nil
+	default:
+		return "", fmt.Errorf("%w: %s", ErrUnknownAction, a)
+	}
+}
+
+// Options configure a bulk operation, the zero values are replaced by the defaults and negative
+// retries disable the retries. Observer configures the wait for the power state of each instance,
+// its zero fields are taken from DefaultObserver.
+type Options struct {
+	Concurrency   int
+	Retries       int
+	RetryInterval time.Duration
+	Observer      secapi.ResourceObserverConfig
+}
+
+// Result is the outcome of the action on one instance, Attempts counts the times the action was
+// issued. An instance already in the power state reached by a start or a stop is skipped, without
+// any attempt.
+type Result struct {
+	Name       string
+	Attempts   int
+	Skipped    bool
+	PowerState schema.InstanceStatusPowerState
+	Err        error
+}
+
+// Report is the outcome of a bulk operation, the results are sorted by instance name.
+type Report struct {
+	Action  Action
+	Results []Result
+}
+
+// Failed returns the results of the instances whose action failed.
+func (r *Report) Failed() []Result {
+	var failed []Result
+	for _, result := range r.Results {
+		if result.Err != nil {
+			failed = append(failed, result)
+		}
+	}
+	return failed
+}
+
+// Err joins the errors of the failed instances, prefixed by their name.
+func (r *Report) Err() error {
+	var errs []error
+	for _, result := range r.Failed() {
+		errs = append(errs, fmt.Errorf("instance %s: %w", result.Name, result.Err))
+	}
+	return errors.Join(errs...)
+}
+
+// Start starts the instances of the workspace matching the labels.
+func Start(ctx context.Context, api secapi.ComputeV1, wpath secapi.WorkspacePath, labels *builders.LabelsBuilder, options Options) (*Report, error) {
+	return Run(ctx, api, wpath, labels, ActionStart, options)
+}
+
+// Stop stops the instances of the workspace matching the labels.
+func Stop(ctx context.Context, api secapi.ComputeV1, wpath secapi.WorkspacePath, labels *builders.LabelsBuilder, options Options) (*Report, error) {
+	return Run(ctx, api, wpath, labels, ActionStop, options)
+}
+
+// Restart restarts the instances of the workspace matching the labels. The instances are on before
+// and after a restart, so the wait for the power state may return before the restart took effect,
+// after the delay of the observer.
+func Restart(ctx context.Context, api secapi.ComputeV1, wpath secapi.WorkspacePath, labels *builders.LabelsBuilder, options Options) (*Report, error) {
+	return Run(ctx, api, wpath, labels, ActionRestart, options)
+}
+
+// Run lists the instances of the workspace matching the labels and runs the action on them: it
+// issues the action then waits for the power state. Each step is retried on its own when it fails
+// with a transient error, an action accepted by the provider is never issued again. The error is
+// only about the listing, the failures of the instances are reported.
+func Run(ctx context.Context, api secapi.ComputeV1, wpath secapi.WorkspacePath, labels *builders.LabelsBuilder, action Action, options Options) (*Report, error) {
+	powerState, err := action.powerState()
+	if err != nil {
+		return nil, err
+	}
+
+	listOptions := secapi.NewListOptions()
+	if labels != nil {
+		listOptions.WithLabels(labels)
+	}
+	iter, err := api.ListInstancesWithOptions(ctx, wpath, listOptions)
+	if err != nil {
+		return nil, err
+	}
+	instances, err := iter.All(ctx)
+	if err != nil {
+		return nil, err
+	}
+	for _, inst := range instances {
+		if inst.Metadata == nil {
+			return nil, fmt.Errorf("instance: %w", secapi.ErrNoMetadata)
+		}
+	}
+	slices.SortFunc(instances, func(a, b *schema.Instance) int {
+		return strings.Compare(a.Metadata.Name, b.Metadata.Name)
+	})
+
+	options = options.withDefaults()
+	report := &Report{Action: action, Results: make([]Result, len(instances))}
+
+	sem := make(chan struct{}, options.Concurrency)
+	var wg sync.WaitGroup
+	for i, inst := range instances {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+
+			sem <- struct{}{}
+			defer func() { <-sem }()
+
+			report.Results[i] = run(ctx, api, inst, action, powerState, options)
+		}()
+	}
+	wg.Wait()
+
+	return report, nil
+}
+
+// Transient tells whether an error of the provider, or of the network reaching it, may not happen
+// again on a later attempt. The cancellation and the deadline of the context are never transient,
+// even when they are reported by the network.
+func Transient(err error) bool {
+	if errors.Is(err, context.Canceled) || errors.Is(err, context.DeadlineExceeded) {
+		return false
+	}
+
+	var netErr net.Error
+	return errors.Is(err, secapi.ErrInternalError) ||
+		errors.Is(err, secapi.ErrConflictingRequest) ||
+		errors.Is(err, secapi.ErrUnknowError) ||
+		errors.Is(err, io.ErrUnexpectedEOF) ||
+		errors.As(err, &netErr)
+}
+
+func run(ctx context.Context, api secapi.ComputeV1, inst *schema.Instance, action Action, powerState schema.InstanceStatusPowerState, options Options) Result {
+	result := Result{Name: inst.Metadata.Name}
+	if action != ActionRestart && inst.Status != nil && inst.Status.PowerState == powerState {
+		result.Skipped = true
+		result.PowerState = powerState
+		return result
+	}
+
+	wref := secapi.WorkspaceReference{
+		Tenant:    secapi.TenantID(inst.Metadata.Tenant),
+		Workspace: secapi.WorkspaceID(inst.Metadata.Workspace),
+		Name:      inst.Metadata.Name,
+	}
+	config := secapi.ResourceObserverUntilValueConfig[schema.InstanceStatusPowerState]{
+		ExpectedValues: []schema.InstanceStatusPowerState{powerState},
+		Delay:          options.Observer.Delay,
+		Interval:       options.Observer.Interval,
+		MaxAttempts:    options.Observer.MaxAttempts,
+	}
+
+	err := retry(ctx, options, func() error {
+		result.Attempts++
+		return issue(ctx, api, inst, action)
+	})
+	if err != nil {
+		result.Err = err
+		return result
+	}
+
+	result.Err = retry(ctx, options, func() error {
+		updated, err := api.GetInstanceUntilPowerState(ctx, wref, config)
+		if err != nil {
+			return err
+		}
+		if updated != nil && updated.Status != nil {
+			result.PowerState = updated.Status.PowerState
+		} else {
+			result.PowerState = powerState
+		}
+		return nil
+	})
+	return result
+}
+
+// Runs an operation until it succeeds or fails with an error that isn't transient
+func retry(ctx context.Context, options Options, operation func() error) error {
+	be := backoff.NewExponentialBackOff()
+	be.InitialInterval = options.RetryInterval
+
+	return backoff.Retry(func() error {
+		return permanentUnlessTransient(operation())
+	}, backoff.WithContext(backoff.WithMaxRetries(be, uint64(options.Retries)), ctx))
+}
+
+func issue(ctx context.Context, api secapi.ComputeV1, inst *schema.Instance, action Action) error {
+	switch action {
+	case ActionStart:
+		return api.StartInstance(ctx, inst)
+	case ActionStop:
+		return api.StopInstance(ctx, inst)
+	default:
+		return api.RestartInstance(ctx, inst)
+	}
+}
+
+func permanentUnlessTransient(err error) error {
+	if Transient(err) {
+		return err
+	}
+	return backoff.Permanent(err)
+}
+
+func (o Options) withDefaults() Options {
+	if o.Concurrency <= 0 {
+		o.Concurrency = DefaultConcurrency
+	}
+	if o.Retries < 0 {
+		o.Retries = 0
+	} else if o.Retries == 0 {
+		o.Retries = DefaultRetries
+	}
+	if o.RetryInterval <= 0 {
+		o.RetryInterval = DefaultRetryInterval
+	}
+	if o.Observer.Delay <= 0 {
+		o.Observer.Delay = DefaultObserver.Delay
+	}
+	if o.Observer.Interval <= 0 {
+		o.Observer.Interval = DefaultObserver.Interval
+	}
+	if o.Observer.MaxAttempts <= 0 {
+		o.Observer.MaxAttempts = DefaultObserver.MaxAttempts
+	}
+	return o
+}
@@ -0,0 +1,162 @@
+package power
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"net"
+	"net/url"
+	"sync/atomic"
+	"syscall"
+	"testing"
+	"time"
+
+	"github.com/eu-sovereign-cloud/go-sdk/internal/secatest"
+	mocksecapi "github.com/eu-sovereign-cloud/go-sdk/mock/secapi"
+	"github.com/eu-sovereign-cloud/go-sdk/pkg/spec/schema"
+	"github.com/eu-sovereign-cloud/go-sdk/secapi"
+	"github.com/eu-sovereign-cloud/go-sdk/secapi/builders"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+var wpath = secapi.WorkspacePath{Tenant: secatest.Tenant1Name, Workspace: secatest.Workspace1Name}
+
+func TestStop(t *testing.T) {
+	ctx := context.Background()
+	labels := builders.NewLabelsBuilder().Equals(secatest.LabelEnvKey, secatest.LabelEnvValue)
+
+	api := mocksecapi.NewMockComputeV1(t)
+	api.EXPECT().ListInstancesWithOptions(mock.Anything, wpath, mock.MatchedBy(func(options *secapi.ListOptions) bool {
+		return options.Labels == labels
+	})).Return(secapi.NewIterator(secatest.Page(
+		buildInstance("vm-4", schema.InstanceStatusPowerStateOn),
+		buildInstance("vm-1", schema.InstanceStatusPowerStateOn),
+		buildInstance("vm-2", schema.InstanceStatusPowerStateOff),
+		buildInstance("vm-3", schema.InstanceStatusPowerStateOn),
+	)), nil)
+
+	var vm3Attempts atomic.Int32
+	api.EXPECT().StopInstance(mock.Anything, mock.Anything).RunAndReturn(func(ctx context.Context, inst *schema.Instance) error {
+		switch inst.Metadata.Name {
+		case "vm-3":
+			if vm3Attempts.Add(1) == 1 {
+				return secapi.ErrInternalError
+			}
+		case "vm-4":
+			return secapi.ErrForbiddenAccess
+		}
+		return nil
+	}).Times(4)
+
+	api.EXPECT().GetInstanceUntilPowerState(mock.Anything, mock.Anything, mock.Anything).RunAndReturn(
+		func(ctx context.Context, wref secapi.WorkspaceReference, config secapi.ResourceObserverUntilValueConfig[schema.InstanceStatusPowerState]) (*schema.Instance, error) {
+			assert.Equal(t, []schema.InstanceStatusPowerState{schema.InstanceStatusPowerStateOff}, config.ExpectedValues)
+			assert.Equal(t, 5, config.MaxAttempts)
+			return buildInstance(wref.Name, schema.InstanceStatusPowerStateOff), nil
+		}).Twice()
+
+	report, err := Stop(ctx, api, wpath, labels, Options{
+		Concurrency:   2,
+		RetryInterval: time.Millisecond,
+		Observer:      secapi.ResourceObserverConfig{MaxAttempts: 5},
+	})
+	require.NoError(t, err)
+
+	assert.Equal(t, ActionStop, report.Action)
+	require.Len(t, report.Results, 4)
+	assert.Equal(t, Result{Name: "vm-1", Attempts: 1, PowerState: schema.InstanceStatusPowerStateOff}, report.Results[0])
+	assert.Equal(t, Result{Name: "vm-2", Skipped: true, PowerState: schema.InstanceStatusPowerStateOff}, report.Results[1])
+	assert.Equal(t, Result{Name: "vm-3", Attempts: 2, PowerState: schema.InstanceStatusPowerStateOff}, report.Results[2])
+	assert.Equal(t, "vm-4", report.Results[3].Name)
+	assert.Equal(t, 1, report.Results[3].Attempts)
+	assert.ErrorIs(t, report.Results[3].Err, secapi.ErrForbiddenAccess)
+
+	require.Len(t, report.Failed(), 1)
+	assert.ErrorIs(t, report.Err(), secapi.ErrForbiddenAccess)
+	assert.ErrorContains(t, report.Err(), "instance vm-4")
+}
+
+func TestRestart_RetriesExhausted(t *testing.T) {
+	api := mocksecapi.NewMockComputeV1(t)
+	api.EXPECT().ListInstancesWithOptions(mock.Anything, wpath, mock.Anything).
+		Return(secapi.NewIterator(secatest.Page(buildInstance("vm-1", schema.InstanceStatusPowerStateOn))), nil)
+	api.EXPECT().RestartInstance(mock.Anything, mock.Anything).Return(nil).Once()
+	api.EXPECT().GetInstanceUntilPowerState(mock.Anything, mock.Anything, mock.Anything).
+		RunAndReturn(func(ctx context.Context, wref secapi.WorkspaceReference, config secapi.ResourceObserverUntilValueConfig[schema.InstanceStatusPowerState]) (*schema.Instance, error) {
+			assert.Equal(t, DefaultObserver.Interval, config.Interval)
+			assert.Equal(t, DefaultObserver.MaxAttempts, config.MaxAttempts)
+			return nil, secapi.ErrInternalError
+		}).Times(3)
+
+	report, err := Restart(context.Background(), api, wpath, nil, Options{Retries: 2, RetryInterval: time.Millisecond})
+	require.NoError(t, err)
+
+	require.Len(t, report.Results, 1)
+	assert.Equal(t, 1, report.Results[0].Attempts)
+	assert.ErrorIs(t, report.Results[0].Err, secapi.ErrInternalError)
+}
+
+func TestStart_WaitTimeout(t *testing.T) {
+	api := mocksecapi.NewMockComputeV1(t)
+	api.EXPECT().ListInstancesWithOptions(mock.Anything, wpath, mock.Anything).
+		Return(secapi.NewIterator(secatest.Page(buildInstance("vm-1", schema.InstanceStatusPowerStateOff))), nil)
+	api.EXPECT().StartInstance(mock.Anything, mock.Anything).Return(nil).Once()
+	api.EXPECT().GetInstanceUntilPowerState(mock.Anything, mock.Anything, mock.Anything).
+		Return(nil, secapi.ErrRetryMaxAttemptsReached).Once()
+
+	report, err := Start(context.Background(), api, wpath, nil, Options{RetryInterval: time.Millisecond})
+	require.NoError(t, err)
+
+	require.Len(t, report.Results, 1)
+	assert.Equal(t, 1, report.Results[0].Attempts)
+	assert.ErrorIs(t, report.Results[0].Err, secapi.ErrRetryMaxAttemptsReached)
+}
+
+func TestRun_Errors(t *testing.T) {
+	api := mocksecapi.NewMockComputeV1(t)
+
+	_, err := Run(context.Background(), api, wpath, nil, "suspend", Options{})
+	assert.ErrorIs(t, err, ErrUnknownAction)
+
+	api.EXPECT().ListInstancesWithOptions(mock.Anything, wpath, mock.Anything).Return(nil, secapi.ErrForbiddenAccess).Once()
+	_, err = Start(context.Background(), api, wpath, nil, Options{})
+	assert.ErrorIs(t, err, secapi.ErrForbiddenAccess)
+
+	api.EXPECT().ListInstancesWithOptions(mock.Anything, wpath, mock.Anything).
+		Return(secapi.NewIterator(secatest.Page(buildInstance("vm-1", schema.InstanceStatusPowerStateOn), &schema.Instance{})), nil).Once()
+	_, err = Stop(context.Background(), api, wpath, nil, Options{})
+	assert.ErrorIs(t, err, secapi.ErrNoMetadata)
+}
+
+func TestTransient(t *testing.T) {
+	for _, err := range []error{
+		secapi.ErrInternalError,
+		fmt.Errorf("instance vm-1: %w", secapi.ErrConflictingRequest),
+		io.ErrUnexpectedEOF,
+		&url.Error{Op: "Get", URL: "https://example.com", Err: syscall.ECONNRESET},
+		&net.OpError{Op: "dial", Net: "tcp", Err: syscall.ECONNREFUSED},
+	} {
+		assert.True(t, Transient(err), err.Error())
+	}
+
+	for _, err := range []error{
+		secapi.ErrForbiddenAccess,
+		context.Canceled,
+		context.DeadlineExceeded,
+		&url.Error{Op: "Get", URL: "https://example.com", Err: context.Canceled},
+		&url.Error{Op: "Get", URL: "https://example.com", Err: context.DeadlineExceeded},
+	} {
+		assert.False(t, Transient(err), err.Error())
+	}
+}
+
+func buildInstance(name string, powerState schema.InstanceStatusPowerState) *schema.Instance {
+	return &schema.Instance{
+		Metadata: secatest.NewRegionalWorkspaceResourceMetadata(name, secatest.Tenant1Name, secatest.Workspace1Name, secatest.Region1Name),
+		Labels:   schema.Labels{secatest.LabelEnvKey: secatest.LabelEnvValue},
+		Status:   &schema.InstanceStatus{PowerState: powerState},
+	}
+}
@@ -0,0 +1,240 @@
+// Package reachability analyzes the security groups of a workspace: it resolves the NICs and
+// the instances each group applies to, answers whether a source can reach an instance with
+// a protocol and a port, and flags the overly broad, shadowed and duplicate rules.
+//
+// The rules only allow traffic, what they don't allow is denied. An endpoint without any
+// security group is not filtered.
+package reachability
+
+import (
+	"context"
+	"fmt"
+	"net/netip"
+	"slices"
+
+	"github.com/eu-sovereign-cloud/go-sdk/internal/secautil"
+	"github.com/eu-sovereign-cloud/go-sdk/pkg/spec/schema"
+	"github.com/eu-sovereign-cloud/go-sdk/secapi"
+)
+
+// Resource types of the references
+const (
+	securityGroupsType     = "security-groups"
+	securityGroupRulesType = "security-group-rules"
+	instancesType          = "instances"
+	nicsType               = "nics"
+	internetGatewaysType   = "internet-gateways"
+	natGatewaysType        = "internet-nat-gateway-instances"
+)
+
+// RuleRef identifies a rule of a security group, the shared rules are referenced
+// by the group and named, the inline ones are indexed in the group.
+type RuleRef struct {
+	Group string
+	Rule  string
+	Index int
+}
+
+func (r RuleRef) String() string {
+	if r.Rule != "" {
+		return fmt.Sprintf("%s/%s", r.Group, r.Rule)
+	}
+	return fmt.Sprintf("%s/rules[%d]", r.Group, r.Index)
+}
+
+// Rule of a security group, inline or shared
+type rule struct {
+	ref  RuleRef
+	spec schema.SecurityGroupRuleSpec
+}
+
+type group struct {
+	name  string
+	rules []*rule
+
+	// Names of the rules referenced by the group and not found
+	missing []string
+}
+
+type nic struct {
+	name      string
+	addresses []netip.Addr
+	groups    []string
+}
+
+type instance struct {
+	name   string
+	nics   []string
+	groups []string
+}
+
+// Analyzer holds the security groups of a workspace and the resources they apply to.
+type Analyzer struct {
+	groups    map[string]*group
+	nics      map[string]*nic
+	instances map[string]*instance
+}
+
+// Load lists the security groups, their shared rules, the NICs and the instances of a workspace.
+func Load(ctx context.Context, network secapi.NetworkV1, compute secapi.ComputeV1, wpath secapi.WorkspacePath) (*Analyzer, error) {
+	groups, err := secautil.List(ctx, network.ListSecurityGroups, wpath)
+	if err != nil {
+		return nil, err
+	}
+	rules, err := secautil.List(ctx, network.ListSecurityGroupRules, wpath)
+	if err != nil {
+		return nil, err
+	}
+	nics, err := secautil.List(ctx, network.ListNics, wpath)
+	if err != nil {
+		return nil, err
+	}
+	instances, err := secautil.List(ctx, compute.ListInstances, wpath)
+	if err != nil {
+		return nil, err
+	}
+
+	return New(groups, rules, nics, instances)
+}
+
+// New creates an analyzer of the given resources, the resources are expected in the same workspace.
+func New(groups []*schema.SecurityGroup, rules []*schema.SecurityGroupRule, nics []*schema.Nic, instances []*schema.Instance) (*Analyzer, error) {
+	a := &Analyzer{
+		groups:    map[string]*group{},
+		nics:      map[string]*nic{},
+		instances: map[string]*instance{},
+	}
+
+	shared := map[string]*schema.SecurityGroupRule{}
+	for _, r := range rules {
+		if r.Metadata == nil {
+			return nil, fmt.Errorf("security group rule: %w", secapi.ErrNoMetadata)
+		}
+		shared[r.Metadata.Name] = r
+	}
+
+	for _, sg := range groups {
+		if sg.Metadata == nil {
+			return nil, fmt.Errorf("security group: %w", secapi.ErrNoMetadata)
+		}
+
+		g := &group{name: sg.Metadata.Name}
+		for i, spec := range sg.Spec.Rules {
+			g.rules = append(g.rules, &rule{ref: RuleRef{Group: g.name, Index: i}, spec: spec})
+		}
+		for _, ref := range sg.Spec.RuleRefs {
+			name, _ := secautil.RefName(ref, securityGroupRulesType)
+			r, found := shared[name]
+			if !found {
+				g.missing = append(g.missing, ref.Resource)
+				continue
+			}
+			g.rules = append(g.rules, &rule{ref: RuleRef{Group: g.name, Rule: name}, spec: r.Spec})
+		}
+		a.groups[g.name] = g
+	}
+
+	for _, n := range nics {
+		if n.Metadata == nil {
+			return nil, fmt.Errorf("nic: %w", secapi.ErrNoMetadata)
+		}
+
+		addresses := n.Spec.Addresses
+		if n.Status != nil && len(n.Status.Addresses) > 0 {
+			addresses = n.Status.Addresses
+		}
+
+		entry := &nic{name: n.Metadata.Name, groups: groupNames(n.Spec.SecurityGroupRefs...)}
+		for _, address := range addresses {
+			addr, err := netip.ParseAddr(address)
+			if err != nil {
+				return nil, fmt.Errorf("nic %s: %w", entry.name, err)
+			}
+			entry.addresses = append(entry.addresses, addr)
+		}
+		a.nics[entry.name] = entry
+	}
+
+	for _, inst := range instances {
+		if inst.Metadata == nil {
+			return nil, fmt.Errorf("instance: %w", secapi.ErrNoMetadata)
+		}
+
+		entry := &instance{name: inst.Metadata.Name}
+		if inst.Spec.SecurityGroupRef != nil {
+			entry.groups = groupNames(*inst.Spec.SecurityGroupRef)
+		}
+		var refs []schema.Reference
+		if inst.Spec.PrimaryNicRef != nil {
+			refs = append(refs, *inst.Spec.PrimaryNicRef)
+		}
+		for _, ref := range append(refs, inst.Spec.AdditionalNicRefs...) {
+			if name, ok := secautil.RefName(ref, nicsType); ok {
+				entry.nics = append(entry.nics, name)
+			}
+		}
+		a.instances[entry.name] = entry
+	}
+
+	return a, nil
+}
+
+// AppliesTo returns the names of the NICs and the instances a security group applies to,
+// an instance is filtered by its own group and the groups of its NICs.
+func (a *Analyzer) AppliesTo(groupName string) (nics []string, instances []string) {
+	for _, n := range a.nics {
+		if slices.Contains(n.groups, groupName) {
+			nics = append(nics, n.name)
+		}
+	}
+	for _, inst := range a.instances {
+		if slices.Contains(a.instanceGroups(inst), groupName) {
+			instances = append(instances, inst.name)
+		}
+	}
+
+	slices.Sort(nics)
+	slices.Sort(instances)
+	return nics, instances
+}
+
+// GroupsOf returns the names of the security groups filtering the traffic of an instance.
+func (a *Analyzer) GroupsOf(instanceName string) ([]string, error) {
+	inst, found := a.instances[instanceName]
+	if !found {
+		return nil, fmt.Errorf("%w: instance %s", ErrUnknownEndpoint, instanceName)
+	}
+	return a.instanceGroups(inst), nil
+}
+
+func (a *Analyzer) instanceGroups(inst *instance) []string {
+	groups := slices.Clone(inst.groups)
+	for _, name := range inst.nics {
+		if n, found := a.nics[name]; found {
+			groups = append(groups, n.groups...)
+		}
+	}
+
+	slices.Sort(groups)
+	return slices.Compact(groups)
+}
+
+func (a *Analyzer) instanceAddresses(inst *instance) []netip.Addr {
+	var addresses []netip.Addr
+	for _, name := range inst.nics {
+		if n, found := a.nics[name]; found {
+			addresses = append(addresses, n.addresses...)
+		}
+	}
+	return addresses
+}
+
+func groupNames(refs ...schema.Reference) []string {
+	var names []string
+	for _, ref := range refs {
+		if name, ok := secautil.RefName(ref, securityGroupsType); ok {
+			names = append(names, name)
+		}
+	}
+	return names
+}
@@ -0,0 +1,249 @@
+package reachability
+
+import (
+	"context"
+	"net/netip"
+	"testing"
+
+	"github.com/eu-sovereign-cloud/go-sdk/internal/secatest"
+	mocksecapi "github.com/eu-sovereign-cloud/go-sdk/mock/secapi"
+	"github.com/eu-sovereign-cloud/go-sdk/pkg/spec/schema"
+	"github.com/eu-sovereign-cloud/go-sdk/secapi"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestAnalyzer_Reachable(t *testing.T) {
+	analyzer := buildAnalyzer(t)
+
+	tests := []struct {
+		name    string
+		query   Query
+		allowed bool
+		egress  []RuleRef
+		ingress []RuleRef
+	}{
+		{
+			name:    "address to bastion",
+			query:   Query{Source: Endpoint{Address: netip.MustParseAddr("203.0.113.7")}, Destination: Endpoint{Instance: "bastion"}, Protocol: schema.SecurityGroupRuleProtocolTCP, Port: 22},
+			allowed: true,
+			ingress: []RuleRef{{Group: "bastion", Index: 0}},
+		},
+		{
+			name:  "unknown address to bastion",
+			query: Query{Source: Endpoint{Address: netip.MustParseAddr("198.51.100.1")}, Destination: Endpoint{Instance: "bastion"}, Protocol: schema.SecurityGroupRuleProtocolTCP, Port: 22},
+		},
+		{
+			name:    "bastion to web",
+			query:   Query{Source: Endpoint{Instance: "bastion"}, Destination: Endpoint{Instance: "web"}, Protocol: schema.SecurityGroupRuleProtocolTCP, Port: 22},
+			allowed: true,
+			egress:  []RuleRef{{Group: "bastion", Index: 1}},
+			ingress: []RuleRef{{Group: "web", Index: 1}, {Group: "web", Index: 3}},
+		},
+		{
+			name:  "db without egress",
+			query: Query{Source: Endpoint{Instance: "db"}, Destination: Endpoint{Instance: "web"}, Protocol: schema.SecurityGroupRuleProtocolTCP, Port: 443},
+		},
+		{
+			name:    "gateway to web",
+			query:   Query{Source: Endpoint{Gateway: "internet-gateways/gateway-1"}, Destination: Endpoint{Instance: "web"}, Protocol: schema.SecurityGroupRuleProtocolTCP, Port: 443},
+			allowed: true,
+			ingress: []RuleRef{{Group: "web", Index: 0}},
+		},
+		{
+			name:   "web to bastion over icmp",
+			query:  Query{Source: Endpoint{Instance: "web"}, Destination: Endpoint{Instance: "bastion"}, Protocol: schema.SecurityGroupRuleProtocolICMP},
+			egress: []RuleRef{{Group: "web", Rule: "web-egress"}},
+		},
+		{
+			name:    "web to unfiltered address",
+			query:   Query{Source: Endpoint{Instance: "web"}, Destination: Endpoint{Address: netip.MustParseAddr("10.0.0.1")}, Protocol: schema.SecurityGroupRuleProtocolUDP, Port: 53},
+			allowed: true,
+			egress:  []RuleRef{{Group: "web", Rule: "web-egress"}},
+		},
+		{
+			name:  "web over IPv6",
+			query: Query{Source: Endpoint{Address: netip.MustParseAddr("2001:db8::1")}, Destination: Endpoint{Instance: "web"}, Protocol: schema.SecurityGroupRuleProtocolTCP, Port: 443, Version: schema.IPVersionIPv6},
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			verdict, err := analyzer.Reachable(test.query)
+			require.NoError(t, err)
+
+			assert.Equal(t, test.allowed, verdict.Allowed)
+			assert.Equal(t, test.egress, verdict.Egress)
+			assert.Equal(t, test.ingress, verdict.Ingress)
+			if !test.allowed {
+				assert.NotEmpty(t, verdict.Reason)
+			}
+		})
+	}
+}
+
+func TestAnalyzer_ReachableErrors(t *testing.T) {
+	analyzer := buildAnalyzer(t)
+
+	_, err := analyzer.Reachable(Query{Source: Endpoint{Instance: "unknown"}, Destination: Endpoint{Instance: "web"}, Protocol: schema.SecurityGroupRuleProtocolTCP, Port: 22})
+	assert.ErrorIs(t, err, ErrUnknownEndpoint)
+
+	_, err = analyzer.Reachable(Query{Destination: Endpoint{Instance: "web"}, Protocol: schema.SecurityGroupRuleProtocolTCP, Port: 22})
+	assert.ErrorIs(t, err, ErrInvalidEndpoint)
+
+	_, err = analyzer.Reachable(Query{Source: Endpoint{Instance: "bastion"}, Destination: Endpoint{Instance: "web"}, Protocol: schema.SecurityGroupRuleProtocolTCPUDP, Port: 22})
+	assert.ErrorIs(t, err, ErrInvalidProtocol)
+
+	_, err = analyzer.Reachable(Query{Source: Endpoint{Instance: "bastion"}, Destination: Endpoint{Instance: "web"}, Protocol: schema.SecurityGroupRuleProtocolTCP})
+	assert.Error(t, err)
+}
+
+func TestAnalyzer_AppliesTo(t *testing.T) {
+	analyzer := buildAnalyzer(t)
+
+	nics, instances := analyzer.AppliesTo("web")
+	assert.Equal(t, []string{"nic-web"}, nics)
+	assert.Equal(t, []string{"web"}, instances)
+
+	nics, instances = analyzer.AppliesTo("bastion")
+	assert.Empty(t, nics)
+	assert.Equal(t, []string{"bastion"}, instances)
+
+	groups, err := analyzer.GroupsOf("db")
+	require.NoError(t, err)
+	assert.Equal(t, []string{"open"}, groups)
+}
+
+func TestAnalyzer_Findings(t *testing.T) {
+	analyzer := buildAnalyzer(t)
+
+	var findings []string
+	for _, finding := range analyzer.Findings() {
+		findings = append(findings, finding.String())
+	}
+	assert.Equal(t, []string{
+		"open/rules[0]: broad: ingress open to any source",
+		"web/gone: missing: rule security-group-rules/gone not found",
+		"web/rules[2]: shadowed: shadowed by web/rules[0]",
+		"web/rules[3]: duplicate: duplicates web/rules[1]",
+	}, findings)
+}
+
+func TestLoad(t *testing.T) {
+	ctx := context.Background()
+	wpath := secapi.WorkspacePath{Tenant: secatest.Tenant1Name, Workspace: secatest.Workspace1Name}
+	groups, rules, nics, instances := buildResources()
+
+	network := mocksecapi.NewMockNetworkV1(t)
+	network.EXPECT().ListSecurityGroups(mock.Anything, wpath).Return(secapi.NewIterator(secatest.Page(groups...)), nil)
+	network.EXPECT().ListSecurityGroupRules(mock.Anything, wpath).Return(secapi.NewIterator(secatest.Page(rules...)), nil)
+	network.EXPECT().ListNics(mock.Anything, wpath).Return(secapi.NewIterator(secatest.Page(nics...)), nil)
+
+	compute := mocksecapi.NewMockComputeV1(t)
+	compute.EXPECT().ListInstances(mock.Anything, wpath).Return(secapi.NewIterator(secatest.Page(instances...)), nil)
+
+	analyzer, err := Load(ctx, network, compute, wpath)
+	require.NoError(t, err)
+
+	verdict, err := analyzer.Reachable(Query{Source: Endpoint{Instance: "bastion"}, Destination: Endpoint{Instance: "web"}, Protocol: schema.SecurityGroupRuleProtocolTCP, Port: 22})
+	require.NoError(t, err)
+	assert.True(t, verdict.Allowed)
+}
+
+func TestPortsOf(t *testing.T) {
+	assert.Equal(t, portSet{{1, 65535}}, portsOf(nil))
+	assert.Equal(t, portSet{{80, 80}}, portsOf(&schema.Ports{From: 80}))
+	assert.Equal(t, portSet{{8080, 8080}}, portsOf(&schema.Ports{To: 8080}))
+	assert.Equal(t, portSet{{22, 22}, {80, 90}}, portsOf(&schema.Ports{From: 80, To: 89, List: []int{22, 90, 85}}))
+	assert.True(t, portsOf(&schema.Ports{From: 1, To: 1024}).covers(portsOf(&schema.Ports{List: []int{22, 443}})))
+	assert.False(t, portsOf(&schema.Ports{List: []int{22, 443}}).covers(portsOf(&schema.Ports{From: 22, To: 23})))
+}
+
+func buildAnalyzer(t *testing.T) *Analyzer {
+	t.Helper()
+
+	analyzer, err := New(buildResources())
+	require.NoError(t, err)
+	return analyzer
+}
+
+func buildResources() ([]*schema.SecurityGroup, []*schema.SecurityGroupRule, []*schema.Nic, []*schema.Instance) {
+	groups := []*schema.SecurityGroup{
+		{
+			Metadata: metadata("web"),
+			Spec: schema.SecurityGroupSpec{
+				Rules: []schema.SecurityGroupRuleSpec{
+					ingress(schema.SecurityGroupRuleProtocolTCP, &schema.Ports{From: 443}, "0.0.0.0/0"),
+					ingress(schema.SecurityGroupRuleProtocolTCP, &schema.Ports{From: 22}, "security-groups/bastion"),
+					ingress(schema.SecurityGroupRuleProtocolTCP, &schema.Ports{List: []int{443}}, "10.0.0.0/8"),
+					ingress(schema.SecurityGroupRuleProtocolTCP, &schema.Ports{To: 22}, "security-groups/bastion"),
+				},
+				RuleRefs: []schema.Reference{{Resource: "security-group-rules/web-egress"}, {Resource: "security-group-rules/gone"}},
+			},
+		},
+		{
+			Metadata: metadata("bastion"),
+			Spec: schema.SecurityGroupSpec{
+				Rules: []schema.SecurityGroupRuleSpec{
+					ingress(schema.SecurityGroupRuleProtocolTCP, &schema.Ports{From: 22}, "203.0.113.0/24"),
+					{Direction: schema.SecurityGroupRuleDirectionEgress, Protocol: schema.SecurityGroupRuleProtocolTCP, Ports: &schema.Ports{From: 22}, SourceRef: []schema.Reference{{Resource: "security-groups/web"}}},
+				},
+			},
+		},
+		{
+			Metadata: metadata("open"),
+			Spec: schema.SecurityGroupSpec{
+				Rules: []schema.SecurityGroupRuleSpec{ingress("", nil)},
+			},
+		},
+	}
+	rules := []*schema.SecurityGroupRule{
+		{
+			Metadata: metadata("web-egress"),
+			Spec:     schema.SecurityGroupRuleSpec{Direction: schema.SecurityGroupRuleDirectionEgress, Version: schema.IPVersionIPv4},
+		},
+	}
+	nics := []*schema.Nic{
+		{
+			Metadata: metadata("nic-web"),
+			Spec:     schema.NicSpec{SecurityGroupRefs: []schema.Reference{{Resource: "security-groups/web"}}},
+			Status:   &schema.NicStatus{Addresses: []string{"10.0.1.10"}},
+		},
+		{
+			Metadata: metadata("nic-bastion"),
+			Spec:     schema.NicSpec{Addresses: []string{"10.0.0.5"}},
+		},
+		{
+			Metadata: metadata("nic-db"),
+			Spec:     schema.NicSpec{Addresses: []string{"10.0.2.5"}, SecurityGroupRefs: []schema.Reference{{Resource: "security-groups/open"}}},
+		},
+	}
+	instances := []*schema.Instance{
+		{
+			Metadata: metadata("web"),
+			Spec:     schema.InstanceSpec{PrimaryNicRef: &schema.Reference{Resource: "nics/nic-web"}},
+		},
+		{
+			Metadata: metadata("bastion"),
+			Spec:     schema.InstanceSpec{PrimaryNicRef: &schema.Reference{Resource: "nics/nic-bastion"}, SecurityGroupRef: &schema.Reference{Resource: "security-groups/bastion"}},
+		},
+		{
+			Metadata: metadata("db"),
+			Spec:     schema.InstanceSpec{PrimaryNicRef: &schema.Reference{Resource: "nics/nic-db"}},
+		},
+	}
+	return groups, rules, nics, instances
+}
+
+func ingress(protocol schema.SecurityGroupRuleSpecProtocol, ports *schema.Ports, sources ...string) schema.SecurityGroupRuleSpec {
+	spec := schema.SecurityGroupRuleSpec{Direction: schema.SecurityGroupRuleDirectionIngress, Protocol: protocol, Ports: ports}
+	for _, source := range sources {
+		spec.SourceRef = append(spec.SourceRef, schema.Reference{Resource: source})
+	}
+	return spec
+}
+
+func metadata(name string) *schema.RegionalWorkspaceResourceMetadata {
+	return secatest.NewRegionalWorkspaceResourceMetadata(name, secatest.Tenant1Name, secatest.Workspace1Name, secatest.Region1Name)
+}
@@ -0,0 +1,180 @@
+package reachability
+
+import (
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/eu-sovereign-cloud/go-sdk/pkg/spec/schema"
+)
+
+// FindingKind is the kind of issue found in the rules of a security group.
+type FindingKind string
+
+const (
+	// FindingBroad is an ingress rule open to any source on any protocol or a wide range of ports
+	FindingBroad FindingKind = "broad"
+
+	// FindingShadowed is a rule allowing nothing more than another rule of the group
+	FindingShadowed FindingKind = "shadowed"
+
+	// FindingDuplicate is a rule allowing the same traffic as another rule of the group
+	FindingDuplicate FindingKind = "duplicate"
+
+	// FindingMissing is a rule referenced by the group and not found in the workspace
+	FindingMissing FindingKind = "missing"
+)
+
+// BroadPortCount is the number of ports from which an ingress rule open to any source is broad.
+var BroadPortCount = 1024
+
+// Finding is an issue of a rule, by another one when it's shadowed or duplicated.
+type Finding struct {
+	Kind    FindingKind
+	Rule    RuleRef
+	By      *RuleRef
+	Message string
+}
+
+func (f Finding) String() string {
+	return fmt.Sprintf("%s: %s: %s", f.Rule, f.Kind, f.Message)
+}
+
+// Findings returns the issues of the rules of all the security groups, sorted by group.
+func (a *Analyzer) Findings() []Finding {
+	names := make([]string, 0, len(a.groups))
+	for name := range a.groups {
+		names = append(names, name)
+	}
+	slices.Sort(names)
+
+	var findings []Finding
+	for _, name := range names {
+		findings = append(findings, a.groups[name].findings()...)
+	}
+	return findings
+}
+
+func (g *group) findings() []Finding {
+	var findings []Finding
+	for _, resource := range g.missing {
+		name := resource
+		if i := strings.LastIndex(resource, "/"); i >= 0 {
+			name = resource[i+1:]
+		}
+		findings = append(findings, Finding{
+			Kind:    FindingMissing,
+			Rule:    RuleRef{Group: g.name, Rule: name},
+			Message: fmt.Sprintf("rule %s not found", resource),
+		})
+	}
+
+	for i, r := range g.rules {
+		if r.broad() {
+			findings = append(findings, Finding{
+				Kind:    FindingBroad,
+				Rule:    r.ref,
+				Message: "ingress open to any source",
+			})
+		}
+
+		for j, other := range g.rules {
+			if i == j || !other.covers(r) {
+				continue
+			}
+
+			by := other.ref
+			if r.covers(other) {
+				// The first of the duplicated rules is reported by the next ones
+				if j > i {
+					continue
+				}
+				findings = append(findings, Finding{
+					Kind:    FindingDuplicate,
+					Rule:    r.ref,
+					By:      &by,
+					Message: fmt.Sprintf("duplicates %s", by),
+				})
+			} else {
+				findings = append(findings, Finding{
+					Kind:    FindingShadowed,
+					Rule:    r.ref,
+					By:      &by,
+					Message: fmt.Sprintf("shadowed by %s", by),
+				})
+			}
+			break
+		}
+	}
+	return findings
+}
+
+func (r *rule) broad() bool {
+	if r.spec.Direction != schema.SecurityGroupRuleDirectionIngress || !anySource(r.spec.SourceRef) {
+		return false
+	}
+
+	switch r.spec.Protocol {
+	case "":
+		return true
+	case schema.SecurityGroupRuleProtocolICMP:
+		return false
+	default:
+		return portsOf(r.spec.Ports).count() >= BroadPortCount
+	}
+}
+
+func anySource(refs []schema.Reference) bool {
+	if len(refs) == 0 {
+		return true
+	}
+	return slices.ContainsFunc(refs, func(ref schema.Reference) bool {
+		prefix, ok := sourcePrefix(ref)
+		return ok && prefix.Bits() == 0
+	})
+}
+
+// Returns whether the rule allows all the traffic of the other one
+func (r *rule) covers(other *rule) bool {
+	if r.spec.Direction != other.spec.Direction || !protocolCovers(r.spec.Protocol, other.spec.Protocol) {
+		return false
+	}
+	if r.spec.Version != "" && r.spec.Version != other.spec.Version {
+		return false
+	}
+
+	if other.spec.Protocol != schema.SecurityGroupRuleProtocolICMP && !portsOf(r.spec.Ports).covers(portsOf(other.spec.Ports)) {
+		return false
+	}
+	if other.spec.Protocol == schema.SecurityGroupRuleProtocolICMP || other.spec.Protocol == "" {
+		if r.spec.Icmp != nil && (other.spec.Icmp == nil || *r.spec.Icmp != *other.spec.Icmp) {
+			return false
+		}
+	}
+
+	if len(r.spec.SourceRef) == 0 {
+		return true
+	}
+	if len(other.spec.SourceRef) == 0 {
+		return false
+	}
+	for _, source := range other.spec.SourceRef {
+		if !slices.ContainsFunc(r.spec.SourceRef, func(ref schema.Reference) bool { return sourceCovers(ref, source) }) {
+			return false
+		}
+	}
+	return true
+}
+
+func sourceCovers(ref, other schema.Reference) bool {
+	if ref.Resource == other.Resource {
+		return true
+	}
+
+	prefix, ok := sourcePrefix(ref)
+	if !ok {
+		return false
+	}
+	otherPrefix, ok := sourcePrefix(other)
+	return ok && prefix.Bits() <= otherPrefix.Bits() && prefix.Contains(otherPrefix.Addr())
+}
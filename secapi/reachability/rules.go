@@ -0,0 +1,321 @@
+package reachability
+
+import (
+	"errors"
+	"fmt"
+	"net/netip"
+	"slices"
+
+	"github.com/eu-sovereign-cloud/go-sdk/internal/secautil"
+	"github.com/eu-sovereign-cloud/go-sdk/pkg/spec/schema"
+)
+
+var (
+	ErrUnknownEndpoint = errors.New("unknown endpoint")
+	ErrInvalidEndpoint = errors.New("endpoint must be an instance, an address or a gateway")
+	ErrInvalidProtocol = errors.New("protocol must be tcp, udp or icmp")
+)
+
+const (
+	minPort = 1
+	maxPort = 65535
+)
+
+// Endpoint is one end of the traffic: an instance of the workspace, an address or a gateway.
+type Endpoint struct {
+	Instance string
+	Address  netip.Addr
+
+	// Gateway is the <type>/<name> of an internet or a NAT gateway
+	Gateway string
+}
+
+func (e Endpoint) String() string {
+	switch {
+	case e.Instance != "":
+		return instancesType + "/" + e.Instance
+	case e.Gateway != "":
+		return e.Gateway
+	default:
+		return e.Address.String()
+	}
+}
+
+// Query asks whether the source can open traffic to the destination.
+type Query struct {
+	Source      Endpoint
+	Destination Endpoint
+
+	// Protocol is tcp, udp or icmp
+	Protocol schema.SecurityGroupRuleSpecProtocol
+
+	// Port is the destination port of tcp and udp
+	Port int
+
+	// Icmp is the type and the code of icmp, nil for any
+	Icmp *schema.IcmpConfig
+
+	// Version restricts the addresses of the endpoints, empty for both
+	Version schema.IPVersion
+}
+
+// Verdict is the answer to a query with the rules allowing the traffic.
+type Verdict struct {
+	Allowed bool
+
+	// Egress are the rules of the source allowing the traffic out, empty when the source isn't filtered
+	Egress []RuleRef
+
+	// Ingress are the rules of the destination allowing the traffic in, empty when the destination isn't filtered
+	Ingress []RuleRef
+
+	// Reason explains a denied traffic
+	Reason string
+}
+
+// Resolved endpoint of a query
+type peer struct {
+	endpoint  Endpoint
+	instance  *instance
+	nics      []string
+	addresses []netip.Addr
+	groups    []string
+}
+
+// Reachable answers whether the traffic of the query is allowed: by the egress rules of
+// the source and by the ingress rules of the destination, when they are filtered.
+func (a *Analyzer) Reachable(q Query) (*Verdict, error) {
+	switch q.Protocol {
+	case schema.SecurityGroupRuleProtocolTCP, schema.SecurityGroupRuleProtocolUDP:
+		if q.Port < minPort || q.Port > maxPort {
+			return nil, fmt.Errorf("invalid port %d", q.Port)
+		}
+	case schema.SecurityGroupRuleProtocolICMP:
+	default:
+		return nil, fmt.Errorf("%w: %q", ErrInvalidProtocol, q.Protocol)
+	}
+
+	src, err := a.resolve(q.Source, q.Version)
+	if err != nil {
+		return nil, err
+	}
+	dst, err := a.resolve(q.Destination, q.Version)
+	if err != nil {
+		return nil, err
+	}
+
+	verdict := &Verdict{Allowed: true}
+	if len(src.groups) > 0 {
+		verdict.Egress = a.matching(src.groups, schema.SecurityGroupRuleDirectionEgress, q, dst)
+		if len(verdict.Egress) == 0 {
+			verdict.Allowed = false
+			verdict.Reason = fmt.Sprintf("no egress rule of %s allows the traffic to %s", src.endpoint, dst.endpoint)
+			return verdict, nil
+		}
+	}
+	if len(dst.groups) > 0 {
+		verdict.Ingress = a.matching(dst.groups, schema.SecurityGroupRuleDirectionIngress, q, src)
+		if len(verdict.Ingress) == 0 {
+			verdict.Allowed = false
+			verdict.Reason = fmt.Sprintf("no ingress rule of %s allows the traffic from %s", dst.endpoint, src.endpoint)
+		}
+	}
+
+	return verdict, nil
+}
+
+func (a *Analyzer) resolve(e Endpoint, version schema.IPVersion) (*peer, error) {
+	p := &peer{endpoint: e}
+	switch {
+	case e.Instance != "":
+		inst, found := a.instances[e.Instance]
+		if !found {
+			return nil, fmt.Errorf("%w: instance %s", ErrUnknownEndpoint, e.Instance)
+		}
+		p.instance = inst
+		p.nics = inst.nics
+		p.groups = a.instanceGroups(inst)
+		for _, addr := range a.instanceAddresses(inst) {
+			if secautil.VersionMatches(version, addr) {
+				p.addresses = append(p.addresses, addr)
+			}
+		}
+	case e.Gateway != "":
+	case e.Address.IsValid():
+		if !secautil.VersionMatches(version, e.Address) {
+			return nil, fmt.Errorf("address %s isn't %s", e.Address, version)
+		}
+		p.addresses = []netip.Addr{e.Address}
+	default:
+		return nil, ErrInvalidEndpoint
+	}
+	return p, nil
+}
+
+// Returns the rules of the groups in the direction allowing the traffic with the peer
+func (a *Analyzer) matching(groups []string, direction schema.SecurityGroupRuleSpecDirection, q Query, other *peer) []RuleRef {
+	var refs []RuleRef
+	for _, name := range groups {
+		g, found := a.groups[name]
+		if !found {
+			continue
+		}
+		for _, r := range g.rules {
+			if r.spec.Direction == direction && r.allows(q, other) {
+				refs = append(refs, r.ref)
+			}
+		}
+	}
+	return refs
+}
+
+func (r *rule) allows(q Query, other *peer) bool {
+	if !protocolCovers(r.spec.Protocol, q.Protocol) {
+		return false
+	}
+	if q.Protocol == schema.SecurityGroupRuleProtocolICMP {
+		if r.spec.Icmp != nil && (q.Icmp == nil || *q.Icmp != *r.spec.Icmp) {
+			return false
+		}
+	} else if !portsOf(r.spec.Ports).contains(q.Port) {
+		return false
+	}
+	if r.spec.Version != "" && q.Version != "" && r.spec.Version != q.Version {
+		return false
+	}
+
+	// The addresses of the peer in the version of the rule
+	addresses := other.addresses
+	if r.spec.Version != "" {
+		addresses = slices.DeleteFunc(slices.Clone(addresses), func(addr netip.Addr) bool {
+			return !secautil.VersionMatches(r.spec.Version, addr)
+		})
+		if len(addresses) == 0 && other.endpoint.Gateway == "" {
+			return false
+		}
+	}
+
+	if len(r.spec.SourceRef) == 0 {
+		return true
+	}
+	for _, ref := range r.spec.SourceRef {
+		if sourceMatches(ref, other, addresses) {
+			return true
+		}
+	}
+	return false
+}
+
+func sourceMatches(ref schema.Reference, other *peer, addresses []netip.Addr) bool {
+	if prefix, ok := sourcePrefix(ref); ok {
+		// A gateway forwards the traffic of any address
+		if other.endpoint.Gateway != "" {
+			return prefix.Bits() == 0
+		}
+		return slices.ContainsFunc(addresses, prefix.Contains)
+	}
+	if name, ok := secautil.RefName(ref, securityGroupsType); ok {
+		return slices.Contains(other.groups, name)
+	}
+	if name, ok := secautil.RefName(ref, instancesType); ok {
+		return other.instance != nil && other.instance.name == name
+	}
+	if name, ok := secautil.RefName(ref, nicsType); ok {
+		return slices.Contains(other.nics, name)
+	}
+	for _, typ := range []string{internetGatewaysType, natGatewaysType} {
+		if name, ok := secautil.RefName(ref, typ); ok {
+			return other.endpoint.Gateway == typ+"/"+name
+		}
+	}
+	return false
+}
+
+// Returns the CIDR block or the IP address of a source reference as a prefix
+func sourcePrefix(ref schema.Reference) (netip.Prefix, bool) {
+	if prefix, err := netip.ParsePrefix(ref.Resource); err == nil {
+		return prefix.Masked(), true
+	}
+	if addr, err := netip.ParseAddr(ref.Resource); err == nil {
+		return netip.PrefixFrom(addr, addr.BitLen()), true
+	}
+	return netip.Prefix{}, false
+}
+
+// Returns whether the protocol of a rule covers another one, an empty protocol covers all of them
+func protocolCovers(rule, other schema.SecurityGroupRuleSpecProtocol) bool {
+	switch rule {
+	case "", other:
+		return true
+	case schema.SecurityGroupRuleProtocolTCPUDP:
+		return other == schema.SecurityGroupRuleProtocolTCP || other == schema.SecurityGroupRuleProtocolUDP
+	default:
+		return false
+	}
+}
+
+// Inclusive range of ports
+type portRange struct {
+	from, to int
+}
+
+// Sorted and merged ranges of ports
+type portSet []portRange
+
+// Returns the ports of a rule, all of them when it doesn't restrict them
+func portsOf(ports *schema.Ports) portSet {
+	if ports == nil || (ports.From == 0 && ports.To == 0 && len(ports.List) == 0) {
+		return portSet{{minPort, maxPort}}
+	}
+
+	var ranges []portRange
+	switch {
+	case ports.From != 0 && ports.To != 0:
+		ranges = append(ranges, portRange{min(ports.From, ports.To), max(ports.From, ports.To)})
+	case ports.From != 0:
+		ranges = append(ranges, portRange{ports.From, ports.From})
+	case ports.To != 0:
+		ranges = append(ranges, portRange{ports.To, ports.To})
+	}
+	for _, port := range ports.List {
+		ranges = append(ranges, portRange{port, port})
+	}
+
+	slices.SortFunc(ranges, func(a, b portRange) int { return a.from - b.from })
+	var set portSet
+	for _, r := range ranges {
+		if n := len(set); n > 0 && r.from <= set[n-1].to+1 {
+			set[n-1].to = max(set[n-1].to, r.to)
+			continue
+		}
+		set = append(set, r)
+	}
+	return set
+}
+
+func (s portSet) contains(port int) bool {
+	for _, r := range s {
+		if port >= r.from && port <= r.to {
+			return true
+		}
+	}
+	return false
+}
+
+// Returns whether all the ports of the other set are in the set
+func (s portSet) covers(other portSet) bool {
+	for _, o := range other {
+		if !slices.ContainsFunc(s, func(r portRange) bool { return o.from >= r.from && o.to <= r.to }) {
+			return false
+		}
+	}
+	return true
+}
+
+func (s portSet) count() int {
+	count := 0
+	for _, r := range s {
+		count += r.to - r.from + 1
+	}
+	return count
+}
@@ -0,0 +1,194 @@
+package secapi
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+	"strings"
+	"sync"
+
+	"github.com/eu-sovereign-cloud/go-sdk/pkg/spec/schema"
+)
+
+// RegionManager builds and caches the regional clients of every region of the regions provider.
+// A cached client is rebuilt when the providers of its region change.
+type RegionManager struct {
+	global *GlobalClient
+
+	mu      sync.Mutex
+	regions map[string]*managedRegion
+	names   []string
+}
+
+type managedRegion struct {
+	region *schema.Region
+	client *RegionalClient
+}
+
+// RegionResult is the result of a function run in a region.
+type RegionResult[T any] struct {
+	Region string
+	Value  T
+	Err    error
+}
+
+func (client *GlobalClient) NewRegionManager() *RegionManager {
+	return &RegionManager{
+		global:  client,
+		regions: map[string]*managedRegion{},
+	}
+}
+
+// Refresh lists the regions and drops the clients of the regions removed or whose providers changed.
+func (m *RegionManager) Refresh(ctx context.Context) error {
+	iter, err := m.global.RegionV1.ListRegions(ctx)
+	if err != nil {
+		return err
+	}
+	regions, err := iter.All(ctx)
+	if err != nil {
+		return err
+	}
+
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	current := make(map[string]*managedRegion, len(regions))
+	names := make([]string, 0, len(regions))
+	for _, region := range regions {
+		if region.Metadata == nil {
+			continue
+		}
+		name := region.Metadata.Name
+
+		if cached, found := m.regions[name]; found && sameProviders(cached.region, region) {
+			current[name] = cached
+		} else {
+			current[name] = &managedRegion{region: region}
+		}
+		names = append(names, name)
+	}
+	slices.Sort(names)
+
+	m.regions = current
+	m.names = names
+	return nil
+}
+
+// Regions returns the names of the regions, as of the last refresh. The regions are listed on first use.
+func (m *RegionManager) Regions(ctx context.Context) ([]string, error) {
+	if err := m.refreshOnce(ctx); err != nil {
+		return nil, err
+	}
+
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	return slices.Clone(m.names), nil
+}
+
+// Client returns the regional client of the region, built on first use.
+func (m *RegionManager) Client(ctx context.Context, name string) (*RegionalClient, error) {
+	if err := m.refreshOnce(ctx); err != nil {
+		return nil, err
+	}
+
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	managed, found := m.regions[name]
+	if !found {
+		return nil, fmt.Errorf("region %s not found in the regions provider", name)
+	}
+
+	if managed.client == nil {
+		client, err := newRegionalClient(m.global.authToken, m.global.httpClient, m.global.providerVersions, managed.region)
+		if err != nil {
+			return nil, err
+		}
+		managed.client = client
+	}
+	return managed.client, nil
+}
+
+func (m *RegionManager) refreshOnce(ctx context.Context) error {
+	m.mu.Lock()
+	listed := m.names != nil
+	m.mu.Unlock()
+
+	if listed {
+		return nil
+	}
+	return m.Refresh(ctx)
+}
+
+// FanOut refreshes the regions and runs the function in every region concurrently.
+// The results are sorted by region, the error is only about the listing of the regions.
+func FanOut[T any](ctx context.Context, m *RegionManager, fn func(ctx context.Context, region string, client *RegionalClient) (T, error)) ([]RegionResult[T], error) {
+	if err := m.Refresh(ctx); err != nil {
+		return nil, err
+	}
+
+	names, err := m.Regions(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	results := make([]RegionResult[T], len(names))
+	var wg sync.WaitGroup
+	for i, name := range names {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+
+			results[i].Region = name
+			client, err := m.Client(ctx, name)
+			if err != nil {
+				results[i].Err = err
+				return
+			}
+			results[i].Value, results[i].Err = fn(ctx, name, client)
+		}()
+	}
+	wg.Wait()
+
+	return results, nil
+}
+
+// ForEachRegion runs the function in every region concurrently, as FanOut,
+// and joins the errors of the regions.
+func (m *RegionManager) ForEachRegion(ctx context.Context, fn func(ctx context.Context, region string, client *RegionalClient) error) error {
+	results, err := FanOut(ctx, m, func(ctx context.Context, region string, client *RegionalClient) (struct{}, error) {
+		return struct{}{}, fn(ctx, region, client)
+	})
+	if err != nil {
+		return err
+	}
+
+	return JoinRegionErrors(results)
+}
+
+// JoinRegionErrors joins the errors of the results, prefixed by their region.
+func JoinRegionErrors[T any](results []RegionResult[T]) error {
+	var errs []error
+	for _, result := range results {
+		if result.Err != nil {
+			errs = append(errs, fmt.Errorf("region %s: %w", result.Region, result.Err))
+		}
+	}
+	return errors.Join(errs...)
+}
+
+func sameProviders(a, b *schema.Region) bool {
+	return providersKey(a) == providersKey(b)
+}
+
+func providersKey(region *schema.Region) string {
+	keys := make([]string, 0, len(region.Spec.Providers))
+	for _, provider := range region.Spec.Providers {
+		keys = append(keys, provider.Name+" "+provider.Version+" "+provider.Url)
+	}
+	slices.Sort(keys)
+	return strings.Join(keys, "\n")
+}
@@ -0,0 +1,131 @@
+package secapi_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/eu-sovereign-cloud/go-sdk/internal/secatest"
+	mocksecapi "github.com/eu-sovereign-cloud/go-sdk/mock/secapi"
+	"github.com/eu-sovereign-cloud/go-sdk/pkg/constants"
+	"github.com/eu-sovereign-cloud/go-sdk/pkg/spec/schema"
+	"github.com/eu-sovereign-cloud/go-sdk/secapi"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRegionManager(t *testing.T) {
+	ctx := context.Background()
+
+	regions := []schema.Region{
+		buildManagedRegion(secatest.Region1Name, "http://compute-1.test"),
+		buildManagedRegion(secatest.Region2Name, "http://compute-2.test"),
+	}
+
+	regionAPI := mocksecapi.NewMockRegionV1(t)
+	regionAPI.EXPECT().ListRegions(mock.Anything).RunAndReturn(func(ctx context.Context) (*secapi.Iterator[schema.Region], error) {
+		listed := append([]schema.Region(nil), regions...)
+		return secapi.NewIterator(func(ctx context.Context, skipToken *string) ([]schema.Region, *schema.ResponseMetadata, error) {
+			return listed, &schema.ResponseMetadata{}, nil
+		}), nil
+	})
+
+	manager := secapi.NewGlobalClientFromAPIs(&secapi.GlobalAPIs{RegionV1: regionAPI}).NewRegionManager()
+
+	names, err := manager.Regions(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, []string{secatest.Region1Name, secatest.Region2Name}, names)
+
+	client1, err := manager.Client(ctx, secatest.Region1Name)
+	require.NoError(t, err)
+	assert.IsType(t, &secapi.ComputeV1Impl{}, client1.ComputeV1)
+
+	client2, err := manager.Client(ctx, secatest.Region2Name)
+	require.NoError(t, err)
+
+	cached, err := manager.Client(ctx, secatest.Region1Name)
+	require.NoError(t, err)
+	assert.Same(t, client1, cached)
+
+	// Changes the providers of the first region and removes the second one
+	regions = []schema.Region{buildManagedRegion(secatest.Region1Name, "http://compute-1-new.test")}
+	require.NoError(t, manager.Refresh(ctx))
+
+	refreshed, err := manager.Client(ctx, secatest.Region1Name)
+	require.NoError(t, err)
+	assert.NotSame(t, client1, refreshed)
+	assert.NotSame(t, client2, refreshed)
+
+	_, err = manager.Client(ctx, secatest.Region2Name)
+	assert.Error(t, err)
+
+	// Unchanged providers keep the client
+	require.NoError(t, manager.Refresh(ctx))
+	cached, err = manager.Client(ctx, secatest.Region1Name)
+	require.NoError(t, err)
+	assert.Same(t, refreshed, cached)
+}
+
+func TestFanOut(t *testing.T) {
+	ctx := context.Background()
+
+	regionAPI := mocksecapi.NewMockRegionV1(t)
+	regionAPI.EXPECT().ListRegions(mock.Anything).RunAndReturn(func(ctx context.Context) (*secapi.Iterator[schema.Region], error) {
+		return secapi.NewIterator(func(ctx context.Context, skipToken *string) ([]schema.Region, *schema.ResponseMetadata, error) {
+			return []schema.Region{
+				buildManagedRegion(secatest.Region2Name, "http://compute-2.test"),
+				buildManagedRegion(secatest.Region1Name, "http://compute-1.test"),
+			}, &schema.ResponseMetadata{}, nil
+		}), nil
+	})
+
+	manager := secapi.NewGlobalClientFromAPIs(&secapi.GlobalAPIs{RegionV1: regionAPI}).NewRegionManager()
+
+	errFailed := errors.New("failed")
+	results, err := secapi.FanOut(ctx, manager, func(ctx context.Context, region string, client *secapi.RegionalClient) (string, error) {
+		if region == secatest.Region2Name {
+			return "", errFailed
+		}
+		return client.ProviderVersion(constants.ComputeProviderName), nil
+	})
+	require.NoError(t, err)
+	require.Len(t, results, 2)
+
+	assert.Equal(t, secatest.Region1Name, results[0].Region)
+	assert.Equal(t, constants.ApiVersion1, results[0].Value)
+	assert.NoError(t, results[0].Err)
+
+	assert.Equal(t, secatest.Region2Name, results[1].Region)
+	assert.ErrorIs(t, results[1].Err, errFailed)
+
+	err = manager.ForEachRegion(ctx, func(ctx context.Context, region string, client *secapi.RegionalClient) error {
+		if region == secatest.Region1Name {
+			return errFailed
+		}
+		return nil
+	})
+	assert.ErrorIs(t, err, errFailed)
+	assert.ErrorContains(t, err, secatest.Region1Name)
+}
+
+func TestFanOut_ListError(t *testing.T) {
+	ctx := context.Background()
+
+	manager := secapi.NewGlobalClientFromAPIs(nil).NewRegionManager()
+
+	_, err := secapi.FanOut(ctx, manager, func(ctx context.Context, region string, client *secapi.RegionalClient) (string, error) {
+		return region, nil
+	})
+	assert.ErrorIs(t, err, secapi.ErrProviderNotAvailable)
+}
+
+func buildManagedRegion(name, computeUrl string) schema.Region {
+	return schema.Region{
+		Metadata: secatest.NewGlobalResourceMetadata(name),
+		Spec: schema.RegionSpec{Providers: []schema.Provider{
+			{Name: constants.ComputeProviderName, Version: constants.ApiVersion1, Url: computeUrl},
+		}},
+	}
+}
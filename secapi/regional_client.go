@@ -6,7 +6,11 @@ import (
 )
 
 type RegionalClient struct {
-	authToken string
+	authToken  string
+	httpClient HttpRequestDoer
+
+	// Selected version by provider name
+	versions map[string]string
 
 	WorkspaceV1 WorkspaceV1
 	ComputeV1   ComputeV1
@@ -14,13 +18,15 @@ type RegionalClient struct {
 	NetworkV1   NetworkV1
 }
 
-func newRegionalClient(authToken string, region *schema.Region) (*RegionalClient, error) {
+func newRegionalClient(authToken string, httpClient HttpRequestDoer, preferences map[string]string, region *schema.Region) (*RegionalClient, error) {
 	client := &RegionalClient{
-		authToken: authToken,
+		authToken:  authToken,
+		httpClient: httpClient,
+		versions:   map[string]string{},
 	}
 
 	// Initializes workspaceV1 API client
-	workspaceV1provider := findRegionalProvider(constants.WorkspaceProviderName, constants.ApiVersion1, region)
+	workspaceV1provider := findRegionalProvider(constants.WorkspaceProviderName, constants.ApiVersion1, preferences, region)
 	if workspaceV1provider != nil {
 		if err := initRegionalAPI(client, workspaceV1provider, newWorkspaceV1Impl, client.setWorkspaceV1); err != nil {
 			return nil, err
@@ -30,7 +36,7 @@ func newRegionalClient(authToken string, region *schema.Region) (*RegionalClient
 	}
 
 	// Initializes computeV1 API client
-	computeV1provider := findRegionalProvider(constants.ComputeProviderName, constants.ApiVersion1, region)
+	computeV1provider := findRegionalProvider(constants.ComputeProviderName, constants.ApiVersion1, preferences, region)
 	if computeV1provider != nil {
 		if err := initRegionalAPI(client, computeV1provider, newComputeV1Impl, client.setComputeV1); err != nil {
 			return nil, err
@@ -40,7 +46,7 @@ func newRegionalClient(authToken string, region *schema.Region) (*RegionalClient
 	}
 
 	// Initializes storageV1 API client
-	storageV1provider := findRegionalProvider(constants.StorageProviderName, constants.ApiVersion1, region)
+	storageV1provider := findRegionalProvider(constants.StorageProviderName, constants.ApiVersion1, preferences, region)
 	if storageV1provider != nil {
 		if err := initRegionalAPI(client, storageV1provider, newStorageV1Impl, client.setStorageV1); err != nil {
 			return nil, err
@@ -50,7 +56,7 @@ func newRegionalClient(authToken string, region *schema.Region) (*RegionalClient
 	}
 
 	// Initializes networkV1 API client
-	networkV1provider := findRegionalProvider(constants.NetworkProviderName, constants.ApiVersion1, region)
+	networkV1provider := findRegionalProvider(constants.NetworkProviderName, constants.ApiVersion1, preferences, region)
 	if networkV1provider != nil {
 		if err := initRegionalAPI(client, networkV1provider, newNetworkV1Impl, client.setNetworkV1); err != nil {
 			return nil, err
@@ -62,12 +68,38 @@ func newRegionalClient(authToken string, region *schema.Region) (*RegionalClient
 	return client, nil
 }
 
+// RegionalAPIs are the implementations of the regional APIs, as mocks or fakes.
+type RegionalAPIs struct {
+	WorkspaceV1 WorkspaceV1
+	ComputeV1   ComputeV1
+	StorageV1   StorageV1
+	NetworkV1   NetworkV1
+}
+
+// NewRegionalClientFromAPIs creates a regional client from the given implementations,
+// it performs no request itself. The missing APIs are unavailable.
+func NewRegionalClientFromAPIs(apis *RegionalAPIs) *RegionalClient {
+	if apis == nil {
+		apis = &RegionalAPIs{}
+	}
+
+	client := &RegionalClient{versions: map[string]string{}}
+
+	setRegionalAPI(apis.WorkspaceV1, newWorkspaceV1Unavailable, client.setWorkspaceV1)
+	setRegionalAPI(apis.ComputeV1, newComputeV1Unavailable, client.setComputeV1)
+	setRegionalAPI(apis.StorageV1, newStorageV1Unavailable, client.setStorageV1)
+	setRegionalAPI(apis.NetworkV1, newNetworkV1Unavailable, client.setNetworkV1)
+
+	return client
+}
+
 func initRegionalAPI[T any](client *RegionalClient, provider *schema.Provider, newFunc func(client *RegionalClient, url string) (T, error), setFunc func(T)) error {
 	api, err := newFunc(client, provider.Url)
 	if err != nil {
 		return err
 	}
 
+	client.versions[provider.Name] = provider.Version
 	setFunc(api)
 	return nil
 }
@@ -77,14 +109,40 @@ func setUnavailableRegionalAPI[T any](newFunc func() T, setFunc func(T)) {
 	setFunc(api)
 }
 
-func findRegionalProvider(name, version string, region *schema.Region) *schema.Provider {
+func setRegionalAPI[T comparable](api T, newUnavailableFunc func() T, setFunc func(T)) {
+	var none T
+	if api == none {
+		setUnavailableRegionalAPI(newUnavailableFunc, setFunc)
+		return
+	}
+
+	setFunc(api)
+}
+
+// Finds the provider of the region compatible with the API version. The preferred version
+// is selected when the region offers it, otherwise the highest compatible version.
+func findRegionalProvider(name, version string, preferences map[string]string, region *schema.Region) *schema.Provider {
+	var selected *schema.Provider
 	for _, provider := range region.Spec.Providers {
-		if provider.Name == name && provider.Version == version {
+		if provider.Name != name || !compatibleProviderVersion(provider.Version, version) {
+			continue
+		}
+
+		if provider.Version == preferences[name] {
 			return &provider
 		}
+		if selected == nil || compareProviderVersions(provider.Version, selected.Version) > 0 {
+			selected = &provider
+		}
 	}
 
-	return nil
+	return selected
+}
+
+// ProviderVersion returns the version selected for the provider of the given name,
+// it is empty when the provider is unavailable or was not created from a region.
+func (client *RegionalClient) ProviderVersion(name string) string {
+	return client.versions[name]
 }
 
 func (client *RegionalClient) setComputeV1(compute ComputeV1) {
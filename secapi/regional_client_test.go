@@ -0,0 +1,57 @@
+package secapi
+
+import (
+	"testing"
+
+	"github.com/eu-sovereign-cloud/go-sdk/internal/secatest"
+	"github.com/eu-sovereign-cloud/go-sdk/pkg/constants"
+	"github.com/eu-sovereign-cloud/go-sdk/pkg/spec/schema"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewRegionalClient_VersionNegotiation(t *testing.T) {
+	providers := []schema.Provider{
+		{Name: constants.NetworkProviderName, Version: "v1", Url: "http://network-v1.test"},
+		{Name: constants.NetworkProviderName, Version: "v1.1", Url: "http://network-v1-1.test"},
+		{Name: constants.NetworkProviderName, Version: "v2", Url: "http://network-v2.test"},
+		{Name: constants.ComputeProviderName, Version: "v1.2", Url: "http://compute.test"},
+		{Name: constants.StorageProviderName, Version: "v2", Url: "http://storage.test"},
+		{Name: constants.WorkspaceProviderName, Version: "v1beta1", Url: "http://workspace.test"},
+	}
+
+	tests := []struct {
+		name        string
+		preferences map[string]string
+		network     string
+	}{
+		{name: "highest compatible", network: "v1.1"},
+		{name: "preferred", preferences: map[string]string{constants.NetworkProviderName: "v1"}, network: "v1"},
+		{name: "preferred not offered", preferences: map[string]string{constants.NetworkProviderName: "v1.3"}, network: "v1.1"},
+		{name: "preferred not compatible", preferences: map[string]string{constants.NetworkProviderName: "v2"}, network: "v1.1"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			region := &schema.Region{
+				Metadata: secatest.NewGlobalResourceMetadata(secatest.Region1Name),
+				Spec:     schema.RegionSpec{Providers: providers},
+			}
+
+			client, err := newRegionalClient(secatest.AuthToken, nil, tt.preferences, region)
+			require.NoError(t, err)
+
+			assert.Equal(t, tt.network, client.ProviderVersion(constants.NetworkProviderName))
+			assert.IsType(t, &NetworkV1Impl{}, client.NetworkV1)
+
+			assert.Equal(t, "v1.2", client.ProviderVersion(constants.ComputeProviderName))
+			assert.IsType(t, &ComputeV1Impl{}, client.ComputeV1)
+
+			assert.Empty(t, client.ProviderVersion(constants.StorageProviderName))
+			assert.IsType(t, &StorageV1Unavailable{}, client.StorageV1)
+
+			assert.Empty(t, client.ProviderVersion(constants.WorkspaceProviderName))
+			assert.IsType(t, &WorkspaceV1Unavailable{}, client.WorkspaceV1)
+		})
+	}
+}
@@ -0,0 +1,220 @@
+// Package routing computes the effective routes of the subnets of a network from their route
+// tables, and validates the routes: their destinations, their overlaps and their targets.
+package routing
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/netip"
+	"slices"
+
+	"github.com/eu-sovereign-cloud/go-sdk/internal/secautil"
+	"github.com/eu-sovereign-cloud/go-sdk/pkg/spec/schema"
+	"github.com/eu-sovereign-cloud/go-sdk/secapi"
+)
+
+var (
+	ErrUnknownSubnet     = errors.New("unknown subnet")
+	ErrUnknownRouteTable = errors.New("unknown route table")
+	ErrNoRoute           = errors.New("no route to the destination")
+)
+
+// Resource types of the route targets
+const (
+	routeTablesType      = "route-tables"
+	internetGatewaysType = "internet-gateways"
+	nicsType             = "nics"
+	natGatewaysType      = "internet-nat-gateway-instances"
+)
+
+// Route is a route of a table, the local routes deliver the traffic in the network without table.
+type Route struct {
+	Table       string
+	Index       int
+	Destination netip.Prefix
+	Target      schema.Reference
+	Local       bool
+}
+
+func (r *Route) String() string {
+	if r.Local {
+		return fmt.Sprintf("%s local", r.Destination)
+	}
+	return fmt.Sprintf("%s via %s", r.Destination, r.Target.Resource)
+}
+
+type table struct {
+	name      string
+	workspace string
+	routes    []*Route
+
+	// Routes with an invalid destination, by index
+	invalid map[int]error
+}
+
+type subnet struct {
+	name     string
+	table    string
+	prefixes []netip.Prefix
+}
+
+// Router holds the route tables and the subnets of a network, and the targets of the workspace.
+type Router struct {
+	tables  map[string]*table
+	subnets map[string]*subnet
+	targets map[string]bool
+}
+
+// Load lists the route tables and the subnets of a network, the internet gateways and the NICs of
+// its workspace. The NAT gateways are served by an extension, they're given by the caller.
+func Load(ctx context.Context, api secapi.NetworkV1, npath secapi.NetworkPath, natGateways []*schema.InternetNatGatewayInstance) (*Router, error) {
+	wpath := secapi.WorkspacePath{Tenant: npath.Tenant, Workspace: npath.Workspace}
+
+	tables, err := secautil.List(ctx, api.ListRouteTables, npath)
+	if err != nil {
+		return nil, err
+	}
+	subnets, err := secautil.List(ctx, api.ListSubnets, npath)
+	if err != nil {
+		return nil, err
+	}
+	gateways, err := secautil.List(ctx, api.ListInternetGateways, wpath)
+	if err != nil {
+		return nil, err
+	}
+	nics, err := secautil.List(ctx, api.ListNics, wpath)
+	if err != nil {
+		return nil, err
+	}
+
+	return New(tables, subnets, gateways, nics, natGateways)
+}
+
+// New creates a router of the given resources, the resources are expected in the same network and workspace.
+func New(tables []*schema.RouteTable, subnets []*schema.Subnet, gateways []*schema.InternetGateway, nics []*schema.Nic, natGateways []*schema.InternetNatGatewayInstance) (*Router, error) {
+	r := &Router{
+		tables:  map[string]*table{},
+		subnets: map[string]*subnet{},
+		targets: map[string]bool{},
+	}
+
+	for _, rt := range tables {
+		if rt.Metadata == nil {
+			return nil, fmt.Errorf("route table: %w", secapi.ErrNoMetadata)
+		}
+
+		t := &table{name: rt.Metadata.Name, workspace: rt.Metadata.Workspace, invalid: map[int]error{}}
+		for i, spec := range rt.Spec.Routes {
+			prefix, err := netip.ParsePrefix(spec.DestinationCidrBlock)
+			if err != nil {
+				t.invalid[i] = err
+				continue
+			}
+			t.routes = append(t.routes, &Route{Table: t.name, Index: i, Destination: prefix.Masked(), Target: spec.TargetRef})
+		}
+
+		// Longest prefixes first, the first route wins between the same prefixes
+		slices.SortStableFunc(t.routes, func(a, b *Route) int { return b.Destination.Bits() - a.Destination.Bits() })
+		r.tables[t.name] = t
+	}
+
+	for _, sn := range subnets {
+		if sn.Metadata == nil {
+			return nil, fmt.Errorf("subnet: %w", secapi.ErrNoMetadata)
+		}
+
+		cidr := sn.Spec.Cidr
+		if cidr.Ipv4 == "" && cidr.Ipv6 == "" && sn.Status != nil && sn.Status.Cidr != nil {
+			cidr = *sn.Status.Cidr
+		}
+
+		s := &subnet{name: sn.Metadata.Name}
+		s.table, _ = secautil.RefName(sn.Spec.RouteTableRef, routeTablesType)
+		for _, block := range []string{cidr.Ipv4, cidr.Ipv6} {
+			if block == "" {
+				continue
+			}
+			prefix, err := netip.ParsePrefix(block)
+			if err != nil {
+				return nil, fmt.Errorf("subnet %s: %w", s.name, err)
+			}
+			s.prefixes = append(s.prefixes, prefix.Masked())
+		}
+		r.subnets[s.name] = s
+	}
+
+	for _, gtw := range gateways {
+		if gtw.Metadata != nil {
+			r.targets[internetGatewaysType+"/"+gtw.Metadata.Name] = true
+		}
+	}
+	for _, n := range nics {
+		if n.Metadata != nil {
+			r.targets[nicsType+"/"+n.Metadata.Name] = true
+		}
+	}
+	for _, gtw := range natGateways {
+		if gtw.Metadata != nil {
+			r.targets[natGatewaysType+"/"+gtw.Metadata.Name] = true
+		}
+	}
+
+	return r, nil
+}
+
+// Lookup returns the effective route of a destination from a subnet: a local route when the
+// destination is in a subnet of the network, else the longest prefix match of the subnet table.
+func (r *Router) Lookup(subnetName string, destination netip.Addr) (*Route, error) {
+	s, found := r.subnets[subnetName]
+	if !found {
+		return nil, fmt.Errorf("%w: %s", ErrUnknownSubnet, subnetName)
+	}
+
+	if local := r.local(destination); local.IsValid() {
+		return &Route{Index: -1, Destination: local, Local: true}, nil
+	}
+
+	t, found := r.tables[s.table]
+	if !found {
+		return nil, fmt.Errorf("%w: %s of subnet %s", ErrUnknownRouteTable, s.table, subnetName)
+	}
+	for _, route := range t.routes {
+		if route.Destination.Contains(destination) {
+			return route, nil
+		}
+	}
+	return nil, fmt.Errorf("%w: %s from subnet %s", ErrNoRoute, destination, subnetName)
+}
+
+// Routes returns the effective routes of a subnet, by decreasing prefix length.
+func (r *Router) Routes(subnetName string) ([]*Route, error) {
+	s, found := r.subnets[subnetName]
+	if !found {
+		return nil, fmt.Errorf("%w: %s", ErrUnknownSubnet, subnetName)
+	}
+	t, found := r.tables[s.table]
+	if !found {
+		return nil, fmt.Errorf("%w: %s of subnet %s", ErrUnknownRouteTable, s.table, subnetName)
+	}
+
+	var routes []*Route
+	for _, route := range t.routes {
+		if !r.unreachable(t, route) {
+			routes = append(routes, route)
+		}
+	}
+	return routes, nil
+}
+
+// Returns the prefix of the subnet containing an address, an invalid prefix if none does
+func (r *Router) local(addr netip.Addr) netip.Prefix {
+	for _, s := range r.subnets {
+		for _, prefix := range s.prefixes {
+			if prefix.Contains(addr) {
+				return prefix
+			}
+		}
+	}
+	return netip.Prefix{}
+}
@@ -0,0 +1,176 @@
+package routing
+
+import (
+	"context"
+	"net/netip"
+	"testing"
+
+	"github.com/eu-sovereign-cloud/go-sdk/internal/secatest"
+	mocksecapi "github.com/eu-sovereign-cloud/go-sdk/mock/secapi"
+	"github.com/eu-sovereign-cloud/go-sdk/pkg/spec/schema"
+	"github.com/eu-sovereign-cloud/go-sdk/secapi"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRouter_Lookup(t *testing.T) {
+	router := buildRouter(t)
+
+	tests := []struct {
+		name        string
+		subnet      string
+		destination string
+		expected    string
+	}{
+		{name: "local", subnet: "subnet-a", destination: "10.0.2.7", expected: "10.0.2.0/24 local"},
+		{name: "local ipv6", subnet: "subnet-a", destination: "fd00:0:0:1::9", expected: "fd00:0:0:1::/64 local"},
+		{name: "longest prefix", subnet: "subnet-a", destination: "192.168.1.10", expected: "192.168.1.0/24 via nics/nic-vpn"},
+		{name: "shorter prefix", subnet: "subnet-a", destination: "192.168.2.10", expected: "192.168.0.0/16 via nics/nic-vpn-old"},
+		{name: "first of the same prefix", subnet: "subnet-a", destination: "8.8.8.8", expected: "0.0.0.0/0 via internet-gateways/gateway-1"},
+		{name: "other table", subnet: "subnet-b", destination: "8.8.8.8", expected: "0.0.0.0/0 via internet-nat-gateway-instances/nat-1"},
+		{name: "ipv6 default", subnet: "subnet-a", destination: "2001:db8::1", expected: "::/0 via internet-gateways/gateway-1"},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			route, err := router.Lookup(test.subnet, netip.MustParseAddr(test.destination))
+			require.NoError(t, err)
+			assert.Equal(t, test.expected, route.String())
+		})
+	}
+
+	_, err := router.Lookup("subnet-b", netip.MustParseAddr("2001:db8::1"))
+	assert.ErrorIs(t, err, ErrNoRoute)
+
+	_, err = router.Lookup("subnet-c", netip.MustParseAddr("8.8.8.8"))
+	assert.ErrorIs(t, err, ErrUnknownRouteTable)
+
+	_, err = router.Lookup("unknown", netip.MustParseAddr("8.8.8.8"))
+	assert.ErrorIs(t, err, ErrUnknownSubnet)
+}
+
+func TestRouter_Routes(t *testing.T) {
+	router := buildRouter(t)
+
+	routes, err := router.Routes("subnet-a")
+	require.NoError(t, err)
+
+	var destinations []string
+	for _, route := range routes {
+		destinations = append(destinations, route.String())
+	}
+	assert.Equal(t, []string{
+		"192.168.1.0/24 via nics/nic-vpn",
+		"192.168.3.0/24 via nics/nic-vpn-old",
+		"192.168.0.0/16 via nics/nic-vpn-old",
+		"172.17.0.0/16 via instances/instance-1",
+		"172.18.0.0/16 via internet-gateways/gateway-2",
+		"172.16.0.0/12 via nics/nic-missing",
+		"0.0.0.0/0 via internet-gateways/gateway-1",
+		"::/0 via internet-gateways/gateway-1",
+	}, destinations)
+}
+
+func TestRouter_Validate(t *testing.T) {
+	router := buildRouter(t)
+
+	var issues []string
+	for _, issue := range router.Validate() {
+		issues = append(issues, issue.String())
+	}
+	assert.Equal(t, []string{
+		`subnet subnet-c: missing-table: route table "unknown" not found`,
+		`main/routes[2]: conflict: 0.0.0.0/0 is already routed via internet-gateways/gateway-1 by routes[0]`,
+		`main/routes[3]: unreachable: 10.0.2.128/25 is in the subnet 10.0.2.0/24`,
+		`main/routes[4]: redundant: overlapped by routes[5] via the same target`,
+		`main/routes[6]: invalid: netip.ParsePrefix("10.0.0.300/24"): ParseAddr("10.0.0.300"): IPv4 field has value >255`,
+		`main/routes[7]: target: target nics/nic-missing not found`,
+		`main/routes[8]: target: target "instances/instance-1" isn't an internet gateway, a NIC or a NAT gateway`,
+		`main/routes[9]: target: target internet-gateways/gateway-2 is in the workspace other`,
+		`private/routes[1]: duplicate: duplicates routes[0]`,
+	}, issues)
+}
+
+func TestLoad(t *testing.T) {
+	ctx := context.Background()
+	npath := secapi.NetworkPath{Tenant: secatest.Tenant1Name, Workspace: secatest.Workspace1Name, Network: secatest.Network1Name}
+	wpath := secapi.WorkspacePath{Tenant: secatest.Tenant1Name, Workspace: secatest.Workspace1Name}
+	tables, subnets, gateways, nics, natGateways := buildResources()
+
+	api := mocksecapi.NewMockNetworkV1(t)
+	api.EXPECT().ListRouteTables(mock.Anything, npath).Return(secapi.NewIterator(secatest.Page(tables...)), nil)
+	api.EXPECT().ListSubnets(mock.Anything, npath).Return(secapi.NewIterator(secatest.Page(subnets...)), nil)
+	api.EXPECT().ListInternetGateways(mock.Anything, wpath).Return(secapi.NewIterator(secatest.Page(gateways...)), nil)
+	api.EXPECT().ListNics(mock.Anything, wpath).Return(secapi.NewIterator(secatest.Page(nics...)), nil)
+
+	router, err := Load(ctx, api, npath, natGateways)
+	require.NoError(t, err)
+
+	route, err := router.Lookup("subnet-b", netip.MustParseAddr("8.8.8.8"))
+	require.NoError(t, err)
+	assert.Equal(t, "internet-nat-gateway-instances/nat-1", route.Target.Resource)
+}
+
+func buildRouter(t *testing.T) *Router {
+	t.Helper()
+
+	router, err := New(buildResources())
+	require.NoError(t, err)
+	return router
+}
+
+func buildResources() ([]*schema.RouteTable, []*schema.Subnet, []*schema.InternetGateway, []*schema.Nic, []*schema.InternetNatGatewayInstance) {
+	tables := []*schema.RouteTable{
+		{
+			Metadata: secatest.NewRegionalNetworkResourceMetadata("main", secatest.Tenant1Name, secatest.Workspace1Name, secatest.Network1Name, secatest.Region1Name),
+			Spec: schema.RouteTableSpec{Routes: []schema.RouteSpec{
+				route("0.0.0.0/0", "internet-gateways/gateway-1"),
+				route("192.168.1.0/24", "nics/nic-vpn"),
+				route("0.0.0.0/0", "internet-nat-gateway-instances/nat-1"),
+				route("10.0.2.128/25", "nics/nic-vpn"),
+				route("192.168.3.0/24", "nics/nic-vpn-old"),
+				route("192.168.0.0/16", "nics/nic-vpn-old"),
+				route("10.0.0.300/24", "nics/nic-vpn"),
+				route("172.16.0.0/12", "nics/nic-missing"),
+				route("172.17.0.0/16", "instances/instance-1"),
+				{DestinationCidrBlock: "172.18.0.0/16", TargetRef: schema.Reference{Resource: "internet-gateways/gateway-2", Workspace: "other"}},
+				route("::/0", "internet-gateways/gateway-1"),
+			}},
+		},
+		{
+			Metadata: secatest.NewRegionalNetworkResourceMetadata("private", secatest.Tenant1Name, secatest.Workspace1Name, secatest.Network1Name, secatest.Region1Name),
+			Spec: schema.RouteTableSpec{Routes: []schema.RouteSpec{
+				route("0.0.0.0/0", "internet-nat-gateway-instances/nat-1"),
+				route("0.0.0.0/0", "internet-nat-gateway-instances/nat-1"),
+			}},
+		},
+	}
+	subnets := []*schema.Subnet{
+		buildSubnet("subnet-a", "main", schema.Cidr{Ipv4: "10.0.1.0/24", Ipv6: "fd00::/64"}),
+		buildSubnet("subnet-b", "private", schema.Cidr{Ipv4: "10.0.2.0/24", Ipv6: "fd00:0:0:1::/64"}),
+		buildSubnet("subnet-c", "unknown", schema.Cidr{Ipv4: "10.0.3.0/24"}),
+	}
+	gateways := []*schema.InternetGateway{
+		{Metadata: secatest.NewRegionalWorkspaceResourceMetadata("gateway-1", secatest.Tenant1Name, secatest.Workspace1Name, secatest.Region1Name)},
+	}
+	nics := []*schema.Nic{
+		{Metadata: secatest.NewRegionalWorkspaceResourceMetadata("nic-vpn", secatest.Tenant1Name, secatest.Workspace1Name, secatest.Region1Name)},
+		{Metadata: secatest.NewRegionalWorkspaceResourceMetadata("nic-vpn-old", secatest.Tenant1Name, secatest.Workspace1Name, secatest.Region1Name)},
+	}
+	natGateways := []*schema.InternetNatGatewayInstance{
+		{Metadata: secatest.NewRegionalWorkspaceResourceMetadata("nat-1", secatest.Tenant1Name, secatest.Workspace1Name, secatest.Region1Name)},
+	}
+	return tables, subnets, gateways, nics, natGateways
+}
+
+func route(destination string, target string) schema.RouteSpec {
+	return schema.RouteSpec{DestinationCidrBlock: destination, TargetRef: schema.Reference{Resource: target}}
+}
+
+func buildSubnet(name string, table string, cidr schema.Cidr) *schema.Subnet {
+	return &schema.Subnet{
+		Metadata: secatest.NewRegionalNetworkResourceMetadata(name, secatest.Tenant1Name, secatest.Workspace1Name, secatest.Network1Name, secatest.Region1Name),
+		Spec:     schema.SubnetSpec{Cidr: cidr, RouteTableRef: schema.Reference{Resource: "route-tables/" + table}, Zone: secatest.ZoneA},
+	}
+}
@@ -0,0 +1,179 @@
+package routing
+
+import (
+	"fmt"
+	"net/netip"
+	"slices"
+
+	"github.com/eu-sovereign-cloud/go-sdk/internal/secautil"
+	"github.com/eu-sovereign-cloud/go-sdk/pkg/spec/schema"
+)
+
+// IssueKind is the kind of issue found in a route table.
+type IssueKind string
+
+const (
+	// IssueInvalid is a route with an invalid destination CIDR block
+	IssueInvalid IssueKind = "invalid"
+
+	// IssueConflict is a route with the destination of a previous route and another target
+	IssueConflict IssueKind = "conflict"
+
+	// IssueDuplicate is a route with the destination and the target of a previous route
+	IssueDuplicate IssueKind = "duplicate"
+
+	// IssueRedundant is a route overlapped by a shorter prefix route with the same target
+	IssueRedundant IssueKind = "redundant"
+
+	// IssueUnreachable is a route to a destination in a subnet of the network, delivered locally
+	IssueUnreachable IssueKind = "unreachable"
+
+	// IssueTarget is a route targeting a resource other than an existing internet gateway, NIC or NAT gateway of the workspace
+	IssueTarget IssueKind = "target"
+
+	// IssueMissingTable is a subnet referencing a route table not found in the network
+	IssueMissingTable IssueKind = "missing-table"
+)
+
+// Issue is an issue of a route of a table, or of the route table of a subnet.
+type Issue struct {
+	Kind    IssueKind
+	Table   string
+	Index   int
+	Subnet  string
+	Message string
+}
+
+func (i Issue) String() string {
+	if i.Subnet != "" {
+		return fmt.Sprintf("subnet %s: %s: %s", i.Subnet, i.Kind, i.Message)
+	}
+	return fmt.Sprintf("%s/routes[%d]: %s: %s", i.Table, i.Index, i.Kind, i.Message)
+}
+
+// Validate returns the issues of the route tables and of the subnets, sorted by table and route.
+func (r *Router) Validate() []Issue {
+	var issues []Issue
+
+	names := make([]string, 0, len(r.subnets))
+	for name := range r.subnets {
+		names = append(names, name)
+	}
+	slices.Sort(names)
+	for _, name := range names {
+		if s := r.subnets[name]; r.tables[s.table] == nil {
+			issues = append(issues, Issue{
+				Kind:    IssueMissingTable,
+				Index:   -1,
+				Subnet:  name,
+				Message: fmt.Sprintf("route table %q not found", s.table),
+			})
+		}
+	}
+
+	var tableIssues []Issue
+	for _, t := range r.tables {
+		for index, err := range t.invalid {
+			tableIssues = append(tableIssues, Issue{Kind: IssueInvalid, Table: t.name, Index: index, Message: err.Error()})
+		}
+		for _, route := range t.routes {
+			tableIssues = append(tableIssues, r.validate(t, route)...)
+		}
+	}
+	slices.SortStableFunc(tableIssues, func(a, b Issue) int {
+		if a.Table != b.Table {
+			if a.Table < b.Table {
+				return -1
+			}
+			return 1
+		}
+		return a.Index - b.Index
+	})
+
+	return append(issues, tableIssues...)
+}
+
+func (r *Router) validate(t *table, route *Route) []Issue {
+	var issues []Issue
+	issue := func(kind IssueKind, format string, args ...any) {
+		issues = append(issues, Issue{Kind: kind, Table: t.name, Index: route.Index, Message: fmt.Sprintf(format, args...)})
+	}
+
+	if msg := r.checkTarget(t, route.Target); msg != "" {
+		issue(IssueTarget, "%s", msg)
+	}
+
+	if previous := samePrefix(t, route); previous != nil {
+		if secautil.RefKey(previous.Target) == secautil.RefKey(route.Target) {
+			issue(IssueDuplicate, "duplicates routes[%d]", previous.Index)
+		} else {
+			issue(IssueConflict, "%s is already routed via %s by routes[%d]", route.Destination, previous.Target.Resource, previous.Index)
+		}
+		return issues
+	}
+
+	if local := r.localPrefix(route.Destination); local.IsValid() {
+		issue(IssueUnreachable, "%s is in the subnet %s", route.Destination, local)
+		return issues
+	}
+
+	// The route matched by the destination without this route
+	for _, other := range t.routes {
+		if other == route || other.Destination.Bits() >= route.Destination.Bits() || !other.Destination.Contains(route.Destination.Addr()) {
+			continue
+		}
+		if secautil.RefKey(other.Target) == secautil.RefKey(route.Target) {
+			issue(IssueRedundant, "overlapped by routes[%d] via the same target", other.Index)
+		}
+		break
+	}
+	return issues
+}
+
+// Returns why a target isn't valid, empty when it is
+func (r *Router) checkTarget(t *table, target schema.Reference) string {
+	if target.Workspace != "" && t.workspace != "" && target.Workspace != t.workspace {
+		return fmt.Sprintf("target %s is in the workspace %s", target.Resource, target.Workspace)
+	}
+
+	key := secautil.RefKey(target)
+	for _, typ := range []string{internetGatewaysType, nicsType, natGatewaysType} {
+		if _, ok := secautil.RefName(target, typ); ok {
+			if !r.targets[key] {
+				return fmt.Sprintf("target %s not found", key)
+			}
+			return ""
+		}
+	}
+	return fmt.Sprintf("target %q isn't an internet gateway, a NIC or a NAT gateway", target.Resource)
+}
+
+// Returns whether a route is never selected
+func (r *Router) unreachable(t *table, route *Route) bool {
+	return samePrefix(t, route) != nil || r.localPrefix(route.Destination).IsValid()
+}
+
+// Returns the previous route of the table with the same destination
+func samePrefix(t *table, route *Route) *Route {
+	for _, other := range t.routes {
+		if other == route {
+			return nil
+		}
+		if other.Destination == route.Destination && other.Index < route.Index {
+			return other
+		}
+	}
+	return nil
+}
+
+// Returns the prefix of the subnet containing a whole destination, an invalid prefix if none does
+func (r *Router) localPrefix(destination netip.Prefix) netip.Prefix {
+	for _, s := range r.subnets {
+		for _, prefix := range s.prefixes {
+			if prefix.Bits() <= destination.Bits() && prefix.Contains(destination.Addr()) {
+				return prefix
+			}
+		}
+	}
+	return netip.Prefix{}
+}
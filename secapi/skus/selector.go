@@ -0,0 +1,247 @@
+// Package skus selects the SKUs meeting requirements: the instance, the storage, the network and
+// the Kubernetes cluster SKUs. The matching SKUs are ranked by their closeness to the requirements.
+package skus
+
+import (
+	"cmp"
+	"context"
+	"errors"
+	"math"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/eu-sovereign-cloud/go-sdk/pkg/spec/schema"
+	"github.com/eu-sovereign-cloud/go-sdk/secapi"
+	"github.com/eu-sovereign-cloud/go-sdk/secapi/builders"
+)
+
+var ErrNoMatchingSku = errors.New("no sku matches the requirements")
+
+// Range bounds a value, a zero bound is unbounded. The ranking prefers the values close to the minimum.
+type Range struct {
+	Min int
+	Max int
+}
+
+func (r Range) contains(value int) bool {
+	return (r.Min == 0 || value >= r.Min) && (r.Max == 0 || value <= r.Max)
+}
+
+// Returns the relative distance of the value to the minimum, or to the maximum when there's no minimum
+func (r Range) distance(value int) float64 {
+	target := r.Min
+	if target == 0 {
+		target = r.Max
+	}
+	if target == 0 {
+		return 0
+	}
+	return math.Abs(float64(value-target)) / float64(target)
+}
+
+// InstanceRequirements are the requirements of the instance SKUs, the labels select the
+// architecture or the generation as arch=arm64 or generation>=3.
+type InstanceRequirements struct {
+	VCPU   Range
+	Ram    Range
+	Labels *builders.LabelsBuilder
+}
+
+// StorageRequirements are the requirements of the storage SKUs, SizeGB excludes the SKUs with a larger minimum volume size.
+type StorageRequirements struct {
+	Iops   Range
+	Types  []schema.StorageSkuSpecType
+	SizeGB int
+	Labels *builders.LabelsBuilder
+}
+
+// NetworkRequirements are the requirements of the network SKUs.
+type NetworkRequirements struct {
+	Bandwidth Range
+	Packets   Range
+	Labels    *builders.LabelsBuilder
+}
+
+// KubernetesRequirements are the requirements of the Kubernetes cluster SKUs, the version matches
+// by prefix as 1.30. The SKUs past their end of life at the given time are excluded, now by default.
+type KubernetesRequirements struct {
+	Version               string
+	Type                  schema.KubernetesClusterSkuSpecType
+	AutomaticUpdatePolicy schema.KubernetesClusterSkuSpecAutomaticUpdatePolicy
+	At                    time.Time
+	Labels                *builders.LabelsBuilder
+}
+
+// SelectInstanceSkus returns the instance SKUs matching the requirements, the closest first.
+func SelectInstanceSkus(skus []*schema.InstanceSku, req InstanceRequirements) []*schema.InstanceSku {
+	return rank(skus, req.Labels, func(sku *schema.InstanceSku) (string, schema.Labels, float64, bool) {
+		if sku.Spec == nil || !req.VCPU.contains(sku.Spec.VCPU) || !req.Ram.contains(sku.Spec.Ram) {
+			return "", nil, 0, false
+		}
+		return nameOf(sku.Metadata), sku.Labels, req.VCPU.distance(sku.Spec.VCPU) + req.Ram.distance(sku.Spec.Ram), true
+	})
+}
+
+// SelectStorageSkus returns the storage SKUs matching the requirements, the closest first.
+func SelectStorageSkus(skus []*schema.StorageSku, req StorageRequirements) []*schema.StorageSku {
+	return rank(skus, req.Labels, func(sku *schema.StorageSku) (string, schema.Labels, float64, bool) {
+		if sku.Spec == nil || !req.Iops.contains(sku.Spec.Iops) {
+			return "", nil, 0, false
+		}
+		if len(req.Types) > 0 && !slices.Contains(req.Types, sku.Spec.Type) {
+			return "", nil, 0, false
+		}
+		if req.SizeGB > 0 && sku.Spec.MinVolumeSize > req.SizeGB {
+			return "", nil, 0, false
+		}
+		return nameOf(sku.Metadata), sku.Labels, req.Iops.distance(sku.Spec.Iops), true
+	})
+}
+
+// SelectNetworkSkus returns the network SKUs matching the requirements, the closest first.
+func SelectNetworkSkus(skus []*schema.NetworkSku, req NetworkRequirements) []*schema.NetworkSku {
+	return rank(skus, req.Labels, func(sku *schema.NetworkSku) (string, schema.Labels, float64, bool) {
+		if sku.Spec == nil || !req.Bandwidth.contains(sku.Spec.Bandwidth) || !req.Packets.contains(sku.Spec.Packets) {
+			return "", nil, 0, false
+		}
+		return nameOf(sku.Metadata), sku.Labels, req.Bandwidth.distance(sku.Spec.Bandwidth) + req.Packets.distance(sku.Spec.Packets), true
+	})
+}
+
+// SelectKubernetesSkus returns the Kubernetes cluster SKUs matching the requirements, the
+// latest version first and, between the same versions, the longest supported first.
+func SelectKubernetesSkus(skus []*schema.KubernetesClusterSku, req KubernetesRequirements) []*schema.KubernetesClusterSku {
+	at := req.At
+	if at.IsZero() {
+		at = time.Now()
+	}
+
+	var matching []*schema.KubernetesClusterSku
+	for _, sku := range skus {
+		if sku.Spec == nil || (req.Labels != nil && !req.Labels.Matches(sku.Labels)) {
+			continue
+		}
+		if req.Version != "" && sku.Spec.Version != req.Version && !strings.HasPrefix(sku.Spec.Version, req.Version+".") {
+			continue
+		}
+		if (req.Type != "" && sku.Spec.Type != req.Type) || (req.AutomaticUpdatePolicy != "" && sku.Spec.AutomaticUpdatePolicy != req.AutomaticUpdatePolicy) {
+			continue
+		}
+		if !sku.Spec.EndOfLifeAt.IsZero() && !sku.Spec.EndOfLifeAt.After(at) {
+			continue
+		}
+		matching = append(matching, sku)
+	}
+
+	slices.SortStableFunc(matching, func(a, b *schema.KubernetesClusterSku) int {
+		return cmp.Or(
+			compareVersions(b.Spec.Version, a.Spec.Version),
+			b.Spec.EndOfLifeAt.Compare(a.Spec.EndOfLifeAt),
+			strings.Compare(nameOf(a.Metadata), nameOf(b.Metadata)),
+		)
+	})
+	return matching
+}
+
+// FindInstanceSku returns the closest instance SKU of the tenant, the labels are selected by the provider.
+func FindInstanceSku(ctx context.Context, api secapi.ComputeV1, tpath secapi.TenantPath, req InstanceRequirements) (*schema.InstanceSku, error) {
+	return find(ctx, api.ListSkusWithOptions, tpath, req.Labels, func(skus []*schema.InstanceSku) []*schema.InstanceSku {
+		return SelectInstanceSkus(skus, req)
+	})
+}
+
+// FindStorageSku returns the closest storage SKU of the tenant, the labels are selected by the provider.
+func FindStorageSku(ctx context.Context, api secapi.StorageV1, tpath secapi.TenantPath, req StorageRequirements) (*schema.StorageSku, error) {
+	return find(ctx, api.ListSkusWithOptions, tpath, req.Labels, func(skus []*schema.StorageSku) []*schema.StorageSku {
+		return SelectStorageSkus(skus, req)
+	})
+}
+
+// FindNetworkSku returns the closest network SKU of the tenant, the labels are selected by the provider.
+func FindNetworkSku(ctx context.Context, api secapi.NetworkV1, tpath secapi.TenantPath, req NetworkRequirements) (*schema.NetworkSku, error) {
+	return find(ctx, api.ListSkusWithOptions, tpath, req.Labels, func(skus []*schema.NetworkSku) []*schema.NetworkSku {
+		return SelectNetworkSkus(skus, req)
+	})
+}
+
+// Sku returned by the providers
+type sku interface {
+	schema.InstanceSku | schema.StorageSku | schema.NetworkSku
+}
+
+func find[T sku](ctx context.Context, list func(context.Context, secapi.TenantPath, *secapi.ListOptions) (*secapi.Iterator[T], error), tpath secapi.TenantPath, labels *builders.LabelsBuilder, selectFn func([]*T) []*T) (*T, error) {
+	options := secapi.NewListOptions()
+	if labels != nil {
+		options.WithLabels(labels)
+	}
+
+	iter, err := list(ctx, tpath, options)
+	if err != nil {
+		return nil, err
+	}
+	skus, err := iter.All(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	selected := selectFn(skus)
+	if len(selected) == 0 {
+		return nil, ErrNoMatchingSku
+	}
+	return selected[0], nil
+}
+
+// Returns the SKUs matching the labels and the match function, by increasing distance then name
+func rank[T any](skus []*T, labels *builders.LabelsBuilder, match func(*T) (string, schema.Labels, float64, bool)) []*T {
+	type candidate struct {
+		sku      *T
+		name     string
+		distance float64
+	}
+
+	var candidates []candidate
+	for _, sku := range skus {
+		name, skuLabels, distance, ok := match(sku)
+		if !ok || (labels != nil && !labels.Matches(skuLabels)) {
+			continue
+		}
+		candidates = append(candidates, candidate{sku: sku, name: name, distance: distance})
+	}
+
+	slices.SortStableFunc(candidates, func(a, b candidate) int {
+		return cmp.Or(cmp.Compare(a.distance, b.distance), strings.Compare(a.name, b.name))
+	})
+
+	selected := make([]*T, 0, len(candidates))
+	for _, c := range candidates {
+		selected = append(selected, c.sku)
+	}
+	return selected
+}
+
+// Compares the dotted versions as 1.30.2, numerically by component
+func compareVersions(a, b string) int {
+	as, bs := strings.Split(strings.TrimPrefix(a, "v"), "."), strings.Split(strings.TrimPrefix(b, "v"), ".")
+	for i := 0; i < max(len(as), len(bs)); i++ {
+		var x, y int
+		if i < len(as) {
+			x, _ = strconv.Atoi(as[i])
+		}
+		if i < len(bs) {
+			y, _ = strconv.Atoi(bs[i])
+		}
+		if c := cmp.Compare(x, y); c != 0 {
+			return c
+		}
+	}
+	return 0
+}
+
+func nameOf(metadata *schema.SkuResourceMetadata) string {
+	if metadata == nil {
+		return ""
+	}
+	return metadata.Name
+}
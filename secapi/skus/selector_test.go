@@ -0,0 +1,185 @@
+package skus
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/eu-sovereign-cloud/go-sdk/internal/secatest"
+	mocksecapi "github.com/eu-sovereign-cloud/go-sdk/mock/secapi"
+	"github.com/eu-sovereign-cloud/go-sdk/pkg/spec/schema"
+	"github.com/eu-sovereign-cloud/go-sdk/secapi"
+	"github.com/eu-sovereign-cloud/go-sdk/secapi/builders"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSelectInstanceSkus(t *testing.T) {
+	skus := []*schema.InstanceSku{
+		buildInstanceSku("d2a.large", 2, 8, "arm64", "2"),
+		buildInstanceSku("d2a.xlarge", 4, 16, "arm64", "3"),
+		buildInstanceSku("d2x.xlarge", 4, 16, "amd64", "3"),
+		buildInstanceSku("d2a.2xlarge", 8, 32, "arm64", "4"),
+		buildInstanceSku("d2a.4xlarge", 16, 64, "arm64", "4"),
+		{Metadata: &schema.SkuResourceMetadata{Name: "broken"}},
+	}
+
+	selected := SelectInstanceSkus(skus, InstanceRequirements{VCPU: Range{Min: 4}, Ram: Range{Min: 16, Max: 32}})
+	assert.Equal(t, []string{"d2a.xlarge", "d2x.xlarge", "d2a.2xlarge"}, instanceNames(selected))
+
+	selected = SelectInstanceSkus(skus, InstanceRequirements{
+		VCPU:   Range{Max: 8},
+		Labels: builders.NewLabelsBuilder().Equals("arch", "arm64").Gte("generation", 3),
+	})
+	assert.Equal(t, []string{"d2a.2xlarge", "d2a.xlarge"}, instanceNames(selected))
+
+	assert.Empty(t, SelectInstanceSkus(skus, InstanceRequirements{VCPU: Range{Min: 32}}))
+}
+
+func TestSelectStorageSkus(t *testing.T) {
+	skus := []*schema.StorageSku{
+		buildStorageSku("rd500", 500, 50, schema.StorageSkuTypeRemoteDurable),
+		buildStorageSku("rd2k", 2000, 100, schema.StorageSkuTypeRemoteDurable),
+		buildStorageSku("rd10k", 10000, 500, schema.StorageSkuTypeRemoteDurable),
+		buildStorageSku("le5k", 5000, 10, schema.StorageSkuTypeLocalEphemeral),
+	}
+
+	selected := SelectStorageSkus(skus, StorageRequirements{Iops: Range{Min: 1000}})
+	assert.Equal(t, []string{"rd2k", "le5k", "rd10k"}, storageNames(selected))
+
+	selected = SelectStorageSkus(skus, StorageRequirements{
+		Iops:   Range{Min: 1000},
+		Types:  []schema.StorageSkuSpecType{schema.StorageSkuTypeRemoteDurable},
+		SizeGB: 200,
+	})
+	assert.Equal(t, []string{"rd2k"}, storageNames(selected))
+}
+
+func TestSelectNetworkSkus(t *testing.T) {
+	skus := []*schema.NetworkSku{
+		buildNetworkSku("n1k", 1000, 100000),
+		buildNetworkSku("n10k", 10000, 1000000),
+		buildNetworkSku("n25k", 25000, 2000000),
+	}
+
+	selected := SelectNetworkSkus(skus, NetworkRequirements{Bandwidth: Range{Min: 5000}})
+	assert.Equal(t, []string{"n10k", "n25k"}, networkNames(selected))
+
+	selected = SelectNetworkSkus(skus, NetworkRequirements{Packets: Range{Max: 1000000}})
+	assert.Equal(t, []string{"n10k", "n1k"}, networkNames(selected))
+}
+
+func TestSelectKubernetesSkus(t *testing.T) {
+	now := time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+	skus := []*schema.KubernetesClusterSku{
+		buildKubernetesSku("k8s-1.29", "1.29.10", now.AddDate(0, -1, 0), schema.KubernetesClusterAutomaticUpdatePolicyStable),
+		buildKubernetesSku("k8s-1.30", "1.30.6", now.AddDate(0, 6, 0), schema.KubernetesClusterAutomaticUpdatePolicyStable),
+		buildKubernetesSku("k8s-1.30-preview", "1.30.7", now.AddDate(0, 3, 0), schema.KubernetesClusterAutomaticUpdatePolicyPreview),
+		buildKubernetesSku("k8s-1.31", "1.31.2", now.AddDate(1, 0, 0), schema.KubernetesClusterAutomaticUpdatePolicyStable),
+		buildKubernetesSku("k8s-1.3", "1.3.0", time.Time{}, schema.KubernetesClusterAutomaticUpdatePolicyStable),
+	}
+
+	selected := SelectKubernetesSkus(skus, KubernetesRequirements{At: now})
+	assert.Equal(t, []string{"k8s-1.31", "k8s-1.30-preview", "k8s-1.30", "k8s-1.3"}, kubernetesNames(selected))
+
+	selected = SelectKubernetesSkus(skus, KubernetesRequirements{
+		Version:               "1.30",
+		AutomaticUpdatePolicy: schema.KubernetesClusterAutomaticUpdatePolicyStable,
+		At:                    now,
+	})
+	assert.Equal(t, []string{"k8s-1.30"}, kubernetesNames(selected))
+
+	selected = SelectKubernetesSkus(skus, KubernetesRequirements{Version: "1.29", At: now})
+	assert.Empty(t, selected)
+}
+
+func TestFindInstanceSku(t *testing.T) {
+	ctx := context.Background()
+	tpath := secapi.TenantPath{Tenant: secatest.Tenant1Name}
+	labels := builders.NewLabelsBuilder().Equals("arch", "arm64")
+
+	api := mocksecapi.NewMockComputeV1(t)
+	api.EXPECT().ListSkusWithOptions(mock.Anything, tpath, mock.MatchedBy(func(options *secapi.ListOptions) bool {
+		return options.Labels == labels
+	})).Return(secapi.NewIterator(func(ctx context.Context, skipToken *string) ([]schema.InstanceSku, *schema.ResponseMetadata, error) {
+		return []schema.InstanceSku{
+			*buildInstanceSku("d2a.2xlarge", 8, 32, "arm64", "4"),
+			*buildInstanceSku("d2a.xlarge", 4, 16, "arm64", "3"),
+		}, &schema.ResponseMetadata{}, nil
+	}), nil).Twice()
+
+	sku, err := FindInstanceSku(ctx, api, tpath, InstanceRequirements{VCPU: Range{Min: 3}, Labels: labels})
+	require.NoError(t, err)
+	assert.Equal(t, "d2a.xlarge", sku.Metadata.Name)
+
+	_, err = FindInstanceSku(ctx, api, tpath, InstanceRequirements{Ram: Range{Min: 64}, Labels: labels})
+	assert.ErrorIs(t, err, ErrNoMatchingSku)
+}
+
+func buildInstanceSku(name string, vcpu, ram int, arch, generation string) *schema.InstanceSku {
+	return &schema.InstanceSku{
+		Metadata: &schema.SkuResourceMetadata{Name: name},
+		Labels:   schema.Labels{"arch": arch, "generation": generation},
+		Spec:     &schema.InstanceSkuSpec{VCPU: vcpu, Ram: ram},
+	}
+}
+
+func buildStorageSku(name string, iops, minVolumeSize int, skuType schema.StorageSkuSpecType) *schema.StorageSku {
+	return &schema.StorageSku{
+		Metadata: &schema.SkuResourceMetadata{Name: name},
+		Spec:     &schema.StorageSkuSpec{Iops: iops, MinVolumeSize: minVolumeSize, Type: skuType},
+	}
+}
+
+func buildNetworkSku(name string, bandwidth, packets int) *schema.NetworkSku {
+	return &schema.NetworkSku{
+		Metadata: &schema.SkuResourceMetadata{Name: name},
+		Spec:     &schema.NetworkSkuSpec{Bandwidth: bandwidth, Packets: packets},
+	}
+}
+
+func buildKubernetesSku(name, version string, endOfLife time.Time, policy schema.KubernetesClusterSkuSpecAutomaticUpdatePolicy) *schema.KubernetesClusterSku {
+	return &schema.KubernetesClusterSku{
+		Metadata: &schema.SkuResourceMetadata{Name: name},
+		Spec: &schema.KubernetesClusterSkuSpec{
+			Version:               version,
+			EndOfLifeAt:           endOfLife,
+			AutomaticUpdatePolicy: policy,
+			Type:                  schema.KubernetesClusterTypeDedicated,
+		},
+	}
+}
+
+func instanceNames(skus []*schema.InstanceSku) []string {
+	var names []string
+	for _, sku := range skus {
+		names = append(names, sku.Metadata.Name)
+	}
+	return names
+}
+
+func storageNames(skus []*schema.StorageSku) []string {
+	var names []string
+	for _, sku := range skus {
+		names = append(names, sku.Metadata.Name)
+	}
+	return names
+}
+
+func networkNames(skus []*schema.NetworkSku) []string {
+	var names []string
+	for _, sku := range skus {
+		names = append(names, sku.Metadata.Name)
+	}
+	return names
+}
+
+func kubernetesNames(skus []*schema.KubernetesClusterSku) []string {
+	var names []string
+	for _, sku := range skus {
+		names = append(names, sku.Metadata.Name)
+	}
+	return names
+}
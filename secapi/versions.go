@@ -0,0 +1,46 @@
+package secapi
+
+import (
+	"slices"
+	"strconv"
+	"strings"
+)
+
+// Returns the numbers of a provider version, as v1, v1.1 or v1.1.2,
+// the versions out of this form, as the pre-releases, are not compatible with any API.
+func parseProviderVersion(version string) ([]int, bool) {
+	rest, found := strings.CutPrefix(version, "v")
+	if !found {
+		return nil, false
+	}
+
+	var numbers []int
+	for _, part := range strings.Split(rest, ".") {
+		number, err := strconv.Atoi(part)
+		if err != nil || number < 0 {
+			return nil, false
+		}
+		numbers = append(numbers, number)
+	}
+	return numbers, true
+}
+
+// Compatible versions share the major number of the API version, as v1.1 with v1
+func compatibleProviderVersion(version, apiVersion string) bool {
+	numbers, ok := parseProviderVersion(version)
+	if !ok {
+		return false
+	}
+	apiNumbers, ok := parseProviderVersion(apiVersion)
+	if !ok {
+		return false
+	}
+
+	return numbers[0] == apiNumbers[0]
+}
+
+func compareProviderVersions(a, b string) int {
+	aNumbers, _ := parseProviderVersion(a)
+	bNumbers, _ := parseProviderVersion(b)
+	return slices.Compare(aNumbers, bNumbers)
+}
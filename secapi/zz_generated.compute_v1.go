@@ -1,3 +1,5 @@
+// Code generated by secagen. DO NOT EDIT.
+
 package secapi
 
 import (
@@ -13,11 +15,13 @@ type ComputeV1 interface {
 	// Instance Sku
 	ListSkusWithOptions(ctx context.Context, tpath TenantPath, options *ListOptions) (*Iterator[schema.InstanceSku], error)
 	ListSkus(ctx context.Context, tpath TenantPath) (*Iterator[schema.InstanceSku], error)
+
 	GetSku(ctx context.Context, tref TenantReference) (*schema.InstanceSku, error)
 
 	// Instance
 	ListInstancesWithOptions(ctx context.Context, wpath WorkspacePath, options *ListOptions) (*Iterator[schema.Instance], error)
 	ListInstances(ctx context.Context, wpath WorkspacePath) (*Iterator[schema.Instance], error)
+
 	GetInstance(ctx context.Context, wref WorkspaceReference) (*schema.Instance, error)
 	GetInstanceUntilState(ctx context.Context, wref WorkspaceReference, config ResourceObserverUntilValueConfig[schema.ResourceState]) (*schema.Instance, error)
 	GetInstanceUntilPowerState(ctx context.Context, wref WorkspaceReference, config ResourceObserverUntilValueConfig[schema.InstanceStatusPowerState]) (*schema.Instance, error)
@@ -30,14 +34,14 @@ type ComputeV1 interface {
 	DeleteInstanceWithParams(ctx context.Context, inst *schema.Instance, params *compute.DeleteInstanceParams) error
 	DeleteInstance(ctx context.Context, inst *schema.Instance) error
 
+	RestartInstanceWithParams(ctx context.Context, inst *schema.Instance, params *compute.RestartInstanceParams) error
+	RestartInstance(ctx context.Context, inst *schema.Instance) error
+
 	StartInstanceWithParams(ctx context.Context, inst *schema.Instance, params *compute.StartInstanceParams) error
 	StartInstance(ctx context.Context, inst *schema.Instance) error
 
 	StopInstanceWithParams(ctx context.Context, inst *schema.Instance, params *compute.StopInstanceParams) error
 	StopInstance(ctx context.Context, inst *schema.Instance) error
-
-	RestartInstanceWithParams(ctx context.Context, inst *schema.Instance, params *compute.RestartInstanceParams) error
-	RestartInstance(ctx context.Context, inst *schema.Instance) error
 }
 
 // Unavailable
@@ -80,6 +84,10 @@ func (api *ComputeV1Unavailable) GetInstanceUntilState(ctx context.Context, wref
 	return nil, ErrProviderNotAvailable
 }
 
+func (api *ComputeV1Unavailable) GetInstanceUntilPowerState(ctx context.Context, wref WorkspaceReference, config ResourceObserverUntilValueConfig[schema.InstanceStatusPowerState]) (*schema.Instance, error) {
+	return nil, ErrProviderNotAvailable
+}
+
 func (api *ComputeV1Unavailable) WatchInstanceUntilDeleted(ctx context.Context, wref WorkspaceReference, config ResourceObserverConfig) error {
 	return ErrProviderNotAvailable
 }
@@ -100,31 +108,27 @@ func (api *ComputeV1Unavailable) DeleteInstance(ctx context.Context, inst *schem
 	return ErrProviderNotAvailable
 }
 
-func (api *ComputeV1Unavailable) StartInstanceWithParams(ctx context.Context, inst *schema.Instance, params *compute.StartInstanceParams) error {
+func (api *ComputeV1Unavailable) RestartInstanceWithParams(ctx context.Context, inst *schema.Instance, params *compute.RestartInstanceParams) error {
 	return ErrProviderNotAvailable
 }
 
-func (api *ComputeV1Unavailable) StartInstance(ctx context.Context, inst *schema.Instance) error {
+func (api *ComputeV1Unavailable) RestartInstance(ctx context.Context, inst *schema.Instance) error {
 	return ErrProviderNotAvailable
 }
 
-func (api *ComputeV1Unavailable) GetInstanceUntilPowerState(ctx context.Context, wref WorkspaceReference, config ResourceObserverUntilValueConfig[schema.InstanceStatusPowerState]) (*schema.Instance, error) {
-	return nil, ErrProviderNotAvailable
-}
-
-func (api *ComputeV1Unavailable) StopInstanceWithParams(ctx context.Context, inst *schema.Instance, params *compute.StopInstanceParams) error {
+func (api *ComputeV1Unavailable) StartInstanceWithParams(ctx context.Context, inst *schema.Instance, params *compute.StartInstanceParams) error {
 	return ErrProviderNotAvailable
 }
 
-func (api *ComputeV1Unavailable) StopInstance(ctx context.Context, inst *schema.Instance) error {
+func (api *ComputeV1Unavailable) StartInstance(ctx context.Context, inst *schema.Instance) error {
 	return ErrProviderNotAvailable
 }
 
-func (api *ComputeV1Unavailable) RestartInstanceWithParams(ctx context.Context, inst *schema.Instance, params *compute.RestartInstanceParams) error {
+func (api *ComputeV1Unavailable) StopInstanceWithParams(ctx context.Context, inst *schema.Instance, params *compute.StopInstanceParams) error {
 	return ErrProviderNotAvailable
 }
 
-func (api *ComputeV1Unavailable) RestartInstance(ctx context.Context, inst *schema.Instance) error {
+func (api *ComputeV1Unavailable) StopInstance(ctx context.Context, inst *schema.Instance) error {
 	return ErrProviderNotAvailable
 }
 
@@ -136,7 +140,7 @@ type ComputeV1Impl struct {
 }
 
 func newComputeV1Impl(client *RegionalClient, computeUrl string) (ComputeV1, error) {
-	compute, err := compute.NewClientWithResponses(computeUrl)
+	compute, err := compute.NewClientWithResponses(computeUrl, compute.WithHTTPClient(client.httpClient))
 	if err != nil {
 		return nil, err
 	}
@@ -180,6 +184,7 @@ func (api *ComputeV1Impl) ListSkusWithOptions(ctx context.Context, tpath TenantP
 			}
 		},
 	}
+
 	return &iter, nil
 }
 
@@ -240,6 +245,7 @@ func (api *ComputeV1Impl) ListInstancesWithOptions(ctx context.Context, wpath Wo
 			}
 		},
 	}
+
 	return &iter, nil
 }
 
@@ -399,12 +405,12 @@ func (api *ComputeV1Impl) DeleteInstance(ctx context.Context, inst *schema.Insta
 	return api.DeleteInstanceWithParams(ctx, inst, nil)
 }
 
-func (api *ComputeV1Impl) StartInstanceWithParams(ctx context.Context, inst *schema.Instance, params *compute.StartInstanceParams) error {
+func (api *ComputeV1Impl) RestartInstanceWithParams(ctx context.Context, inst *schema.Instance, params *compute.RestartInstanceParams) error {
 	if err := api.validateWorkspaceMetadata(inst.Metadata); err != nil {
 		return err
 	}
 
-	resp, err := api.compute.StartInstanceWithResponse(ctx, inst.Metadata.Tenant, inst.Metadata.Workspace, inst.Metadata.Name, params, api.loadRequestHeaders)
+	resp, err := api.compute.RestartInstanceWithResponse(ctx, inst.Metadata.Tenant, inst.Metadata.Workspace, inst.Metadata.Name, params, api.loadRequestHeaders)
 	if err != nil {
 		return err
 	}
@@ -416,16 +422,16 @@ func (api *ComputeV1Impl) StartInstanceWithParams(ctx context.Context, inst *sch
 	}
 }
 
-func (api *ComputeV1Impl) StartInstance(ctx context.Context, inst *schema.Instance) error {
-	return api.StartInstanceWithParams(ctx, inst, nil)
+func (api *ComputeV1Impl) RestartInstance(ctx context.Context, inst *schema.Instance) error {
+	return api.RestartInstanceWithParams(ctx, inst, nil)
 }
 
-func (api *ComputeV1Impl) StopInstanceWithParams(ctx context.Context, inst *schema.Instance, params *compute.StopInstanceParams) error {
+func (api *ComputeV1Impl) StartInstanceWithParams(ctx context.Context, inst *schema.Instance, params *compute.StartInstanceParams) error {
 	if err := api.validateWorkspaceMetadata(inst.Metadata); err != nil {
 		return err
 	}
 
-	resp, err := api.compute.StopInstanceWithResponse(ctx, inst.Metadata.Tenant, inst.Metadata.Workspace, inst.Metadata.Name, params, api.loadRequestHeaders)
+	resp, err := api.compute.StartInstanceWithResponse(ctx, inst.Metadata.Tenant, inst.Metadata.Workspace, inst.Metadata.Name, params, api.loadRequestHeaders)
 	if err != nil {
 		return err
 	}
@@ -437,16 +443,16 @@ func (api *ComputeV1Impl) StopInstanceWithParams(ctx context.Context, inst *sche
 	}
 }
 
-func (api *ComputeV1Impl) StopInstance(ctx context.Context, inst *schema.Instance) error {
-	return api.StopInstanceWithParams(ctx, inst, nil)
+func (api *ComputeV1Impl) StartInstance(ctx context.Context, inst *schema.Instance) error {
+	return api.StartInstanceWithParams(ctx, inst, nil)
 }
 
-func (api *ComputeV1Impl) RestartInstanceWithParams(ctx context.Context, inst *schema.Instance, params *compute.RestartInstanceParams) error {
+func (api *ComputeV1Impl) StopInstanceWithParams(ctx context.Context, inst *schema.Instance, params *compute.StopInstanceParams) error {
 	if err := api.validateWorkspaceMetadata(inst.Metadata); err != nil {
 		return err
 	}
 
-	resp, err := api.compute.RestartInstanceWithResponse(ctx, inst.Metadata.Tenant, inst.Metadata.Workspace, inst.Metadata.Name, params, api.loadRequestHeaders)
+	resp, err := api.compute.StopInstanceWithResponse(ctx, inst.Metadata.Tenant, inst.Metadata.Workspace, inst.Metadata.Name, params, api.loadRequestHeaders)
 	if err != nil {
 		return err
 	}
@@ -458,6 +464,6 @@ func (api *ComputeV1Impl) RestartInstanceWithParams(ctx context.Context, inst *s
 	}
 }
 
-func (api *ComputeV1Impl) RestartInstance(ctx context.Context, inst *schema.Instance) error {
-	return api.RestartInstanceWithParams(ctx, inst, nil)
+func (api *ComputeV1Impl) StopInstance(ctx context.Context, inst *schema.Instance) error {
+	return api.StopInstanceWithParams(ctx, inst, nil)
 }
@@ -1,3 +1,5 @@
+// Code generated by secagen. DO NOT EDIT.
+
 package secapi
 
 import (
@@ -85,8 +87,8 @@ type NetworkV1 interface {
 
 	WatchSecurityGroupRuleUntilDeleted(ctx context.Context, wref WorkspaceReference, config ResourceObserverConfig) error
 
-	CreateOrUpdateSecurityGroupRuleWithParams(ctx context.Context, group *schema.SecurityGroupRule, params *network.CreateOrUpdateSecurityGroupRuleParams) (*schema.SecurityGroupRule, error)
-	CreateOrUpdateSecurityGroupRule(ctx context.Context, group *schema.SecurityGroupRule) (*schema.SecurityGroupRule, error)
+	CreateOrUpdateSecurityGroupRuleWithParams(ctx context.Context, rule *schema.SecurityGroupRule, params *network.CreateOrUpdateSecurityGroupRuleParams) (*schema.SecurityGroupRule, error)
+	CreateOrUpdateSecurityGroupRule(ctx context.Context, rule *schema.SecurityGroupRule) (*schema.SecurityGroupRule, error)
 
 	DeleteSecurityGroupRuleWithParams(ctx context.Context, rule *schema.SecurityGroupRule, params *network.DeleteSecurityGroupRuleParams) error
 	DeleteSecurityGroupRule(ctx context.Context, rule *schema.SecurityGroupRule) error
@@ -103,8 +105,8 @@ type NetworkV1 interface {
 	CreateOrUpdateSecurityGroupWithParams(ctx context.Context, group *schema.SecurityGroup, params *network.CreateOrUpdateSecurityGroupParams) (*schema.SecurityGroup, error)
 	CreateOrUpdateSecurityGroup(ctx context.Context, group *schema.SecurityGroup) (*schema.SecurityGroup, error)
 
-	DeleteSecurityGroupWithParams(ctx context.Context, route *schema.SecurityGroup, params *network.DeleteSecurityGroupParams) error
-	DeleteSecurityGroup(ctx context.Context, route *schema.SecurityGroup) error
+	DeleteSecurityGroupWithParams(ctx context.Context, group *schema.SecurityGroup, params *network.DeleteSecurityGroupParams) error
+	DeleteSecurityGroup(ctx context.Context, group *schema.SecurityGroup) error
 
 	// Nic
 	ListNicsWithOptions(ctx context.Context, wpath WorkspacePath, options *ListOptions) (*Iterator[schema.Nic], error)
@@ -333,11 +335,11 @@ func (api *NetworkV1Unavailable) WatchSecurityGroupRuleUntilDeleted(ctx context.
 	return ErrProviderNotAvailable
 }
 
-func (api *NetworkV1Unavailable) CreateOrUpdateSecurityGroupRuleWithParams(ctx context.Context, group *schema.SecurityGroupRule, params *network.CreateOrUpdateSecurityGroupRuleParams) (*schema.SecurityGroupRule, error) {
+func (api *NetworkV1Unavailable) CreateOrUpdateSecurityGroupRuleWithParams(ctx context.Context, rule *schema.SecurityGroupRule, params *network.CreateOrUpdateSecurityGroupRuleParams) (*schema.SecurityGroupRule, error) {
 	return nil, ErrProviderNotAvailable
 }
 
-func (api *NetworkV1Unavailable) CreateOrUpdateSecurityGroupRule(ctx context.Context, group *schema.SecurityGroupRule) (*schema.SecurityGroupRule, error) {
+func (api *NetworkV1Unavailable) CreateOrUpdateSecurityGroupRule(ctx context.Context, rule *schema.SecurityGroupRule) (*schema.SecurityGroupRule, error) {
 	return nil, ErrProviderNotAvailable
 }
 
@@ -379,11 +381,11 @@ func (api *NetworkV1Unavailable) CreateOrUpdateSecurityGroup(ctx context.Context
 	return nil, ErrProviderNotAvailable
 }
 
-func (api *NetworkV1Unavailable) DeleteSecurityGroupWithParams(ctx context.Context, route *schema.SecurityGroup, params *network.DeleteSecurityGroupParams) error {
+func (api *NetworkV1Unavailable) DeleteSecurityGroupWithParams(ctx context.Context, group *schema.SecurityGroup, params *network.DeleteSecurityGroupParams) error {
 	return ErrProviderNotAvailable
 }
 
-func (api *NetworkV1Unavailable) DeleteSecurityGroup(ctx context.Context, route *schema.SecurityGroup) error {
+func (api *NetworkV1Unavailable) DeleteSecurityGroup(ctx context.Context, group *schema.SecurityGroup) error {
 	return ErrProviderNotAvailable
 }
 
@@ -471,7 +473,7 @@ type NetworkV1Impl struct {
 }
 
 func newNetworkV1Impl(client *RegionalClient, networkUrl string) (NetworkV1, error) {
-	network, err := network.NewClientWithResponses(networkUrl)
+	network, err := network.NewClientWithResponses(networkUrl, network.WithHTTPClient(client.httpClient))
 	if err != nil {
 		return nil, err
 	}
@@ -664,7 +666,7 @@ func (api *NetworkV1Impl) WatchNetworkUntilDeleted(ctx context.Context, wref Wor
 }
 
 func (api *NetworkV1Impl) CreateOrUpdateNetworkWithParams(ctx context.Context, net *schema.Network, params *network.CreateOrUpdateNetworkParams) (*schema.Network, error) {
-	if err := api.validateRegionalMetadata(net.Metadata); err != nil {
+	if err := api.validateWorkspaceMetadata(net.Metadata); err != nil {
 		return nil, err
 	}
 
@@ -685,11 +687,11 @@ func (api *NetworkV1Impl) CreateOrUpdateNetwork(ctx context.Context, net *schema
 }
 
 func (api *NetworkV1Impl) DeleteNetworkWithParams(ctx context.Context, net *schema.Network, params *network.DeleteNetworkParams) error {
-	if err := api.validateRegionalMetadata(net.Metadata); err != nil {
+	if err := api.validateWorkspaceMetadata(net.Metadata); err != nil {
 		return err
 	}
 
-	resp, err := api.network.DeleteNetworkWithResponse(ctx, net.Metadata.Tenant, net.Metadata.Workspace, net.Metadata.Name, params, api.loadRequestHeaders, api.loadRequestHeaders)
+	resp, err := api.network.DeleteNetworkWithResponse(ctx, net.Metadata.Tenant, net.Metadata.Workspace, net.Metadata.Name, params, api.loadRequestHeaders)
 	if err != nil {
 		return err
 	}
@@ -1159,7 +1161,7 @@ func (api *NetworkV1Impl) WatchInternetGatewayUntilDeleted(ctx context.Context,
 }
 
 func (api *NetworkV1Impl) CreateOrUpdateInternetGatewayWithParams(ctx context.Context, gtw *schema.InternetGateway, params *network.CreateOrUpdateInternetGatewayParams) (*schema.InternetGateway, error) {
-	if err := api.validateRegionalMetadata(gtw.Metadata); err != nil {
+	if err := api.validateWorkspaceMetadata(gtw.Metadata); err != nil {
 		return nil, err
 	}
 
@@ -1180,7 +1182,7 @@ func (api *NetworkV1Impl) CreateOrUpdateInternetGateway(ctx context.Context, gtw
 }
 
 func (api *NetworkV1Impl) DeleteInternetGatewayWithParams(ctx context.Context, gtw *schema.InternetGateway, params *network.DeleteInternetGatewayParams) error {
-	if err := api.validateRegionalMetadata(gtw.Metadata); err != nil {
+	if err := api.validateWorkspaceMetadata(gtw.Metadata); err != nil {
 		return err
 	}
 
@@ -1200,7 +1202,7 @@ func (api *NetworkV1Impl) DeleteInternetGateway(ctx context.Context, gtw *schema
 	return api.DeleteInternetGatewayWithParams(ctx, gtw, nil)
 }
 
-// Security Group Rules
+// Security Group Rule
 
 func (api *NetworkV1Impl) ListSecurityGroupRulesWithOptions(ctx context.Context, wpath WorkspacePath, options *ListOptions) (*Iterator[schema.SecurityGroupRule], error) {
 	if err := wpath.validate(); err != nil {
@@ -1323,12 +1325,12 @@ func (api *NetworkV1Impl) WatchSecurityGroupRuleUntilDeleted(ctx context.Context
 	}
 }
 
-func (api *NetworkV1Impl) CreateOrUpdateSecurityGroupRuleWithParams(ctx context.Context, group *schema.SecurityGroupRule, params *network.CreateOrUpdateSecurityGroupRuleParams) (*schema.SecurityGroupRule, error) {
-	if err := api.validateRegionalMetadata(group.Metadata); err != nil {
+func (api *NetworkV1Impl) CreateOrUpdateSecurityGroupRuleWithParams(ctx context.Context, rule *schema.SecurityGroupRule, params *network.CreateOrUpdateSecurityGroupRuleParams) (*schema.SecurityGroupRule, error) {
+	if err := api.validateWorkspaceMetadata(rule.Metadata); err != nil {
 		return nil, err
 	}
 
-	resp, err := api.network.CreateOrUpdateSecurityGroupRuleWithResponse(ctx, group.Metadata.Tenant, group.Metadata.Workspace, group.Metadata.Name, params, *group, api.loadRequestHeaders)
+	resp, err := api.network.CreateOrUpdateSecurityGroupRuleWithResponse(ctx, rule.Metadata.Tenant, rule.Metadata.Workspace, rule.Metadata.Name, params, *rule, api.loadRequestHeaders)
 	if err != nil {
 		return nil, err
 	}
@@ -1340,12 +1342,12 @@ func (api *NetworkV1Impl) CreateOrUpdateSecurityGroupRuleWithParams(ctx context.
 	}
 }
 
-func (api *NetworkV1Impl) CreateOrUpdateSecurityGroupRule(ctx context.Context, group *schema.SecurityGroupRule) (*schema.SecurityGroupRule, error) {
-	return api.CreateOrUpdateSecurityGroupRuleWithParams(ctx, group, nil)
+func (api *NetworkV1Impl) CreateOrUpdateSecurityGroupRule(ctx context.Context, rule *schema.SecurityGroupRule) (*schema.SecurityGroupRule, error) {
+	return api.CreateOrUpdateSecurityGroupRuleWithParams(ctx, rule, nil)
 }
 
 func (api *NetworkV1Impl) DeleteSecurityGroupRuleWithParams(ctx context.Context, rule *schema.SecurityGroupRule, params *network.DeleteSecurityGroupRuleParams) error {
-	if err := api.validateRegionalMetadata(rule.Metadata); err != nil {
+	if err := api.validateWorkspaceMetadata(rule.Metadata); err != nil {
 		return err
 	}
 
@@ -1489,7 +1491,7 @@ func (api *NetworkV1Impl) WatchSecurityGroupUntilDeleted(ctx context.Context, wr
 }
 
 func (api *NetworkV1Impl) CreateOrUpdateSecurityGroupWithParams(ctx context.Context, group *schema.SecurityGroup, params *network.CreateOrUpdateSecurityGroupParams) (*schema.SecurityGroup, error) {
-	if err := api.validateRegionalMetadata(group.Metadata); err != nil {
+	if err := api.validateWorkspaceMetadata(group.Metadata); err != nil {
 		return nil, err
 	}
 
@@ -1510,7 +1512,7 @@ func (api *NetworkV1Impl) CreateOrUpdateSecurityGroup(ctx context.Context, group
 }
 
 func (api *NetworkV1Impl) DeleteSecurityGroupWithParams(ctx context.Context, group *schema.SecurityGroup, params *network.DeleteSecurityGroupParams) error {
-	if err := api.validateRegionalMetadata(group.Metadata); err != nil {
+	if err := api.validateWorkspaceMetadata(group.Metadata); err != nil {
 		return err
 	}
 
@@ -1654,7 +1656,7 @@ func (api *NetworkV1Impl) WatchNicUntilDeleted(ctx context.Context, wref Workspa
 }
 
 func (api *NetworkV1Impl) CreateOrUpdateNicWithParams(ctx context.Context, nic *schema.Nic, params *network.CreateOrUpdateNicParams) (*schema.Nic, error) {
-	if err := api.validateRegionalMetadata(nic.Metadata); err != nil {
+	if err := api.validateWorkspaceMetadata(nic.Metadata); err != nil {
 		return nil, err
 	}
 
@@ -1675,7 +1677,7 @@ func (api *NetworkV1Impl) CreateOrUpdateNic(ctx context.Context, nic *schema.Nic
 }
 
 func (api *NetworkV1Impl) DeleteNicWithParams(ctx context.Context, nic *schema.Nic, params *network.DeleteNicParams) error {
-	if err := api.validateRegionalMetadata(nic.Metadata); err != nil {
+	if err := api.validateWorkspaceMetadata(nic.Metadata); err != nil {
 		return err
 	}
 
@@ -1819,7 +1821,7 @@ func (api *NetworkV1Impl) WatchPublicIpUntilDeleted(ctx context.Context, wref Wo
 }
 
 func (api *NetworkV1Impl) CreateOrUpdatePublicIpWithParams(ctx context.Context, ip *schema.PublicIp, params *network.CreateOrUpdatePublicIpParams) (*schema.PublicIp, error) {
-	if err := api.validateRegionalMetadata(ip.Metadata); err != nil {
+	if err := api.validateWorkspaceMetadata(ip.Metadata); err != nil {
 		return nil, err
 	}
 
@@ -1840,7 +1842,7 @@ func (api *NetworkV1Impl) CreateOrUpdatePublicIp(ctx context.Context, ip *schema
 }
 
 func (api *NetworkV1Impl) DeletePublicIpWithParams(ctx context.Context, ip *schema.PublicIp, params *network.DeletePublicIpParams) error {
-	if err := api.validateRegionalMetadata(ip.Metadata); err != nil {
+	if err := api.validateWorkspaceMetadata(ip.Metadata); err != nil {
 		return err
 	}
 
@@ -1859,39 +1861,3 @@ func (api *NetworkV1Impl) DeletePublicIpWithParams(ctx context.Context, ip *sche
 func (api *NetworkV1Impl) DeletePublicIp(ctx context.Context, ip *schema.PublicIp) error {
 	return api.DeletePublicIpWithParams(ctx, ip, nil)
 }
-
-func (api *NetworkV1Impl) validateRegionalMetadata(metadata *schema.RegionalWorkspaceResourceMetadata) error {
-	if metadata == nil {
-		return ErrNoMetadata
-	}
-
-	if metadata.Tenant == "" {
-		return ErrNoMetadataTenant
-	}
-
-	if metadata.Workspace == "" {
-		return ErrNoMetadataWorkspace
-	}
-
-	return nil
-}
-
-func (api *NetworkV1Impl) validateNetworkMetadata(metadata *schema.RegionalNetworkResourceMetadata) error {
-	if metadata == nil {
-		return ErrNoMetadata
-	}
-
-	if metadata.Tenant == "" {
-		return ErrNoMetadataTenant
-	}
-
-	if metadata.Workspace == "" {
-		return ErrNoMetadataWorkspace
-	}
-
-	if metadata.Network == "" {
-		return ErrNoMetadataNetwork
-	}
-
-	return nil
-}
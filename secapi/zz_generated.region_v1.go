@@ -1,3 +1,5 @@
+// Code generated by secagen. DO NOT EDIT.
+
 package secapi
 
 import (
@@ -43,8 +45,8 @@ type RegionV1Impl struct {
 	region region.ClientWithResponsesInterface
 }
 
-func newRegionV1Impl(client *GlobalClient, regionsUrl string) (RegionV1, error) {
-	region, err := region.NewClientWithResponses(regionsUrl)
+func newRegionV1Impl(client *GlobalClient, regionUrl string) (RegionV1, error) {
+	region, err := region.NewClientWithResponses(regionUrl, region.WithHTTPClient(client.httpClient))
 	if err != nil {
 		return nil, err
 	}
@@ -56,16 +58,16 @@ func (api *RegionV1Impl) ListRegionsWithOptions(ctx context.Context, options *Li
 	iter := Iterator[schema.Region]{
 		fn: func(ctx context.Context, skipToken *string) ([]schema.Region, *schema.ResponseMetadata, error) {
 			var params *region.ListRegionsParams
-			if options != nil {
+			if options == nil {
 				params = &region.ListRegionsParams{
 					Accept:    AcceptHeaderJson[region.ListRegionsParamsAccept](),
-					Labels:    options.Labels.BuildPtr(),
-					Limit:     options.Limit,
 					SkipToken: skipToken,
 				}
 			} else {
 				params = &region.ListRegionsParams{
 					Accept:    AcceptHeaderJson[region.ListRegionsParamsAccept](),
+					Labels:    options.Labels.BuildPtr(),
+					Limit:     options.Limit,
 					SkipToken: skipToken,
 				}
 			}
@@ -82,6 +84,7 @@ func (api *RegionV1Impl) ListRegionsWithOptions(ctx context.Context, options *Li
 			}
 		},
 	}
+
 	return &iter, nil
 }
 
@@ -0,0 +1,114 @@
+package main
+
+// Providers wrapped by the secapi package, a new resource or provider only needs an entry here.
+// The operations, the paths and the types of each resource are read from the code generated from the spec.
+var providers = []providerConfig{
+	{
+		Package:   "foundation.region.v1",
+		Alias:     "region",
+		Interface: "RegionV1",
+		Client:    "GlobalClient",
+		Resources: []resourceConfig{
+			{Type: "Region"},
+		},
+	},
+	{
+		Package:   "foundation.authorization.v1",
+		Alias:     "authorization",
+		Interface: "AuthorizationV1",
+		Client:    "GlobalClient",
+		Resources: []resourceConfig{
+			{Type: "Role", Var: "role"},
+			{Type: "RoleAssignment", Var: "assign"},
+		},
+	},
+	{
+		Package:   "extensions.wellknown.v1",
+		Alias:     "wellknown",
+		Interface: "WellknownV1",
+		Client:    "GlobalClient",
+		Resources: []resourceConfig{
+			{Type: "Wellknown"},
+		},
+	},
+	{
+		Package:   "foundation.workspace.v1",
+		Alias:     "workspace",
+		Interface: "WorkspaceV1",
+		Client:    "RegionalClient",
+		Resources: []resourceConfig{
+			{Type: "Workspace", Var: "ws", MockList: "Workspace", MockNotFoundNoResp: true},
+		},
+	},
+	{
+		Package:   "foundation.compute.v1",
+		Alias:     "compute",
+		Interface: "ComputeV1",
+		Client:    "RegionalClient",
+		Resources: []resourceConfig{
+			{Type: "InstanceSku"},
+			{Type: "Instance", Var: "inst"},
+		},
+	},
+	{
+		Package:   "foundation.storage.v1",
+		Alias:     "storage",
+		Interface: "StorageV1",
+		Client:    "RegionalClient",
+		Resources: []resourceConfig{
+			{Type: "StorageSku", MockGet: "StorageSkus"},
+			{Type: "BlockStorage", Var: "block"},
+			{Type: "Image", Var: "image"},
+		},
+	},
+	{
+		Package:   "foundation.network.v1",
+		Alias:     "network",
+		Interface: "NetworkV1",
+		Client:    "RegionalClient",
+		Resources: []resourceConfig{
+			{Type: "NetworkSku"},
+			{Type: "Network", Var: "net"},
+			{Type: "Subnet", Var: "sub"},
+			{Type: "RouteTable", Var: "route"},
+			{Type: "InternetGateway", Var: "gtw"},
+			{Type: "SecurityGroupRule", Var: "rule"},
+			{Type: "SecurityGroup", Var: "group"},
+			{Type: "Nic", Var: "nic"},
+			{Type: "PublicIp", Var: "ip"},
+		},
+	},
+}
+
+type providerConfig struct {
+	// Directory of the generated package under pkg/spec, as foundation.network.v1
+	Package string
+
+	// Name of the generated package, as network
+	Alias string
+
+	// Name of the secapi interface, as NetworkV1
+	Interface string
+
+	// Client creating the implementation, GlobalClient or RegionalClient
+	Client string
+
+	// Resources, in the order of their methods
+	Resources []resourceConfig
+}
+
+type resourceConfig struct {
+	// Name of the schema type, as Network
+	Type string
+
+	// Name of the variables holding a resource, only required when the resource is writable
+	Var string
+
+	// Names of the resource in the secatest list and get helpers when they differ from the
+	// plural and the type, as MockListWorkspaceV1, the existing tests keep calling them
+	MockList string
+	MockGet  string
+
+	// Whether the secatest not found helper doesn't take a response, as MockNotFoundWorkspaceV1
+	MockNotFoundNoResp bool
+}
@@ -0,0 +1,138 @@
+// Secagen generates the secapi wrappers of the providers, their unavailable stubs,
+// the unions of the types package, the mock helpers of the secatest package and
+// the resource kinds of the seca command, from the code generated from the spec under pkg/spec.
+//
+// Run it from the tools directory, as the Makefile does:
+//
+//	go run ./secagen -root ..
+package main
+
+import (
+	"bytes"
+	"embed"
+	"flag"
+	"fmt"
+	"go/format"
+	"os"
+	"path/filepath"
+	"slices"
+	"strings"
+	"text/template"
+)
+
+//go:embed templates/*.tmpl
+var templatesFS embed.FS
+
+var templates = template.Must(template.New("").Funcs(template.FuncMap{
+	"anything": func(n int) string {
+		return strings.TrimSuffix(strings.Repeat("mock.Anything, ", n), ", ")
+	},
+	"join": strings.Join,
+	"lowerFirst": func(s string) string {
+		return strings.ToLower(s[:1]) + s[1:]
+	},
+}).ParseFS(templatesFS, "templates/*.tmpl"))
+
+func main() {
+	root := flag.String("root", ".", "root directory of the go-sdk module")
+	check := flag.Bool("check", false, "fails when a generated file is not up to date, instead of writing it")
+	flag.Parse()
+
+	if err := run(*root, *check); err != nil {
+		fmt.Fprintln(os.Stderr, "secagen:", err)
+		os.Exit(1)
+	}
+}
+
+func run(root string, check bool) error {
+	files, err := generate(root)
+	if err != nil {
+		return err
+	}
+
+	for _, name := range sortedKeys(files) {
+		path := filepath.Join(root, name)
+		if check {
+			current, err := os.ReadFile(path)
+			if err != nil {
+				return err
+			}
+			if !bytes.Equal(current, files[name]) {
+				return fmt.Errorf("%s is not up to date", name)
+			}
+			continue
+		}
+
+		if err := os.WriteFile(path, files[name], 0o644); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// Generates the files, by path relative to the root
+func generate(root string) (map[string][]byte, error) {
+	schemas, err := parseSchemaTypes(filepath.Join(root, "pkg", "spec", "schema"))
+	if err != nil {
+		return nil, err
+	}
+
+	files := map[string][]byte{}
+	var all []*provider
+	for _, config := range providers {
+		p, err := buildProvider(root, config, schemas)
+		if err != nil {
+			return nil, err
+		}
+		all = append(all, p)
+
+		name := strings.ToLower(strings.TrimSuffix(p.Interface, p.Version)) + "_" + strings.ToLower(p.Version)
+
+		if files[filepath.Join("secapi", "zz_generated."+name+".go")], err = execute("secapi.go.tmpl", p); err != nil {
+			return nil, err
+		}
+
+		// The discovery endpoints out of a tenant return URLs, their mocks are written by hand
+		if slices.ContainsFunc(p.Resources, func(r *resource) bool { return r.Scope.Ref != "" }) {
+			if files[filepath.Join("internal", "secatest", "zz_generated.mocks_"+name+".go")], err = execute("secatest.go.tmpl", p); err != nil {
+				return nil, err
+			}
+		}
+	}
+
+	unions, err := buildUnions(all, schemas)
+	if err != nil {
+		return nil, err
+	}
+	if files[filepath.Join("pkg", "types", "zz_generated.types.go")], err = execute("types.go.tmpl", unions); err != nil {
+		return nil, err
+	}
+
+	if files[filepath.Join("cmd", "seca", "zz_generated.kinds.go")], err = execute("seca.go.tmpl", all); err != nil {
+		return nil, err
+	}
+
+	return files, nil
+}
+
+func execute(name string, data any) ([]byte, error) {
+	var buf bytes.Buffer
+	if err := templates.ExecuteTemplate(&buf, name, data); err != nil {
+		return nil, err
+	}
+
+	source, err := format.Source(buf.Bytes())
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", name, err)
+	}
+	return source, nil
+}
+
+func sortedKeys[V any](m map[string]V) []string {
+	keys := make([]string, 0, len(m))
+	for key := range m {
+		keys = append(keys, key)
+	}
+	slices.Sort(keys)
+	return keys
+}
@@ -0,0 +1,85 @@
+package main
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+// The generated files are committed, they must match the output of the generator
+func TestGenerate_UpToDate(t *testing.T) {
+	root := filepath.Join("..", "..")
+
+	files, err := generate(root)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	for _, name := range sortedKeys(files) {
+		current, err := os.ReadFile(filepath.Join(root, name))
+		if err != nil {
+			t.Fatal(err)
+		}
+		if string(current) != string(files[name]) {
+			t.Errorf("%s is not up to date, run make secapi", name)
+		}
+	}
+}
+
+func TestBuildProvider(t *testing.T) {
+	root := filepath.Join("..", "..")
+
+	schemas, err := parseSchemaTypes(filepath.Join(root, "pkg", "spec", "schema"))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	p, err := buildProvider(root, providerConfig{
+		Package:   "foundation.compute.v1",
+		Alias:     "compute",
+		Interface: "ComputeV1",
+		Client:    "RegionalClient",
+		Resources: []resourceConfig{{Type: "Instance", Var: "inst"}},
+	}, schemas)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	r := p.Resources[0]
+	if r.Scope.Ref != "WorkspaceReference" || r.Validator != "validateWorkspaceMetadata" || r.PowerState != "InstanceStatusPowerState" {
+		t.Errorf("unexpected instance resource: %+v", r)
+	}
+	if len(r.Actions) != 3 {
+		t.Errorf("expected the restart, start and stop actions, got %d", len(r.Actions))
+	}
+
+	_, err = buildProvider(root, providerConfig{
+		Package:   "foundation.compute.v1",
+		Alias:     "compute",
+		Interface: "ComputeV1",
+		Resources: []resourceConfig{{Type: "Instance"}},
+	}, schemas)
+	if err == nil {
+		t.Error("expected an error for a writable resource without variable name")
+	}
+}
+
+func TestNames(t *testing.T) {
+	for _, tc := range []struct{ name, title, plural string }{
+		{"SecurityGroupRule", "Security Group Rule", "SecurityGroupRules"},
+		{"PublicIp", "Public Ip", "PublicIps"},
+		{"NetworkPolicy", "Network Policy", "NetworkPolicies"},
+		{"ObjectStorageAccess", "Object Storage Access", "ObjectStorageAccesses"},
+	} {
+		if got := title(tc.name); got != tc.title {
+			t.Errorf("title(%s) = %s", tc.name, got)
+		}
+		if got := plural(tc.name); got != tc.plural {
+			t.Errorf("plural(%s) = %s", tc.name, got)
+		}
+	}
+
+	if got := versionSuffix("KubernetesV1Beta1"); got != "V1Beta1" {
+		t.Errorf("versionSuffix(KubernetesV1Beta1) = %s", got)
+	}
+}
@@ -0,0 +1,377 @@
+package main
+
+import (
+	"cmp"
+	"fmt"
+	"path/filepath"
+	"regexp"
+	"strings"
+)
+
+// Path parameters of the generated clients, in the order of the paths
+var pathParams = []struct {
+	typ   string
+	field string
+}{
+	{"TenantPathParam", "Tenant"},
+	{"WorkspacePathParam", "Workspace"},
+	{"NetworkPathParam", "Network"},
+}
+
+// Scopes of the resources, by their innermost path parameter
+var scopes = map[string]scope{
+	"":          {Name: "Global"},
+	"Tenant":    {Name: "Tenant", Path: "TenantPath", PathVar: "tpath", Ref: "TenantReference", RefVar: "tref"},
+	"Workspace": {Name: "Workspace", Path: "WorkspacePath", PathVar: "wpath", Ref: "WorkspaceReference", RefVar: "wref"},
+	"Network":   {Name: "Network", Path: "NetworkPath", PathVar: "npath", Ref: "NetworkReference", RefVar: "nref"},
+}
+
+// Validation methods of the API struct, by metadata type
+var metadataValidators = map[string]string{
+	"GlobalTenantResourceMetadata":      "validateGlobalMetadata",
+	"RegionalResourceMetadata":          "validateRegionalMetadata",
+	"RegionalWorkspaceResourceMetadata": "validateWorkspaceMetadata",
+	"RegionalNetworkResourceMetadata":   "validateNetworkMetadata",
+}
+
+type scope struct {
+	Name    string
+	Path    string
+	PathVar string
+	Ref     string
+	RefVar  string
+}
+
+type provider struct {
+	providerConfig
+
+	// Version suffix of the names, as V1
+	Version string
+
+	// Name of the package of the mocks of the server interface, as mocknetwork
+	MockAlias string
+
+	Resources []*resource
+}
+
+type resource struct {
+	resourceConfig
+
+	// Words of the type, as Security Group
+	Title  string
+	Plural string
+
+	// Names of the resource in the secatest helpers
+	ListMock string
+	GetMock  string
+
+	Scope scope
+
+	// Arguments of the client calls before the params, each one followed by a comma
+	PathArgs     string
+	RefArgs      string
+	MetadataArgs string
+
+	Iterator   string
+	Metadata   string
+	Spec       string
+	Status     string
+	Validator  string
+	PowerState string
+
+	List           *operation
+	Get            *operation
+	CreateOrUpdate *operation
+	Delete         *operation
+	Actions        []*operation
+
+	Methods []*method
+}
+
+// Writable resources have a status, and are created, updated and deleted
+func (r *resource) Writable() bool {
+	return r.CreateOrUpdate != nil
+}
+
+type operation struct {
+	Name string
+
+	// Parameters of the server method, as written in the secatest package
+	ServerParams string
+	ServerArgs   int
+}
+
+// Method of the secapi interface
+type method struct {
+	Kind    string
+	Name    string
+	Params  string
+	Results string
+
+	// Whether the method starts a group in the interface
+	Group bool
+
+	Resource *resource
+	Op       *operation
+
+	// Observed value of the until methods
+	ValueType  string
+	ValueField string
+
+	// Check of the status code of the delete and action methods
+	Check string
+}
+
+// Returns the values returned when the provider is not available
+func (m *method) Unavailable() string {
+	if m.Results == "error" {
+		return "ErrProviderNotAvailable"
+	}
+	return "nil, ErrProviderNotAvailable"
+}
+
+// Builds the model of a provider from the code generated from its spec
+func buildProvider(root string, config providerConfig, schemas *schemaTypes) (*provider, error) {
+	api, err := parseSpecAPI(filepath.Join(root, "pkg", "spec", config.Package, "api.go"))
+	if err != nil {
+		return nil, err
+	}
+
+	p := &provider{
+		providerConfig: config,
+		Version:        versionSuffix(config.Interface),
+		MockAlias:      "mock" + config.Alias,
+	}
+
+	for _, rc := range config.Resources {
+		r, err := buildResource(p, rc, api, schemas)
+		if err != nil {
+			return nil, fmt.Errorf("%s %s: %w", config.Interface, rc.Type, err)
+		}
+		p.Resources = append(p.Resources, r)
+	}
+
+	return p, nil
+}
+
+func buildResource(p *provider, config resourceConfig, api *specAPI, schemas *schemaTypes) (*resource, error) {
+	r := &resource{
+		resourceConfig: config,
+		Title:          title(config.Type),
+		Plural:         plural(config.Type),
+		ListMock:       cmp.Or(config.MockList, plural(config.Type)),
+		GetMock:        cmp.Or(config.MockGet, config.Type),
+		Metadata:       schemas.field(config.Type, "Metadata"),
+		Spec:           schemas.field(config.Type, "Spec"),
+		Status:         schemas.resolve(schemas.field(config.Type, "Status")),
+	}
+	if _, ok := schemas.structs[config.Type]; !ok {
+		return nil, fmt.Errorf("unknown schema type")
+	}
+
+	// Finds the operations by the types of their responses
+	var get, list *specOperation
+	for _, op := range api.operations {
+		response := api.responses[op.Name]
+		switch {
+		case strings.HasPrefix(op.Name, "Get") && response == config.Type:
+			get = op
+		case strings.HasPrefix(op.Name, "List") && response != "" && api.iterators[response] == config.Type:
+			list = op
+			r.Iterator = response
+		}
+	}
+	if get == nil {
+		return nil, fmt.Errorf("no get operation")
+	}
+	suffix := strings.TrimPrefix(get.Name, "Get")
+
+	// Scope of the resource
+	var fields []string
+	named := false
+	for _, param := range get.Client {
+		switch typ := strings.TrimPrefix(param.Type, schemaQualifier); typ {
+		case "ResourcePathParam":
+			named = true
+		default:
+			for _, pp := range pathParams {
+				if pp.typ == typ {
+					fields = append(fields, pp.field)
+				}
+			}
+		}
+	}
+
+	innermost := ""
+	if len(fields) > 0 {
+		innermost = fields[len(fields)-1]
+	}
+	r.Scope = scopes[innermost]
+
+	for _, field := range fields {
+		r.PathArgs += fmt.Sprintf("schema.%sPathParam(%s.%s), ", field, r.Scope.PathVar, field)
+		r.RefArgs += fmt.Sprintf("schema.%sPathParam(%s.%s), ", field, r.Scope.RefVar, field)
+		r.MetadataArgs += fmt.Sprintf("%s.Metadata.%s, ", config.Var, field)
+	}
+	switch {
+	case named && r.Scope.Ref != "":
+		r.RefArgs += r.Scope.RefVar + ".Name, "
+	case named:
+		r.RefArgs += "name, "
+	}
+	r.MetadataArgs += config.Var + ".Metadata.Name, "
+
+	// Operations
+	r.Get = newOperation(p, get)
+	if list != nil {
+		r.List = newOperation(p, list)
+	}
+	for _, op := range api.operations {
+		if !strings.HasSuffix(op.Name, suffix) || op == get {
+			continue
+		}
+
+		switch verb := strings.TrimSuffix(op.Name, suffix); verb {
+		case "CreateOrUpdate":
+			r.CreateOrUpdate = newOperation(p, op)
+		case "Delete":
+			r.Delete = newOperation(p, op)
+		default:
+			// Actions are the other operations on a resource, as StartInstance
+			if regexp.MustCompile(`^[A-Z][a-z]+$`).MatchString(verb) && api.responses[op.Name] == "" {
+				r.Actions = append(r.Actions, newOperation(p, op))
+			}
+		}
+	}
+
+	if r.Writable() {
+		if config.Var == "" {
+			return nil, fmt.Errorf("no variable name of the writable resource")
+		}
+		if r.Delete == nil {
+			return nil, fmt.Errorf("no delete operation")
+		}
+		if r.Scope.Ref == "" {
+			return nil, fmt.Errorf("writable resource out of a tenant")
+		}
+		if r.Validator = metadataValidators[r.Metadata]; r.Validator == "" {
+			return nil, fmt.Errorf("no validation of the metadata %q", r.Metadata)
+		}
+		if r.Status == "" || schemas.field(r.Status, "State") == "" {
+			return nil, fmt.Errorf("no state in the status")
+		}
+		r.PowerState = schemas.field(r.Status, "PowerState")
+	}
+
+	r.Methods = buildMethods(p, r, named)
+	return r, nil
+}
+
+func newOperation(p *provider, op *specOperation) *operation {
+	params := make([]string, 0, len(op.Server))
+	for _, param := range op.Server {
+		typ := param.Type
+		if strings.HasPrefix(typ, schemaQualifier) {
+			typ = "schema." + strings.TrimPrefix(typ, schemaQualifier)
+		} else if strings.HasSuffix(typ, "Params") {
+			typ = p.Alias + "." + typ
+		}
+		// Avoids shadowing the package of the provider, as network
+		name := param.Name
+		if name == p.Alias {
+			name += "Path"
+		}
+		params = append(params, name+" "+typ)
+	}
+
+	return &operation{
+		Name:         op.Name,
+		ServerParams: strings.Join(params, ", "),
+		ServerArgs:   len(op.Server) + 2,
+	}
+}
+
+// Builds the methods of the secapi interface of a resource
+func buildMethods(p *provider, r *resource, named bool) []*method {
+	typ := "schema." + r.Type
+	ctx := "ctx context.Context"
+
+	path := ""
+	if r.Scope.Path != "" {
+		path = fmt.Sprintf(", %s %s", r.Scope.PathVar, r.Scope.Path)
+	}
+
+	ref := ""
+	switch {
+	case r.Scope.Ref != "":
+		ref = fmt.Sprintf(", %s %s", r.Scope.RefVar, r.Scope.Ref)
+	case named:
+		ref = ", name string"
+	}
+
+	var methods []*method
+	add := func(m *method) {
+		m.Resource = r
+		methods = append(methods, m)
+	}
+
+	if r.List != nil {
+		add(&method{Kind: "listWithOptions", Name: r.List.Name + "WithOptions", Params: ctx + path + ", options *ListOptions", Results: fmt.Sprintf("(*Iterator[%s], error)", typ), Group: true, Op: r.List})
+		add(&method{Kind: "list", Name: r.List.Name, Params: ctx + path, Results: fmt.Sprintf("(*Iterator[%s], error)", typ), Op: r.List})
+	}
+
+	add(&method{Kind: "get", Name: r.Get.Name, Params: ctx + ref, Results: fmt.Sprintf("(*%s, error)", typ), Group: true, Op: r.Get})
+	if !r.Writable() {
+		return methods
+	}
+
+	add(&method{Kind: "until", Name: r.Get.Name + "UntilState", Params: ctx + ref + ", config ResourceObserverUntilValueConfig[schema.ResourceState]", Results: fmt.Sprintf("(*%s, error)", typ), Op: r.Get,
+		ValueType: "schema.ResourceState", ValueField: "State"})
+	if r.PowerState != "" {
+		valueType := "schema." + r.PowerState
+		add(&method{Kind: "until", Name: r.Get.Name + "UntilPowerState", Params: ctx + ref + fmt.Sprintf(", config ResourceObserverUntilValueConfig[%s]", valueType), Results: fmt.Sprintf("(*%s, error)", typ), Op: r.Get,
+			ValueType: valueType, ValueField: "PowerState"})
+	}
+	add(&method{Kind: "watchDeleted", Name: "Watch" + r.Type + "UntilDeleted", Params: ctx + ref + ", config ResourceObserverConfig", Results: "error", Group: true, Op: r.Get})
+
+	resourceParam := fmt.Sprintf("%s, %s *%s", ctx, r.Var, typ)
+	withParams := func(op *operation) string {
+		return fmt.Sprintf("%s, params *%s.%sParams", resourceParam, p.Alias, op.Name)
+	}
+
+	add(&method{Kind: "putWithParams", Name: r.CreateOrUpdate.Name + "WithParams", Params: withParams(r.CreateOrUpdate), Results: fmt.Sprintf("(*%s, error)", typ), Group: true, Op: r.CreateOrUpdate})
+	add(&method{Kind: "put", Name: r.CreateOrUpdate.Name, Params: resourceParam, Results: fmt.Sprintf("(*%s, error)", typ), Op: r.CreateOrUpdate})
+
+	for _, op := range append([]*operation{r.Delete}, r.Actions...) {
+		check := "checkSuccessPostStatusCode"
+		if op == r.Delete {
+			check = "checkSuccessDeleteStatusCode"
+		}
+
+		add(&method{Kind: "postWithParams", Name: op.Name + "WithParams", Params: withParams(op), Results: "error", Group: true, Op: op, Check: check})
+		add(&method{Kind: "post", Name: op.Name, Params: resourceParam, Results: "error", Op: op})
+	}
+
+	return methods
+}
+
+// Returns the version suffix of an interface name, as V1 or V1Beta1
+func versionSuffix(name string) string {
+	return regexp.MustCompile(`V\d+(Beta\d+|Alpha\d+)?$`).FindString(name)
+}
+
+// Splits a type name in words, as Security Group Rule
+func title(name string) string {
+	return regexp.MustCompile(`([a-z0-9])([A-Z])`).ReplaceAllString(name, "$1 $2")
+}
+
+func plural(name string) string {
+	switch {
+	case strings.HasSuffix(name, "s"):
+		return name + "es"
+	case strings.HasSuffix(name, "y") && !strings.ContainsAny(name[len(name)-2:len(name)-1], "aeiou"):
+		return name[:len(name)-1] + "ies"
+	default:
+		return name + "s"
+	}
+}
@@ -0,0 +1,209 @@
+package main
+
+import (
+	"fmt"
+	"go/ast"
+	"go/parser"
+	"go/token"
+	"go/types"
+	"path/filepath"
+	"strings"
+)
+
+// Prefix of the schema types in the packages generated by oapi-codegen
+const schemaQualifier = "externalRef0."
+
+type param struct {
+	Name string
+	Type string
+}
+
+// Operation of a generated client, as GetNetwork
+type specOperation struct {
+	Name string
+
+	// Parameters of the client method, without the context and the request editors
+	Client []param
+
+	// Parameters of the server method, without the response writer and the request
+	Server []param
+}
+
+// API generated by oapi-codegen from the spec of a provider
+type specAPI struct {
+	operations []*specOperation
+
+	// Types of the JSON200 bodies of the responses, by operation
+	responses map[string]string
+
+	// Types of the items of the iterators, by iterator
+	iterators map[string]string
+}
+
+func (api *specAPI) operation(name string) *specOperation {
+	for _, op := range api.operations {
+		if op.Name == name {
+			return op
+		}
+	}
+	return nil
+}
+
+// Parses the api.go file generated for a provider
+func parseSpecAPI(path string) (*specAPI, error) {
+	file, err := parser.ParseFile(token.NewFileSet(), path, nil, parser.SkipObjectResolution)
+	if err != nil {
+		return nil, err
+	}
+
+	api := &specAPI{responses: map[string]string{}, iterators: map[string]string{}}
+	server := map[string][]param{}
+
+	for _, spec := range typeSpecs(file) {
+		name := spec.Name.Name
+
+		switch t := spec.Type.(type) {
+		case *ast.InterfaceType:
+			switch name {
+			case "ClientWithResponsesInterface":
+				for _, m := range t.Methods.List {
+					method := m.Names[0].Name
+					if !strings.HasSuffix(method, "WithResponse") || strings.HasSuffix(method, "WithBodyWithResponse") {
+						continue
+					}
+
+					params := funcParams(m.Type.(*ast.FuncType))
+					api.operations = append(api.operations, &specOperation{
+						Name: strings.TrimSuffix(method, "WithResponse"),
+						// Drops the context and the request editors
+						Client: params[1 : len(params)-1],
+					})
+				}
+			case "ServerInterface":
+				for _, m := range t.Methods.List {
+					// Drops the response writer and the request
+					server[m.Names[0].Name] = funcParams(m.Type.(*ast.FuncType))[2:]
+				}
+			}
+
+		case *ast.StructType:
+			for _, field := range t.Fields.List {
+				if len(field.Names) != 1 {
+					continue
+				}
+
+				switch {
+				case strings.HasSuffix(name, "Response") && field.Names[0].Name == "JSON200":
+					api.responses[strings.TrimSuffix(name, "Response")] = typeName(field.Type)
+				case strings.HasSuffix(name, "Iterator") && field.Names[0].Name == "Items":
+					if array, ok := field.Type.(*ast.ArrayType); ok {
+						api.iterators[name] = typeName(array.Elt)
+					}
+				}
+			}
+		}
+	}
+
+	for _, op := range api.operations {
+		params, ok := server[op.Name]
+		if !ok {
+			return nil, fmt.Errorf("%s: operation %s has no server method", path, op.Name)
+		}
+		op.Server = params
+	}
+
+	return api, nil
+}
+
+// Struct of the schema package
+type schemaStruct struct {
+	fields map[string]string
+}
+
+type schemaTypes struct {
+	structs map[string]*schemaStruct
+	aliases map[string]string
+}
+
+// Resolves the aliases, as RoleStatus to Status
+func (s *schemaTypes) resolve(name string) string {
+	for {
+		target, ok := s.aliases[name]
+		if !ok {
+			return name
+		}
+		name = target
+	}
+}
+
+func (s *schemaTypes) field(typeName, field string) string {
+	st, ok := s.structs[s.resolve(typeName)]
+	if !ok {
+		return ""
+	}
+	return st.fields[field]
+}
+
+// Parses the schema package generated for the spec schemas
+func parseSchemaTypes(dir string) (*schemaTypes, error) {
+	paths, err := filepath.Glob(filepath.Join(dir, "*.go"))
+	if err != nil {
+		return nil, err
+	}
+
+	s := &schemaTypes{structs: map[string]*schemaStruct{}, aliases: map[string]string{}}
+	for _, path := range paths {
+		file, err := parser.ParseFile(token.NewFileSet(), path, nil, parser.SkipObjectResolution)
+		if err != nil {
+			return nil, err
+		}
+
+		for _, spec := range typeSpecs(file) {
+			switch t := spec.Type.(type) {
+			case *ast.StructType:
+				st := &schemaStruct{fields: map[string]string{}}
+				for _, field := range t.Fields.List {
+					for _, name := range field.Names {
+						st.fields[name.Name] = typeName(field.Type)
+					}
+				}
+				s.structs[spec.Name.Name] = st
+			case *ast.Ident:
+				if spec.Assign.IsValid() {
+					s.aliases[spec.Name.Name] = t.Name
+				}
+			}
+		}
+	}
+
+	return s, nil
+}
+
+func typeSpecs(file *ast.File) []*ast.TypeSpec {
+	var specs []*ast.TypeSpec
+	for _, decl := range file.Decls {
+		gen, ok := decl.(*ast.GenDecl)
+		if !ok || gen.Tok != token.TYPE {
+			continue
+		}
+		for _, spec := range gen.Specs {
+			specs = append(specs, spec.(*ast.TypeSpec))
+		}
+	}
+	return specs
+}
+
+func funcParams(fn *ast.FuncType) []param {
+	var params []param
+	for _, field := range fn.Params.List {
+		for _, name := range field.Names {
+			params = append(params, param{Name: name.Name, Type: types.ExprString(field.Type)})
+		}
+	}
+	return params
+}
+
+// Returns the name of a type, without its pointer and its schema qualifier
+func typeName(expr ast.Expr) string {
+	return strings.TrimPrefix(strings.TrimPrefix(types.ExprString(expr), "*"), schemaQualifier)
+}
@@ -0,0 +1,75 @@
+package main
+
+import (
+	"fmt"
+	"slices"
+)
+
+// Unions of the types package
+type unions struct {
+	Imports []*provider
+
+	Resources []string
+	Metadata  []string
+	Specs     []string
+	Statuses  []string
+	Iterators []string
+
+	PowerState *powerState
+}
+
+type powerState struct {
+	Type     string
+	Statuses []string
+}
+
+func buildUnions(providers []*provider, schemas *schemaTypes) (*unions, error) {
+	u := &unions{}
+
+	add := func(items *[]string, item string) {
+		if !slices.Contains(*items, item) {
+			*items = append(*items, item)
+		}
+	}
+
+	for _, p := range providers {
+		imported := false
+		for _, r := range p.Resources {
+			// Only the resources have metadata, as opposed to the wellknown document
+			if r.Metadata == "" {
+				continue
+			}
+
+			add(&u.Resources, "schema."+r.Type)
+			add(&u.Metadata, "schema."+r.Metadata)
+			add(&u.Specs, "schema."+r.Spec)
+
+			if !r.Writable() {
+				continue
+			}
+
+			if schemas.field(r.Status, "Conditions") == "" {
+				return nil, fmt.Errorf("%s: no conditions in the status %s", r.Type, r.Status)
+			}
+			add(&u.Statuses, "schema."+r.Status)
+			add(&u.Iterators, p.Alias+"."+r.Iterator)
+			if !imported {
+				u.Imports = append(u.Imports, p)
+				imported = true
+			}
+
+			if r.PowerState == "" {
+				continue
+			}
+			if u.PowerState == nil {
+				u.PowerState = &powerState{Type: r.PowerState}
+			}
+			if u.PowerState.Type != r.PowerState {
+				return nil, fmt.Errorf("%s: power state %s differs from %s", r.Type, r.PowerState, u.PowerState.Type)
+			}
+			add(&u.PowerState.Statuses, "schema."+r.Status)
+		}
+	}
+
+	return u, nil
+}